@@ -0,0 +1,34 @@
+// Package api holds the OpenAPI 3 document that is the contract for the
+// gateway's REST API. The request validator in internal/middleware checks
+// traffic against it and the Go client in api/client is generated from it.
+package api
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config client/oapi-codegen.yaml openapi.yaml
+
+//go:embed openapi.yaml
+var spec []byte
+
+// Spec returns the document as written, for serving to API consumers
+func Spec() []byte {
+	return spec
+}
+
+// Load parses the document and checks that it is valid OpenAPI 3.
+func Load() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %w", err)
+	}
+	return doc, nil
+}
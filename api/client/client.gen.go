@@ -0,0 +1,14979 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyAuthScopes      = "ApiKeyAuth.Scopes"
+	BearerAuthScopes      = "BearerAuth.Scopes"
+	StreamTokenAuthScopes = "StreamTokenAuth.Scopes"
+	SupportKeyAuthScopes  = "SupportKeyAuth.Scopes"
+)
+
+// Defines values for AuditActorType.
+const (
+	AuditActorTypeAttendee  AuditActorType = "attendee"
+	AuditActorTypeOrganizer AuditActorType = "organizer"
+	AuditActorTypePlatform  AuditActorType = "platform"
+	AuditActorTypeSupport   AuditActorType = "support"
+	AuditActorTypeSystem    AuditActorType = "system"
+)
+
+// Defines values for CheckoutKind.
+const (
+	Link    CheckoutKind = "link"
+	Session CheckoutKind = "session"
+)
+
+// Defines values for CheckoutStatus.
+const (
+	CheckoutStatusCancelled  CheckoutStatus = "cancelled"
+	CheckoutStatusCompleted  CheckoutStatus = "completed"
+	CheckoutStatusExpired    CheckoutStatus = "expired"
+	CheckoutStatusOpen       CheckoutStatus = "open"
+	CheckoutStatusProcessing CheckoutStatus = "processing"
+)
+
+// Defines values for DiscrepancyType.
+const (
+	AmountMismatch DiscrepancyType = "amount_mismatch"
+	MissingLocal   DiscrepancyType = "missing_local"
+	MissingStripe  DiscrepancyType = "missing_stripe"
+	StatusMismatch DiscrepancyType = "status_mismatch"
+)
+
+// Defines values for ErrorCode.
+const (
+	AuthenticationRequired     ErrorCode = "authentication_required"
+	CaptchaRequired            ErrorCode = "captcha_required"
+	CardDeclined               ErrorCode = "card_declined"
+	CardValidationFailed       ErrorCode = "card_validation_failed"
+	CheckoutExpired            ErrorCode = "checkout_expired"
+	CheckoutNotFound           ErrorCode = "checkout_not_found"
+	CheckoutNotOpen            ErrorCode = "checkout_not_open"
+	CustomerNotFound           ErrorCode = "customer_not_found"
+	DisputeNotFound            ErrorCode = "dispute_not_found"
+	DisputeNotOpen             ErrorCode = "dispute_not_open"
+	ExpiredCard                ErrorCode = "expired_card"
+	FeePlanNotFound            ErrorCode = "fee_plan_not_found"
+	Forbidden                  ErrorCode = "forbidden"
+	IncorrectCvc               ErrorCode = "incorrect_cvc"
+	IncorrectNumber            ErrorCode = "incorrect_number"
+	InsufficientFunds          ErrorCode = "insufficient_funds"
+	InternalError              ErrorCode = "internal_error"
+	InvalidBan                 ErrorCode = "invalid_ban"
+	InvalidCardToken           ErrorCode = "invalid_card_token"
+	InvalidCheckout            ErrorCode = "invalid_checkout"
+	InvalidCredentials         ErrorCode = "invalid_credentials"
+	InvalidCurrency            ErrorCode = "invalid_currency"
+	InvalidExpiry              ErrorCode = "invalid_expiry"
+	InvalidFeePlan             ErrorCode = "invalid_fee_plan"
+	InvalidLineItems           ErrorCode = "invalid_line_items"
+	InvalidOrganizer           ErrorCode = "invalid_organizer"
+	InvalidPaymentStatus       ErrorCode = "invalid_payment_status"
+	InvalidReconciliationRange ErrorCode = "invalid_reconciliation_range"
+	InvalidRefundAmount        ErrorCode = "invalid_refund_amount"
+	InvalidReplay              ErrorCode = "invalid_replay"
+	InvalidRequest             ErrorCode = "invalid_request"
+	InvalidSearch              ErrorCode = "invalid_search"
+	InvalidWebhook             ErrorCode = "invalid_webhook"
+	InvalidWebhookEndpoint     ErrorCode = "invalid_webhook_endpoint"
+	NoDefaultPaymentMethod     ErrorCode = "no_default_payment_method"
+	NotFound                   ErrorCode = "not_found"
+	NotStripePayment           ErrorCode = "not_stripe_payment"
+	OrganizerInactive          ErrorCode = "organizer_inactive"
+	OrganizerNotFound          ErrorCode = "organizer_not_found"
+	OrganizerRequired          ErrorCode = "organizer_required"
+	OtpExpired                 ErrorCode = "otp_expired"
+	OtpInvalid                 ErrorCode = "otp_invalid"
+	OtpNotApplicable           ErrorCode = "otp_not_applicable"
+	PaymentDeclined            ErrorCode = "payment_declined"
+	PaymentMethodNotFound      ErrorCode = "payment_method_not_found"
+	PaymentNotCancelable       ErrorCode = "payment_not_cancelable"
+	PaymentNotFound            ErrorCode = "payment_not_found"
+	PaymentNotRefundable       ErrorCode = "payment_not_refundable"
+	PayoutNotFound             ErrorCode = "payout_not_found"
+	PayoutNotPending           ErrorCode = "payout_not_pending"
+	ProcessingError            ErrorCode = "processing_error"
+	ProviderUnavailable        ErrorCode = "provider_unavailable"
+	RateLimited                ErrorCode = "rate_limited"
+	RawCardData                ErrorCode = "raw_card_data"
+	ReasonRequired             ErrorCode = "reason_required"
+	ReconciliationNotFound     ErrorCode = "reconciliation_not_found"
+	RequestTooLarge            ErrorCode = "request_too_large"
+	ReviewExpired              ErrorCode = "review_expired"
+	ReviewNotFound             ErrorCode = "review_not_found"
+	ReviewNotPending           ErrorCode = "review_not_pending"
+	ServiceUnavailable         ErrorCode = "service_unavailable"
+	Timeout                    ErrorCode = "timeout"
+	Unauthenticated            ErrorCode = "unauthenticated"
+	WebhookDeliveryNotFound    ErrorCode = "webhook_delivery_not_found"
+	WebhookEndpointDisabled    ErrorCode = "webhook_endpoint_disabled"
+	WebhookEndpointNotFound    ErrorCode = "webhook_endpoint_not_found"
+	WebhookNotConfigured       ErrorCode = "webhook_not_configured"
+)
+
+// Defines values for FeeLineKind.
+const (
+	PlatformCommission         FeeLineKind = "platform_commission"
+	PlatformCommissionReversal FeeLineKind = "platform_commission_reversal"
+)
+
+// Defines values for LedgerAccount.
+const (
+	CustomerClearing LedgerAccount = "customer_clearing"
+	Disputes         LedgerAccount = "disputes"
+	OrganizerPayable LedgerAccount = "organizer_payable"
+	PlatformRevenue  LedgerAccount = "platform_revenue"
+	ProcessorFees    LedgerAccount = "processor_fees"
+	Refunds          LedgerAccount = "refunds"
+)
+
+// Defines values for LineItemKind.
+const (
+	Fee    LineItemKind = "fee"
+	Tax    LineItemKind = "tax"
+	Ticket LineItemKind = "ticket"
+)
+
+// Defines values for OrganizerStatus.
+const (
+	Active    OrganizerStatus = "active"
+	Suspended OrganizerStatus = "suspended"
+)
+
+// Defines values for PaymentStatus.
+const (
+	PaymentStatusCancelled PaymentStatus = "cancelled"
+	PaymentStatusDisputed  PaymentStatus = "disputed"
+	PaymentStatusFailed    PaymentStatus = "failed"
+	PaymentStatusInReview  PaymentStatus = "in_review"
+	PaymentStatusPending   PaymentStatus = "pending"
+	PaymentStatusRefunded  PaymentStatus = "refunded"
+	PaymentStatusSuccess   PaymentStatus = "success"
+)
+
+// Defines values for PayoutMethod.
+const (
+	BankExport     PayoutMethod = "bank_export"
+	StripeTransfer PayoutMethod = "stripe_transfer"
+)
+
+// Defines values for PayoutSchedule.
+const (
+	AfterEvent  PayoutSchedule = "after_event"
+	Daily       PayoutSchedule = "daily"
+	Destination PayoutSchedule = "destination"
+	Manual      PayoutSchedule = "manual"
+	Weekly      PayoutSchedule = "weekly"
+)
+
+// Defines values for PayoutStatus.
+const (
+	PayoutStatusFailed  PayoutStatus = "failed"
+	PayoutStatusPaid    PayoutStatus = "paid"
+	PayoutStatusPending PayoutStatus = "pending"
+)
+
+// Defines values for ReconciliationStatus.
+const (
+	ReconciliationStatusCompleted ReconciliationStatus = "completed"
+	ReconciliationStatusFailed    ReconciliationStatus = "failed"
+	ReconciliationStatusRunning   ReconciliationStatus = "running"
+)
+
+// Defines values for ReplaySource.
+const (
+	History ReplaySource = "history"
+	Log     ReplaySource = "log"
+)
+
+// Defines values for RiskDecision.
+const (
+	Allow  RiskDecision = "allow"
+	Deny   RiskDecision = "deny"
+	Review RiskDecision = "review"
+)
+
+// Defines values for RiskReviewStatus.
+const (
+	RiskReviewStatusApproved RiskReviewStatus = "approved"
+	RiskReviewStatusExpired  RiskReviewStatus = "expired"
+	RiskReviewStatusPending  RiskReviewStatus = "pending"
+	RiskReviewStatusRejected RiskReviewStatus = "rejected"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// AccountBalance defines model for AccountBalance.
+type AccountBalance struct {
+	Account     LedgerAccount `json:"account"`
+	Balance     int64         `json:"balance"`
+	Credits     int64         `json:"credits"`
+	Currency    string        `json:"currency"`
+	Debits      int64         `json:"debits"`
+	OrganizerId *string       `json:"organizer_id,omitempty"`
+}
+
+// AccountBalanceListEnvelope defines model for AccountBalanceListEnvelope.
+type AccountBalanceListEnvelope struct {
+	Data      *[]AccountBalance `json:"data"`
+	Message   string            `json:"message"`
+	Success   bool              `json:"success"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+}
+
+// Address defines model for Address.
+type Address struct {
+	City       *string `json:"city,omitempty"`
+	Country    *string `json:"country,omitempty"`
+	Line1      *string `json:"line1,omitempty"`
+	Line2      *string `json:"line2,omitempty"`
+	PostalCode *string `json:"postal_code,omitempty"`
+	State      *string `json:"state,omitempty"`
+}
+
+// AuditActorType defines model for AuditActorType.
+type AuditActorType string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action      string         `json:"action"`
+	Actor       string         `json:"actor"`
+	ActorType   AuditActorType `json:"actor_type"`
+	After       *interface{}   `json:"after"`
+	Before      *interface{}   `json:"before"`
+	CreatedAt   time.Time      `json:"created_at"`
+	Hash        string         `json:"hash"`
+	OrderId     *string        `json:"order_id,omitempty"`
+	OrganizerId *string        `json:"organizer_id,omitempty"`
+	PaymentId   *string        `json:"payment_id,omitempty"`
+	PrevHash    string         `json:"prev_hash"`
+	RequestId   *string        `json:"request_id,omitempty"`
+	Seq         int64          `json:"seq"`
+	SourceIp    *string        `json:"source_ip,omitempty"`
+	TargetId    string         `json:"target_id"`
+	TargetType  string         `json:"target_type"`
+}
+
+// AuditEntryListEnvelope defines model for AuditEntryListEnvelope.
+type AuditEntryListEnvelope struct {
+	Data      *[]AuditEntry `json:"data"`
+	Message   string        `json:"message"`
+	Success   bool          `json:"success"`
+	Timestamp *time.Time    `json:"timestamp,omitempty"`
+}
+
+// AuditVerification defines model for AuditVerification.
+type AuditVerification struct {
+	BrokenAt   *int64    `json:"broken_at,omitempty"`
+	Checked    int64     `json:"checked"`
+	HeadHash   string    `json:"head_hash"`
+	HeadSeq    int64     `json:"head_seq"`
+	Problem    *string   `json:"problem,omitempty"`
+	Valid      bool      `json:"valid"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+// AuditVerificationEnvelope defines model for AuditVerificationEnvelope.
+type AuditVerificationEnvelope struct {
+	Data      AuditVerification `json:"data"`
+	Message   string            `json:"message"`
+	Success   bool              `json:"success"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+}
+
+// CardDetails Raw card data, accepted only in raw-card test mode
+type CardDetails struct {
+	Address  *Address `json:"address,omitempty"`
+	Cvc      string   `json:"cvc"`
+	ExpMonth string   `json:"exp_month"`
+	ExpYear  string   `json:"exp_year"`
+	Name     *string  `json:"name,omitempty"`
+	Number   string   `json:"number"`
+}
+
+// CardFieldError defines model for CardFieldError.
+type CardFieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// CardValidation defines model for CardValidation.
+type CardValidation struct {
+	CardType *string           `json:"card_type,omitempty"`
+	Errors   *[]CardFieldError `json:"errors,omitempty"`
+	Last4    *string           `json:"last4,omitempty"`
+	Message  *string           `json:"message,omitempty"`
+	Valid    bool              `json:"valid"`
+}
+
+// CardValidationEnvelope defines model for CardValidationEnvelope.
+type CardValidationEnvelope struct {
+	Data      CardValidation `json:"data"`
+	Message   string         `json:"message"`
+	Success   bool           `json:"success"`
+	Timestamp *time.Time     `json:"timestamp,omitempty"`
+}
+
+// CardValidationRequest defines model for CardValidationRequest.
+type CardValidationRequest struct {
+	// CaptchaToken Required once the caller has checked too many cards
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+
+	// Card Raw card data, accepted only in raw-card test mode
+	Card CardDetails `json:"card"`
+}
+
+// CheckoutKind session is a hosted checkout started by the ticketing frontend; link a payment link shared for group bookings and invoices.
+type CheckoutKind string
+
+// CheckoutPage defines model for CheckoutPage.
+type CheckoutPage struct {
+	Amount        float64     `json:"amount"`
+	CancelUrl     *string     `json:"cancel_url,omitempty"`
+	Currency      string      `json:"currency"`
+	Description   *string     `json:"description,omitempty"`
+	Email         *string     `json:"email,omitempty"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+	Id            string      `json:"id"`
+	LineItems     *[]LineItem `json:"line_items"`
+	OrganizerName string      `json:"organizer_name"`
+	Reference     *string     `json:"reference,omitempty"`
+
+	// Status processing means a payment is under way, such as one awaiting 3-D Secure.
+	Status     CheckoutStatus `json:"status"`
+	SuccessUrl *string        `json:"success_url,omitempty"`
+}
+
+// CheckoutPageEnvelope defines model for CheckoutPageEnvelope.
+type CheckoutPageEnvelope struct {
+	Data      CheckoutPage `json:"data"`
+	Message   string       `json:"message"`
+	Success   bool         `json:"success"`
+	Timestamp *time.Time   `json:"timestamp,omitempty"`
+}
+
+// CheckoutPayRequest A Stripe token from Stripe.js, or raw card details in raw-card test mode.
+type CheckoutPayRequest struct {
+	BillingCountry *string `json:"billing_country,omitempty"`
+
+	// CaptchaToken Required once the caller has tried too many cards
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+
+	// Card Raw card data, accepted only in raw-card test mode
+	Card  *CardDetails `json:"card,omitempty"`
+	Email *string      `json:"email,omitempty"`
+
+	// ReturnUrl Where the bank sends the attendee after 3-D Secure
+	ReturnUrl *string `json:"return_url,omitempty"`
+	Token     *string `json:"token,omitempty"`
+}
+
+// CheckoutPayResult defines model for CheckoutPayResult.
+type CheckoutPayResult struct {
+	Checkout CheckoutPage   `json:"checkout"`
+	Payment  *StripePayment `json:"payment,omitempty"`
+}
+
+// CheckoutPayResultEnvelope defines model for CheckoutPayResultEnvelope.
+type CheckoutPayResultEnvelope struct {
+	Data      CheckoutPayResult `json:"data"`
+	Message   string            `json:"message"`
+	Success   bool              `json:"success"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+}
+
+// CheckoutSession defines model for CheckoutSession.
+type CheckoutSession struct {
+	Amount      float64    `json:"amount"`
+	CancelUrl   *string    `json:"cancel_url,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Currency    string     `json:"currency"`
+	Description *string    `json:"description,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	Id          string     `json:"id"`
+
+	// Kind session is a hosted checkout started by the ticketing frontend; link a payment link shared for group bookings and invoices.
+	Kind        CheckoutKind `json:"kind"`
+	LineItems   *[]LineItem  `json:"line_items"`
+	OrderId     string       `json:"order_id"`
+	OrganizerId string       `json:"organizer_id"`
+
+	// PaymentId The latest payment attempt
+	PaymentId *string `json:"payment_id,omitempty"`
+
+	// Reference The organizer's booking or invoice number
+	Reference *string `json:"reference,omitempty"`
+
+	// Status processing means a payment is under way, such as one awaiting 3-D Secure.
+	Status     CheckoutStatus `json:"status"`
+	SuccessUrl *string        `json:"success_url,omitempty"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+
+	// Url The hosted checkout page
+	Url string `json:"url"`
+}
+
+// CheckoutSessionEnvelope defines model for CheckoutSessionEnvelope.
+type CheckoutSessionEnvelope struct {
+	Data      CheckoutSession `json:"data"`
+	Message   string          `json:"message"`
+	Success   bool            `json:"success"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+}
+
+// CheckoutSessionListEnvelope defines model for CheckoutSessionListEnvelope.
+type CheckoutSessionListEnvelope struct {
+	Data      *[]CheckoutSession `json:"data"`
+	Message   string             `json:"message"`
+	Success   bool               `json:"success"`
+	Timestamp *time.Time         `json:"timestamp,omitempty"`
+}
+
+// CheckoutStatus processing means a payment is under way, such as one awaiting 3-D Secure.
+type CheckoutStatus string
+
+// ConfirmPayoutRequest defines model for ConfirmPayoutRequest.
+type ConfirmPayoutRequest struct {
+	// Reference Bank transfer reference
+	Reference string `json:"reference"`
+}
+
+// CreateCheckoutRequest Without order_id the session's own ID is used; without
+// expires_in_minutes the default for the kind applies.
+type CreateCheckoutRequest struct {
+	CancelUrl        *string `json:"cancel_url,omitempty"`
+	Currency         *string `json:"currency,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	Email            *string `json:"email,omitempty"`
+	ExpiresInMinutes *int    `json:"expires_in_minutes,omitempty"`
+
+	// Kind session is a hosted checkout started by the ticketing frontend; link a payment link shared for group bookings and invoices.
+	Kind        *CheckoutKind     `json:"kind,omitempty"`
+	LineItems   []LineItemRequest `json:"line_items"`
+	OrderId     *string           `json:"order_id,omitempty"`
+	OrganizerId *string           `json:"organizer_id,omitempty"`
+	Reference   *string           `json:"reference,omitempty"`
+	SuccessUrl  *string           `json:"success_url,omitempty"`
+}
+
+// CreateOrganizerRequest defines model for CreateOrganizerRequest.
+type CreateOrganizerRequest struct {
+	DefaultCurrency *string             `json:"default_currency,omitempty"`
+	Email           openapi_types.Email `json:"email"`
+	FeePlan         *string             `json:"fee_plan,omitempty"`
+
+	// Id Reuse the ID from the event service
+	Id                 *string         `json:"id,omitempty"`
+	Name               string          `json:"name"`
+	PayoutSchedule     *PayoutSchedule `json:"payout_schedule,omitempty"`
+	SettlementCurrency *string         `json:"settlement_currency,omitempty"`
+	StripeAccountId    *string         `json:"stripe_account_id,omitempty"`
+}
+
+// CreateWebhookEndpointRequest defines model for CreateWebhookEndpointRequest.
+type CreateWebhookEndpointRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes For example payment.success or payment.*
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// OrganizerId Platform only; empty registers a platform endpoint
+	OrganizerId *string `json:"organizer_id,omitempty"`
+	Url         string  `json:"url"`
+}
+
+// Customer defines model for Customer.
+type Customer struct {
+	CreatedAt        time.Time `json:"created_at"`
+	Email            *string   `json:"email,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	StripeCustomerId string    `json:"stripe_customer_id"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	UserId           string    `json:"user_id"`
+}
+
+// CustomerEnvelope defines model for CustomerEnvelope.
+type CustomerEnvelope struct {
+	Data      Customer   `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// CustomerRequest defines model for CustomerRequest.
+type CustomerRequest struct {
+	Email  *string `json:"email,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	UserId string  `json:"user_id"`
+}
+
+// Deleted defines model for Deleted.
+type Deleted struct {
+	Id string `json:"id"`
+}
+
+// DeletedEnvelope defines model for DeletedEnvelope.
+type DeletedEnvelope struct {
+	Data      Deleted    `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// DiscrepancyType defines model for DiscrepancyType.
+type DiscrepancyType string
+
+// Dispute defines model for Dispute.
+type Dispute struct {
+	Amount              float64    `json:"amount"`
+	ChargeId            string     `json:"charge_id"`
+	ClosedAt            *time.Time `json:"closed_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	Currency            string     `json:"currency"`
+	EvidenceDueBy       *time.Time `json:"evidence_due_by,omitempty"`
+	EvidenceSubmittedAt *time.Time `json:"evidence_submitted_at,omitempty"`
+	Id                  string     `json:"id"`
+	OrganizerId         string     `json:"organizer_id"`
+	PaymentId           string     `json:"payment_id"`
+	PaymentIntentId     string     `json:"payment_intent_id"`
+	Reason              string     `json:"reason"`
+
+	// Status Stripe's dispute status, e.g. needs_response, under_review, won or lost
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DisputeEnvelope defines model for DisputeEnvelope.
+type DisputeEnvelope struct {
+	Data      Dispute    `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// DisputeEvidenceRequest defines model for DisputeEvidenceRequest.
+type DisputeEvidenceRequest struct {
+	// AttendanceNotes Anything else that shows the attendee was there
+	AttendanceNotes    *string `json:"attendance_notes,omitempty"`
+	CustomerEmail      *string `json:"customer_email,omitempty"`
+	CustomerName       *string `json:"customer_name,omitempty"`
+	ProductDescription *string `json:"product_description,omitempty"`
+
+	// ServiceDate Event date
+	ServiceDate *string `json:"service_date,omitempty"`
+
+	// Submit false stages the evidence without sending it to the bank
+	Submit *bool `json:"submit,omitempty"`
+
+	// TicketScanLog Entry scans for the disputed tickets
+	TicketScanLog *string `json:"ticket_scan_log,omitempty"`
+}
+
+// DisputeListEnvelope defines model for DisputeListEnvelope.
+type DisputeListEnvelope struct {
+	Data      *[]Dispute `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// Envelope defines model for Envelope.
+type Envelope struct {
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// ErrorCode Stable, machine-readable error code. Codes are never renamed, but new
+// ones may be added; treat unknown codes by their HTTP status.
+type ErrorCode string
+
+// EventLogEntry defines model for EventLogEntry.
+type EventLogEntry struct {
+	CreatedAt   time.Time     `json:"created_at"`
+	Event       *PaymentEvent `json:"event"`
+	Id          string        `json:"id"`
+	OrderId     *string       `json:"order_id,omitempty"`
+	OrganizerId *string       `json:"organizer_id,omitempty"`
+	PaymentId   string        `json:"payment_id"`
+	Published   bool          `json:"published"`
+	Type        string        `json:"type"`
+}
+
+// EventLogListEnvelope defines model for EventLogListEnvelope.
+type EventLogListEnvelope struct {
+	Data      *[]EventLogEntry `json:"data"`
+	Message   string           `json:"message"`
+	Success   bool             `json:"success"`
+	Timestamp *time.Time       `json:"timestamp,omitempty"`
+}
+
+// EventTimeline defines model for EventTimeline.
+type EventTimeline struct {
+	Events    *[]PaymentEvent `json:"events"`
+	PaymentId string          `json:"payment_id"`
+	Source    ReplaySource    `json:"source"`
+}
+
+// EventTimelineEnvelope defines model for EventTimelineEnvelope.
+type EventTimelineEnvelope struct {
+	Data      EventTimeline `json:"data"`
+	Message   string        `json:"message"`
+	Success   bool          `json:"success"`
+	Timestamp *time.Time    `json:"timestamp,omitempty"`
+}
+
+// FXSnapshot defines model for FXSnapshot.
+type FXSnapshot struct {
+	AsOf         time.Time `json:"as_of"`
+	BaseCurrency string    `json:"base_currency"`
+
+	// Rate Units of base_currency per one unit of the transaction currency
+	Rate   float64 `json:"rate"`
+	Source string  `json:"source"`
+}
+
+// FailPayoutRequest defines model for FailPayoutRequest.
+type FailPayoutRequest struct {
+	Reason string `json:"reason"`
+}
+
+// FeeLine defines model for FeeLine.
+type FeeLine struct {
+	Amount    float64     `json:"amount"`
+	CreatedAt time.Time   `json:"created_at"`
+	Id        int64       `json:"id"`
+	Kind      FeeLineKind `json:"kind"`
+	PaymentId string      `json:"payment_id"`
+	PlanId    string      `json:"plan_id"`
+	Reference string      `json:"reference"`
+}
+
+// FeeLineKind defines model for FeeLineKind.
+type FeeLineKind string
+
+// FeeLineListEnvelope defines model for FeeLineListEnvelope.
+type FeeLineListEnvelope struct {
+	Data      *[]FeeLine `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// FeePlan defines model for FeePlan.
+type FeePlan struct {
+	CreatedAt time.Time  `json:"created_at"`
+	Fixed     float64    `json:"fixed"`
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+	Percent   float64    `json:"percent"`
+	Tiers     *[]FeeTier `json:"tiers,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// FeePlanEnvelope defines model for FeePlanEnvelope.
+type FeePlanEnvelope struct {
+	Data      FeePlan    `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// FeePlanListEnvelope defines model for FeePlanListEnvelope.
+type FeePlanListEnvelope struct {
+	Data      *[]FeePlan `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// FeePlanRequest defines model for FeePlanRequest.
+type FeePlanRequest struct {
+	Fixed   *float64   `json:"fixed,omitempty"`
+	Id      string     `json:"id"`
+	Name    string     `json:"name"`
+	Percent *float64   `json:"percent,omitempty"`
+	Tiers   *[]FeeTier `json:"tiers,omitempty"`
+}
+
+// FeeTier defines model for FeeTier.
+type FeeTier struct {
+	Fixed   float64 `json:"fixed"`
+	Percent float64 `json:"percent"`
+	UpTo    float64 `json:"up_to"`
+}
+
+// ForceRefundRequest defines model for ForceRefundRequest.
+type ForceRefundRequest struct {
+	Amount *float64 `json:"amount,omitempty"`
+	Reason string   `json:"reason"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Service   string    `json:"service"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version"`
+}
+
+// IPBan defines model for IPBan.
+type IPBan struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Ip        string    `json:"ip"`
+	Reason    string    `json:"reason"`
+}
+
+// IPBanEnvelope defines model for IPBanEnvelope.
+type IPBanEnvelope struct {
+	Data      IPBan      `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// IPBanListEnvelope defines model for IPBanListEnvelope.
+type IPBanListEnvelope struct {
+	Data      *[]IPBan   `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// IPBanRequest defines model for IPBanRequest.
+type IPBanRequest struct {
+	Ip string `json:"ip"`
+
+	// Minutes Defaults to the configured cooling-off period
+	Minutes *int   `json:"minutes,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// JournalEntry defines model for JournalEntry.
+type JournalEntry struct {
+	Currency    string         `json:"currency"`
+	Description *string        `json:"description,omitempty"`
+	Id          string         `json:"id"`
+	Kind        string         `json:"kind"`
+	Lines       *[]JournalLine `json:"lines"`
+	OrderId     *string        `json:"order_id,omitempty"`
+	PaymentId   *string        `json:"payment_id,omitempty"`
+	PostedAt    time.Time      `json:"posted_at"`
+	Reference   string         `json:"reference"`
+}
+
+// JournalEntryListEnvelope defines model for JournalEntryListEnvelope.
+type JournalEntryListEnvelope struct {
+	Data      *[]JournalEntry `json:"data"`
+	Message   string          `json:"message"`
+	Success   bool            `json:"success"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+}
+
+// JournalLine defines model for JournalLine.
+type JournalLine struct {
+	Account     LedgerAccount `json:"account"`
+	Credit      int64         `json:"credit"`
+	Debit       int64         `json:"debit"`
+	EntryId     string        `json:"entry_id"`
+	Id          int64         `json:"id"`
+	OrganizerId *string       `json:"organizer_id,omitempty"`
+}
+
+// LedgerAccount defines model for LedgerAccount.
+type LedgerAccount string
+
+// LedgerInvariantReport defines model for LedgerInvariantReport.
+type LedgerInvariantReport struct {
+	Balanced          bool      `json:"balanced"`
+	CheckedAt         time.Time `json:"checked_at"`
+	EntriesChecked    int       `json:"entries_checked"`
+	TotalCredits      int64     `json:"total_credits"`
+	TotalDebits       int64     `json:"total_debits"`
+	UnbalancedEntries *[]string `json:"unbalanced_entries,omitempty"`
+}
+
+// LedgerInvariantReportEnvelope defines model for LedgerInvariantReportEnvelope.
+type LedgerInvariantReportEnvelope struct {
+	Data      LedgerInvariantReport `json:"data"`
+	Message   string                `json:"message"`
+	Success   bool                  `json:"success"`
+	Timestamp *time.Time            `json:"timestamp,omitempty"`
+}
+
+// LineItem defines model for LineItem.
+type LineItem struct {
+	// Amount unit_amount times quantity, less discount, plus tax and fee
+	Amount           float64      `json:"amount"`
+	Discount         *float64     `json:"discount,omitempty"`
+	Fee              *float64     `json:"fee,omitempty"`
+	Id               *string      `json:"id,omitempty"`
+	Kind             LineItemKind `json:"kind"`
+	Name             string       `json:"name"`
+	Quantity         int          `json:"quantity"`
+	RefundedAmount   *float64     `json:"refunded_amount,omitempty"`
+	RefundedQuantity *int         `json:"refunded_quantity,omitempty"`
+	Sku              *string      `json:"sku,omitempty"`
+	Tax              *float64     `json:"tax,omitempty"`
+	TicketTier       *string      `json:"ticket_tier,omitempty"`
+	UnitAmount       float64      `json:"unit_amount"`
+}
+
+// LineItemKind defines model for LineItemKind.
+type LineItemKind string
+
+// LineItemRequest discount, tax and fee are totals for the line, not per unit.
+type LineItemRequest struct {
+	Discount   *float64      `json:"discount,omitempty"`
+	Fee        *float64      `json:"fee,omitempty"`
+	Kind       *LineItemKind `json:"kind,omitempty"`
+	Name       string        `json:"name"`
+	Quantity   int           `json:"quantity"`
+	Sku        *string       `json:"sku,omitempty"`
+	Tax        *float64      `json:"tax,omitempty"`
+	TicketTier *string       `json:"ticket_tier,omitempty"`
+	UnitAmount *float64      `json:"unit_amount,omitempty"`
+}
+
+// OTPActionRequest defines model for OTPActionRequest.
+type OTPActionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// OTPRequest defines model for OTPRequest.
+type OTPRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// OTPSent defines model for OTPSent.
+type OTPSent struct {
+	// TtlSeconds How long the OTP stays valid
+	TtlSeconds int `json:"ttl_seconds"`
+}
+
+// OTPSentEnvelope defines model for OTPSentEnvelope.
+type OTPSentEnvelope struct {
+	Data      OTPSent    `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// Organizer defines model for Organizer.
+type Organizer struct {
+	CreatedAt          time.Time       `json:"created_at"`
+	DefaultCurrency    string          `json:"default_currency"`
+	Email              string          `json:"email"`
+	EventEndsAt        *time.Time      `json:"event_ends_at,omitempty"`
+	FeePlan            string          `json:"fee_plan"`
+	Id                 string          `json:"id"`
+	Name               string          `json:"name"`
+	PayoutSchedule     PayoutSchedule  `json:"payout_schedule"`
+	SettlementCurrency string          `json:"settlement_currency"`
+	Status             OrganizerStatus `json:"status"`
+
+	// StripeAccountId Stripe Connect account
+	StripeAccountId *string   `json:"stripe_account_id,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// OrganizerCredentials defines model for OrganizerCredentials.
+type OrganizerCredentials struct {
+	ApiKey    string    `json:"api_key"`
+	Organizer Organizer `json:"organizer"`
+}
+
+// OrganizerCredentialsEnvelope defines model for OrganizerCredentialsEnvelope.
+type OrganizerCredentialsEnvelope struct {
+	Data      OrganizerCredentials `json:"data"`
+	Message   string               `json:"message"`
+	Success   bool                 `json:"success"`
+	Timestamp *time.Time           `json:"timestamp,omitempty"`
+}
+
+// OrganizerEnvelope defines model for OrganizerEnvelope.
+type OrganizerEnvelope struct {
+	Data      Organizer  `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// OrganizerStatus defines model for OrganizerStatus.
+type OrganizerStatus string
+
+// Payment defines model for Payment.
+type Payment struct {
+	Currency string      `json:"currency"`
+	Date     time.Time   `json:"date"`
+	Email    *string     `json:"email,omitempty"`
+	Fx       *FXSnapshot `json:"fx,omitempty"`
+
+	// LineItems Returned when a single payment is read
+	LineItems   *[]LineItem `json:"line_items,omitempty"`
+	OrderId     string      `json:"order_id"`
+	OrganizerId string      `json:"organizer_id"`
+	PaymentId   string      `json:"payment_id"`
+	Price       float64     `json:"price"`
+
+	// Status disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+	Status PaymentStatus `json:"status"`
+
+	// TransactionId Stripe PaymentIntent ID for card payments
+	TransactionId *string `json:"transaction_id,omitempty"`
+}
+
+// PaymentEnvelope defines model for PaymentEnvelope.
+type PaymentEnvelope struct {
+	Data      Payment    `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// PaymentEvent defines model for PaymentEvent.
+type PaymentEvent struct {
+	Dispute   *Dispute  `json:"dispute,omitempty"`
+	EventId   *string   `json:"event_id,omitempty"`
+	Payment   *Payment  `json:"payment"`
+	PaymentId string    `json:"payment_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Type      string    `json:"type"`
+}
+
+// PaymentListEnvelope defines model for PaymentListEnvelope.
+type PaymentListEnvelope struct {
+	Data      *[]Payment `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// PaymentMethodRef defines model for PaymentMethodRef.
+type PaymentMethodRef struct {
+	PaymentMethodId string `json:"payment_method_id"`
+}
+
+// PaymentMethodRefEnvelope defines model for PaymentMethodRefEnvelope.
+type PaymentMethodRefEnvelope struct {
+	Data      PaymentMethodRef `json:"data"`
+	Message   string           `json:"message"`
+	Success   bool             `json:"success"`
+	Timestamp *time.Time       `json:"timestamp,omitempty"`
+}
+
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	BillingCountry *string `json:"billing_country,omitempty"`
+
+	// Currency Defaults to the organizer's currency
+	Currency *string `json:"currency,omitempty"`
+	Email    *string `json:"email,omitempty"`
+
+	// LineItems Must add up to price
+	LineItems *[]LineItemRequest `json:"line_items,omitempty"`
+	OrderId   string             `json:"order_id"`
+
+	// OrganizerId Taken from the API key for organizer callers
+	OrganizerId *string `json:"organizer_id,omitempty"`
+
+	// PaymentId Generated when empty
+	PaymentId     *string `json:"payment_id,omitempty"`
+	Price         float64 `json:"price"`
+	TicketCount   *int    `json:"ticket_count,omitempty"`
+	TransactionId *string `json:"transaction_id,omitempty"`
+}
+
+// PaymentStatus disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+type PaymentStatus string
+
+// PaymentStatusEnvelope defines model for PaymentStatusEnvelope.
+type PaymentStatusEnvelope struct {
+	Data      PaymentStatusResult `json:"data"`
+	Message   string              `json:"message"`
+	Success   bool                `json:"success"`
+	Timestamp *time.Time          `json:"timestamp,omitempty"`
+}
+
+// PaymentStatusResult defines model for PaymentStatusResult.
+type PaymentStatusResult struct {
+	PaymentId string `json:"payment_id"`
+
+	// Status disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+	Status PaymentStatus `json:"status"`
+}
+
+// PaymentTimeline defines model for PaymentTimeline.
+type PaymentTimeline struct {
+	Audit      *[]AuditEntry   `json:"audit"`
+	Disputes   *[]Dispute      `json:"disputes"`
+	Events     *[]PaymentEvent `json:"events"`
+	Payment    *Payment        `json:"payment"`
+	Refunds    *[]Refund       `json:"refunds"`
+	RiskReview *RiskReview     `json:"risk_review,omitempty"`
+	Source     ReplaySource    `json:"source"`
+}
+
+// PaymentTimelineEnvelope defines model for PaymentTimelineEnvelope.
+type PaymentTimelineEnvelope struct {
+	Data      PaymentTimeline `json:"data"`
+	Message   string          `json:"message"`
+	Success   bool            `json:"success"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+}
+
+// Payout defines model for Payout.
+type Payout struct {
+	Amount             float64      `json:"amount"`
+	Available          float64      `json:"available"`
+	CreatedAt          time.Time    `json:"created_at"`
+	Currency           string       `json:"currency"`
+	FailureReason      *string      `json:"failure_reason,omitempty"`
+	Fx                 *FXSnapshot  `json:"fx,omitempty"`
+	Id                 string       `json:"id"`
+	Method             PayoutMethod `json:"method"`
+	OrganizerId        string       `json:"organizer_id"`
+	PaidAt             *time.Time   `json:"paid_at,omitempty"`
+	PeriodEnd          time.Time    `json:"period_end"`
+	PeriodStart        time.Time    `json:"period_start"`
+	ProviderReference  *string      `json:"provider_reference,omitempty"`
+	Reserve            float64      `json:"reserve"`
+	SettlementAmount   float64      `json:"settlement_amount"`
+	SettlementCurrency string       `json:"settlement_currency"`
+	Status             PayoutStatus `json:"status"`
+}
+
+// PayoutEnvelope defines model for PayoutEnvelope.
+type PayoutEnvelope struct {
+	Data      Payout     `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// PayoutListEnvelope defines model for PayoutListEnvelope.
+type PayoutListEnvelope struct {
+	Data      *[]Payout  `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// PayoutMethod defines model for PayoutMethod.
+type PayoutMethod string
+
+// PayoutRunRequest defines model for PayoutRunRequest.
+type PayoutRunRequest struct {
+	// Force Ignore the organizer's schedule
+	Force       *bool   `json:"force,omitempty"`
+	OrganizerId *string `json:"organizer_id,omitempty"`
+}
+
+// PayoutSchedule defines model for PayoutSchedule.
+type PayoutSchedule string
+
+// PayoutStatus defines model for PayoutStatus.
+type PayoutStatus string
+
+// Problem An RFC 7807 problem document. Branch on code; detail is written for
+// the caller and may be shown to end users, and card errors carry a
+// message meant for the cardholder.
+type Problem struct {
+	// Code Stable, machine-readable error code. Codes are never renamed, but new
+	// ones may be added; treat unknown codes by their HTTP status.
+	Code   ErrorCode `json:"code"`
+	Detail *string   `json:"detail,omitempty"`
+
+	// Instance Path of the request that failed
+	Instance      *string         `json:"instance,omitempty"`
+	InvalidParams *[]InvalidParam `json:"invalid_params,omitempty"`
+
+	// RequestId Quote it when contacting support
+	RequestId *string `json:"request_id,omitempty"`
+	Status    int     `json:"status"`
+
+	// Title Short summary, the same for every error with this code
+	Title string `json:"title"`
+
+	// Type urn:evently:error:<code>
+	Type string `json:"type"`
+}
+
+// ReconciliationItem defines model for ReconciliationItem.
+type ReconciliationItem struct {
+	ChargeId        *string         `json:"charge_id,omitempty"`
+	Currency        *string         `json:"currency,omitempty"`
+	Details         *string         `json:"details,omitempty"`
+	Id              int64           `json:"id"`
+	LocalAmount     float64         `json:"local_amount"`
+	LocalStatus     *string         `json:"local_status,omitempty"`
+	OrderId         *string         `json:"order_id,omitempty"`
+	PaymentId       *string         `json:"payment_id,omitempty"`
+	PaymentIntentId *string         `json:"payment_intent_id,omitempty"`
+	RunId           string          `json:"run_id"`
+	StripeAmount    float64         `json:"stripe_amount"`
+	StripeFee       float64         `json:"stripe_fee"`
+	StripeStatus    *string         `json:"stripe_status,omitempty"`
+	Type            DiscrepancyType `json:"type"`
+}
+
+// ReconciliationRequest An inclusive range of dates, as YYYY-MM-DD
+type ReconciliationRequest struct {
+	From openapi_types.Date `json:"from"`
+	To   openapi_types.Date `json:"to"`
+}
+
+// ReconciliationRun defines model for ReconciliationRun.
+type ReconciliationRun struct {
+	CompletedAt      *time.Time           `json:"completed_at,omitempty"`
+	DiscrepancyCount int                  `json:"discrepancy_count"`
+	Error            *string              `json:"error,omitempty"`
+	Id               string               `json:"id"`
+	LocalCount       int                  `json:"local_count"`
+	MatchedCount     int                  `json:"matched_count"`
+	PeriodEnd        time.Time            `json:"period_end"`
+	PeriodStart      time.Time            `json:"period_start"`
+	StartedAt        time.Time            `json:"started_at"`
+	Status           ReconciliationStatus `json:"status"`
+	StripeCount      int                  `json:"stripe_count"`
+}
+
+// ReconciliationRunDetail defines model for ReconciliationRunDetail.
+type ReconciliationRunDetail struct {
+	Discrepancies *[]ReconciliationItem `json:"discrepancies"`
+	Run           ReconciliationRun     `json:"run"`
+}
+
+// ReconciliationRunDetailEnvelope defines model for ReconciliationRunDetailEnvelope.
+type ReconciliationRunDetailEnvelope struct {
+	Data      ReconciliationRunDetail `json:"data"`
+	Message   string                  `json:"message"`
+	Success   bool                    `json:"success"`
+	Timestamp *time.Time              `json:"timestamp,omitempty"`
+}
+
+// ReconciliationRunEnvelope defines model for ReconciliationRunEnvelope.
+type ReconciliationRunEnvelope struct {
+	Data      ReconciliationRun `json:"data"`
+	Message   string            `json:"message"`
+	Success   bool              `json:"success"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+}
+
+// ReconciliationRunListEnvelope defines model for ReconciliationRunListEnvelope.
+type ReconciliationRunListEnvelope struct {
+	Data      *[]ReconciliationRun `json:"data"`
+	Message   string               `json:"message"`
+	Success   bool                 `json:"success"`
+	Timestamp *time.Time           `json:"timestamp,omitempty"`
+}
+
+// ReconciliationStatus defines model for ReconciliationStatus.
+type ReconciliationStatus string
+
+// Refund defines model for Refund.
+type Refund struct {
+	Amount    float64     `json:"amount"`
+	CreatedAt time.Time   `json:"created_at"`
+	Currency  string      `json:"currency"`
+	Fx        *FXSnapshot `json:"fx,omitempty"`
+
+	// Id The provider's refund ID for Stripe refunds
+	Id          string              `json:"id"`
+	LineItems   *[]RefundedLineItem `json:"line_items,omitempty"`
+	OrganizerId string              `json:"organizer_id"`
+	PaymentId   string              `json:"payment_id"`
+	Reason      *string             `json:"reason,omitempty"`
+}
+
+// RefundEnvelope defines model for RefundEnvelope.
+type RefundEnvelope struct {
+	Data      Refund     `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// RefundLineItemRequest defines model for RefundLineItemRequest.
+type RefundLineItemRequest struct {
+	LineItemId string `json:"line_item_id"`
+
+	// Quantity Defaults to all units not yet refunded
+	Quantity *int `json:"quantity,omitempty"`
+}
+
+// RefundListEnvelope defines model for RefundListEnvelope.
+type RefundListEnvelope struct {
+	Data      *[]Refund  `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// RefundRequest defines model for RefundRequest.
+type RefundRequest struct {
+	// Amount Defaults to the amount not yet refunded
+	Amount *float64 `json:"amount,omitempty"`
+
+	// LineItems Refund these lines of an itemized payment instead of an amount
+	LineItems *[]RefundLineItemRequest `json:"line_items,omitempty"`
+	Reason    *string                  `json:"reason,omitempty"`
+}
+
+// RefundedLineItem defines model for RefundedLineItem.
+type RefundedLineItem struct {
+	Amount     float64 `json:"amount"`
+	LineItemId string  `json:"line_item_id"`
+	Quantity   int     `json:"quantity"`
+}
+
+// ReplayRequest defines model for ReplayRequest.
+type ReplayRequest struct {
+	// DryRun List the events without publishing
+	DryRun     *bool         `json:"dry_run,omitempty"`
+	EventTypes *[]string     `json:"event_types,omitempty"`
+	From       *time.Time    `json:"from,omitempty"`
+	Limit      *int          `json:"limit,omitempty"`
+	OrderId    *string       `json:"order_id,omitempty"`
+	PaymentId  *string       `json:"payment_id,omitempty"`
+	Source     *ReplaySource `json:"source,omitempty"`
+	To         *time.Time    `json:"to,omitempty"`
+}
+
+// ReplayResult defines model for ReplayResult.
+type ReplayResult struct {
+	DryRun    bool            `json:"dry_run"`
+	Events    *[]PaymentEvent `json:"events"`
+	Failed    int             `json:"failed"`
+	Matched   int             `json:"matched"`
+	Published int             `json:"published"`
+	Source    ReplaySource    `json:"source"`
+}
+
+// ReplayResultEnvelope defines model for ReplayResultEnvelope.
+type ReplayResultEnvelope struct {
+	Data      ReplayResult `json:"data"`
+	Message   string       `json:"message"`
+	Success   bool         `json:"success"`
+	Timestamp *time.Time   `json:"timestamp,omitempty"`
+}
+
+// ReplaySource defines model for ReplaySource.
+type ReplaySource string
+
+// RiskAssessment defines model for RiskAssessment.
+type RiskAssessment struct {
+	Decision RiskDecision   `json:"decision"`
+	Hits     *[]RiskRuleHit `json:"hits,omitempty"`
+
+	// Listed Which lists matched, e.g. email or ip
+	Listed    *[]string           `json:"listed,omitempty"`
+	RulesFrom string              `json:"rules_from"`
+	Score     int                 `json:"score"`
+	Signals   *map[string]float64 `json:"signals"`
+	Warnings  *[]string           `json:"warnings,omitempty"`
+}
+
+// RiskDecision defines model for RiskDecision.
+type RiskDecision string
+
+// RiskLists defines model for RiskLists.
+type RiskLists struct {
+	Cards     *[]string `json:"cards"`
+	Countries *[]string `json:"countries"`
+	Emails    *[]string `json:"emails"`
+	Ips       *[]string `json:"ips"`
+}
+
+// RiskReview defines model for RiskReview.
+type RiskReview struct {
+	Amount        float64          `json:"amount"`
+	Assessment    *RiskAssessment  `json:"assessment"`
+	CreatedAt     time.Time        `json:"created_at"`
+	Currency      string           `json:"currency"`
+	DecidedAt     *time.Time       `json:"decided_at,omitempty"`
+	Note          *string          `json:"note,omitempty"`
+	OrderId       string           `json:"order_id"`
+	OrganizerId   string           `json:"organizer_id"`
+	PaymentId     string           `json:"payment_id"`
+	Reviewer      *string          `json:"reviewer,omitempty"`
+	Score         int              `json:"score"`
+	Status        RiskReviewStatus `json:"status"`
+	TransactionId *string          `json:"transaction_id,omitempty"`
+}
+
+// RiskReviewDecisionRequest defines model for RiskReviewDecisionRequest.
+type RiskReviewDecisionRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// RiskReviewEnvelope defines model for RiskReviewEnvelope.
+type RiskReviewEnvelope struct {
+	Data      RiskReview `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// RiskReviewListEnvelope defines model for RiskReviewListEnvelope.
+type RiskReviewListEnvelope struct {
+	Data      *[]RiskReview `json:"data"`
+	Message   string        `json:"message"`
+	Success   bool          `json:"success"`
+	Timestamp *time.Time    `json:"timestamp,omitempty"`
+}
+
+// RiskReviewStatus defines model for RiskReviewStatus.
+type RiskReviewStatus string
+
+// RiskRule defines model for RiskRule.
+type RiskRule struct {
+	Name string `json:"name"`
+
+	// Op gt, gte, lt, lte or eq
+	Op      string        `json:"op"`
+	Outcome *RiskDecision `json:"outcome,omitempty"`
+	Score   *int          `json:"score,omitempty"`
+	Signal  string        `json:"signal"`
+	Value   float64       `json:"value"`
+}
+
+// RiskRuleHit defines model for RiskRuleHit.
+type RiskRuleHit struct {
+	Outcome *RiskDecision `json:"outcome,omitempty"`
+	Rule    string        `json:"rule"`
+	Score   *int          `json:"score,omitempty"`
+	Signal  string        `json:"signal"`
+	Value   float64       `json:"value"`
+}
+
+// RiskRules defines model for RiskRules.
+type RiskRules struct {
+	DenyScore int `json:"deny_score"`
+
+	// HistoryWindow Nanoseconds; the period the organizer's average amount is taken over
+	HistoryWindow int64     `json:"history_window"`
+	Lists         RiskLists `json:"lists"`
+
+	// MinHistory Payments needed before amount_ratio is scored
+	MinHistory  int         `json:"min_history"`
+	ReviewScore int         `json:"review_score"`
+	Rules       *[]RiskRule `json:"rules"`
+	Source      string      `json:"source"`
+
+	// VelocityWindow Nanoseconds
+	VelocityWindow int64 `json:"velocity_window"`
+}
+
+// RiskRulesEnvelope defines model for RiskRulesEnvelope.
+type RiskRulesEnvelope struct {
+	Data      RiskRules  `json:"data"`
+	Message   string     `json:"message"`
+	Success   bool       `json:"success"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// SavedPaymentMethod defines model for SavedPaymentMethod.
+type SavedPaymentMethod struct {
+	Brand     string `json:"brand"`
+	ExpMonth  int64  `json:"exp_month"`
+	ExpYear   int64  `json:"exp_year"`
+	Id        string `json:"id"`
+	IsDefault bool   `json:"is_default"`
+	Last4     string `json:"last4"`
+}
+
+// SavedPaymentMethodListEnvelope defines model for SavedPaymentMethodListEnvelope.
+type SavedPaymentMethodListEnvelope struct {
+	Data      *[]SavedPaymentMethod `json:"data"`
+	Message   string                `json:"message"`
+	Success   bool                  `json:"success"`
+	Timestamp *time.Time            `json:"timestamp,omitempty"`
+}
+
+// SettlementStatement defines model for SettlementStatement.
+type SettlementStatement struct {
+	Adjustments     float64          `json:"adjustments"`
+	ClosingBalance  float64          `json:"closing_balance"`
+	Lines           *[]StatementLine `json:"lines"`
+	OpeningBalance  float64          `json:"opening_balance"`
+	Payout          *Payout          `json:"payout"`
+	PayoutAmount    float64          `json:"payout_amount"`
+	PlatformFees    float64          `json:"platform_fees"`
+	PreviousPayouts float64          `json:"previous_payouts"`
+	Refunds         float64          `json:"refunds"`
+	Reserve         float64          `json:"reserve"`
+	Sales           float64          `json:"sales"`
+}
+
+// SettlementStatementEnvelope defines model for SettlementStatementEnvelope.
+type SettlementStatementEnvelope struct {
+	Data      SettlementStatement `json:"data"`
+	Message   string              `json:"message"`
+	Success   bool                `json:"success"`
+	Timestamp *time.Time          `json:"timestamp,omitempty"`
+}
+
+// SetupIntentEnvelope defines model for SetupIntentEnvelope.
+type SetupIntentEnvelope struct {
+	Data      SetupIntentResponse `json:"data"`
+	Message   string              `json:"message"`
+	Success   bool                `json:"success"`
+	Timestamp *time.Time          `json:"timestamp,omitempty"`
+}
+
+// SetupIntentResponse defines model for SetupIntentResponse.
+type SetupIntentResponse struct {
+	ClientSecret  string `json:"client_secret"`
+	SetupIntentId string `json:"setup_intent_id"`
+	Status        string `json:"status"`
+}
+
+// StatementLine defines model for StatementLine.
+type StatementLine struct {
+	Amount    float64   `json:"amount"`
+	Date      time.Time `json:"date"`
+	Kind      string    `json:"kind"`
+	OrderId   *string   `json:"order_id,omitempty"`
+	PaymentId *string   `json:"payment_id,omitempty"`
+	Reference string    `json:"reference"`
+}
+
+// StatusOverrideRequest defines model for StatusOverrideRequest.
+type StatusOverrideRequest struct {
+	Reason string `json:"reason"`
+
+	// Status disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+	Status PaymentStatus `json:"status"`
+}
+
+// StatusUpdate defines model for StatusUpdate.
+type StatusUpdate struct {
+	EventId   *string `json:"event_id,omitempty"`
+	OrderId   *string `json:"order_id,omitempty"`
+	PaymentId string  `json:"payment_id"`
+
+	// Status disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+	Status    PaymentStatus `json:"status"`
+	Timestamp time.Time     `json:"timestamp"`
+	Type      string        `json:"type"`
+}
+
+// StreamToken defines model for StreamToken.
+type StreamToken struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Token     string    `json:"token"`
+}
+
+// StreamTokenEnvelope defines model for StreamTokenEnvelope.
+type StreamTokenEnvelope struct {
+	Data      StreamToken `json:"data"`
+	Message   string      `json:"message"`
+	Success   bool        `json:"success"`
+	Timestamp *time.Time  `json:"timestamp,omitempty"`
+}
+
+// StripeConfirmRequest defines model for StripeConfirmRequest.
+type StripeConfirmRequest struct {
+	ReturnUrl *string `json:"return_url,omitempty"`
+}
+
+// StripeEvent A Stripe event as Stripe sends it; only the fields the gateway reads are listed
+type StripeEvent struct {
+	Data *map[string]interface{} `json:"data,omitempty"`
+	Id   string                  `json:"id"`
+	Type string                  `json:"type"`
+}
+
+// StripeNextAction defines model for StripeNextAction.
+type StripeNextAction struct {
+	RedirectUrl  *string `json:"redirect_url,omitempty"`
+	ReturnUrl    *string `json:"return_url,omitempty"`
+	Type         string  `json:"type"`
+	UseStripeSdk *bool   `json:"use_stripe_sdk,omitempty"`
+}
+
+// StripePayment defines model for StripePayment.
+type StripePayment struct {
+	Amount       float64 `json:"amount"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// Created Unix time
+	Created       int64             `json:"created"`
+	Currency      string            `json:"currency"`
+	NextAction    *StripeNextAction `json:"next_action,omitempty"`
+	OrderId       string            `json:"order_id"`
+	OrganizerId   *string           `json:"organizer_id,omitempty"`
+	PaymentId     string            `json:"payment_id"`
+	PaymentMethod string            `json:"payment_method"`
+	PlatformFee   *float64          `json:"platform_fee,omitempty"`
+	ReceiptUrl    *string           `json:"receipt_url,omitempty"`
+
+	// Status disputed means the cardholder opened a chargeback; in_review that the risk engine holds the payment for manual review.
+	Status        PaymentStatus `json:"status"`
+	TransactionId string        `json:"transaction_id"`
+}
+
+// StripePaymentEnvelope defines model for StripePaymentEnvelope.
+type StripePaymentEnvelope struct {
+	Data      StripePayment `json:"data"`
+	Message   string        `json:"message"`
+	Success   bool          `json:"success"`
+	Timestamp *time.Time    `json:"timestamp,omitempty"`
+}
+
+// StripePaymentRequest One of token, card or user_id is required.
+type StripePaymentRequest struct {
+	Amount         float64 `json:"amount"`
+	BillingCountry *string `json:"billing_country,omitempty"`
+
+	// Card Raw card data, accepted only in raw-card test mode
+	Card        *CardDetails `json:"card,omitempty"`
+	Currency    string       `json:"currency"`
+	Description *string      `json:"description,omitempty"`
+	Email       *string      `json:"email,omitempty"`
+
+	// LineItems Must add up to amount
+	LineItems *[]LineItemRequest `json:"line_items,omitempty"`
+	Metadata  *map[string]string `json:"metadata,omitempty"`
+
+	// OffSession The customer is not present to authenticate; requires user_id
+	OffSession  *bool   `json:"off_session,omitempty"`
+	OrderId     string  `json:"order_id"`
+	OrganizerId *string `json:"organizer_id,omitempty"`
+	PaymentId   string  `json:"payment_id"`
+
+	// ReturnUrl Where the bank sends the customer after 3-D Secure
+	ReturnUrl         *string `json:"return_url,omitempty"`
+	SavePaymentMethod *bool   `json:"save_payment_method,omitempty"`
+	TicketCount       *int    `json:"ticket_count,omitempty"`
+
+	// Token Stripe token or PaymentMethod ID
+	Token *string `json:"token,omitempty"`
+
+	// UserId Charge a card saved on this user's Stripe Customer
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// StripeRefundRequest defines model for StripeRefundRequest.
+type StripeRefundRequest struct {
+	Amount *float64 `json:"amount,omitempty"`
+
+	// LineItems Refund these lines of an itemized payment instead of an amount
+	LineItems *[]RefundLineItemRequest `json:"line_items,omitempty"`
+
+	// PaymentId Stripe PaymentIntent ID
+	PaymentId string  `json:"payment_id"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
+// StripeWebhookAck defines model for StripeWebhookAck.
+type StripeWebhookAck struct {
+	Received bool `json:"received"`
+}
+
+// UpdateOrganizerRequest Organizers may change their profile and payout schedule. The Connect account, settlement currency, fee plan, event end and status can only be changed by the platform.
+type UpdateOrganizerRequest struct {
+	DefaultCurrency *string              `json:"default_currency,omitempty"`
+	Email           *openapi_types.Email `json:"email,omitempty"`
+	EventEndsAt     *time.Time           `json:"event_ends_at,omitempty"`
+	FeePlan         *string              `json:"fee_plan,omitempty"`
+	Name            *string              `json:"name,omitempty"`
+	PayoutSchedule  *PayoutSchedule      `json:"payout_schedule,omitempty"`
+
+	// SettlementCurrency Platform only
+	SettlementCurrency *string          `json:"settlement_currency,omitempty"`
+	Status             *OrganizerStatus `json:"status,omitempty"`
+
+	// StripeAccountId Platform only
+	StripeAccountId *string `json:"stripe_account_id,omitempty"`
+}
+
+// UpdateWebhookEndpointRequest defines model for UpdateWebhookEndpointRequest.
+type UpdateWebhookEndpointRequest struct {
+	Description *string   `json:"description,omitempty"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+	EventTypes  *[]string `json:"event_types,omitempty"`
+	Url         *string   `json:"url,omitempty"`
+}
+
+// ValidateOTPRequest defines model for ValidateOTPRequest.
+type ValidateOTPRequest struct {
+	OrderId string `json:"order_id"`
+	Otp     string `json:"otp"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	EndpointId  string     `json:"endpoint_id"`
+	EventId     string     `json:"event_id"`
+	EventType   string     `json:"event_type"`
+	Id          string     `json:"id"`
+	LastError   *string    `json:"last_error,omitempty"`
+
+	// LastResponse Truncated response body
+	LastResponse   *string               `json:"last_response,omitempty"`
+	LastStatusCode *int                  `json:"last_status_code,omitempty"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	OrganizerId    *string               `json:"organizer_id,omitempty"`
+	Payload        string                `json:"payload"`
+	RedeliveryOf   *string               `json:"redelivery_of,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// WebhookDeliveryEnvelope defines model for WebhookDeliveryEnvelope.
+type WebhookDeliveryEnvelope struct {
+	Data      WebhookDelivery `json:"data"`
+	Message   string          `json:"message"`
+	Success   bool            `json:"success"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+}
+
+// WebhookDeliveryListEnvelope defines model for WebhookDeliveryListEnvelope.
+type WebhookDeliveryListEnvelope struct {
+	Data      *[]WebhookDelivery `json:"data"`
+	Message   string             `json:"message"`
+	Success   bool               `json:"success"`
+	Timestamp *time.Time         `json:"timestamp,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	CreatedAt           time.Time  `json:"created_at"`
+	Description         *string    `json:"description,omitempty"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	DisabledReason      *string    `json:"disabled_reason,omitempty"`
+	Enabled             bool       `json:"enabled"`
+	EventTypes          *[]string  `json:"event_types"`
+	Id                  string     `json:"id"`
+	OrganizerId         *string    `json:"organizer_id,omitempty"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	Url                 string     `json:"url"`
+}
+
+// WebhookEndpointEnvelope defines model for WebhookEndpointEnvelope.
+type WebhookEndpointEnvelope struct {
+	Data      WebhookEndpoint `json:"data"`
+	Message   string          `json:"message"`
+	Success   bool            `json:"success"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+}
+
+// WebhookEndpointListEnvelope defines model for WebhookEndpointListEnvelope.
+type WebhookEndpointListEnvelope struct {
+	Data      *[]WebhookEndpoint `json:"data"`
+	Message   string             `json:"message"`
+	Success   bool               `json:"success"`
+	Timestamp *time.Time         `json:"timestamp,omitempty"`
+}
+
+// WebhookEndpointSecret defines model for WebhookEndpointSecret.
+type WebhookEndpointSecret struct {
+	Endpoint *WebhookEndpoint `json:"endpoint"`
+	Secret   string           `json:"secret"`
+}
+
+// WebhookEndpointSecretEnvelope defines model for WebhookEndpointSecretEnvelope.
+type WebhookEndpointSecretEnvelope struct {
+	Data      WebhookEndpointSecret `json:"data"`
+	Message   string                `json:"message"`
+	Success   bool                  `json:"success"`
+	Timestamp *time.Time            `json:"timestamp,omitempty"`
+}
+
+// BannedIP defines model for BannedIP.
+type BannedIP = string
+
+// CheckoutID defines model for CheckoutID.
+type CheckoutID = string
+
+// DisputeID defines model for DisputeID.
+type DisputeID = string
+
+// FeePlanID defines model for FeePlanID.
+type FeePlanID = string
+
+// IntentID defines model for IntentID.
+type IntentID = string
+
+// LastEventID defines model for LastEventID.
+type LastEventID = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// OrderID defines model for OrderID.
+type OrderID = string
+
+// OrganizerID defines model for OrganizerID.
+type OrganizerID = string
+
+// PaymentID defines model for PaymentID.
+type PaymentID = string
+
+// PaymentMethodID defines model for PaymentMethodID.
+type PaymentMethodID = string
+
+// PayoutID defines model for PayoutID.
+type PayoutID = string
+
+// ReconciliationRunID defines model for ReconciliationRunID.
+type ReconciliationRunID = string
+
+// StreamTokenQuery defines model for StreamTokenQuery.
+type StreamTokenQuery = string
+
+// UserID defines model for UserID.
+type UserID = string
+
+// WebhookDeliveryID defines model for WebhookDeliveryID.
+type WebhookDeliveryID = string
+
+// WebhookEndpointID defines model for WebhookEndpointID.
+type WebhookEndpointID = string
+
+// Error An RFC 7807 problem document. Branch on code; detail is written for
+// the caller and may be shown to end users, and card errors carry a
+// message meant for the cardholder.
+type Error = Problem
+
+// AdminLookupPaymentsParams defines parameters for AdminLookupPayments.
+type AdminLookupPaymentsParams struct {
+	Q     *string `form:"q,omitempty" json:"q,omitempty"`
+	Limit *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	Actor       *string         `form:"actor,omitempty" json:"actor,omitempty"`
+	ActorType   *AuditActorType `form:"actor_type,omitempty" json:"actor_type,omitempty"`
+	Action      *string         `form:"action,omitempty" json:"action,omitempty"`
+	TargetType  *string         `form:"target_type,omitempty" json:"target_type,omitempty"`
+	TargetId    *string         `form:"target_id,omitempty" json:"target_id,omitempty"`
+	PaymentId   *string         `form:"payment_id,omitempty" json:"payment_id,omitempty"`
+	OrderId     *string         `form:"order_id,omitempty" json:"order_id,omitempty"`
+	OrganizerId *string         `form:"organizer_id,omitempty" json:"organizer_id,omitempty"`
+	From        *time.Time      `form:"from,omitempty" json:"from,omitempty"`
+	To          *time.Time      `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Clamped to 1000
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ExportAuditEntriesParams defines parameters for ExportAuditEntries.
+type ExportAuditEntriesParams struct {
+	Actor       *string         `form:"actor,omitempty" json:"actor,omitempty"`
+	ActorType   *AuditActorType `form:"actor_type,omitempty" json:"actor_type,omitempty"`
+	Action      *string         `form:"action,omitempty" json:"action,omitempty"`
+	TargetType  *string         `form:"target_type,omitempty" json:"target_type,omitempty"`
+	TargetId    *string         `form:"target_id,omitempty" json:"target_id,omitempty"`
+	PaymentId   *string         `form:"payment_id,omitempty" json:"payment_id,omitempty"`
+	OrderId     *string         `form:"order_id,omitempty" json:"order_id,omitempty"`
+	OrganizerId *string         `form:"organizer_id,omitempty" json:"organizer_id,omitempty"`
+	From        *time.Time      `form:"from,omitempty" json:"from,omitempty"`
+	To          *time.Time      `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Clamped to 1000
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PayCheckoutParams defines parameters for PayCheckout.
+type PayCheckoutParams struct {
+	// XCaptchaToken Alternative to captcha_token in the body
+	XCaptchaToken *string `json:"X-Captcha-Token,omitempty"`
+}
+
+// ListCheckoutSessionsParams defines parameters for ListCheckoutSessions.
+type ListCheckoutSessionsParams struct {
+	Status *CheckoutStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrganizerId Platform callers only
+	OrganizerId *string `form:"organizer_id,omitempty" json:"organizer_id,omitempty"`
+	Limit       *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset      *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListDisputesParams defines parameters for ListDisputes.
+type ListDisputesParams struct {
+	// Status Stripe's dispute status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SubmitDisputeEvidenceMultipartBody defines parameters for SubmitDisputeEvidence.
+type SubmitDisputeEvidenceMultipartBody struct {
+	// AttendanceNotes Anything else that shows the attendee was there
+	AttendanceNotes    *string             `json:"attendance_notes,omitempty"`
+	AttendanceRecords  *openapi_types.File `json:"attendance_records,omitempty"`
+	CustomerEmail      *string             `json:"customer_email,omitempty"`
+	CustomerName       *string             `json:"customer_name,omitempty"`
+	ProductDescription *string             `json:"product_description,omitempty"`
+
+	// ServiceDate Event date
+	ServiceDate *string `json:"service_date,omitempty"`
+
+	// Submit false stages the evidence without sending it to the bank
+	Submit *bool `json:"submit,omitempty"`
+
+	// TicketScanLog Entry scans for the disputed tickets
+	TicketScanLog *string `json:"ticket_scan_log,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	PaymentId *string    `form:"payment_id,omitempty" json:"payment_id,omitempty"`
+	OrderId   *string    `form:"order_id,omitempty" json:"order_id,omitempty"`
+	From      *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To        *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// EventType Repeat for several types
+	EventType *[]string `form:"event_type,omitempty" json:"event_type,omitempty"`
+
+	// Limit Clamped to 10000
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// StreamOrderParams defines parameters for StreamOrder.
+type StreamOrderParams struct {
+	// Token A stream token, for browsers that cannot send an API key
+	Token *StreamTokenQuery `form:"token,omitempty" json:"token,omitempty"`
+
+	// LastEventId Resume after this event; the Last-Event-ID header works too
+	LastEventId *LastEventID `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+}
+
+// ListOrganizerPaymentsParams defines parameters for ListOrganizerPayments.
+type ListOrganizerPaymentsParams struct {
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListOrganizerPayoutsParams defines parameters for ListOrganizerPayouts.
+type ListOrganizerPayoutsParams struct {
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// StreamPaymentParams defines parameters for StreamPayment.
+type StreamPaymentParams struct {
+	// Token A stream token, for browsers that cannot send an API key
+	Token *StreamTokenQuery `form:"token,omitempty" json:"token,omitempty"`
+
+	// LastEventId Resume after this event; the Last-Event-ID header works too
+	LastEventId *LastEventID `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+}
+
+// ListReconciliationRunsParams defines parameters for ListReconciliationRuns.
+type ListReconciliationRunsParams struct {
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListRiskReviewsParams defines parameters for ListRiskReviews.
+type ListRiskReviewsParams struct {
+	// Status Defaults to pending
+	Status      *RiskReviewStatus `form:"status,omitempty" json:"status,omitempty"`
+	OrganizerId *string           `form:"organizer_id,omitempty" json:"organizer_id,omitempty"`
+	Limit       *Limit            `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset      *Offset           `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ValidateCardParams defines parameters for ValidateCard.
+type ValidateCardParams struct {
+	// XCaptchaToken Alternative to captcha_token in the body
+	XCaptchaToken *string `json:"X-Captcha-Token,omitempty"`
+}
+
+// HandleStripeWebhookParams defines parameters for HandleStripeWebhook.
+type HandleStripeWebhookParams struct {
+	StripeSignature *string `json:"Stripe-Signature,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminResendOTPJSONRequestBody defines body for AdminResendOTP for application/json ContentType.
+type AdminResendOTPJSONRequestBody = OTPActionRequest
+
+// AdminResetOTPJSONRequestBody defines body for AdminResetOTP for application/json ContentType.
+type AdminResetOTPJSONRequestBody = OTPActionRequest
+
+// AdminForceRefundJSONRequestBody defines body for AdminForceRefund for application/json ContentType.
+type AdminForceRefundJSONRequestBody = ForceRefundRequest
+
+// AdminOverrideStatusJSONRequestBody defines body for AdminOverrideStatus for application/json ContentType.
+type AdminOverrideStatusJSONRequestBody = StatusOverrideRequest
+
+// PayCheckoutJSONRequestBody defines body for PayCheckout for application/json ContentType.
+type PayCheckoutJSONRequestBody = CheckoutPayRequest
+
+// ConfirmCheckoutJSONRequestBody defines body for ConfirmCheckout for application/json ContentType.
+type ConfirmCheckoutJSONRequestBody = StripeConfirmRequest
+
+// CreateCheckoutSessionJSONRequestBody defines body for CreateCheckoutSession for application/json ContentType.
+type CreateCheckoutSessionJSONRequestBody = CreateCheckoutRequest
+
+// EnsureCustomerJSONRequestBody defines body for EnsureCustomer for application/json ContentType.
+type EnsureCustomerJSONRequestBody = CustomerRequest
+
+// SubmitDisputeEvidenceJSONRequestBody defines body for SubmitDisputeEvidence for application/json ContentType.
+type SubmitDisputeEvidenceJSONRequestBody = DisputeEvidenceRequest
+
+// SubmitDisputeEvidenceMultipartRequestBody defines body for SubmitDisputeEvidence for multipart/form-data ContentType.
+type SubmitDisputeEvidenceMultipartRequestBody SubmitDisputeEvidenceMultipartBody
+
+// ReplayEventsJSONRequestBody defines body for ReplayEvents for application/json ContentType.
+type ReplayEventsJSONRequestBody = ReplayRequest
+
+// CreateFeePlanJSONRequestBody defines body for CreateFeePlan for application/json ContentType.
+type CreateFeePlanJSONRequestBody = FeePlanRequest
+
+// SaveFeePlanJSONRequestBody defines body for SaveFeePlan for application/json ContentType.
+type SaveFeePlanJSONRequestBody = FeePlanRequest
+
+// CreateOrganizerJSONRequestBody defines body for CreateOrganizer for application/json ContentType.
+type CreateOrganizerJSONRequestBody = CreateOrganizerRequest
+
+// UpdateOrganizerJSONRequestBody defines body for UpdateOrganizer for application/json ContentType.
+type UpdateOrganizerJSONRequestBody = UpdateOrganizerRequest
+
+// SendOTPJSONRequestBody defines body for SendOTP for application/json ContentType.
+type SendOTPJSONRequestBody = OTPRequest
+
+// ProcessPaymentJSONRequestBody defines body for ProcessPayment for application/json ContentType.
+type ProcessPaymentJSONRequestBody = PaymentRequest
+
+// ValidateOTPJSONRequestBody defines body for ValidateOTP for application/json ContentType.
+type ValidateOTPJSONRequestBody = ValidateOTPRequest
+
+// RefundPaymentJSONRequestBody defines body for RefundPayment for application/json ContentType.
+type RefundPaymentJSONRequestBody = RefundRequest
+
+// RunPayoutsJSONRequestBody defines body for RunPayouts for application/json ContentType.
+type RunPayoutsJSONRequestBody = PayoutRunRequest
+
+// ConfirmPayoutJSONRequestBody defines body for ConfirmPayout for application/json ContentType.
+type ConfirmPayoutJSONRequestBody = ConfirmPayoutRequest
+
+// FailPayoutJSONRequestBody defines body for FailPayout for application/json ContentType.
+type FailPayoutJSONRequestBody = FailPayoutRequest
+
+// StartReconciliationJSONRequestBody defines body for StartReconciliation for application/json ContentType.
+type StartReconciliationJSONRequestBody = ReconciliationRequest
+
+// BanIPJSONRequestBody defines body for BanIP for application/json ContentType.
+type BanIPJSONRequestBody = IPBanRequest
+
+// ApproveRiskReviewJSONRequestBody defines body for ApproveRiskReview for application/json ContentType.
+type ApproveRiskReviewJSONRequestBody = RiskReviewDecisionRequest
+
+// RejectRiskReviewJSONRequestBody defines body for RejectRiskReview for application/json ContentType.
+type RejectRiskReviewJSONRequestBody = RiskReviewDecisionRequest
+
+// CreateStripePaymentJSONRequestBody defines body for CreateStripePayment for application/json ContentType.
+type CreateStripePaymentJSONRequestBody = StripePaymentRequest
+
+// ConfirmStripePaymentJSONRequestBody defines body for ConfirmStripePayment for application/json ContentType.
+type ConfirmStripePaymentJSONRequestBody = StripeConfirmRequest
+
+// CreateStripeRefundJSONRequestBody defines body for CreateStripeRefund for application/json ContentType.
+type CreateStripeRefundJSONRequestBody = StripeRefundRequest
+
+// ValidateCardJSONRequestBody defines body for ValidateCard for application/json ContentType.
+type ValidateCardJSONRequestBody = CardValidationRequest
+
+// HandleStripeWebhookJSONRequestBody defines body for HandleStripeWebhook for application/json ContentType.
+type HandleStripeWebhookJSONRequestBody = StripeEvent
+
+// CreateWebhookEndpointJSONRequestBody defines body for CreateWebhookEndpoint for application/json ContentType.
+type CreateWebhookEndpointJSONRequestBody = CreateWebhookEndpointRequest
+
+// UpdateWebhookEndpointJSONRequestBody defines body for UpdateWebhookEndpoint for application/json ContentType.
+type UpdateWebhookEndpointJSONRequestBody = UpdateWebhookEndpointRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AdminLookupPayments request
+	AdminLookupPayments(ctx context.Context, params *AdminLookupPaymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminResendOTPWithBody request with any body
+	AdminResendOTPWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminResendOTP(ctx context.Context, id PaymentID, body AdminResendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminResetOTPWithBody request with any body
+	AdminResetOTPWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminResetOTP(ctx context.Context, id PaymentID, body AdminResetOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminForceRefundWithBody request with any body
+	AdminForceRefundWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminForceRefund(ctx context.Context, id PaymentID, body AdminForceRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminResync request
+	AdminResync(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminOverrideStatusWithBody request with any body
+	AdminOverrideStatusWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminOverrideStatus(ctx context.Context, id PaymentID, body AdminOverrideStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetPaymentTimeline request
+	AdminGetPaymentTimeline(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAuditEntries request
+	ListAuditEntries(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAuditEntries request
+	ExportAuditEntries(ctx context.Context, params *ExportAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyAuditLog request
+	VerifyAuditLog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCheckoutPage request
+	GetCheckoutPage(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PayCheckoutWithBody request with any body
+	PayCheckoutWithBody(ctx context.Context, id CheckoutID, params *PayCheckoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PayCheckout(ctx context.Context, id CheckoutID, params *PayCheckoutParams, body PayCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmCheckoutWithBody request with any body
+	ConfirmCheckoutWithBody(ctx context.Context, id CheckoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmCheckout(ctx context.Context, id CheckoutID, body ConfirmCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCheckoutSessions request
+	ListCheckoutSessions(ctx context.Context, params *ListCheckoutSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCheckoutSessionWithBody request with any body
+	CreateCheckoutSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCheckoutSession(ctx context.Context, body CreateCheckoutSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCheckoutSession request
+	GetCheckoutSession(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelCheckoutSession request
+	CancelCheckoutSession(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnsureCustomerWithBody request with any body
+	EnsureCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnsureCustomer(ctx context.Context, body EnsureCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomer request
+	GetCustomer(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPaymentMethods request
+	ListPaymentMethods(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePaymentMethod request
+	DeletePaymentMethod(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetDefaultPaymentMethod request
+	SetDefaultPaymentMethod(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSetupIntent request
+	CreateSetupIntent(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDebugVars request
+	GetDebugVars(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDisputes request
+	ListDisputes(ctx context.Context, params *ListDisputesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDispute request
+	GetDispute(ctx context.Context, id DisputeID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitDisputeEvidenceWithBody request with any body
+	SubmitDisputeEvidenceWithBody(ctx context.Context, id DisputeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitDisputeEvidence(ctx context.Context, id DisputeID, body SubmitDisputeEvidenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEvents request
+	ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayEventsWithBody request with any body
+	ReplayEventsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplayEvents(ctx context.Context, body ReplayEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFeePlans request
+	ListFeePlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFeePlanWithBody request with any body
+	CreateFeePlanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFeePlan(ctx context.Context, body CreateFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFeePlan request
+	GetFeePlan(ctx context.Context, id FeePlanID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveFeePlanWithBody request with any body
+	SaveFeePlanWithBody(ctx context.Context, id FeePlanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SaveFeePlan(ctx context.Context, id FeePlanID, body SaveFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLedgerBalances request
+	GetLedgerBalances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckLedgerInvariants request
+	CheckLedgerInvariants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLedgerOrganizerBalances request
+	GetLedgerOrganizerBalances(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPaymentJournalEntries request
+	GetPaymentJournalEntries(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamOrder request
+	StreamOrder(ctx context.Context, id OrderID, params *StreamOrderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrderStreamToken request
+	CreateOrderStreamToken(ctx context.Context, id OrderID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizerWithBody request with any body
+	CreateOrganizerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizer(ctx context.Context, body CreateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizer request
+	GetOrganizer(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizerWithBody request with any body
+	UpdateOrganizerWithBody(ctx context.Context, id OrganizerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizer(ctx context.Context, id OrganizerID, body UpdateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateOrganizerAPIKey request
+	RotateOrganizerAPIKey(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizerBalances request
+	GetOrganizerBalances(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizerPayments request
+	ListOrganizerPayments(ctx context.Context, id OrganizerID, params *ListOrganizerPaymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizerPayouts request
+	ListOrganizerPayouts(ctx context.Context, id OrganizerID, params *ListOrganizerPayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SendOTPWithBody request with any body
+	SendOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SendOTP(ctx context.Context, body SendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcessPaymentWithBody request with any body
+	ProcessPaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProcessPayment(ctx context.Context, body ProcessPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateOTPWithBody request with any body
+	ValidateOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateOTP(ctx context.Context, body ValidateOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPayment request
+	GetPayment(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPaymentFees request
+	GetPaymentFees(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefundPaymentWithBody request with any body
+	RefundPaymentWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefundPayment(ctx context.Context, id PaymentID, body RefundPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRefunds request
+	ListRefunds(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPaymentStatus request
+	GetPaymentStatus(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamPayment request
+	StreamPayment(ctx context.Context, id PaymentID, params *StreamPaymentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePaymentStreamToken request
+	CreatePaymentStreamToken(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPaymentTimeline request
+	GetPaymentTimeline(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportBankPayouts request
+	ExportBankPayouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunPayoutsWithBody request with any body
+	RunPayoutsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunPayouts(ctx context.Context, body RunPayoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPayout request
+	GetPayout(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmPayoutWithBody request with any body
+	ConfirmPayoutWithBody(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmPayout(ctx context.Context, id PayoutID, body ConfirmPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FailPayoutWithBody request with any body
+	FailPayoutWithBody(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FailPayout(ctx context.Context, id PayoutID, body FailPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPayoutStatement request
+	GetPayoutStatement(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPayoutStatement request
+	ExportPayoutStatement(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReconciliationRuns request
+	ListReconciliationRuns(ctx context.Context, params *ListReconciliationRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartReconciliationWithBody request with any body
+	StartReconciliationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartReconciliation(ctx context.Context, body StartReconciliationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReconciliationRun request
+	GetReconciliationRun(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportReconciliationRun request
+	ExportReconciliationRun(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIPBans request
+	ListIPBans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BanIPWithBody request with any body
+	BanIPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BanIP(ctx context.Context, body BanIPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnbanIP request
+	UnbanIP(ctx context.Context, ip BannedIP, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRiskReviews request
+	ListRiskReviews(ctx context.Context, params *ListRiskReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRiskReview request
+	GetRiskReview(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveRiskReviewWithBody request with any body
+	ApproveRiskReviewWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveRiskReview(ctx context.Context, id PaymentID, body ApproveRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectRiskReviewWithBody request with any body
+	RejectRiskReviewWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RejectRiskReview(ctx context.Context, id PaymentID, body RejectRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRiskRules request
+	GetRiskRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStripePaymentWithBody request with any body
+	CreateStripePaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStripePayment(ctx context.Context, body CreateStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStripePayment request
+	GetStripePayment(ctx context.Context, id IntentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmStripePaymentWithBody request with any body
+	ConfirmStripePaymentWithBody(ctx context.Context, id IntentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmStripePayment(ctx context.Context, id IntentID, body ConfirmStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStripeRefundWithBody request with any body
+	CreateStripeRefundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStripeRefund(ctx context.Context, body CreateStripeRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateCardWithBody request with any body
+	ValidateCardWithBody(ctx context.Context, params *ValidateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateCard(ctx context.Context, params *ValidateCardParams, body ValidateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HandleStripeWebhookWithBody request with any body
+	HandleStripeWebhookWithBody(ctx context.Context, params *HandleStripeWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HandleStripeWebhook(ctx context.Context, params *HandleStripeWebhookParams, body HandleStripeWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookDelivery request
+	GetWebhookDelivery(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RedeliverWebhook request
+	RedeliverWebhook(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookEndpoints request
+	ListWebhookEndpoints(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookEndpointWithBody request with any body
+	CreateWebhookEndpointWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhookEndpoint(ctx context.Context, body CreateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhookEndpoint request
+	DeleteWebhookEndpoint(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookEndpoint request
+	GetWebhookEndpoint(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookEndpointWithBody request with any body
+	UpdateWebhookEndpointWithBody(ctx context.Context, id WebhookEndpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhookEndpoint(ctx context.Context, id WebhookEndpointID, body UpdateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, id WebhookEndpointID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateWebhookSecret request
+	RotateWebhookSecret(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AdminLookupPayments(ctx context.Context, params *AdminLookupPaymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminLookupPaymentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResendOTPWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResendOTPRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResendOTP(ctx context.Context, id PaymentID, body AdminResendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResendOTPRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResetOTPWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResetOTPRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResetOTP(ctx context.Context, id PaymentID, body AdminResetOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResetOTPRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminForceRefundWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminForceRefundRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminForceRefund(ctx context.Context, id PaymentID, body AdminForceRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminForceRefundRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResync(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResyncRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminOverrideStatusWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminOverrideStatusRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminOverrideStatus(ctx context.Context, id PaymentID, body AdminOverrideStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminOverrideStatusRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetPaymentTimeline(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetPaymentTimelineRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAuditEntries(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAuditEntries(ctx context.Context, params *ExportAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAuditEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyAuditLog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyAuditLogRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCheckoutPage(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCheckoutPageRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PayCheckoutWithBody(ctx context.Context, id CheckoutID, params *PayCheckoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPayCheckoutRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PayCheckout(ctx context.Context, id CheckoutID, params *PayCheckoutParams, body PayCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPayCheckoutRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmCheckoutWithBody(ctx context.Context, id CheckoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmCheckoutRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmCheckout(ctx context.Context, id CheckoutID, body ConfirmCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmCheckoutRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCheckoutSessions(ctx context.Context, params *ListCheckoutSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCheckoutSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCheckoutSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCheckoutSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCheckoutSession(ctx context.Context, body CreateCheckoutSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCheckoutSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCheckoutSession(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCheckoutSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelCheckoutSession(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelCheckoutSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnsureCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnsureCustomerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnsureCustomer(ctx context.Context, body EnsureCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnsureCustomerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCustomer(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomerRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPaymentMethods(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPaymentMethodsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePaymentMethod(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePaymentMethodRequest(c.Server, id, pm)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetDefaultPaymentMethod(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDefaultPaymentMethodRequest(c.Server, id, pm)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSetupIntent(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSetupIntentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDebugVars(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDebugVarsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDisputes(ctx context.Context, params *ListDisputesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDisputesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDispute(ctx context.Context, id DisputeID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDisputeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitDisputeEvidenceWithBody(ctx context.Context, id DisputeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitDisputeEvidenceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitDisputeEvidence(ctx context.Context, id DisputeID, body SubmitDisputeEvidenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitDisputeEvidenceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplayEventsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayEventsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplayEvents(ctx context.Context, body ReplayEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayEventsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListFeePlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFeePlansRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFeePlanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFeePlanRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFeePlan(ctx context.Context, body CreateFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFeePlanRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFeePlan(ctx context.Context, id FeePlanID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeePlanRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveFeePlanWithBody(ctx context.Context, id FeePlanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveFeePlanRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveFeePlan(ctx context.Context, id FeePlanID, body SaveFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveFeePlanRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLedgerBalances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerBalancesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckLedgerInvariants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckLedgerInvariantsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLedgerOrganizerBalances(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerOrganizerBalancesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPaymentJournalEntries(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPaymentJournalEntriesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamOrder(ctx context.Context, id OrderID, params *StreamOrderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamOrderRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrderStreamToken(ctx context.Context, id OrderID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrderStreamTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganizerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganizer(ctx context.Context, body CreateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganizer(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizerRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizerWithBody(ctx context.Context, id OrganizerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizerRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizer(ctx context.Context, id OrganizerID, body UpdateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizerRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateOrganizerAPIKey(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateOrganizerAPIKeyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganizerBalances(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizerBalancesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganizerPayments(ctx context.Context, id OrganizerID, params *ListOrganizerPaymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizerPaymentsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganizerPayouts(ctx context.Context, id OrganizerID, params *ListOrganizerPayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizerPayoutsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SendOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SendOTP(ctx context.Context, body SendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessPaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessPaymentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessPayment(ctx context.Context, body ProcessPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessPaymentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateOTP(ctx context.Context, body ValidateOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPayment(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPaymentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPaymentFees(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPaymentFeesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefundPaymentWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefundPaymentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefundPayment(ctx context.Context, id PaymentID, body RefundPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefundPaymentRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRefunds(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRefundsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPaymentStatus(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPaymentStatusRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamPayment(ctx context.Context, id PaymentID, params *StreamPaymentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamPaymentRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePaymentStreamToken(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePaymentStreamTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPaymentTimeline(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPaymentTimelineRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportBankPayouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportBankPayoutsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunPayoutsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunPayoutsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunPayouts(ctx context.Context, body RunPayoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunPayoutsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPayout(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPayoutRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmPayoutWithBody(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPayoutRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmPayout(ctx context.Context, id PayoutID, body ConfirmPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPayoutRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FailPayoutWithBody(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFailPayoutRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FailPayout(ctx context.Context, id PayoutID, body FailPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFailPayoutRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPayoutStatement(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPayoutStatementRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPayoutStatement(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPayoutStatementRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListReconciliationRuns(ctx context.Context, params *ListReconciliationRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReconciliationRunsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartReconciliationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartReconciliationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartReconciliation(ctx context.Context, body StartReconciliationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartReconciliationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReconciliationRun(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReconciliationRunRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportReconciliationRun(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportReconciliationRunRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIPBans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIPBansRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BanIPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBanIPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BanIP(ctx context.Context, body BanIPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBanIPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnbanIP(ctx context.Context, ip BannedIP, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnbanIPRequest(c.Server, ip)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRiskReviews(ctx context.Context, params *ListRiskReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRiskReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRiskReview(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRiskReviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveRiskReviewWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveRiskReviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveRiskReview(ctx context.Context, id PaymentID, body ApproveRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveRiskReviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectRiskReviewWithBody(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectRiskReviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectRiskReview(ctx context.Context, id PaymentID, body RejectRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectRiskReviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRiskRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRiskRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStripePaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStripePaymentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStripePayment(ctx context.Context, body CreateStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStripePaymentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStripePayment(ctx context.Context, id IntentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStripePaymentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmStripePaymentWithBody(ctx context.Context, id IntentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmStripePaymentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmStripePayment(ctx context.Context, id IntentID, body ConfirmStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmStripePaymentRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStripeRefundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStripeRefundRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStripeRefund(ctx context.Context, body CreateStripeRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStripeRefundRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateCardWithBody(ctx context.Context, params *ValidateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateCardRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateCard(ctx context.Context, params *ValidateCardParams, body ValidateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateCardRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HandleStripeWebhookWithBody(ctx context.Context, params *HandleStripeWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHandleStripeWebhookRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HandleStripeWebhook(ctx context.Context, params *HandleStripeWebhookParams, body HandleStripeWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHandleStripeWebhookRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookDelivery(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookDeliveryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RedeliverWebhook(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeliverWebhookRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhookEndpoints(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookEndpointsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookEndpointWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookEndpointRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookEndpoint(ctx context.Context, body CreateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookEndpointRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhookEndpoint(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookEndpointRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookEndpoint(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookEndpointRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookEndpointWithBody(ctx context.Context, id WebhookEndpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookEndpointRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookEndpoint(ctx context.Context, id WebhookEndpointID, body UpdateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookEndpointRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id WebhookEndpointID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateWebhookSecret(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateWebhookSecretRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAdminLookupPaymentsRequest generates requests for AdminLookupPayments
+func NewAdminLookupPaymentsRequest(server string, params *AdminLookupPaymentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminResendOTPRequest calls the generic AdminResendOTP builder with application/json body
+func NewAdminResendOTPRequest(server string, id PaymentID, body AdminResendOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminResendOTPRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAdminResendOTPRequestWithBody generates requests for AdminResendOTP with any type of body
+func NewAdminResendOTPRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/otp/resend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminResetOTPRequest calls the generic AdminResetOTP builder with application/json body
+func NewAdminResetOTPRequest(server string, id PaymentID, body AdminResetOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminResetOTPRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAdminResetOTPRequestWithBody generates requests for AdminResetOTP with any type of body
+func NewAdminResetOTPRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/otp/reset", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminForceRefundRequest calls the generic AdminForceRefund builder with application/json body
+func NewAdminForceRefundRequest(server string, id PaymentID, body AdminForceRefundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminForceRefundRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAdminForceRefundRequestWithBody generates requests for AdminForceRefund with any type of body
+func NewAdminForceRefundRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/refund", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminResyncRequest generates requests for AdminResync
+func NewAdminResyncRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/resync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminOverrideStatusRequest calls the generic AdminOverrideStatus builder with application/json body
+func NewAdminOverrideStatusRequest(server string, id PaymentID, body AdminOverrideStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminOverrideStatusRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAdminOverrideStatusRequestWithBody generates requests for AdminOverrideStatus with any type of body
+func NewAdminOverrideStatusRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminGetPaymentTimelineRequest generates requests for AdminGetPaymentTimeline
+func NewAdminGetPaymentTimelineRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/payments/%s/timeline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuditEntriesRequest generates requests for ListAuditEntries
+func NewListAuditEntriesRequest(server string, params *ListAuditEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_type", runtime.ParamLocationQuery, *params.ActorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_type", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PaymentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "payment_id", runtime.ParamLocationQuery, *params.PaymentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_id", runtime.ParamLocationQuery, *params.OrderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizer_id", runtime.ParamLocationQuery, *params.OrganizerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportAuditEntriesRequest generates requests for ExportAuditEntries
+func NewExportAuditEntriesRequest(server string, params *ExportAuditEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/audit/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_type", runtime.ParamLocationQuery, *params.ActorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_type", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PaymentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "payment_id", runtime.ParamLocationQuery, *params.PaymentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_id", runtime.ParamLocationQuery, *params.OrderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizer_id", runtime.ParamLocationQuery, *params.OrganizerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyAuditLogRequest generates requests for VerifyAuditLog
+func NewVerifyAuditLogRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/audit/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCheckoutPageRequest generates requests for GetCheckoutPage
+func NewGetCheckoutPageRequest(server string, id CheckoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/pay/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPayCheckoutRequest calls the generic PayCheckout builder with application/json body
+func NewPayCheckoutRequest(server string, id CheckoutID, params *PayCheckoutParams, body PayCheckoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPayCheckoutRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPayCheckoutRequestWithBody generates requests for PayCheckout with any type of body
+func NewPayCheckoutRequestWithBody(server string, id CheckoutID, params *PayCheckoutParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/pay/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCaptchaToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Captcha-Token", runtime.ParamLocationHeader, *params.XCaptchaToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Captcha-Token", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewConfirmCheckoutRequest calls the generic ConfirmCheckout builder with application/json body
+func NewConfirmCheckoutRequest(server string, id CheckoutID, body ConfirmCheckoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmCheckoutRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewConfirmCheckoutRequestWithBody generates requests for ConfirmCheckout with any type of body
+func NewConfirmCheckoutRequestWithBody(server string, id CheckoutID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/pay/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCheckoutSessionsRequest generates requests for ListCheckoutSessions
+func NewListCheckoutSessionsRequest(server string, params *ListCheckoutSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizer_id", runtime.ParamLocationQuery, *params.OrganizerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCheckoutSessionRequest calls the generic CreateCheckoutSession builder with application/json body
+func NewCreateCheckoutSessionRequest(server string, body CreateCheckoutSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCheckoutSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateCheckoutSessionRequestWithBody generates requests for CreateCheckoutSession with any type of body
+func NewCreateCheckoutSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCheckoutSessionRequest generates requests for GetCheckoutSession
+func NewGetCheckoutSessionRequest(server string, id CheckoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelCheckoutSessionRequest generates requests for CancelCheckoutSession
+func NewCancelCheckoutSessionRequest(server string, id CheckoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/checkout/sessions/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnsureCustomerRequest calls the generic EnsureCustomer builder with application/json body
+func NewEnsureCustomerRequest(server string, body EnsureCustomerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnsureCustomerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEnsureCustomerRequestWithBody generates requests for EnsureCustomer with any type of body
+func NewEnsureCustomerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCustomerRequest generates requests for GetCustomer
+func NewGetCustomerRequest(server string, id UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPaymentMethodsRequest generates requests for ListPaymentMethods
+func NewListPaymentMethodsRequest(server string, id UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers/%s/payment-methods", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePaymentMethodRequest generates requests for DeletePaymentMethod
+func NewDeletePaymentMethodRequest(server string, id UserID, pm PaymentMethodID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pm", runtime.ParamLocationPath, pm)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers/%s/payment-methods/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetDefaultPaymentMethodRequest generates requests for SetDefaultPaymentMethod
+func NewSetDefaultPaymentMethodRequest(server string, id UserID, pm PaymentMethodID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pm", runtime.ParamLocationPath, pm)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers/%s/payment-methods/%s/default", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSetupIntentRequest generates requests for CreateSetupIntent
+func NewCreateSetupIntentRequest(server string, id UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/customers/%s/setup-intents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDebugVarsRequest generates requests for GetDebugVars
+func NewGetDebugVarsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/debug/vars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDisputesRequest generates requests for ListDisputes
+func NewListDisputesRequest(server string, params *ListDisputesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/disputes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDisputeRequest generates requests for GetDispute
+func NewGetDisputeRequest(server string, id DisputeID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/disputes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitDisputeEvidenceRequest calls the generic SubmitDisputeEvidence builder with application/json body
+func NewSubmitDisputeEvidenceRequest(server string, id DisputeID, body SubmitDisputeEvidenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitDisputeEvidenceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSubmitDisputeEvidenceRequestWithBody generates requests for SubmitDisputeEvidence with any type of body
+func NewSubmitDisputeEvidenceRequestWithBody(server string, id DisputeID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/disputes/%s/evidence", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListEventsRequest generates requests for ListEvents
+func NewListEventsRequest(server string, params *ListEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PaymentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "payment_id", runtime.ParamLocationQuery, *params.PaymentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_id", runtime.ParamLocationQuery, *params.OrderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_type", runtime.ParamLocationQuery, *params.EventType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplayEventsRequest calls the generic ReplayEvents builder with application/json body
+func NewReplayEventsRequest(server string, body ReplayEventsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplayEventsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplayEventsRequestWithBody generates requests for ReplayEvents with any type of body
+func NewReplayEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/events/replay")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFeePlansRequest generates requests for ListFeePlans
+func NewListFeePlansRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/fee-plans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateFeePlanRequest calls the generic CreateFeePlan builder with application/json body
+func NewCreateFeePlanRequest(server string, body CreateFeePlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFeePlanRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFeePlanRequestWithBody generates requests for CreateFeePlan with any type of body
+func NewCreateFeePlanRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/fee-plans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFeePlanRequest generates requests for GetFeePlan
+func NewGetFeePlanRequest(server string, id FeePlanID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/fee-plans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveFeePlanRequest calls the generic SaveFeePlan builder with application/json body
+func NewSaveFeePlanRequest(server string, id FeePlanID, body SaveFeePlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveFeePlanRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSaveFeePlanRequestWithBody generates requests for SaveFeePlan with any type of body
+func NewSaveFeePlanRequestWithBody(server string, id FeePlanID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/fee-plans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLedgerBalancesRequest generates requests for GetLedgerBalances
+func NewGetLedgerBalancesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/ledger/balances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckLedgerInvariantsRequest generates requests for CheckLedgerInvariants
+func NewCheckLedgerInvariantsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/ledger/invariants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLedgerOrganizerBalancesRequest generates requests for GetLedgerOrganizerBalances
+func NewGetLedgerOrganizerBalancesRequest(server string, id OrganizerID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/ledger/organizers/%s/balances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPaymentJournalEntriesRequest generates requests for GetPaymentJournalEntries
+func NewGetPaymentJournalEntriesRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/ledger/payments/%s/entries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamOrderRequest generates requests for StreamOrder
+func NewStreamOrderRequest(server string, id OrderID, params *StreamOrderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders/%s/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LastEventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_event_id", runtime.ParamLocationQuery, *params.LastEventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrderStreamTokenRequest generates requests for CreateOrderStreamToken
+func NewCreateOrderStreamTokenRequest(server string, id OrderID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders/%s/stream-token", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizerRequest calls the generic CreateOrganizer builder with application/json body
+func NewCreateOrganizerRequest(server string, body CreateOrganizerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOrganizerRequestWithBody generates requests for CreateOrganizer with any type of body
+func NewCreateOrganizerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOrganizerRequest generates requests for GetOrganizer
+func NewGetOrganizerRequest(server string, id OrganizerID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizerRequest calls the generic UpdateOrganizer builder with application/json body
+func NewUpdateOrganizerRequest(server string, id OrganizerID, body UpdateOrganizerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizerRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizerRequestWithBody generates requests for UpdateOrganizer with any type of body
+func NewUpdateOrganizerRequestWithBody(server string, id OrganizerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRotateOrganizerAPIKeyRequest generates requests for RotateOrganizerAPIKey
+func NewRotateOrganizerAPIKeyRequest(server string, id OrganizerID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s/api-key", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizerBalancesRequest generates requests for GetOrganizerBalances
+func NewGetOrganizerBalancesRequest(server string, id OrganizerID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s/balances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrganizerPaymentsRequest generates requests for ListOrganizerPayments
+func NewListOrganizerPaymentsRequest(server string, id OrganizerID, params *ListOrganizerPaymentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s/payments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrganizerPayoutsRequest generates requests for ListOrganizerPayouts
+func NewListOrganizerPayoutsRequest(server string, id OrganizerID, params *ListOrganizerPayoutsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizers/%s/payouts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSendOTPRequest calls the generic SendOTP builder with application/json body
+func NewSendOTPRequest(server string, body SendOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSendOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSendOTPRequestWithBody generates requests for SendOTP with any type of body
+func NewSendOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/OTP")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewProcessPaymentRequest calls the generic ProcessPayment builder with application/json body
+func NewProcessPaymentRequest(server string, body ProcessPaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessPaymentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewProcessPaymentRequestWithBody generates requests for ProcessPayment with any type of body
+func NewProcessPaymentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/process")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewValidateOTPRequest calls the generic ValidateOTP builder with application/json body
+func NewValidateOTPRequest(server string, body ValidateOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewValidateOTPRequestWithBody generates requests for ValidateOTP with any type of body
+func NewValidateOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPaymentRequest generates requests for GetPayment
+func NewGetPaymentRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPaymentFeesRequest generates requests for GetPaymentFees
+func NewGetPaymentFeesRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/fees", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRefundPaymentRequest calls the generic RefundPayment builder with application/json body
+func NewRefundPaymentRequest(server string, id PaymentID, body RefundPaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefundPaymentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRefundPaymentRequestWithBody generates requests for RefundPayment with any type of body
+func NewRefundPaymentRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/refund", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRefundsRequest generates requests for ListRefunds
+func NewListRefundsRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/refunds", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPaymentStatusRequest generates requests for GetPaymentStatus
+func NewGetPaymentStatusRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamPaymentRequest generates requests for StreamPayment
+func NewStreamPaymentRequest(server string, id PaymentID, params *StreamPaymentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LastEventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_event_id", runtime.ParamLocationQuery, *params.LastEventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePaymentStreamTokenRequest generates requests for CreatePaymentStreamToken
+func NewCreatePaymentStreamTokenRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/stream-token", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPaymentTimelineRequest generates requests for GetPaymentTimeline
+func NewGetPaymentTimelineRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payments/%s/timeline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportBankPayoutsRequest generates requests for ExportBankPayouts
+func NewExportBankPayoutsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/bank-export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunPayoutsRequest calls the generic RunPayouts builder with application/json body
+func NewRunPayoutsRequest(server string, body RunPayoutsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunPayoutsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRunPayoutsRequestWithBody generates requests for RunPayouts with any type of body
+func NewRunPayoutsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/run")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPayoutRequest generates requests for GetPayout
+func NewGetPayoutRequest(server string, id PayoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmPayoutRequest calls the generic ConfirmPayout builder with application/json body
+func NewConfirmPayoutRequest(server string, id PayoutID, body ConfirmPayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmPayoutRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewConfirmPayoutRequestWithBody generates requests for ConfirmPayout with any type of body
+func NewConfirmPayoutRequestWithBody(server string, id PayoutID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFailPayoutRequest calls the generic FailPayout builder with application/json body
+func NewFailPayoutRequest(server string, id PayoutID, body FailPayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFailPayoutRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewFailPayoutRequestWithBody generates requests for FailPayout with any type of body
+func NewFailPayoutRequestWithBody(server string, id PayoutID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/%s/fail", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPayoutStatementRequest generates requests for GetPayoutStatement
+func NewGetPayoutStatementRequest(server string, id PayoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/%s/statement", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPayoutStatementRequest generates requests for ExportPayoutStatement
+func NewExportPayoutStatementRequest(server string, id PayoutID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payouts/%s/statement/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListReconciliationRunsRequest generates requests for ListReconciliationRuns
+func NewListReconciliationRunsRequest(server string, params *ListReconciliationRunsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reconciliation/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartReconciliationRequest calls the generic StartReconciliation builder with application/json body
+func NewStartReconciliationRequest(server string, body StartReconciliationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartReconciliationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartReconciliationRequestWithBody generates requests for StartReconciliation with any type of body
+func NewStartReconciliationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reconciliation/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetReconciliationRunRequest generates requests for GetReconciliationRun
+func NewGetReconciliationRunRequest(server string, id ReconciliationRunID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reconciliation/runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportReconciliationRunRequest generates requests for ExportReconciliationRun
+func NewExportReconciliationRunRequest(server string, id ReconciliationRunID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reconciliation/runs/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListIPBansRequest generates requests for ListIPBans
+func NewListIPBansRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/ip-bans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBanIPRequest calls the generic BanIP builder with application/json body
+func NewBanIPRequest(server string, body BanIPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBanIPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBanIPRequestWithBody generates requests for BanIP with any type of body
+func NewBanIPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/ip-bans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnbanIPRequest generates requests for UnbanIP
+func NewUnbanIPRequest(server string, ip BannedIP) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ip", runtime.ParamLocationPath, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/ip-bans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRiskReviewsRequest generates requests for ListRiskReviews
+func NewListRiskReviewsRequest(server string, params *ListRiskReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizer_id", runtime.ParamLocationQuery, *params.OrganizerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRiskReviewRequest generates requests for GetRiskReview
+func NewGetRiskReviewRequest(server string, id PaymentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/reviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveRiskReviewRequest calls the generic ApproveRiskReview builder with application/json body
+func NewApproveRiskReviewRequest(server string, id PaymentID, body ApproveRiskReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveRiskReviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewApproveRiskReviewRequestWithBody generates requests for ApproveRiskReview with any type of body
+func NewApproveRiskReviewRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/reviews/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRejectRiskReviewRequest calls the generic RejectRiskReview builder with application/json body
+func NewRejectRiskReviewRequest(server string, id PaymentID, body RejectRiskReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRejectRiskReviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRejectRiskReviewRequestWithBody generates requests for RejectRiskReview with any type of body
+func NewRejectRiskReviewRequestWithBody(server string, id PaymentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/reviews/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRiskRulesRequest generates requests for GetRiskRules
+func NewGetRiskRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/risk/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateStripePaymentRequest calls the generic CreateStripePayment builder with application/json body
+func NewCreateStripePaymentRequest(server string, body CreateStripePaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStripePaymentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateStripePaymentRequestWithBody generates requests for CreateStripePayment with any type of body
+func NewCreateStripePaymentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/payment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStripePaymentRequest generates requests for GetStripePayment
+func NewGetStripePaymentRequest(server string, id IntentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/payment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmStripePaymentRequest calls the generic ConfirmStripePayment builder with application/json body
+func NewConfirmStripePaymentRequest(server string, id IntentID, body ConfirmStripePaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmStripePaymentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewConfirmStripePaymentRequestWithBody generates requests for ConfirmStripePayment with any type of body
+func NewConfirmStripePaymentRequestWithBody(server string, id IntentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/payment/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateStripeRefundRequest calls the generic CreateStripeRefund builder with application/json body
+func NewCreateStripeRefundRequest(server string, body CreateStripeRefundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStripeRefundRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateStripeRefundRequestWithBody generates requests for CreateStripeRefund with any type of body
+func NewCreateStripeRefundRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/refund")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewValidateCardRequest calls the generic ValidateCard builder with application/json body
+func NewValidateCardRequest(server string, params *ValidateCardParams, body ValidateCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateCardRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewValidateCardRequestWithBody generates requests for ValidateCard with any type of body
+func NewValidateCardRequestWithBody(server string, params *ValidateCardParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/validate-card")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCaptchaToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Captcha-Token", runtime.ParamLocationHeader, *params.XCaptchaToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Captcha-Token", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewHandleStripeWebhookRequest calls the generic HandleStripeWebhook builder with application/json body
+func NewHandleStripeWebhookRequest(server string, params *HandleStripeWebhookParams, body HandleStripeWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHandleStripeWebhookRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewHandleStripeWebhookRequestWithBody generates requests for HandleStripeWebhook with any type of body
+func NewHandleStripeWebhookRequestWithBody(server string, params *HandleStripeWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stripe/webhook")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.StripeSignature != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Stripe-Signature", runtime.ParamLocationHeader, *params.StripeSignature)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Stripe-Signature", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookDeliveryRequest generates requests for GetWebhookDelivery
+func NewGetWebhookDeliveryRequest(server string, id WebhookDeliveryID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/deliveries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRedeliverWebhookRequest generates requests for RedeliverWebhook
+func NewRedeliverWebhookRequest(server string, id WebhookDeliveryID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/deliveries/%s/redeliver", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWebhookEndpointsRequest generates requests for ListWebhookEndpoints
+func NewListWebhookEndpointsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookEndpointRequest calls the generic CreateWebhookEndpoint builder with application/json body
+func NewCreateWebhookEndpointRequest(server string, body CreateWebhookEndpointJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookEndpointRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWebhookEndpointRequestWithBody generates requests for CreateWebhookEndpoint with any type of body
+func NewCreateWebhookEndpointRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookEndpointRequest generates requests for DeleteWebhookEndpoint
+func NewDeleteWebhookEndpointRequest(server string, id WebhookEndpointID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookEndpointRequest generates requests for GetWebhookEndpoint
+func NewGetWebhookEndpointRequest(server string, id WebhookEndpointID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookEndpointRequest calls the generic UpdateWebhookEndpoint builder with application/json body
+func NewUpdateWebhookEndpointRequest(server string, id WebhookEndpointID, body UpdateWebhookEndpointJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookEndpointRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookEndpointRequestWithBody generates requests for UpdateWebhookEndpoint with any type of body
+func NewUpdateWebhookEndpointRequestWithBody(server string, id WebhookEndpointID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, id WebhookEndpointID, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateWebhookSecretRequest generates requests for RotateWebhookSecret
+func NewRotateWebhookSecretRequest(server string, id WebhookEndpointID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/endpoints/%s/rotate-secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminLookupPaymentsWithResponse request
+	AdminLookupPaymentsWithResponse(ctx context.Context, params *AdminLookupPaymentsParams, reqEditors ...RequestEditorFn) (*AdminLookupPaymentsResponse, error)
+
+	// AdminResendOTPWithBodyWithResponse request with any body
+	AdminResendOTPWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminResendOTPResponse, error)
+
+	AdminResendOTPWithResponse(ctx context.Context, id PaymentID, body AdminResendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminResendOTPResponse, error)
+
+	// AdminResetOTPWithBodyWithResponse request with any body
+	AdminResetOTPWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminResetOTPResponse, error)
+
+	AdminResetOTPWithResponse(ctx context.Context, id PaymentID, body AdminResetOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminResetOTPResponse, error)
+
+	// AdminForceRefundWithBodyWithResponse request with any body
+	AdminForceRefundWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminForceRefundResponse, error)
+
+	AdminForceRefundWithResponse(ctx context.Context, id PaymentID, body AdminForceRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminForceRefundResponse, error)
+
+	// AdminResyncWithResponse request
+	AdminResyncWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*AdminResyncResponse, error)
+
+	// AdminOverrideStatusWithBodyWithResponse request with any body
+	AdminOverrideStatusWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminOverrideStatusResponse, error)
+
+	AdminOverrideStatusWithResponse(ctx context.Context, id PaymentID, body AdminOverrideStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminOverrideStatusResponse, error)
+
+	// AdminGetPaymentTimelineWithResponse request
+	AdminGetPaymentTimelineWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*AdminGetPaymentTimelineResponse, error)
+
+	// ListAuditEntriesWithResponse request
+	ListAuditEntriesWithResponse(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error)
+
+	// ExportAuditEntriesWithResponse request
+	ExportAuditEntriesWithResponse(ctx context.Context, params *ExportAuditEntriesParams, reqEditors ...RequestEditorFn) (*ExportAuditEntriesResponse, error)
+
+	// VerifyAuditLogWithResponse request
+	VerifyAuditLogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyAuditLogResponse, error)
+
+	// GetCheckoutPageWithResponse request
+	GetCheckoutPageWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*GetCheckoutPageResponse, error)
+
+	// PayCheckoutWithBodyWithResponse request with any body
+	PayCheckoutWithBodyWithResponse(ctx context.Context, id CheckoutID, params *PayCheckoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PayCheckoutResponse, error)
+
+	PayCheckoutWithResponse(ctx context.Context, id CheckoutID, params *PayCheckoutParams, body PayCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PayCheckoutResponse, error)
+
+	// ConfirmCheckoutWithBodyWithResponse request with any body
+	ConfirmCheckoutWithBodyWithResponse(ctx context.Context, id CheckoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmCheckoutResponse, error)
+
+	ConfirmCheckoutWithResponse(ctx context.Context, id CheckoutID, body ConfirmCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmCheckoutResponse, error)
+
+	// ListCheckoutSessionsWithResponse request
+	ListCheckoutSessionsWithResponse(ctx context.Context, params *ListCheckoutSessionsParams, reqEditors ...RequestEditorFn) (*ListCheckoutSessionsResponse, error)
+
+	// CreateCheckoutSessionWithBodyWithResponse request with any body
+	CreateCheckoutSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCheckoutSessionResponse, error)
+
+	CreateCheckoutSessionWithResponse(ctx context.Context, body CreateCheckoutSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCheckoutSessionResponse, error)
+
+	// GetCheckoutSessionWithResponse request
+	GetCheckoutSessionWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*GetCheckoutSessionResponse, error)
+
+	// CancelCheckoutSessionWithResponse request
+	CancelCheckoutSessionWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*CancelCheckoutSessionResponse, error)
+
+	// EnsureCustomerWithBodyWithResponse request with any body
+	EnsureCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnsureCustomerResponse, error)
+
+	EnsureCustomerWithResponse(ctx context.Context, body EnsureCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*EnsureCustomerResponse, error)
+
+	// GetCustomerWithResponse request
+	GetCustomerWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error)
+
+	// ListPaymentMethodsWithResponse request
+	ListPaymentMethodsWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*ListPaymentMethodsResponse, error)
+
+	// DeletePaymentMethodWithResponse request
+	DeletePaymentMethodWithResponse(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*DeletePaymentMethodResponse, error)
+
+	// SetDefaultPaymentMethodWithResponse request
+	SetDefaultPaymentMethodWithResponse(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*SetDefaultPaymentMethodResponse, error)
+
+	// CreateSetupIntentWithResponse request
+	CreateSetupIntentWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*CreateSetupIntentResponse, error)
+
+	// GetDebugVarsWithResponse request
+	GetDebugVarsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDebugVarsResponse, error)
+
+	// ListDisputesWithResponse request
+	ListDisputesWithResponse(ctx context.Context, params *ListDisputesParams, reqEditors ...RequestEditorFn) (*ListDisputesResponse, error)
+
+	// GetDisputeWithResponse request
+	GetDisputeWithResponse(ctx context.Context, id DisputeID, reqEditors ...RequestEditorFn) (*GetDisputeResponse, error)
+
+	// SubmitDisputeEvidenceWithBodyWithResponse request with any body
+	SubmitDisputeEvidenceWithBodyWithResponse(ctx context.Context, id DisputeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitDisputeEvidenceResponse, error)
+
+	SubmitDisputeEvidenceWithResponse(ctx context.Context, id DisputeID, body SubmitDisputeEvidenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitDisputeEvidenceResponse, error)
+
+	// ListEventsWithResponse request
+	ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+
+	// ReplayEventsWithBodyWithResponse request with any body
+	ReplayEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplayEventsResponse, error)
+
+	ReplayEventsWithResponse(ctx context.Context, body ReplayEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplayEventsResponse, error)
+
+	// ListFeePlansWithResponse request
+	ListFeePlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFeePlansResponse, error)
+
+	// CreateFeePlanWithBodyWithResponse request with any body
+	CreateFeePlanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeePlanResponse, error)
+
+	CreateFeePlanWithResponse(ctx context.Context, body CreateFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeePlanResponse, error)
+
+	// GetFeePlanWithResponse request
+	GetFeePlanWithResponse(ctx context.Context, id FeePlanID, reqEditors ...RequestEditorFn) (*GetFeePlanResponse, error)
+
+	// SaveFeePlanWithBodyWithResponse request with any body
+	SaveFeePlanWithBodyWithResponse(ctx context.Context, id FeePlanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveFeePlanResponse, error)
+
+	SaveFeePlanWithResponse(ctx context.Context, id FeePlanID, body SaveFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveFeePlanResponse, error)
+
+	// GetLedgerBalancesWithResponse request
+	GetLedgerBalancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLedgerBalancesResponse, error)
+
+	// CheckLedgerInvariantsWithResponse request
+	CheckLedgerInvariantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckLedgerInvariantsResponse, error)
+
+	// GetLedgerOrganizerBalancesWithResponse request
+	GetLedgerOrganizerBalancesWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetLedgerOrganizerBalancesResponse, error)
+
+	// GetPaymentJournalEntriesWithResponse request
+	GetPaymentJournalEntriesWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentJournalEntriesResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// StreamOrderWithResponse request
+	StreamOrderWithResponse(ctx context.Context, id OrderID, params *StreamOrderParams, reqEditors ...RequestEditorFn) (*StreamOrderResponse, error)
+
+	// CreateOrderStreamTokenWithResponse request
+	CreateOrderStreamTokenWithResponse(ctx context.Context, id OrderID, reqEditors ...RequestEditorFn) (*CreateOrderStreamTokenResponse, error)
+
+	// CreateOrganizerWithBodyWithResponse request with any body
+	CreateOrganizerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizerResponse, error)
+
+	CreateOrganizerWithResponse(ctx context.Context, body CreateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizerResponse, error)
+
+	// GetOrganizerWithResponse request
+	GetOrganizerWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetOrganizerResponse, error)
+
+	// UpdateOrganizerWithBodyWithResponse request with any body
+	UpdateOrganizerWithBodyWithResponse(ctx context.Context, id OrganizerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizerResponse, error)
+
+	UpdateOrganizerWithResponse(ctx context.Context, id OrganizerID, body UpdateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizerResponse, error)
+
+	// RotateOrganizerAPIKeyWithResponse request
+	RotateOrganizerAPIKeyWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*RotateOrganizerAPIKeyResponse, error)
+
+	// GetOrganizerBalancesWithResponse request
+	GetOrganizerBalancesWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetOrganizerBalancesResponse, error)
+
+	// ListOrganizerPaymentsWithResponse request
+	ListOrganizerPaymentsWithResponse(ctx context.Context, id OrganizerID, params *ListOrganizerPaymentsParams, reqEditors ...RequestEditorFn) (*ListOrganizerPaymentsResponse, error)
+
+	// ListOrganizerPayoutsWithResponse request
+	ListOrganizerPayoutsWithResponse(ctx context.Context, id OrganizerID, params *ListOrganizerPayoutsParams, reqEditors ...RequestEditorFn) (*ListOrganizerPayoutsResponse, error)
+
+	// SendOTPWithBodyWithResponse request with any body
+	SendOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendOTPResponse, error)
+
+	SendOTPWithResponse(ctx context.Context, body SendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*SendOTPResponse, error)
+
+	// ProcessPaymentWithBodyWithResponse request with any body
+	ProcessPaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessPaymentResponse, error)
+
+	ProcessPaymentWithResponse(ctx context.Context, body ProcessPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessPaymentResponse, error)
+
+	// ValidateOTPWithBodyWithResponse request with any body
+	ValidateOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateOTPResponse, error)
+
+	ValidateOTPWithResponse(ctx context.Context, body ValidateOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateOTPResponse, error)
+
+	// GetPaymentWithResponse request
+	GetPaymentWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentResponse, error)
+
+	// GetPaymentFeesWithResponse request
+	GetPaymentFeesWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentFeesResponse, error)
+
+	// RefundPaymentWithBodyWithResponse request with any body
+	RefundPaymentWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefundPaymentResponse, error)
+
+	RefundPaymentWithResponse(ctx context.Context, id PaymentID, body RefundPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RefundPaymentResponse, error)
+
+	// ListRefundsWithResponse request
+	ListRefundsWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*ListRefundsResponse, error)
+
+	// GetPaymentStatusWithResponse request
+	GetPaymentStatusWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentStatusResponse, error)
+
+	// StreamPaymentWithResponse request
+	StreamPaymentWithResponse(ctx context.Context, id PaymentID, params *StreamPaymentParams, reqEditors ...RequestEditorFn) (*StreamPaymentResponse, error)
+
+	// CreatePaymentStreamTokenWithResponse request
+	CreatePaymentStreamTokenWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*CreatePaymentStreamTokenResponse, error)
+
+	// GetPaymentTimelineWithResponse request
+	GetPaymentTimelineWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentTimelineResponse, error)
+
+	// ExportBankPayoutsWithResponse request
+	ExportBankPayoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportBankPayoutsResponse, error)
+
+	// RunPayoutsWithBodyWithResponse request with any body
+	RunPayoutsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunPayoutsResponse, error)
+
+	RunPayoutsWithResponse(ctx context.Context, body RunPayoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*RunPayoutsResponse, error)
+
+	// GetPayoutWithResponse request
+	GetPayoutWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*GetPayoutResponse, error)
+
+	// ConfirmPayoutWithBodyWithResponse request with any body
+	ConfirmPayoutWithBodyWithResponse(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPayoutResponse, error)
+
+	ConfirmPayoutWithResponse(ctx context.Context, id PayoutID, body ConfirmPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPayoutResponse, error)
+
+	// FailPayoutWithBodyWithResponse request with any body
+	FailPayoutWithBodyWithResponse(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FailPayoutResponse, error)
+
+	FailPayoutWithResponse(ctx context.Context, id PayoutID, body FailPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*FailPayoutResponse, error)
+
+	// GetPayoutStatementWithResponse request
+	GetPayoutStatementWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*GetPayoutStatementResponse, error)
+
+	// ExportPayoutStatementWithResponse request
+	ExportPayoutStatementWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*ExportPayoutStatementResponse, error)
+
+	// ListReconciliationRunsWithResponse request
+	ListReconciliationRunsWithResponse(ctx context.Context, params *ListReconciliationRunsParams, reqEditors ...RequestEditorFn) (*ListReconciliationRunsResponse, error)
+
+	// StartReconciliationWithBodyWithResponse request with any body
+	StartReconciliationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartReconciliationResponse, error)
+
+	StartReconciliationWithResponse(ctx context.Context, body StartReconciliationJSONRequestBody, reqEditors ...RequestEditorFn) (*StartReconciliationResponse, error)
+
+	// GetReconciliationRunWithResponse request
+	GetReconciliationRunWithResponse(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*GetReconciliationRunResponse, error)
+
+	// ExportReconciliationRunWithResponse request
+	ExportReconciliationRunWithResponse(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*ExportReconciliationRunResponse, error)
+
+	// ListIPBansWithResponse request
+	ListIPBansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIPBansResponse, error)
+
+	// BanIPWithBodyWithResponse request with any body
+	BanIPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BanIPResponse, error)
+
+	BanIPWithResponse(ctx context.Context, body BanIPJSONRequestBody, reqEditors ...RequestEditorFn) (*BanIPResponse, error)
+
+	// UnbanIPWithResponse request
+	UnbanIPWithResponse(ctx context.Context, ip BannedIP, reqEditors ...RequestEditorFn) (*UnbanIPResponse, error)
+
+	// ListRiskReviewsWithResponse request
+	ListRiskReviewsWithResponse(ctx context.Context, params *ListRiskReviewsParams, reqEditors ...RequestEditorFn) (*ListRiskReviewsResponse, error)
+
+	// GetRiskReviewWithResponse request
+	GetRiskReviewWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetRiskReviewResponse, error)
+
+	// ApproveRiskReviewWithBodyWithResponse request with any body
+	ApproveRiskReviewWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveRiskReviewResponse, error)
+
+	ApproveRiskReviewWithResponse(ctx context.Context, id PaymentID, body ApproveRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveRiskReviewResponse, error)
+
+	// RejectRiskReviewWithBodyWithResponse request with any body
+	RejectRiskReviewWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectRiskReviewResponse, error)
+
+	RejectRiskReviewWithResponse(ctx context.Context, id PaymentID, body RejectRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectRiskReviewResponse, error)
+
+	// GetRiskRulesWithResponse request
+	GetRiskRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRiskRulesResponse, error)
+
+	// CreateStripePaymentWithBodyWithResponse request with any body
+	CreateStripePaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStripePaymentResponse, error)
+
+	CreateStripePaymentWithResponse(ctx context.Context, body CreateStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStripePaymentResponse, error)
+
+	// GetStripePaymentWithResponse request
+	GetStripePaymentWithResponse(ctx context.Context, id IntentID, reqEditors ...RequestEditorFn) (*GetStripePaymentResponse, error)
+
+	// ConfirmStripePaymentWithBodyWithResponse request with any body
+	ConfirmStripePaymentWithBodyWithResponse(ctx context.Context, id IntentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmStripePaymentResponse, error)
+
+	ConfirmStripePaymentWithResponse(ctx context.Context, id IntentID, body ConfirmStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmStripePaymentResponse, error)
+
+	// CreateStripeRefundWithBodyWithResponse request with any body
+	CreateStripeRefundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStripeRefundResponse, error)
+
+	CreateStripeRefundWithResponse(ctx context.Context, body CreateStripeRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStripeRefundResponse, error)
+
+	// ValidateCardWithBodyWithResponse request with any body
+	ValidateCardWithBodyWithResponse(ctx context.Context, params *ValidateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateCardResponse, error)
+
+	ValidateCardWithResponse(ctx context.Context, params *ValidateCardParams, body ValidateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateCardResponse, error)
+
+	// HandleStripeWebhookWithBodyWithResponse request with any body
+	HandleStripeWebhookWithBodyWithResponse(ctx context.Context, params *HandleStripeWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleStripeWebhookResponse, error)
+
+	HandleStripeWebhookWithResponse(ctx context.Context, params *HandleStripeWebhookParams, body HandleStripeWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleStripeWebhookResponse, error)
+
+	// GetWebhookDeliveryWithResponse request
+	GetWebhookDeliveryWithResponse(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*GetWebhookDeliveryResponse, error)
+
+	// RedeliverWebhookWithResponse request
+	RedeliverWebhookWithResponse(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*RedeliverWebhookResponse, error)
+
+	// ListWebhookEndpointsWithResponse request
+	ListWebhookEndpointsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookEndpointsResponse, error)
+
+	// CreateWebhookEndpointWithBodyWithResponse request with any body
+	CreateWebhookEndpointWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookEndpointResponse, error)
+
+	CreateWebhookEndpointWithResponse(ctx context.Context, body CreateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookEndpointResponse, error)
+
+	// DeleteWebhookEndpointWithResponse request
+	DeleteWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*DeleteWebhookEndpointResponse, error)
+
+	// GetWebhookEndpointWithResponse request
+	GetWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*GetWebhookEndpointResponse, error)
+
+	// UpdateWebhookEndpointWithBodyWithResponse request with any body
+	UpdateWebhookEndpointWithBodyWithResponse(ctx context.Context, id WebhookEndpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookEndpointResponse, error)
+
+	UpdateWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, body UpdateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookEndpointResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, id WebhookEndpointID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// RotateWebhookSecretWithResponse request
+	RotateWebhookSecretWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*RotateWebhookSecretResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+}
+
+type AdminLookupPaymentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminLookupPaymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminLookupPaymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminResendOTPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminResendOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminResendOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminResetOTPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminResetOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminResetOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminForceRefundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminForceRefundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminForceRefundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminResyncResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminResyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminResyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminOverrideStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminOverrideStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminOverrideStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetPaymentTimelineResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentTimelineEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetPaymentTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetPaymentTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditEntriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AuditEntryListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAuditEntriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAuditEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAuditEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyAuditLogResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AuditVerificationEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCheckoutPageResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutPageEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCheckoutPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCheckoutPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PayCheckoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutPayResultEnvelope
+	ApplicationproblemJSON429     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PayCheckoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PayCheckoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmCheckoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutPayResultEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmCheckoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmCheckoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCheckoutSessionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutSessionListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCheckoutSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCheckoutSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCheckoutSessionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CheckoutSessionEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCheckoutSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCheckoutSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCheckoutSessionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutSessionEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCheckoutSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCheckoutSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelCheckoutSessionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckoutSessionEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelCheckoutSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelCheckoutSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnsureCustomerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CustomerEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EnsureCustomerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnsureCustomerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CustomerEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPaymentMethodsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SavedPaymentMethodListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPaymentMethodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPaymentMethodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePaymentMethodResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentMethodRefEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePaymentMethodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePaymentMethodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetDefaultPaymentMethodResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentMethodRefEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetDefaultPaymentMethodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetDefaultPaymentMethodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSetupIntentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SetupIntentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSetupIntentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSetupIntentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDebugVarsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *map[string]interface{}
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDebugVarsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDebugVarsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDisputesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DisputeListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDisputesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDisputesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDisputeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DisputeEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDisputeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDisputeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitDisputeEvidenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DisputeEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitDisputeEvidenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitDisputeEvidenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EventLogListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplayEventsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ReplayResultEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplayEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplayEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFeePlansResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeePlanListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFeePlansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFeePlansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFeePlanResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeePlanEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFeePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFeePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeePlanResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeePlanEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveFeePlanResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeePlanEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveFeePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveFeePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerBalancesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AccountBalanceListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckLedgerInvariantsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerInvariantReportEnvelope
+	JSON409                       *LedgerInvariantReportEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckLedgerInvariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckLedgerInvariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerOrganizerBalancesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AccountBalanceListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerOrganizerBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerOrganizerBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPaymentJournalEntriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *JournalEntryListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPaymentJournalEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPaymentJournalEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamOrderResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON403     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrderStreamTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StreamTokenEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrderStreamTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrderStreamTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrganizerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *OrganizerCredentialsEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *OrganizerEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOrganizerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *OrganizerEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateOrganizerAPIKeyResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *OrganizerCredentialsEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateOrganizerAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateOrganizerAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizerBalancesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AccountBalanceListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizerBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizerBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizerPaymentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizerPaymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizerPaymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizerPayoutsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PayoutListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizerPayoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizerPayoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SendOTPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *OTPSentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SendOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessPaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessPaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessPaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateOTPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Envelope
+	ApplicationproblemJSON401     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPaymentFeesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeeLineListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPaymentFeesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPaymentFeesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefundPaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RefundPaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefundPaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRefundsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RefundListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRefundsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRefundsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPaymentStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentStatusEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPaymentStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPaymentStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamPaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON403     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamPaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamPaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePaymentStreamTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StreamTokenEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePaymentStreamTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePaymentStreamTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPaymentTimelineResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EventTimelineEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPaymentTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPaymentTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportBankPayoutsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportBankPayoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportBankPayoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunPayoutsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PayoutListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RunPayoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunPayoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPayoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PayoutEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmPayoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PayoutEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmPayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmPayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FailPayoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PayoutEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FailPayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FailPayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPayoutStatementResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SettlementStatementEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPayoutStatementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPayoutStatementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPayoutStatementResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPayoutStatementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPayoutStatementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReconciliationRunsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ReconciliationRunListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReconciliationRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReconciliationRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartReconciliationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *ReconciliationRunEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StartReconciliationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartReconciliationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReconciliationRunResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ReconciliationRunDetailEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReconciliationRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReconciliationRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportReconciliationRunResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportReconciliationRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportReconciliationRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIPBansResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *IPBanListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIPBansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIPBansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BanIPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *IPBanEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BanIPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BanIPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnbanIPResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Envelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnbanIPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnbanIPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRiskReviewsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RiskReviewListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRiskReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRiskReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRiskReviewResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RiskReviewEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRiskReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRiskReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveRiskReviewResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RiskReviewEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveRiskReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveRiskReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectRiskReviewResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RiskReviewEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectRiskReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectRiskReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRiskRulesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RiskRulesEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRiskRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRiskRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStripePaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StripePaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStripePaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStripePaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStripePaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StripePaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStripePaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStripePaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmStripePaymentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StripePaymentEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmStripePaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmStripePaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStripeRefundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RefundEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStripeRefundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStripeRefundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateCardResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CardValidationEnvelope
+	ApplicationproblemJSON429     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HandleStripeWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StripeWebhookAck
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r HandleStripeWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HandleStripeWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookDeliveryResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookDeliveryEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedeliverWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookDeliveryEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RedeliverWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedeliverWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookEndpointsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookEndpointListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookEndpointsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookEndpointsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookEndpointResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *WebhookEndpointSecretEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookEndpointResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookEndpointResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookEndpointResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeletedEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookEndpointResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookEndpointResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookEndpointResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookEndpointEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookEndpointResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookEndpointResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookEndpointResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookEndpointEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookEndpointResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookEndpointResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookDeliveryListEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateWebhookSecretResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *WebhookEndpointSecretEnvelope
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateWebhookSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateWebhookSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AdminLookupPaymentsWithResponse request returning *AdminLookupPaymentsResponse
+func (c *ClientWithResponses) AdminLookupPaymentsWithResponse(ctx context.Context, params *AdminLookupPaymentsParams, reqEditors ...RequestEditorFn) (*AdminLookupPaymentsResponse, error) {
+	rsp, err := c.AdminLookupPayments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminLookupPaymentsResponse(rsp)
+}
+
+// AdminResendOTPWithBodyWithResponse request with arbitrary body returning *AdminResendOTPResponse
+func (c *ClientWithResponses) AdminResendOTPWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminResendOTPResponse, error) {
+	rsp, err := c.AdminResendOTPWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResendOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminResendOTPWithResponse(ctx context.Context, id PaymentID, body AdminResendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminResendOTPResponse, error) {
+	rsp, err := c.AdminResendOTP(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResendOTPResponse(rsp)
+}
+
+// AdminResetOTPWithBodyWithResponse request with arbitrary body returning *AdminResetOTPResponse
+func (c *ClientWithResponses) AdminResetOTPWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminResetOTPResponse, error) {
+	rsp, err := c.AdminResetOTPWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResetOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminResetOTPWithResponse(ctx context.Context, id PaymentID, body AdminResetOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminResetOTPResponse, error) {
+	rsp, err := c.AdminResetOTP(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResetOTPResponse(rsp)
+}
+
+// AdminForceRefundWithBodyWithResponse request with arbitrary body returning *AdminForceRefundResponse
+func (c *ClientWithResponses) AdminForceRefundWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminForceRefundResponse, error) {
+	rsp, err := c.AdminForceRefundWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminForceRefundResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminForceRefundWithResponse(ctx context.Context, id PaymentID, body AdminForceRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminForceRefundResponse, error) {
+	rsp, err := c.AdminForceRefund(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminForceRefundResponse(rsp)
+}
+
+// AdminResyncWithResponse request returning *AdminResyncResponse
+func (c *ClientWithResponses) AdminResyncWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*AdminResyncResponse, error) {
+	rsp, err := c.AdminResync(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResyncResponse(rsp)
+}
+
+// AdminOverrideStatusWithBodyWithResponse request with arbitrary body returning *AdminOverrideStatusResponse
+func (c *ClientWithResponses) AdminOverrideStatusWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminOverrideStatusResponse, error) {
+	rsp, err := c.AdminOverrideStatusWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminOverrideStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminOverrideStatusWithResponse(ctx context.Context, id PaymentID, body AdminOverrideStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminOverrideStatusResponse, error) {
+	rsp, err := c.AdminOverrideStatus(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminOverrideStatusResponse(rsp)
+}
+
+// AdminGetPaymentTimelineWithResponse request returning *AdminGetPaymentTimelineResponse
+func (c *ClientWithResponses) AdminGetPaymentTimelineWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*AdminGetPaymentTimelineResponse, error) {
+	rsp, err := c.AdminGetPaymentTimeline(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetPaymentTimelineResponse(rsp)
+}
+
+// ListAuditEntriesWithResponse request returning *ListAuditEntriesResponse
+func (c *ClientWithResponses) ListAuditEntriesWithResponse(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error) {
+	rsp, err := c.ListAuditEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEntriesResponse(rsp)
+}
+
+// ExportAuditEntriesWithResponse request returning *ExportAuditEntriesResponse
+func (c *ClientWithResponses) ExportAuditEntriesWithResponse(ctx context.Context, params *ExportAuditEntriesParams, reqEditors ...RequestEditorFn) (*ExportAuditEntriesResponse, error) {
+	rsp, err := c.ExportAuditEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAuditEntriesResponse(rsp)
+}
+
+// VerifyAuditLogWithResponse request returning *VerifyAuditLogResponse
+func (c *ClientWithResponses) VerifyAuditLogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyAuditLogResponse, error) {
+	rsp, err := c.VerifyAuditLog(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyAuditLogResponse(rsp)
+}
+
+// GetCheckoutPageWithResponse request returning *GetCheckoutPageResponse
+func (c *ClientWithResponses) GetCheckoutPageWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*GetCheckoutPageResponse, error) {
+	rsp, err := c.GetCheckoutPage(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCheckoutPageResponse(rsp)
+}
+
+// PayCheckoutWithBodyWithResponse request with arbitrary body returning *PayCheckoutResponse
+func (c *ClientWithResponses) PayCheckoutWithBodyWithResponse(ctx context.Context, id CheckoutID, params *PayCheckoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PayCheckoutResponse, error) {
+	rsp, err := c.PayCheckoutWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePayCheckoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PayCheckoutWithResponse(ctx context.Context, id CheckoutID, params *PayCheckoutParams, body PayCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PayCheckoutResponse, error) {
+	rsp, err := c.PayCheckout(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePayCheckoutResponse(rsp)
+}
+
+// ConfirmCheckoutWithBodyWithResponse request with arbitrary body returning *ConfirmCheckoutResponse
+func (c *ClientWithResponses) ConfirmCheckoutWithBodyWithResponse(ctx context.Context, id CheckoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmCheckoutResponse, error) {
+	rsp, err := c.ConfirmCheckoutWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmCheckoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmCheckoutWithResponse(ctx context.Context, id CheckoutID, body ConfirmCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmCheckoutResponse, error) {
+	rsp, err := c.ConfirmCheckout(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmCheckoutResponse(rsp)
+}
+
+// ListCheckoutSessionsWithResponse request returning *ListCheckoutSessionsResponse
+func (c *ClientWithResponses) ListCheckoutSessionsWithResponse(ctx context.Context, params *ListCheckoutSessionsParams, reqEditors ...RequestEditorFn) (*ListCheckoutSessionsResponse, error) {
+	rsp, err := c.ListCheckoutSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCheckoutSessionsResponse(rsp)
+}
+
+// CreateCheckoutSessionWithBodyWithResponse request with arbitrary body returning *CreateCheckoutSessionResponse
+func (c *ClientWithResponses) CreateCheckoutSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCheckoutSessionResponse, error) {
+	rsp, err := c.CreateCheckoutSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCheckoutSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCheckoutSessionWithResponse(ctx context.Context, body CreateCheckoutSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCheckoutSessionResponse, error) {
+	rsp, err := c.CreateCheckoutSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCheckoutSessionResponse(rsp)
+}
+
+// GetCheckoutSessionWithResponse request returning *GetCheckoutSessionResponse
+func (c *ClientWithResponses) GetCheckoutSessionWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*GetCheckoutSessionResponse, error) {
+	rsp, err := c.GetCheckoutSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCheckoutSessionResponse(rsp)
+}
+
+// CancelCheckoutSessionWithResponse request returning *CancelCheckoutSessionResponse
+func (c *ClientWithResponses) CancelCheckoutSessionWithResponse(ctx context.Context, id CheckoutID, reqEditors ...RequestEditorFn) (*CancelCheckoutSessionResponse, error) {
+	rsp, err := c.CancelCheckoutSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelCheckoutSessionResponse(rsp)
+}
+
+// EnsureCustomerWithBodyWithResponse request with arbitrary body returning *EnsureCustomerResponse
+func (c *ClientWithResponses) EnsureCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnsureCustomerResponse, error) {
+	rsp, err := c.EnsureCustomerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnsureCustomerResponse(rsp)
+}
+
+func (c *ClientWithResponses) EnsureCustomerWithResponse(ctx context.Context, body EnsureCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*EnsureCustomerResponse, error) {
+	rsp, err := c.EnsureCustomer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnsureCustomerResponse(rsp)
+}
+
+// GetCustomerWithResponse request returning *GetCustomerResponse
+func (c *ClientWithResponses) GetCustomerWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error) {
+	rsp, err := c.GetCustomer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomerResponse(rsp)
+}
+
+// ListPaymentMethodsWithResponse request returning *ListPaymentMethodsResponse
+func (c *ClientWithResponses) ListPaymentMethodsWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*ListPaymentMethodsResponse, error) {
+	rsp, err := c.ListPaymentMethods(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPaymentMethodsResponse(rsp)
+}
+
+// DeletePaymentMethodWithResponse request returning *DeletePaymentMethodResponse
+func (c *ClientWithResponses) DeletePaymentMethodWithResponse(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*DeletePaymentMethodResponse, error) {
+	rsp, err := c.DeletePaymentMethod(ctx, id, pm, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePaymentMethodResponse(rsp)
+}
+
+// SetDefaultPaymentMethodWithResponse request returning *SetDefaultPaymentMethodResponse
+func (c *ClientWithResponses) SetDefaultPaymentMethodWithResponse(ctx context.Context, id UserID, pm PaymentMethodID, reqEditors ...RequestEditorFn) (*SetDefaultPaymentMethodResponse, error) {
+	rsp, err := c.SetDefaultPaymentMethod(ctx, id, pm, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDefaultPaymentMethodResponse(rsp)
+}
+
+// CreateSetupIntentWithResponse request returning *CreateSetupIntentResponse
+func (c *ClientWithResponses) CreateSetupIntentWithResponse(ctx context.Context, id UserID, reqEditors ...RequestEditorFn) (*CreateSetupIntentResponse, error) {
+	rsp, err := c.CreateSetupIntent(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSetupIntentResponse(rsp)
+}
+
+// GetDebugVarsWithResponse request returning *GetDebugVarsResponse
+func (c *ClientWithResponses) GetDebugVarsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDebugVarsResponse, error) {
+	rsp, err := c.GetDebugVars(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDebugVarsResponse(rsp)
+}
+
+// ListDisputesWithResponse request returning *ListDisputesResponse
+func (c *ClientWithResponses) ListDisputesWithResponse(ctx context.Context, params *ListDisputesParams, reqEditors ...RequestEditorFn) (*ListDisputesResponse, error) {
+	rsp, err := c.ListDisputes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDisputesResponse(rsp)
+}
+
+// GetDisputeWithResponse request returning *GetDisputeResponse
+func (c *ClientWithResponses) GetDisputeWithResponse(ctx context.Context, id DisputeID, reqEditors ...RequestEditorFn) (*GetDisputeResponse, error) {
+	rsp, err := c.GetDispute(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDisputeResponse(rsp)
+}
+
+// SubmitDisputeEvidenceWithBodyWithResponse request with arbitrary body returning *SubmitDisputeEvidenceResponse
+func (c *ClientWithResponses) SubmitDisputeEvidenceWithBodyWithResponse(ctx context.Context, id DisputeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitDisputeEvidenceResponse, error) {
+	rsp, err := c.SubmitDisputeEvidenceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitDisputeEvidenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitDisputeEvidenceWithResponse(ctx context.Context, id DisputeID, body SubmitDisputeEvidenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitDisputeEvidenceResponse, error) {
+	rsp, err := c.SubmitDisputeEvidence(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitDisputeEvidenceResponse(rsp)
+}
+
+// ListEventsWithResponse request returning *ListEventsResponse
+func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
+	rsp, err := c.ListEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventsResponse(rsp)
+}
+
+// ReplayEventsWithBodyWithResponse request with arbitrary body returning *ReplayEventsResponse
+func (c *ClientWithResponses) ReplayEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplayEventsResponse, error) {
+	rsp, err := c.ReplayEventsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayEventsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplayEventsWithResponse(ctx context.Context, body ReplayEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplayEventsResponse, error) {
+	rsp, err := c.ReplayEvents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayEventsResponse(rsp)
+}
+
+// ListFeePlansWithResponse request returning *ListFeePlansResponse
+func (c *ClientWithResponses) ListFeePlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFeePlansResponse, error) {
+	rsp, err := c.ListFeePlans(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFeePlansResponse(rsp)
+}
+
+// CreateFeePlanWithBodyWithResponse request with arbitrary body returning *CreateFeePlanResponse
+func (c *ClientWithResponses) CreateFeePlanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeePlanResponse, error) {
+	rsp, err := c.CreateFeePlanWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFeePlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFeePlanWithResponse(ctx context.Context, body CreateFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeePlanResponse, error) {
+	rsp, err := c.CreateFeePlan(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFeePlanResponse(rsp)
+}
+
+// GetFeePlanWithResponse request returning *GetFeePlanResponse
+func (c *ClientWithResponses) GetFeePlanWithResponse(ctx context.Context, id FeePlanID, reqEditors ...RequestEditorFn) (*GetFeePlanResponse, error) {
+	rsp, err := c.GetFeePlan(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeePlanResponse(rsp)
+}
+
+// SaveFeePlanWithBodyWithResponse request with arbitrary body returning *SaveFeePlanResponse
+func (c *ClientWithResponses) SaveFeePlanWithBodyWithResponse(ctx context.Context, id FeePlanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveFeePlanResponse, error) {
+	rsp, err := c.SaveFeePlanWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveFeePlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveFeePlanWithResponse(ctx context.Context, id FeePlanID, body SaveFeePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveFeePlanResponse, error) {
+	rsp, err := c.SaveFeePlan(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveFeePlanResponse(rsp)
+}
+
+// GetLedgerBalancesWithResponse request returning *GetLedgerBalancesResponse
+func (c *ClientWithResponses) GetLedgerBalancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLedgerBalancesResponse, error) {
+	rsp, err := c.GetLedgerBalances(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerBalancesResponse(rsp)
+}
+
+// CheckLedgerInvariantsWithResponse request returning *CheckLedgerInvariantsResponse
+func (c *ClientWithResponses) CheckLedgerInvariantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckLedgerInvariantsResponse, error) {
+	rsp, err := c.CheckLedgerInvariants(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckLedgerInvariantsResponse(rsp)
+}
+
+// GetLedgerOrganizerBalancesWithResponse request returning *GetLedgerOrganizerBalancesResponse
+func (c *ClientWithResponses) GetLedgerOrganizerBalancesWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetLedgerOrganizerBalancesResponse, error) {
+	rsp, err := c.GetLedgerOrganizerBalances(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerOrganizerBalancesResponse(rsp)
+}
+
+// GetPaymentJournalEntriesWithResponse request returning *GetPaymentJournalEntriesResponse
+func (c *ClientWithResponses) GetPaymentJournalEntriesWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentJournalEntriesResponse, error) {
+	rsp, err := c.GetPaymentJournalEntries(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPaymentJournalEntriesResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// StreamOrderWithResponse request returning *StreamOrderResponse
+func (c *ClientWithResponses) StreamOrderWithResponse(ctx context.Context, id OrderID, params *StreamOrderParams, reqEditors ...RequestEditorFn) (*StreamOrderResponse, error) {
+	rsp, err := c.StreamOrder(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamOrderResponse(rsp)
+}
+
+// CreateOrderStreamTokenWithResponse request returning *CreateOrderStreamTokenResponse
+func (c *ClientWithResponses) CreateOrderStreamTokenWithResponse(ctx context.Context, id OrderID, reqEditors ...RequestEditorFn) (*CreateOrderStreamTokenResponse, error) {
+	rsp, err := c.CreateOrderStreamToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrderStreamTokenResponse(rsp)
+}
+
+// CreateOrganizerWithBodyWithResponse request with arbitrary body returning *CreateOrganizerResponse
+func (c *ClientWithResponses) CreateOrganizerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizerResponse, error) {
+	rsp, err := c.CreateOrganizerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizerResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizerWithResponse(ctx context.Context, body CreateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizerResponse, error) {
+	rsp, err := c.CreateOrganizer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizerResponse(rsp)
+}
+
+// GetOrganizerWithResponse request returning *GetOrganizerResponse
+func (c *ClientWithResponses) GetOrganizerWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetOrganizerResponse, error) {
+	rsp, err := c.GetOrganizer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizerResponse(rsp)
+}
+
+// UpdateOrganizerWithBodyWithResponse request with arbitrary body returning *UpdateOrganizerResponse
+func (c *ClientWithResponses) UpdateOrganizerWithBodyWithResponse(ctx context.Context, id OrganizerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizerResponse, error) {
+	rsp, err := c.UpdateOrganizerWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizerResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizerWithResponse(ctx context.Context, id OrganizerID, body UpdateOrganizerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizerResponse, error) {
+	rsp, err := c.UpdateOrganizer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizerResponse(rsp)
+}
+
+// RotateOrganizerAPIKeyWithResponse request returning *RotateOrganizerAPIKeyResponse
+func (c *ClientWithResponses) RotateOrganizerAPIKeyWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*RotateOrganizerAPIKeyResponse, error) {
+	rsp, err := c.RotateOrganizerAPIKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateOrganizerAPIKeyResponse(rsp)
+}
+
+// GetOrganizerBalancesWithResponse request returning *GetOrganizerBalancesResponse
+func (c *ClientWithResponses) GetOrganizerBalancesWithResponse(ctx context.Context, id OrganizerID, reqEditors ...RequestEditorFn) (*GetOrganizerBalancesResponse, error) {
+	rsp, err := c.GetOrganizerBalances(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizerBalancesResponse(rsp)
+}
+
+// ListOrganizerPaymentsWithResponse request returning *ListOrganizerPaymentsResponse
+func (c *ClientWithResponses) ListOrganizerPaymentsWithResponse(ctx context.Context, id OrganizerID, params *ListOrganizerPaymentsParams, reqEditors ...RequestEditorFn) (*ListOrganizerPaymentsResponse, error) {
+	rsp, err := c.ListOrganizerPayments(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizerPaymentsResponse(rsp)
+}
+
+// ListOrganizerPayoutsWithResponse request returning *ListOrganizerPayoutsResponse
+func (c *ClientWithResponses) ListOrganizerPayoutsWithResponse(ctx context.Context, id OrganizerID, params *ListOrganizerPayoutsParams, reqEditors ...RequestEditorFn) (*ListOrganizerPayoutsResponse, error) {
+	rsp, err := c.ListOrganizerPayouts(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizerPayoutsResponse(rsp)
+}
+
+// SendOTPWithBodyWithResponse request with arbitrary body returning *SendOTPResponse
+func (c *ClientWithResponses) SendOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendOTPResponse, error) {
+	rsp, err := c.SendOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) SendOTPWithResponse(ctx context.Context, body SendOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*SendOTPResponse, error) {
+	rsp, err := c.SendOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendOTPResponse(rsp)
+}
+
+// ProcessPaymentWithBodyWithResponse request with arbitrary body returning *ProcessPaymentResponse
+func (c *ClientWithResponses) ProcessPaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessPaymentResponse, error) {
+	rsp, err := c.ProcessPaymentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessPaymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) ProcessPaymentWithResponse(ctx context.Context, body ProcessPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessPaymentResponse, error) {
+	rsp, err := c.ProcessPayment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessPaymentResponse(rsp)
+}
+
+// ValidateOTPWithBodyWithResponse request with arbitrary body returning *ValidateOTPResponse
+func (c *ClientWithResponses) ValidateOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateOTPResponse, error) {
+	rsp, err := c.ValidateOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateOTPWithResponse(ctx context.Context, body ValidateOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateOTPResponse, error) {
+	rsp, err := c.ValidateOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateOTPResponse(rsp)
+}
+
+// GetPaymentWithResponse request returning *GetPaymentResponse
+func (c *ClientWithResponses) GetPaymentWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentResponse, error) {
+	rsp, err := c.GetPayment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPaymentResponse(rsp)
+}
+
+// GetPaymentFeesWithResponse request returning *GetPaymentFeesResponse
+func (c *ClientWithResponses) GetPaymentFeesWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentFeesResponse, error) {
+	rsp, err := c.GetPaymentFees(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPaymentFeesResponse(rsp)
+}
+
+// RefundPaymentWithBodyWithResponse request with arbitrary body returning *RefundPaymentResponse
+func (c *ClientWithResponses) RefundPaymentWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefundPaymentResponse, error) {
+	rsp, err := c.RefundPaymentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefundPaymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefundPaymentWithResponse(ctx context.Context, id PaymentID, body RefundPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RefundPaymentResponse, error) {
+	rsp, err := c.RefundPayment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefundPaymentResponse(rsp)
+}
+
+// ListRefundsWithResponse request returning *ListRefundsResponse
+func (c *ClientWithResponses) ListRefundsWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*ListRefundsResponse, error) {
+	rsp, err := c.ListRefunds(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRefundsResponse(rsp)
+}
+
+// GetPaymentStatusWithResponse request returning *GetPaymentStatusResponse
+func (c *ClientWithResponses) GetPaymentStatusWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentStatusResponse, error) {
+	rsp, err := c.GetPaymentStatus(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPaymentStatusResponse(rsp)
+}
+
+// StreamPaymentWithResponse request returning *StreamPaymentResponse
+func (c *ClientWithResponses) StreamPaymentWithResponse(ctx context.Context, id PaymentID, params *StreamPaymentParams, reqEditors ...RequestEditorFn) (*StreamPaymentResponse, error) {
+	rsp, err := c.StreamPayment(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamPaymentResponse(rsp)
+}
+
+// CreatePaymentStreamTokenWithResponse request returning *CreatePaymentStreamTokenResponse
+func (c *ClientWithResponses) CreatePaymentStreamTokenWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*CreatePaymentStreamTokenResponse, error) {
+	rsp, err := c.CreatePaymentStreamToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePaymentStreamTokenResponse(rsp)
+}
+
+// GetPaymentTimelineWithResponse request returning *GetPaymentTimelineResponse
+func (c *ClientWithResponses) GetPaymentTimelineWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetPaymentTimelineResponse, error) {
+	rsp, err := c.GetPaymentTimeline(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPaymentTimelineResponse(rsp)
+}
+
+// ExportBankPayoutsWithResponse request returning *ExportBankPayoutsResponse
+func (c *ClientWithResponses) ExportBankPayoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportBankPayoutsResponse, error) {
+	rsp, err := c.ExportBankPayouts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportBankPayoutsResponse(rsp)
+}
+
+// RunPayoutsWithBodyWithResponse request with arbitrary body returning *RunPayoutsResponse
+func (c *ClientWithResponses) RunPayoutsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunPayoutsResponse, error) {
+	rsp, err := c.RunPayoutsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunPayoutsResponse(rsp)
+}
+
+func (c *ClientWithResponses) RunPayoutsWithResponse(ctx context.Context, body RunPayoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*RunPayoutsResponse, error) {
+	rsp, err := c.RunPayouts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunPayoutsResponse(rsp)
+}
+
+// GetPayoutWithResponse request returning *GetPayoutResponse
+func (c *ClientWithResponses) GetPayoutWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*GetPayoutResponse, error) {
+	rsp, err := c.GetPayout(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPayoutResponse(rsp)
+}
+
+// ConfirmPayoutWithBodyWithResponse request with arbitrary body returning *ConfirmPayoutResponse
+func (c *ClientWithResponses) ConfirmPayoutWithBodyWithResponse(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPayoutResponse, error) {
+	rsp, err := c.ConfirmPayoutWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmPayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmPayoutWithResponse(ctx context.Context, id PayoutID, body ConfirmPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPayoutResponse, error) {
+	rsp, err := c.ConfirmPayout(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmPayoutResponse(rsp)
+}
+
+// FailPayoutWithBodyWithResponse request with arbitrary body returning *FailPayoutResponse
+func (c *ClientWithResponses) FailPayoutWithBodyWithResponse(ctx context.Context, id PayoutID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FailPayoutResponse, error) {
+	rsp, err := c.FailPayoutWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFailPayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) FailPayoutWithResponse(ctx context.Context, id PayoutID, body FailPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*FailPayoutResponse, error) {
+	rsp, err := c.FailPayout(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFailPayoutResponse(rsp)
+}
+
+// GetPayoutStatementWithResponse request returning *GetPayoutStatementResponse
+func (c *ClientWithResponses) GetPayoutStatementWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*GetPayoutStatementResponse, error) {
+	rsp, err := c.GetPayoutStatement(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPayoutStatementResponse(rsp)
+}
+
+// ExportPayoutStatementWithResponse request returning *ExportPayoutStatementResponse
+func (c *ClientWithResponses) ExportPayoutStatementWithResponse(ctx context.Context, id PayoutID, reqEditors ...RequestEditorFn) (*ExportPayoutStatementResponse, error) {
+	rsp, err := c.ExportPayoutStatement(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPayoutStatementResponse(rsp)
+}
+
+// ListReconciliationRunsWithResponse request returning *ListReconciliationRunsResponse
+func (c *ClientWithResponses) ListReconciliationRunsWithResponse(ctx context.Context, params *ListReconciliationRunsParams, reqEditors ...RequestEditorFn) (*ListReconciliationRunsResponse, error) {
+	rsp, err := c.ListReconciliationRuns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReconciliationRunsResponse(rsp)
+}
+
+// StartReconciliationWithBodyWithResponse request with arbitrary body returning *StartReconciliationResponse
+func (c *ClientWithResponses) StartReconciliationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartReconciliationResponse, error) {
+	rsp, err := c.StartReconciliationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartReconciliationResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartReconciliationWithResponse(ctx context.Context, body StartReconciliationJSONRequestBody, reqEditors ...RequestEditorFn) (*StartReconciliationResponse, error) {
+	rsp, err := c.StartReconciliation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartReconciliationResponse(rsp)
+}
+
+// GetReconciliationRunWithResponse request returning *GetReconciliationRunResponse
+func (c *ClientWithResponses) GetReconciliationRunWithResponse(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*GetReconciliationRunResponse, error) {
+	rsp, err := c.GetReconciliationRun(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReconciliationRunResponse(rsp)
+}
+
+// ExportReconciliationRunWithResponse request returning *ExportReconciliationRunResponse
+func (c *ClientWithResponses) ExportReconciliationRunWithResponse(ctx context.Context, id ReconciliationRunID, reqEditors ...RequestEditorFn) (*ExportReconciliationRunResponse, error) {
+	rsp, err := c.ExportReconciliationRun(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportReconciliationRunResponse(rsp)
+}
+
+// ListIPBansWithResponse request returning *ListIPBansResponse
+func (c *ClientWithResponses) ListIPBansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIPBansResponse, error) {
+	rsp, err := c.ListIPBans(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIPBansResponse(rsp)
+}
+
+// BanIPWithBodyWithResponse request with arbitrary body returning *BanIPResponse
+func (c *ClientWithResponses) BanIPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BanIPResponse, error) {
+	rsp, err := c.BanIPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBanIPResponse(rsp)
+}
+
+func (c *ClientWithResponses) BanIPWithResponse(ctx context.Context, body BanIPJSONRequestBody, reqEditors ...RequestEditorFn) (*BanIPResponse, error) {
+	rsp, err := c.BanIP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBanIPResponse(rsp)
+}
+
+// UnbanIPWithResponse request returning *UnbanIPResponse
+func (c *ClientWithResponses) UnbanIPWithResponse(ctx context.Context, ip BannedIP, reqEditors ...RequestEditorFn) (*UnbanIPResponse, error) {
+	rsp, err := c.UnbanIP(ctx, ip, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnbanIPResponse(rsp)
+}
+
+// ListRiskReviewsWithResponse request returning *ListRiskReviewsResponse
+func (c *ClientWithResponses) ListRiskReviewsWithResponse(ctx context.Context, params *ListRiskReviewsParams, reqEditors ...RequestEditorFn) (*ListRiskReviewsResponse, error) {
+	rsp, err := c.ListRiskReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRiskReviewsResponse(rsp)
+}
+
+// GetRiskReviewWithResponse request returning *GetRiskReviewResponse
+func (c *ClientWithResponses) GetRiskReviewWithResponse(ctx context.Context, id PaymentID, reqEditors ...RequestEditorFn) (*GetRiskReviewResponse, error) {
+	rsp, err := c.GetRiskReview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRiskReviewResponse(rsp)
+}
+
+// ApproveRiskReviewWithBodyWithResponse request with arbitrary body returning *ApproveRiskReviewResponse
+func (c *ClientWithResponses) ApproveRiskReviewWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveRiskReviewResponse, error) {
+	rsp, err := c.ApproveRiskReviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveRiskReviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveRiskReviewWithResponse(ctx context.Context, id PaymentID, body ApproveRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveRiskReviewResponse, error) {
+	rsp, err := c.ApproveRiskReview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveRiskReviewResponse(rsp)
+}
+
+// RejectRiskReviewWithBodyWithResponse request with arbitrary body returning *RejectRiskReviewResponse
+func (c *ClientWithResponses) RejectRiskReviewWithBodyWithResponse(ctx context.Context, id PaymentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectRiskReviewResponse, error) {
+	rsp, err := c.RejectRiskReviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectRiskReviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) RejectRiskReviewWithResponse(ctx context.Context, id PaymentID, body RejectRiskReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectRiskReviewResponse, error) {
+	rsp, err := c.RejectRiskReview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectRiskReviewResponse(rsp)
+}
+
+// GetRiskRulesWithResponse request returning *GetRiskRulesResponse
+func (c *ClientWithResponses) GetRiskRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRiskRulesResponse, error) {
+	rsp, err := c.GetRiskRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRiskRulesResponse(rsp)
+}
+
+// CreateStripePaymentWithBodyWithResponse request with arbitrary body returning *CreateStripePaymentResponse
+func (c *ClientWithResponses) CreateStripePaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStripePaymentResponse, error) {
+	rsp, err := c.CreateStripePaymentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStripePaymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStripePaymentWithResponse(ctx context.Context, body CreateStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStripePaymentResponse, error) {
+	rsp, err := c.CreateStripePayment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStripePaymentResponse(rsp)
+}
+
+// GetStripePaymentWithResponse request returning *GetStripePaymentResponse
+func (c *ClientWithResponses) GetStripePaymentWithResponse(ctx context.Context, id IntentID, reqEditors ...RequestEditorFn) (*GetStripePaymentResponse, error) {
+	rsp, err := c.GetStripePayment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStripePaymentResponse(rsp)
+}
+
+// ConfirmStripePaymentWithBodyWithResponse request with arbitrary body returning *ConfirmStripePaymentResponse
+func (c *ClientWithResponses) ConfirmStripePaymentWithBodyWithResponse(ctx context.Context, id IntentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmStripePaymentResponse, error) {
+	rsp, err := c.ConfirmStripePaymentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmStripePaymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmStripePaymentWithResponse(ctx context.Context, id IntentID, body ConfirmStripePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmStripePaymentResponse, error) {
+	rsp, err := c.ConfirmStripePayment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmStripePaymentResponse(rsp)
+}
+
+// CreateStripeRefundWithBodyWithResponse request with arbitrary body returning *CreateStripeRefundResponse
+func (c *ClientWithResponses) CreateStripeRefundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStripeRefundResponse, error) {
+	rsp, err := c.CreateStripeRefundWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStripeRefundResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStripeRefundWithResponse(ctx context.Context, body CreateStripeRefundJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStripeRefundResponse, error) {
+	rsp, err := c.CreateStripeRefund(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStripeRefundResponse(rsp)
+}
+
+// ValidateCardWithBodyWithResponse request with arbitrary body returning *ValidateCardResponse
+func (c *ClientWithResponses) ValidateCardWithBodyWithResponse(ctx context.Context, params *ValidateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateCardResponse, error) {
+	rsp, err := c.ValidateCardWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateCardWithResponse(ctx context.Context, params *ValidateCardParams, body ValidateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateCardResponse, error) {
+	rsp, err := c.ValidateCard(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateCardResponse(rsp)
+}
+
+// HandleStripeWebhookWithBodyWithResponse request with arbitrary body returning *HandleStripeWebhookResponse
+func (c *ClientWithResponses) HandleStripeWebhookWithBodyWithResponse(ctx context.Context, params *HandleStripeWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleStripeWebhookResponse, error) {
+	rsp, err := c.HandleStripeWebhookWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleStripeWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) HandleStripeWebhookWithResponse(ctx context.Context, params *HandleStripeWebhookParams, body HandleStripeWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleStripeWebhookResponse, error) {
+	rsp, err := c.HandleStripeWebhook(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleStripeWebhookResponse(rsp)
+}
+
+// GetWebhookDeliveryWithResponse request returning *GetWebhookDeliveryResponse
+func (c *ClientWithResponses) GetWebhookDeliveryWithResponse(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*GetWebhookDeliveryResponse, error) {
+	rsp, err := c.GetWebhookDelivery(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookDeliveryResponse(rsp)
+}
+
+// RedeliverWebhookWithResponse request returning *RedeliverWebhookResponse
+func (c *ClientWithResponses) RedeliverWebhookWithResponse(ctx context.Context, id WebhookDeliveryID, reqEditors ...RequestEditorFn) (*RedeliverWebhookResponse, error) {
+	rsp, err := c.RedeliverWebhook(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeliverWebhookResponse(rsp)
+}
+
+// ListWebhookEndpointsWithResponse request returning *ListWebhookEndpointsResponse
+func (c *ClientWithResponses) ListWebhookEndpointsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookEndpointsResponse, error) {
+	rsp, err := c.ListWebhookEndpoints(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookEndpointsResponse(rsp)
+}
+
+// CreateWebhookEndpointWithBodyWithResponse request with arbitrary body returning *CreateWebhookEndpointResponse
+func (c *ClientWithResponses) CreateWebhookEndpointWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookEndpointResponse, error) {
+	rsp, err := c.CreateWebhookEndpointWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookEndpointResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookEndpointWithResponse(ctx context.Context, body CreateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookEndpointResponse, error) {
+	rsp, err := c.CreateWebhookEndpoint(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookEndpointResponse(rsp)
+}
+
+// DeleteWebhookEndpointWithResponse request returning *DeleteWebhookEndpointResponse
+func (c *ClientWithResponses) DeleteWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*DeleteWebhookEndpointResponse, error) {
+	rsp, err := c.DeleteWebhookEndpoint(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookEndpointResponse(rsp)
+}
+
+// GetWebhookEndpointWithResponse request returning *GetWebhookEndpointResponse
+func (c *ClientWithResponses) GetWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*GetWebhookEndpointResponse, error) {
+	rsp, err := c.GetWebhookEndpoint(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookEndpointResponse(rsp)
+}
+
+// UpdateWebhookEndpointWithBodyWithResponse request with arbitrary body returning *UpdateWebhookEndpointResponse
+func (c *ClientWithResponses) UpdateWebhookEndpointWithBodyWithResponse(ctx context.Context, id WebhookEndpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookEndpointResponse, error) {
+	rsp, err := c.UpdateWebhookEndpointWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookEndpointResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookEndpointWithResponse(ctx context.Context, id WebhookEndpointID, body UpdateWebhookEndpointJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookEndpointResponse, error) {
+	rsp, err := c.UpdateWebhookEndpoint(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookEndpointResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, id WebhookEndpointID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// RotateWebhookSecretWithResponse request returning *RotateWebhookSecretResponse
+func (c *ClientWithResponses) RotateWebhookSecretWithResponse(ctx context.Context, id WebhookEndpointID, reqEditors ...RequestEditorFn) (*RotateWebhookSecretResponse, error) {
+	rsp, err := c.RotateWebhookSecret(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateWebhookSecretResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// ParseAdminLookupPaymentsResponse parses an HTTP response from a AdminLookupPaymentsWithResponse call
+func ParseAdminLookupPaymentsResponse(rsp *http.Response) (*AdminLookupPaymentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminLookupPaymentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminResendOTPResponse parses an HTTP response from a AdminResendOTPWithResponse call
+func ParseAdminResendOTPResponse(rsp *http.Response) (*AdminResendOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminResendOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminResetOTPResponse parses an HTTP response from a AdminResetOTPWithResponse call
+func ParseAdminResetOTPResponse(rsp *http.Response) (*AdminResetOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminResetOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminForceRefundResponse parses an HTTP response from a AdminForceRefundWithResponse call
+func ParseAdminForceRefundResponse(rsp *http.Response) (*AdminForceRefundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminForceRefundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminResyncResponse parses an HTTP response from a AdminResyncWithResponse call
+func ParseAdminResyncResponse(rsp *http.Response) (*AdminResyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminResyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminOverrideStatusResponse parses an HTTP response from a AdminOverrideStatusWithResponse call
+func ParseAdminOverrideStatusResponse(rsp *http.Response) (*AdminOverrideStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminOverrideStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetPaymentTimelineResponse parses an HTTP response from a AdminGetPaymentTimelineWithResponse call
+func ParseAdminGetPaymentTimelineResponse(rsp *http.Response) (*AdminGetPaymentTimelineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetPaymentTimelineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentTimelineEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditEntriesResponse parses an HTTP response from a ListAuditEntriesWithResponse call
+func ParseListAuditEntriesResponse(rsp *http.Response) (*ListAuditEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEntryListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAuditEntriesResponse parses an HTTP response from a ExportAuditEntriesWithResponse call
+func ParseExportAuditEntriesResponse(rsp *http.Response) (*ExportAuditEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAuditEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyAuditLogResponse parses an HTTP response from a VerifyAuditLogWithResponse call
+func ParseVerifyAuditLogResponse(rsp *http.Response) (*VerifyAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditVerificationEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCheckoutPageResponse parses an HTTP response from a GetCheckoutPageWithResponse call
+func ParseGetCheckoutPageResponse(rsp *http.Response) (*GetCheckoutPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCheckoutPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutPageEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePayCheckoutResponse parses an HTTP response from a PayCheckoutWithResponse call
+func ParsePayCheckoutResponse(rsp *http.Response) (*PayCheckoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PayCheckoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutPayResultEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmCheckoutResponse parses an HTTP response from a ConfirmCheckoutWithResponse call
+func ParseConfirmCheckoutResponse(rsp *http.Response) (*ConfirmCheckoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmCheckoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutPayResultEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCheckoutSessionsResponse parses an HTTP response from a ListCheckoutSessionsWithResponse call
+func ParseListCheckoutSessionsResponse(rsp *http.Response) (*ListCheckoutSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCheckoutSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutSessionListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCheckoutSessionResponse parses an HTTP response from a CreateCheckoutSessionWithResponse call
+func ParseCreateCheckoutSessionResponse(rsp *http.Response) (*CreateCheckoutSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCheckoutSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CheckoutSessionEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCheckoutSessionResponse parses an HTTP response from a GetCheckoutSessionWithResponse call
+func ParseGetCheckoutSessionResponse(rsp *http.Response) (*GetCheckoutSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCheckoutSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutSessionEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelCheckoutSessionResponse parses an HTTP response from a CancelCheckoutSessionWithResponse call
+func ParseCancelCheckoutSessionResponse(rsp *http.Response) (*CancelCheckoutSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelCheckoutSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckoutSessionEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnsureCustomerResponse parses an HTTP response from a EnsureCustomerWithResponse call
+func ParseEnsureCustomerResponse(rsp *http.Response) (*EnsureCustomerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnsureCustomerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomerEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCustomerResponse parses an HTTP response from a GetCustomerWithResponse call
+func ParseGetCustomerResponse(rsp *http.Response) (*GetCustomerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCustomerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomerEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPaymentMethodsResponse parses an HTTP response from a ListPaymentMethodsWithResponse call
+func ParseListPaymentMethodsResponse(rsp *http.Response) (*ListPaymentMethodsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPaymentMethodsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SavedPaymentMethodListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePaymentMethodResponse parses an HTTP response from a DeletePaymentMethodWithResponse call
+func ParseDeletePaymentMethodResponse(rsp *http.Response) (*DeletePaymentMethodResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePaymentMethodResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentMethodRefEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetDefaultPaymentMethodResponse parses an HTTP response from a SetDefaultPaymentMethodWithResponse call
+func ParseSetDefaultPaymentMethodResponse(rsp *http.Response) (*SetDefaultPaymentMethodResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetDefaultPaymentMethodResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentMethodRefEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSetupIntentResponse parses an HTTP response from a CreateSetupIntentWithResponse call
+func ParseCreateSetupIntentResponse(rsp *http.Response) (*CreateSetupIntentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSetupIntentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SetupIntentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDebugVarsResponse parses an HTTP response from a GetDebugVarsWithResponse call
+func ParseGetDebugVarsResponse(rsp *http.Response) (*GetDebugVarsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDebugVarsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDisputesResponse parses an HTTP response from a ListDisputesWithResponse call
+func ParseListDisputesResponse(rsp *http.Response) (*ListDisputesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDisputesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisputeListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDisputeResponse parses an HTTP response from a GetDisputeWithResponse call
+func ParseGetDisputeResponse(rsp *http.Response) (*GetDisputeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDisputeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisputeEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitDisputeEvidenceResponse parses an HTTP response from a SubmitDisputeEvidenceWithResponse call
+func ParseSubmitDisputeEvidenceResponse(rsp *http.Response) (*SubmitDisputeEvidenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitDisputeEvidenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisputeEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
+func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventLogListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayEventsResponse parses an HTTP response from a ReplayEventsWithResponse call
+func ParseReplayEventsResponse(rsp *http.Response) (*ReplayEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReplayResultEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFeePlansResponse parses an HTTP response from a ListFeePlansWithResponse call
+func ParseListFeePlansResponse(rsp *http.Response) (*ListFeePlansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFeePlansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeePlanListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateFeePlanResponse parses an HTTP response from a CreateFeePlanWithResponse call
+func ParseCreateFeePlanResponse(rsp *http.Response) (*CreateFeePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateFeePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeePlanEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeePlanResponse parses an HTTP response from a GetFeePlanWithResponse call
+func ParseGetFeePlanResponse(rsp *http.Response) (*GetFeePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeePlanEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSaveFeePlanResponse parses an HTTP response from a SaveFeePlanWithResponse call
+func ParseSaveFeePlanResponse(rsp *http.Response) (*SaveFeePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SaveFeePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeePlanEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLedgerBalancesResponse parses an HTTP response from a GetLedgerBalancesWithResponse call
+func ParseGetLedgerBalancesResponse(rsp *http.Response) (*GetLedgerBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLedgerBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountBalanceListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCheckLedgerInvariantsResponse parses an HTTP response from a CheckLedgerInvariantsWithResponse call
+func ParseCheckLedgerInvariantsResponse(rsp *http.Response) (*CheckLedgerInvariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckLedgerInvariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LedgerInvariantReportEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest LedgerInvariantReportEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLedgerOrganizerBalancesResponse parses an HTTP response from a GetLedgerOrganizerBalancesWithResponse call
+func ParseGetLedgerOrganizerBalancesResponse(rsp *http.Response) (*GetLedgerOrganizerBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLedgerOrganizerBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountBalanceListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPaymentJournalEntriesResponse parses an HTTP response from a GetPaymentJournalEntriesWithResponse call
+func ParseGetPaymentJournalEntriesResponse(rsp *http.Response) (*GetPaymentJournalEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPaymentJournalEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JournalEntryListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamOrderResponse parses an HTTP response from a StreamOrderWithResponse call
+func ParseStreamOrderResponse(rsp *http.Response) (*StreamOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrderStreamTokenResponse parses an HTTP response from a CreateOrderStreamTokenWithResponse call
+func ParseCreateOrderStreamTokenResponse(rsp *http.Response) (*CreateOrderStreamTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrderStreamTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StreamTokenEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrganizerResponse parses an HTTP response from a CreateOrganizerWithResponse call
+func ParseCreateOrganizerResponse(rsp *http.Response) (*CreateOrganizerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganizerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizerCredentialsEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizerResponse parses an HTTP response from a GetOrganizerWithResponse call
+func ParseGetOrganizerResponse(rsp *http.Response) (*GetOrganizerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizerEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizerResponse parses an HTTP response from a UpdateOrganizerWithResponse call
+func ParseUpdateOrganizerResponse(rsp *http.Response) (*UpdateOrganizerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizerEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateOrganizerAPIKeyResponse parses an HTTP response from a RotateOrganizerAPIKeyWithResponse call
+func ParseRotateOrganizerAPIKeyResponse(rsp *http.Response) (*RotateOrganizerAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateOrganizerAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizerCredentialsEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizerBalancesResponse parses an HTTP response from a GetOrganizerBalancesWithResponse call
+func ParseGetOrganizerBalancesResponse(rsp *http.Response) (*GetOrganizerBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizerBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountBalanceListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizerPaymentsResponse parses an HTTP response from a ListOrganizerPaymentsWithResponse call
+func ParseListOrganizerPaymentsResponse(rsp *http.Response) (*ListOrganizerPaymentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizerPaymentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizerPayoutsResponse parses an HTTP response from a ListOrganizerPayoutsWithResponse call
+func ParseListOrganizerPayoutsResponse(rsp *http.Response) (*ListOrganizerPayoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizerPayoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PayoutListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSendOTPResponse parses an HTTP response from a SendOTPWithResponse call
+func ParseSendOTPResponse(rsp *http.Response) (*SendOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SendOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OTPSentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessPaymentResponse parses an HTTP response from a ProcessPaymentWithResponse call
+func ParseProcessPaymentResponse(rsp *http.Response) (*ProcessPaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessPaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateOTPResponse parses an HTTP response from a ValidateOTPWithResponse call
+func ParseValidateOTPResponse(rsp *http.Response) (*ValidateOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Envelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPaymentResponse parses an HTTP response from a GetPaymentWithResponse call
+func ParseGetPaymentResponse(rsp *http.Response) (*GetPaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPaymentFeesResponse parses an HTTP response from a GetPaymentFeesWithResponse call
+func ParseGetPaymentFeesResponse(rsp *http.Response) (*GetPaymentFeesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPaymentFeesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeeLineListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefundPaymentResponse parses an HTTP response from a RefundPaymentWithResponse call
+func ParseRefundPaymentResponse(rsp *http.Response) (*RefundPaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefundPaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRefundsResponse parses an HTTP response from a ListRefundsWithResponse call
+func ParseListRefundsResponse(rsp *http.Response) (*ListRefundsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRefundsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefundListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPaymentStatusResponse parses an HTTP response from a GetPaymentStatusWithResponse call
+func ParseGetPaymentStatusResponse(rsp *http.Response) (*GetPaymentStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPaymentStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentStatusEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamPaymentResponse parses an HTTP response from a StreamPaymentWithResponse call
+func ParseStreamPaymentResponse(rsp *http.Response) (*StreamPaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamPaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePaymentStreamTokenResponse parses an HTTP response from a CreatePaymentStreamTokenWithResponse call
+func ParseCreatePaymentStreamTokenResponse(rsp *http.Response) (*CreatePaymentStreamTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePaymentStreamTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StreamTokenEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPaymentTimelineResponse parses an HTTP response from a GetPaymentTimelineWithResponse call
+func ParseGetPaymentTimelineResponse(rsp *http.Response) (*GetPaymentTimelineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPaymentTimelineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventTimelineEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportBankPayoutsResponse parses an HTTP response from a ExportBankPayoutsWithResponse call
+func ParseExportBankPayoutsResponse(rsp *http.Response) (*ExportBankPayoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportBankPayoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunPayoutsResponse parses an HTTP response from a RunPayoutsWithResponse call
+func ParseRunPayoutsResponse(rsp *http.Response) (*RunPayoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunPayoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PayoutListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPayoutResponse parses an HTTP response from a GetPayoutWithResponse call
+func ParseGetPayoutResponse(rsp *http.Response) (*GetPayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PayoutEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmPayoutResponse parses an HTTP response from a ConfirmPayoutWithResponse call
+func ParseConfirmPayoutResponse(rsp *http.Response) (*ConfirmPayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmPayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PayoutEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFailPayoutResponse parses an HTTP response from a FailPayoutWithResponse call
+func ParseFailPayoutResponse(rsp *http.Response) (*FailPayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FailPayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PayoutEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPayoutStatementResponse parses an HTTP response from a GetPayoutStatementWithResponse call
+func ParseGetPayoutStatementResponse(rsp *http.Response) (*GetPayoutStatementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPayoutStatementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SettlementStatementEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPayoutStatementResponse parses an HTTP response from a ExportPayoutStatementWithResponse call
+func ParseExportPayoutStatementResponse(rsp *http.Response) (*ExportPayoutStatementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPayoutStatementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListReconciliationRunsResponse parses an HTTP response from a ListReconciliationRunsWithResponse call
+func ParseListReconciliationRunsResponse(rsp *http.Response) (*ListReconciliationRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReconciliationRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReconciliationRunListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartReconciliationResponse parses an HTTP response from a StartReconciliationWithResponse call
+func ParseStartReconciliationResponse(rsp *http.Response) (*StartReconciliationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartReconciliationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReconciliationRunEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReconciliationRunResponse parses an HTTP response from a GetReconciliationRunWithResponse call
+func ParseGetReconciliationRunResponse(rsp *http.Response) (*GetReconciliationRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReconciliationRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReconciliationRunDetailEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportReconciliationRunResponse parses an HTTP response from a ExportReconciliationRunWithResponse call
+func ParseExportReconciliationRunResponse(rsp *http.Response) (*ExportReconciliationRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportReconciliationRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIPBansResponse parses an HTTP response from a ListIPBansWithResponse call
+func ParseListIPBansResponse(rsp *http.Response) (*ListIPBansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIPBansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IPBanListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBanIPResponse parses an HTTP response from a BanIPWithResponse call
+func ParseBanIPResponse(rsp *http.Response) (*BanIPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BanIPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IPBanEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnbanIPResponse parses an HTTP response from a UnbanIPWithResponse call
+func ParseUnbanIPResponse(rsp *http.Response) (*UnbanIPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnbanIPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Envelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRiskReviewsResponse parses an HTTP response from a ListRiskReviewsWithResponse call
+func ParseListRiskReviewsResponse(rsp *http.Response) (*ListRiskReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRiskReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RiskReviewListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRiskReviewResponse parses an HTTP response from a GetRiskReviewWithResponse call
+func ParseGetRiskReviewResponse(rsp *http.Response) (*GetRiskReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRiskReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RiskReviewEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveRiskReviewResponse parses an HTTP response from a ApproveRiskReviewWithResponse call
+func ParseApproveRiskReviewResponse(rsp *http.Response) (*ApproveRiskReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveRiskReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RiskReviewEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectRiskReviewResponse parses an HTTP response from a RejectRiskReviewWithResponse call
+func ParseRejectRiskReviewResponse(rsp *http.Response) (*RejectRiskReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectRiskReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RiskReviewEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRiskRulesResponse parses an HTTP response from a GetRiskRulesWithResponse call
+func ParseGetRiskRulesResponse(rsp *http.Response) (*GetRiskRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRiskRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RiskRulesEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStripePaymentResponse parses an HTTP response from a CreateStripePaymentWithResponse call
+func ParseCreateStripePaymentResponse(rsp *http.Response) (*CreateStripePaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStripePaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StripePaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStripePaymentResponse parses an HTTP response from a GetStripePaymentWithResponse call
+func ParseGetStripePaymentResponse(rsp *http.Response) (*GetStripePaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStripePaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StripePaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmStripePaymentResponse parses an HTTP response from a ConfirmStripePaymentWithResponse call
+func ParseConfirmStripePaymentResponse(rsp *http.Response) (*ConfirmStripePaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmStripePaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StripePaymentEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStripeRefundResponse parses an HTTP response from a CreateStripeRefundWithResponse call
+func ParseCreateStripeRefundResponse(rsp *http.Response) (*CreateStripeRefundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStripeRefundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefundEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateCardResponse parses an HTTP response from a ValidateCardWithResponse call
+func ParseValidateCardResponse(rsp *http.Response) (*ValidateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CardValidationEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHandleStripeWebhookResponse parses an HTTP response from a HandleStripeWebhookWithResponse call
+func ParseHandleStripeWebhookResponse(rsp *http.Response) (*HandleStripeWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HandleStripeWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StripeWebhookAck
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookDeliveryResponse parses an HTTP response from a GetWebhookDeliveryWithResponse call
+func ParseGetWebhookDeliveryResponse(rsp *http.Response) (*GetWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedeliverWebhookResponse parses an HTTP response from a RedeliverWebhookWithResponse call
+func ParseRedeliverWebhookResponse(rsp *http.Response) (*RedeliverWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedeliverWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookEndpointsResponse parses an HTTP response from a ListWebhookEndpointsWithResponse call
+func ParseListWebhookEndpointsResponse(rsp *http.Response) (*ListWebhookEndpointsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookEndpointsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookEndpointResponse parses an HTTP response from a CreateWebhookEndpointWithResponse call
+func ParseCreateWebhookEndpointResponse(rsp *http.Response) (*CreateWebhookEndpointResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookEndpointResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookEndpointSecretEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookEndpointResponse parses an HTTP response from a DeleteWebhookEndpointWithResponse call
+func ParseDeleteWebhookEndpointResponse(rsp *http.Response) (*DeleteWebhookEndpointResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookEndpointResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeletedEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookEndpointResponse parses an HTTP response from a GetWebhookEndpointWithResponse call
+func ParseGetWebhookEndpointResponse(rsp *http.Response) (*GetWebhookEndpointResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookEndpointResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookEndpointResponse parses an HTTP response from a UpdateWebhookEndpointWithResponse call
+func ParseUpdateWebhookEndpointResponse(rsp *http.Response) (*UpdateWebhookEndpointResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookEndpointResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryListEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateWebhookSecretResponse parses an HTTP response from a RotateWebhookSecretWithResponse call
+func ParseRotateWebhookSecretResponse(rsp *http.Response) (*RotateWebhookSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateWebhookSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointSecretEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func runGet(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("get", "<payment-id>")
+	id := parseWithID(fs, args)
+
+	payment, err := gw.Payments.GetPayment(ctx, id)
+	if err != nil {
+		fail("%v", err)
+	}
+	printPayments(*format, payment)
+}
+
+func runList(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("list", "")
+	organizerID := fs.String("organizer", "", "list an organizer's payments, newest first")
+	term := fs.String("q", "", "search by payment ID, order ID, Stripe PaymentIntent ID or email")
+	limit := fs.Int("limit", 20, "maximum number of payments")
+	offset := fs.Int("offset", 0, "payments to skip, with -organizer")
+	_ = fs.Parse(args)
+
+	var payments []*models.Payment
+	var err error
+	switch {
+	case *organizerID != "" && *term == "":
+		payments, err = gw.Payments.ListOrganizerPayments(ctx, *organizerID, *limit, *offset)
+	case *term != "" && *organizerID == "":
+		payments, err = gw.Payments.SearchPayments(ctx, *term, *limit)
+	default:
+		fail("list needs exactly one of -organizer or -q")
+	}
+	if err != nil {
+		fail("%v", err)
+	}
+	printPayments(*format, payments...)
+}
+
+func runRefund(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("refund", "<payment-id>")
+	amount := fs.Float64("amount", 0, "amount to refund (default the full payment)")
+	reason := fs.String("reason", "", "why the refund is being forced (required)")
+	id := parseWithID(fs, args)
+
+	req := &models.ForceRefundRequest{Reason: *reason}
+	if *amount > 0 {
+		req.Amount = amount
+	}
+	payment, err := gw.stripe().Admin.ForceRefund(ctx, id, req)
+	if err != nil {
+		fail("refund failed: %v", err)
+	}
+	printPayments(*format, payment)
+}
+
+func runCancel(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("cancel", "<payment-id>")
+	reason := fs.String("reason", "", "why the payment is being cancelled (required)")
+	id := parseWithID(fs, args)
+
+	payment, err := gw.stripe().Admin.Cancel(ctx, id, *reason)
+	if err != nil {
+		fail("cancel failed: %v", err)
+	}
+	printPayments(*format, payment)
+}
+
+func runResync(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("resync", "<payment-id>")
+	id := parseWithID(fs, args)
+
+	payment, err := gw.stripe().Admin.Resync(ctx, id)
+	if err != nil {
+		fail("resync failed: %v", err)
+	}
+	printPayments(*format, payment)
+}
+
+func runReplay(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("replay", "")
+	paymentID := fs.String("payment", "", "replay the events of one payment")
+	orderID := fs.String("order", "", "replay the events of one order")
+	from := fs.String("from", "", "start of the time window (RFC 3339)")
+	to := fs.String("to", "", "end of the time window (RFC 3339)")
+	types := fs.String("type", "", "comma-separated event types to replay, e.g. payment.success,payment.refunded")
+	source := fs.String("source", string(models.ReplayFromLog), "log (events as published) or history (regenerated from records)")
+	limit := fs.Int("limit", 0, "maximum number of events (default 1000)")
+	dryRun := fs.Bool("dry-run", false, "list the matching events without publishing them")
+	_ = fs.Parse(args)
+
+	req := &models.ReplayRequest{
+		EventFilter: models.EventFilter{
+			PaymentID: *paymentID,
+			OrderID:   *orderID,
+			Limit:     *limit,
+		},
+		Source: models.ReplaySource(*source),
+		DryRun: *dryRun,
+	}
+	var err error
+	if req.From, err = parseTime(*from); err != nil {
+		fail("invalid -from: %v", err)
+	}
+	if req.To, err = parseTime(*to); err != nil {
+		fail("invalid -to: %v", err)
+	}
+	if *types != "" {
+		for _, t := range strings.Split(*types, ",") {
+			req.EventTypes = append(req.EventTypes, strings.TrimSpace(t))
+		}
+	}
+
+	result, err := gw.Events.Replay(ctx, req)
+	if err != nil {
+		fail("replay failed: %v", err)
+	}
+	if *format == formatJSON {
+		printJSON(result)
+	} else {
+		printEvents(result.Events)
+		if result.DryRun {
+			fmt.Fprintf(out, "\n%d events matched from %s (dry run, nothing published)\n", result.Matched, result.Source)
+		} else {
+			fmt.Fprintf(out, "\n%d of %d events republished from %s, %d failed\n", result.Published, result.Matched, result.Source, result.Failed)
+		}
+	}
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// runOutbox works on the event log entries whose Kafka publish failed when
+// the event happened
+func runOutbox(ctx context.Context, gw *gatewayServices, args []string) {
+	action := subcommand("outbox", args, "list", "drain")
+	fs, format := newFlags("outbox "+action, "")
+	limit := fs.Int("limit", 0, "maximum number of events (default 1000)")
+	_ = fs.Parse(args[1:])
+
+	if action == "list" {
+		entries, err := gw.Events.Outbox(ctx, *limit)
+		if err != nil {
+			fail("%v", err)
+		}
+		if *format == formatJSON {
+			printJSON(entries)
+			return
+		}
+		t := newTable("ID", "TYPE", "PAYMENT", "ORDER", "CREATED")
+		for _, entry := range entries {
+			t.row(entry.ID, entry.Type, entry.PaymentID, entry.OrderID, formatTime(entry.CreatedAt))
+		}
+		t.flush()
+		return
+	}
+
+	result, err := gw.Events.DrainOutbox(ctx, *limit)
+	if err != nil {
+		fail("drain failed: %v", err)
+	}
+	if *format == formatJSON {
+		printJSON(result)
+	} else {
+		printEvents(result.Events)
+		fmt.Fprintf(out, "\n%d of %d events published, %d failed\n", result.Published, result.Pending, result.Failed)
+	}
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// runDLQ works on webhook deliveries that were given up after their last
+// retry. Kafka events have no dead-letter topic: events that could not be
+// published are in the outbox instead.
+func runDLQ(ctx context.Context, gw *gatewayServices, args []string) {
+	action := subcommand("dlq", args, "list", "redeliver")
+	if action == "redeliver" {
+		fs, format := newFlags("dlq redeliver", "<delivery-id>")
+		id := parseWithID(fs, args[1:])
+
+		delivery, err := gw.Webhooks.Redeliver(ctx, id)
+		if err != nil {
+			fail("redeliver failed: %v", err)
+		}
+		printDeliveries(*format, delivery)
+		return
+	}
+
+	fs, format := newFlags("dlq list", "")
+	limit := fs.Int("limit", 50, "maximum number of deliveries")
+	offset := fs.Int("offset", 0, "deliveries to skip")
+	_ = fs.Parse(args[1:])
+
+	deliveries, err := gw.Webhooks.ListFailedDeliveries(ctx, *limit, *offset)
+	if err != nil {
+		fail("%v", err)
+	}
+	printDeliveries(*format, deliveries...)
+}
+
+func runReconcile(ctx context.Context, gw *gatewayServices, args []string) {
+	fs, format := newFlags("reconcile", "")
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	from := fs.String("from", yesterday, "first day to reconcile (YYYY-MM-DD)")
+	to := fs.String("to", "", "last day to reconcile, inclusive (default -from)")
+	_ = fs.Parse(args)
+	if *to == "" {
+		to = from
+	}
+
+	start, err := time.Parse("2006-01-02", *from)
+	if err != nil {
+		fail("invalid -from: %v", err)
+	}
+	end, err := time.Parse("2006-01-02", *to)
+	if err != nil {
+		fail("invalid -to: %v", err)
+	}
+	end = end.AddDate(0, 0, 1)
+	if !end.After(start) {
+		fail("-to is before -from")
+	}
+
+	run, runErr := gw.stripe().Reconciliation.Run(ctx, start, end)
+	if run == nil {
+		fail("reconciliation failed: %v", runErr)
+	}
+	items, err := gw.stripe().Reconciliation.ListItems(run.ID)
+	if err != nil {
+		fail("%v", err)
+	}
+
+	if *format == formatJSON {
+		printJSON(map[string]interface{}{"run": run, "discrepancies": items})
+	} else {
+		t := newTable("TYPE", "PAYMENT", "ORDER", "DETAIL")
+		for _, item := range items {
+			t.row(string(item.Type), item.PaymentID, item.OrderID, item.Details)
+		}
+		t.flush()
+		fmt.Fprintf(out, "\nrun %s %s: %d Stripe, %d local, %d matched, %d discrepancies\n",
+			run.ID, run.Status, run.StripeCount, run.LocalCount, run.MatchedCount, run.DiscrepancyCount)
+	}
+	if runErr != nil {
+		fail("reconciliation failed: %v", runErr)
+	}
+	if run.DiscrepancyCount > 0 {
+		os.Exit(1)
+	}
+}
+
+// newFlags returns a command's flag set with the shared -o flag
+func newFlags(name, args string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: paymentctl %s [flags] %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	format := fs.String("o", formatTable, "output format: table or json")
+	return fs, format
+}
+
+// parseWithID parses flags given before or after a command's one argument
+func parseWithID(fs *flag.FlagSet, args []string) string {
+	var id string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		id, args = args[0], args[1:]
+	}
+	_ = fs.Parse(args)
+	if id == "" && fs.NArg() > 0 {
+		id = fs.Arg(0)
+	}
+	if id == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	return id
+}
+
+func subcommand(command string, args []string, actions ...string) string {
+	if len(args) > 0 {
+		for _, action := range actions {
+			if args[0] == action {
+				return action
+			}
+		}
+	}
+	fmt.Fprintf(os.Stderr, "usage: paymentctl %s %s [flags]\n", command, strings.Join(actions, "|"))
+	os.Exit(2)
+	return ""
+}
+
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,164 @@
+// Command paymentctl is the operations tool for the payment gateway. It
+// works directly against the gateway's database, Redis and Stripe account
+// through the same services the server uses, so every change it makes
+// produces the usual events and audit entries.
+//
+//	paymentctl get pay_123
+//	paymentctl list -organizer org_1 -limit 50
+//	paymentctl list -q attendee@example.com -o json
+//	paymentctl refund pay_123 -amount 25 -reason "duplicate booking"
+//	paymentctl cancel pay_123 -reason "attendee asked to cancel"
+//	paymentctl resync pay_123
+//	paymentctl migrate
+//	paymentctl replay -payment pay_123 -dry-run
+//	paymentctl outbox list
+//	paymentctl outbox drain
+//	paymentctl dlq list
+//	paymentctl dlq redeliver del_123
+//	paymentctl reconcile -from 2024-05-01 -to 2024-05-07
+//
+// Configuration is read from the environment and .env, as for the server;
+// STRIPE_SECRET_KEY is needed only by refund, cancel, resync and reconcile.
+// Results are printed as a table, or as JSON with -o json; log output goes
+// to the log file and stderr never carries results. Changes are audited as
+// made by the platform on behalf of paymentctl:$USER.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/gateway"
+	"payment-gateway/internal/kafka"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+const usage = `usage: paymentctl <command> [flags] [args]
+
+commands:
+  get <payment-id>          show a payment
+  list                      list payments by organizer or search term
+  refund <payment-id>       refund a payment, ignoring the organizer's refund rules
+  cancel <payment-id>       cancel a payment that has not completed
+  resync <payment-id>       apply the status Stripe reports for a payment
+  migrate                   create or update the database schema
+  replay                    republish past payment events to Kafka
+  outbox list|drain         show or publish events whose Kafka publish failed
+  dlq list|redeliver <id>   show or retry webhook deliveries that ran out of retries
+  reconcile                 reconcile payments with Stripe for a date range
+
+Run paymentctl <command> -h for the command's flags.
+`
+
+// gatewayServices holds the services the commands work through. The ones
+// that call Stripe are created on first use, so commands that do not need
+// Stripe work without STRIPE_SECRET_KEY.
+type gatewayServices struct {
+	*gateway.Services
+	withStripe *gateway.StripeServices
+}
+
+func (gw *gatewayServices) stripe() *gateway.StripeServices {
+	if gw.withStripe == nil {
+		backed, err := gw.WithStripe()
+		if err != nil {
+			fail("%v", err)
+		}
+		gw.withStripe = backed
+	}
+	return gw.withStripe
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command, args := os.Args[1], os.Args[2:]
+
+	log := logger.NewFileLogger()
+	defer log.Close()
+	_ = godotenv.Load()
+	cfg := config.Load()
+
+	store, err := storage.NewMySQLStore(cfg.Database, log)
+	if err != nil {
+		fail("failed to open database: %v", err)
+	}
+	defer store.Close()
+
+	ctx := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	ctx = audit.WithSource(ctx, audit.Source{
+		RequestID:  utils.GenerateRequestID(),
+		OnBehalfOf: "paymentctl:" + envOr("USER", "unknown"),
+	})
+
+	switch command {
+	case "migrate":
+		// Opening the store creates and updates the tables
+		fmt.Fprintln(out, "database schema is up to date")
+		return
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(out, usage)
+		return
+	}
+
+	gw := newGateway(cfg, store, log)
+	switch command {
+	case "get":
+		runGet(ctx, gw, args)
+	case "list":
+		runList(ctx, gw, args)
+	case "refund":
+		runRefund(ctx, gw, args)
+	case "cancel":
+		runCancel(ctx, gw, args)
+	case "resync":
+		runResync(ctx, gw, args)
+	case "replay":
+		runReplay(ctx, gw, args)
+	case "outbox":
+		runOutbox(ctx, gw, args)
+	case "dlq":
+		runDLQ(ctx, gw, args)
+	case "reconcile":
+		runReconcile(ctx, gw, args)
+	default:
+		fmt.Fprintf(os.Stderr, "paymentctl: unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
+}
+
+// newGateway builds the services the same way the server does
+func newGateway(cfg *config.Config, store *storage.MySQLStore, log *logger.Logger) *gatewayServices {
+	producer, err := kafka.NewProducer(cfg.Kafka.Brokers, true, log)
+	if err != nil {
+		fail("failed to create Kafka producer: %v", err)
+	}
+	// Changes made here reach the status streams open on the gateway
+	core, err := gateway.New(cfg, store, producer, log)
+	if err != nil {
+		fail("%v", err)
+	}
+	return &gatewayServices{Services: core}
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "paymentctl: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// out is where results are printed
+var out io.Writer = os.Stdout
+
+func printJSON(v interface{}) {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		fail("failed to encode output: %v", err)
+	}
+}
+
+// printPayments prints one payment as an object and several as a list
+func printPayments(format string, payments ...*models.Payment) {
+	if format == formatJSON {
+		if len(payments) == 1 {
+			printJSON(payments[0])
+		} else {
+			printJSON(payments)
+		}
+		return
+	}
+	t := newTable("PAYMENT", "ORDER", "ORGANIZER", "STATUS", "AMOUNT", "EMAIL", "TRANSACTION", "DATE")
+	for _, p := range payments {
+		t.row(p.PaymentID, p.OrderID, p.OrganizerID, string(p.Status),
+			fmt.Sprintf("%.2f %s", p.Price, strings.ToUpper(p.Currency)), p.Email, p.TransactionID, formatTime(p.Date))
+	}
+	t.flush()
+}
+
+func printDeliveries(format string, deliveries ...*models.WebhookDelivery) {
+	if format == formatJSON {
+		if len(deliveries) == 1 {
+			printJSON(deliveries[0])
+		} else {
+			printJSON(deliveries)
+		}
+		return
+	}
+	t := newTable("DELIVERY", "ENDPOINT", "EVENT", "TYPE", "STATUS", "ATTEMPTS", "LAST ERROR", "UPDATED")
+	for _, d := range deliveries {
+		lastError := d.LastError
+		if lastError == "" && d.LastStatusCode != 0 {
+			lastError = fmt.Sprintf("HTTP %d", d.LastStatusCode)
+		}
+		t.row(d.ID, d.EndpointID, d.EventID, d.EventType, string(d.Status), fmt.Sprint(d.Attempts), lastError, formatTime(d.UpdatedAt))
+	}
+	t.flush()
+}
+
+func printEvents(events []*models.PaymentEvent) {
+	t := newTable("EVENT", "TYPE", "PAYMENT", "TIMESTAMP")
+	for _, event := range events {
+		t.row(event.EventID, event.Type, event.PaymentID, formatTime(event.Timestamp))
+	}
+	t.flush()
+}
+
+type table struct {
+	w *tabwriter.Writer
+}
+
+func newTable(headers ...string) *table {
+	t := &table{w: tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)}
+	t.row(headers...)
+	return t
+}
+
+func (t *table) row(cells ...string) {
+	for i, cell := range cells {
+		if cell == "" {
+			cells[i] = "-"
+		}
+	}
+	fmt.Fprintln(t.w, strings.Join(cells, "\t"))
+}
+
+func (t *table) flush() {
+	_ = t.w.Flush()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,288 @@
+// Package apperror is the error model of the gateway's API. Every error a
+// caller sees carries a stable, machine-readable code that frontends can
+// switch on, the HTTP status it maps to and a message written for the
+// caller. Whatever caused it (SQL errors, Stripe responses) stays on the
+// server and is only logged.
+package apperror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+// Code identifies a kind of error. Codes are part of the API contract:
+// never rename one, only add new ones.
+type Code string
+
+const (
+	// Requests
+	InvalidRequest     Code = "invalid_request"
+	Unauthenticated    Code = "unauthenticated"
+	InvalidCredentials Code = "invalid_credentials"
+	Forbidden          Code = "forbidden"
+	NotFound           Code = "not_found"
+	RequestTooLarge    Code = "request_too_large"
+	RateLimited        Code = "rate_limited"
+	CaptchaRequired    Code = "captcha_required"
+	InvalidBan         Code = "invalid_ban"
+
+	// Payments and refunds
+	PaymentNotFound      Code = "payment_not_found"
+	PaymentNotRefundable Code = "payment_not_refundable"
+	PaymentNotCancelable Code = "payment_not_cancelable"
+	InvalidRefundAmount  Code = "invalid_refund_amount"
+	InvalidCurrency      Code = "invalid_currency"
+	InvalidPaymentStatus Code = "invalid_payment_status"
+	NotStripePayment     Code = "not_stripe_payment"
+	PaymentDeclined      Code = "payment_declined" // refused by the risk engine
+	ReasonRequired       Code = "reason_required"
+	InvalidSearch        Code = "invalid_search"
+	InvalidLineItems     Code = "invalid_line_items"
+
+	// OTPs
+	OTPInvalid       Code = "otp_invalid"
+	OTPExpired       Code = "otp_expired"
+	OTPNotApplicable Code = "otp_not_applicable"
+
+	// Cards
+	CardDeclined           Code = "card_declined"
+	InsufficientFunds      Code = "insufficient_funds"
+	ExpiredCard            Code = "expired_card"
+	IncorrectCVC           Code = "incorrect_cvc"
+	IncorrectNumber        Code = "incorrect_number"
+	InvalidExpiry          Code = "invalid_expiry"
+	ProcessingError        Code = "processing_error"
+	AuthenticationRequired Code = "authentication_required"
+	CardValidationFailed   Code = "card_validation_failed"
+	RawCardData            Code = "raw_card_data"
+	InvalidCardToken       Code = "invalid_card_token"
+
+	// Organizers and customers
+	OrganizerNotFound      Code = "organizer_not_found"
+	OrganizerInactive      Code = "organizer_inactive"
+	OrganizerRequired      Code = "organizer_required"
+	InvalidOrganizer       Code = "invalid_organizer"
+	CustomerNotFound       Code = "customer_not_found"
+	PaymentMethodNotFound  Code = "payment_method_not_found"
+	NoDefaultPaymentMethod Code = "no_default_payment_method"
+
+	// Fees, payouts and reconciliation
+	FeePlanNotFound            Code = "fee_plan_not_found"
+	InvalidFeePlan             Code = "invalid_fee_plan"
+	PayoutNotFound             Code = "payout_not_found"
+	PayoutNotPending           Code = "payout_not_pending"
+	ReconciliationNotFound     Code = "reconciliation_not_found"
+	InvalidReconciliationRange Code = "invalid_reconciliation_range"
+
+	// Checkout sessions and payment links
+	CheckoutNotFound Code = "checkout_not_found"
+	CheckoutNotOpen  Code = "checkout_not_open"
+	CheckoutExpired  Code = "checkout_expired"
+	InvalidCheckout  Code = "invalid_checkout"
+
+	// Risk reviews and disputes
+	ReviewNotFound   Code = "review_not_found"
+	ReviewNotPending Code = "review_not_pending"
+	ReviewExpired    Code = "review_expired"
+	DisputeNotFound  Code = "dispute_not_found"
+	DisputeNotOpen   Code = "dispute_not_open"
+
+	// Webhooks and events
+	WebhookEndpointNotFound Code = "webhook_endpoint_not_found"
+	WebhookDeliveryNotFound Code = "webhook_delivery_not_found"
+	InvalidWebhookEndpoint  Code = "invalid_webhook_endpoint"
+	WebhookEndpointDisabled Code = "webhook_endpoint_disabled"
+	InvalidWebhook          Code = "invalid_webhook"
+	WebhookNotConfigured    Code = "webhook_not_configured"
+	InvalidReplay           Code = "invalid_replay"
+
+	// Failures on our side or our providers'
+	ProviderUnavailable Code = "provider_unavailable"
+	ServiceUnavailable  Code = "service_unavailable"
+	Timeout             Code = "timeout"
+	Internal            Code = "internal_error"
+)
+
+type codeSpec struct {
+	status int
+	title  string
+}
+
+var codes = map[Code]codeSpec{
+	InvalidRequest:     {http.StatusBadRequest, "Invalid request"},
+	Unauthenticated:    {http.StatusUnauthorized, "Authentication required"},
+	InvalidCredentials: {http.StatusUnauthorized, "Authentication failed"},
+	Forbidden:          {http.StatusForbidden, "Access denied"},
+	NotFound:           {http.StatusNotFound, "Not found"},
+	RequestTooLarge:    {http.StatusRequestEntityTooLarge, "Request too large"},
+	RateLimited:        {http.StatusTooManyRequests, "Too many requests"},
+	CaptchaRequired:    {http.StatusTooManyRequests, "Verification required"},
+	InvalidBan:         {http.StatusBadRequest, "Invalid IP ban"},
+
+	PaymentNotFound:      {http.StatusNotFound, "Payment not found"},
+	PaymentNotRefundable: {http.StatusConflict, "Payment cannot be refunded"},
+	PaymentNotCancelable: {http.StatusConflict, "Payment cannot be cancelled"},
+	InvalidRefundAmount:  {http.StatusBadRequest, "Invalid refund amount"},
+	InvalidCurrency:      {http.StatusBadRequest, "Invalid currency"},
+	InvalidPaymentStatus: {http.StatusBadRequest, "Invalid payment status"},
+	NotStripePayment:     {http.StatusConflict, "Payment is not a Stripe payment"},
+	PaymentDeclined:      {http.StatusPaymentRequired, "Payment declined"},
+	ReasonRequired:       {http.StatusBadRequest, "Reason required"},
+	InvalidSearch:        {http.StatusBadRequest, "Invalid search"},
+	InvalidLineItems:     {http.StatusBadRequest, "Invalid line items"},
+
+	OTPInvalid:       {http.StatusUnauthorized, "Invalid OTP"},
+	OTPExpired:       {http.StatusUnauthorized, "OTP expired"},
+	OTPNotApplicable: {http.StatusConflict, "Payment is not awaiting an OTP"},
+
+	CardDeclined:           {http.StatusPaymentRequired, "Card declined"},
+	InsufficientFunds:      {http.StatusPaymentRequired, "Insufficient funds"},
+	ExpiredCard:            {http.StatusPaymentRequired, "Card expired"},
+	IncorrectCVC:           {http.StatusPaymentRequired, "Incorrect security code"},
+	IncorrectNumber:        {http.StatusPaymentRequired, "Incorrect card number"},
+	InvalidExpiry:          {http.StatusPaymentRequired, "Invalid expiry date"},
+	ProcessingError:        {http.StatusPaymentRequired, "Card could not be processed"},
+	AuthenticationRequired: {http.StatusPaymentRequired, "Authentication required"},
+	CardValidationFailed:   {http.StatusBadRequest, "Invalid card details"},
+	RawCardData:            {http.StatusBadRequest, "Raw card data not accepted"},
+	InvalidCardToken:       {http.StatusBadRequest, "Invalid card token"},
+
+	OrganizerNotFound:      {http.StatusNotFound, "Organizer not found"},
+	OrganizerInactive:      {http.StatusForbidden, "Organizer is not active"},
+	OrganizerRequired:      {http.StatusBadRequest, "Organizer required"},
+	InvalidOrganizer:       {http.StatusBadRequest, "Invalid organizer details"},
+	CustomerNotFound:       {http.StatusNotFound, "Customer not found"},
+	PaymentMethodNotFound:  {http.StatusNotFound, "Payment method not found"},
+	NoDefaultPaymentMethod: {http.StatusUnprocessableEntity, "No default payment method"},
+
+	FeePlanNotFound:            {http.StatusNotFound, "Fee plan not found"},
+	InvalidFeePlan:             {http.StatusBadRequest, "Invalid fee plan"},
+	PayoutNotFound:             {http.StatusNotFound, "Payout not found"},
+	PayoutNotPending:           {http.StatusConflict, "Payout is not awaiting confirmation"},
+	ReconciliationNotFound:     {http.StatusNotFound, "Reconciliation run not found"},
+	InvalidReconciliationRange: {http.StatusBadRequest, "Invalid date range"},
+
+	CheckoutNotFound: {http.StatusNotFound, "Checkout not found"},
+	CheckoutNotOpen:  {http.StatusConflict, "Checkout is not open"},
+	CheckoutExpired:  {http.StatusConflict, "Checkout expired"},
+	InvalidCheckout:  {http.StatusBadRequest, "Invalid checkout"},
+
+	ReviewNotFound:   {http.StatusNotFound, "Risk review not found"},
+	ReviewNotPending: {http.StatusConflict, "Risk review already decided"},
+	ReviewExpired:    {http.StatusConflict, "Risk review expired"},
+	DisputeNotFound:  {http.StatusNotFound, "Dispute not found"},
+	DisputeNotOpen:   {http.StatusConflict, "Dispute is closed"},
+
+	WebhookEndpointNotFound: {http.StatusNotFound, "Webhook endpoint not found"},
+	WebhookDeliveryNotFound: {http.StatusNotFound, "Webhook delivery not found"},
+	InvalidWebhookEndpoint:  {http.StatusBadRequest, "Invalid webhook endpoint"},
+	WebhookEndpointDisabled: {http.StatusConflict, "Webhook endpoint disabled"},
+	InvalidWebhook:          {http.StatusBadRequest, "Invalid webhook"},
+	WebhookNotConfigured:    {http.StatusServiceUnavailable, "Webhooks not configured"},
+	InvalidReplay:           {http.StatusBadRequest, "Invalid replay request"},
+
+	ProviderUnavailable: {http.StatusBadGateway, "Payment provider error"},
+	ServiceUnavailable:  {http.StatusServiceUnavailable, "Service unavailable"},
+	Timeout:             {http.StatusGatewayTimeout, "Request timed out"},
+	Internal:            {http.StatusInternalServerError, "Internal error"},
+}
+
+// InvalidParam names one request field that failed validation
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Error is an error the API can report to its caller
+type Error struct {
+	Code    Code
+	Message string         // written for the caller
+	Params  []InvalidParam // fields that failed validation, if any
+	cause   error
+}
+
+// New returns an error with a code and a message for the caller. Services
+// declare their sentinel errors with it.
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Wrap returns an error with a code and a message for the caller, keeping
+// cause for the logs.
+func Wrap(code Code, message string, cause error) *Error {
+	return &Error{Code: code, Message: message, cause: cause}
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Status is the HTTP status the error is reported with
+func (e *Error) Status() int {
+	if spec, ok := codes[e.Code]; ok {
+		return spec.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Title is the short summary shared by every error with the same code
+func (e *Error) Title() string {
+	if spec, ok := codes[e.Code]; ok {
+		return spec.title
+	}
+	return codes[Internal].title
+}
+
+// Internal reports whether the error is our own or a provider's fault
+// rather than the caller's, so its cause must not be shown.
+func (e *Error) Internal() bool {
+	return e.Status() >= http.StatusInternalServerError
+}
+
+// From finds the API error behind err. Card errors from Stripe are
+// translated for the cardholder. Service errors the caller caused keep the
+// context services wrapped them with; internal ones only keep their own
+// message. Errors the API does not know become internal errors.
+func From(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var stripeErr *stripe.Error
+	isStripe := errors.As(err, &stripeErr)
+	if isStripe && stripeErr.Type == stripe.ErrorTypeCard {
+		return FromStripe(stripeErr)
+	}
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		if appErr.Internal() {
+			return &Error{Code: appErr.Code, Message: appErr.Message, cause: err}
+		}
+		// Context added around a sentinel is written for the caller; a
+		// wrapped cause is not
+		message := err.Error()
+		if appErr.cause != nil {
+			message = appErr.Message
+		}
+		return &Error{Code: appErr.Code, Message: message, Params: appErr.Params, cause: err}
+	}
+	if isStripe {
+		return FromStripe(stripeErr)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Wrap(Timeout, "the request took too long", err)
+	}
+	return Wrap(Internal, "an unexpected error occurred", err)
+}
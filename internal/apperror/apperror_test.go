@@ -0,0 +1,91 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+var errPaymentNotFound = New(PaymentNotFound, "payment not found")
+
+func TestEveryCodeHasAStatusAndTitle(t *testing.T) {
+	for code, spec := range codes {
+		if spec.status < 400 || spec.title == "" {
+			t.Errorf("%s: status %d, title %q", code, spec.status, spec.title)
+		}
+	}
+	unknown := New("made_up", "?")
+	if unknown.Status() != http.StatusInternalServerError || unknown.Title() != "Internal error" || !unknown.Internal() {
+		t.Errorf("unknown code: %d %q", unknown.Status(), unknown.Title())
+	}
+}
+
+func TestFromKeepsCausesOffTheCaller(t *testing.T) {
+	sqlErr := errors.New("Error 1062: Duplicate entry 'pay_1' for key 'PRIMARY'")
+	tests := []struct {
+		name    string
+		err     error
+		code    Code
+		message string
+	}{
+		{"sentinel", errPaymentNotFound, PaymentNotFound, "payment not found"},
+		{"sentinel with context", fmt.Errorf("refund of pay_1: %w", errPaymentNotFound), PaymentNotFound, "refund of pay_1: payment not found"},
+		{"wrapped cause", Wrap(InvalidRequest, "bad amount", sqlErr), InvalidRequest, "bad amount"},
+		{"internal with context", fmt.Errorf("saving pay_1: %w", Wrap(ServiceUnavailable, "database unavailable", sqlErr)), ServiceUnavailable, "database unavailable"},
+		{"unknown", sqlErr, Internal, "an unexpected error occurred"},
+		{"deadline", fmt.Errorf("querying: %w", context.DeadlineExceeded), Timeout, "the request took too long"},
+		{"stripe outage", &stripe.Error{Type: stripe.ErrorTypeAPI, Msg: "boom"}, ProviderUnavailable, "the payment provider could not complete the request"},
+		{"stripe card error", fmt.Errorf("charging: %w", &stripe.Error{Type: stripe.ErrorTypeCard, DeclineCode: stripe.DeclineCodeExpiredCard}), ExpiredCard, expiredMessage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := From(tt.err)
+			if e.Code != tt.code || e.Message != tt.message {
+				t.Errorf("From = %s %q, want %s %q", e.Code, e.Message, tt.code, tt.message)
+			}
+			if strings.Contains(e.Message, "Duplicate entry") {
+				t.Error("the SQL error reached the caller")
+			}
+			// Card errors keep Stripe's error rather than the context around it
+			var stripeErr *stripe.Error
+			if !errors.Is(e, tt.err) && !errors.As(e, &stripeErr) {
+				t.Error("the cause was lost for the logs")
+			}
+		})
+	}
+	if From(nil) != nil {
+		t.Error("From(nil) is not nil")
+	}
+}
+
+func TestFromStripeNeverExplainsFraudDeclines(t *testing.T) {
+	tests := []struct {
+		err  *stripe.Error
+		code Code
+	}{
+		{&stripe.Error{Type: stripe.ErrorTypeCard, DeclineCode: stripe.DeclineCodeInsufficientFunds}, InsufficientFunds},
+		{&stripe.Error{Type: stripe.ErrorTypeCard, DeclineCode: stripe.DeclineCodeInvalidCVC}, IncorrectCVC},
+		{&stripe.Error{Type: stripe.ErrorTypeCard, Code: stripe.ErrorCodeInvalidNumber}, IncorrectNumber},
+		{&stripe.Error{Type: stripe.ErrorTypeCard, Code: stripe.ErrorCodeCardDeclined, DeclineCode: stripe.DeclineCodeAuthenticationRequired}, AuthenticationRequired},
+		{&stripe.Error{Type: stripe.ErrorTypeCard, DeclineCode: stripe.DeclineCodeFraudulent, Msg: "Your card was declined (fraudulent)."}, CardDeclined},
+		{&stripe.Error{Type: stripe.ErrorTypeCard, DeclineCode: stripe.DeclineCodeStolenCard}, CardDeclined},
+		{&stripe.Error{Type: stripe.ErrorTypeInvalidRequest, Msg: "No such payment_intent"}, ProviderUnavailable},
+	}
+	for _, tt := range tests {
+		e := FromStripe(tt.err)
+		if e.Code != tt.code {
+			t.Errorf("%s/%s: code %s, want %s", tt.err.Code, tt.err.DeclineCode, e.Code, tt.code)
+		}
+		if strings.Contains(strings.ToLower(e.Message), "fraud") || strings.Contains(e.Message, "stolen") {
+			t.Errorf("%s: message %q gives the reason away", tt.err.DeclineCode, e.Message)
+		}
+		if e.Code != ProviderUnavailable && e.Status() != http.StatusPaymentRequired {
+			t.Errorf("%s: status %d, want 402", e.Code, e.Status())
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package apperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ContentType is the media type of problem responses (RFC 7807)
+const ContentType = "application/problem+json"
+
+// typePrefix makes each code a URI, as RFC 7807 asks of problem types
+const typePrefix = "urn:evently:error:"
+
+// Problem is the body of every error response
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          Code           `json:"code"`
+	RequestID     string         `json:"request_id,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// NewProblem describes e for the caller. Only e's message is shown, never
+// its cause.
+func NewProblem(e *Error, instance, requestID string) Problem {
+	return Problem{
+		Type:          typePrefix + string(e.Code),
+		Title:         e.Title(),
+		Status:        e.Status(),
+		Detail:        e.Message,
+		Instance:      instance,
+		Code:          e.Code,
+		RequestID:     requestID,
+		InvalidParams: e.Params,
+	}
+}
+
+// Respond writes err as a problem. message says what failed, such as
+// "Failed to save fee plan", and is shown in place of an internal error's
+// own message; errors the caller caused explain themselves.
+func Respond(c *gin.Context, message string, err error) {
+	e := From(err)
+	if e.Internal() {
+		// EnhancedLogger logs the cause; the caller only sees message
+		_ = c.Error(err)
+		if message != "" {
+			e = &Error{Code: e.Code, Message: message}
+		}
+	}
+	write(c, e)
+}
+
+// Abort writes e as a problem and stops the handler chain
+func Abort(c *gin.Context, e *Error) {
+	if e.Internal() && e.cause != nil {
+		_ = c.Error(e.cause)
+	}
+	write(c, e)
+	c.Abort()
+}
+
+func write(c *gin.Context, e *Error) {
+	c.Render(e.Status(), problemRender{NewProblem(e, c.Request.URL.Path, c.GetString("request_id"))})
+}
+
+// problemRender writes a Problem as JSON under the problem content type
+type problemRender struct {
+	problem Problem
+}
+
+func (r problemRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return json.NewEncoder(w).Encode(r.problem)
+}
+
+func (r problemRender) WriteContentType(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ContentType)
+}
+
+// Invalid describes a request that could not be bound: each field that
+// failed validation is listed by its JSON name.
+func Invalid(err error) *Error {
+	var fields validator.ValidationErrors
+	if errors.As(err, &fields) {
+		e := New(InvalidRequest, "the request has invalid fields")
+		for _, field := range fields {
+			e.Params = append(e.Params, InvalidParam{Name: field.Field(), Reason: reason(field)})
+		}
+		return e
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		e := New(InvalidRequest, "the request has invalid fields")
+		e.Params = []InvalidParam{{Name: typeErr.Field, Reason: "must be " + jsonType(typeErr.Type.Kind())}}
+		return e
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return New(InvalidRequest, "the request body is not valid JSON")
+	}
+
+	// Custom unmarshallers, such as a refund's amount, write their errors
+	// for the caller
+	return New(InvalidRequest, err.Error())
+}
+
+func jsonType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	default:
+		return "a number"
+	}
+}
+
+func reason(field validator.FieldError) string {
+	switch field.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be an email address"
+	case "oneof":
+		return "must be one of " + field.Param()
+	case "len":
+		return "must be " + field.Param() + " characters long"
+	case "min", "gte":
+		return "must be at least " + field.Param()
+	case "max", "lte":
+		return "must be at most " + field.Param()
+	case "gt":
+		return "must be greater than " + field.Param()
+	case "lt":
+		return "must be less than " + field.Param()
+	default:
+		return fmt.Sprintf("failed the %s check", field.Tag())
+	}
+}
+
+// Name validation errors after the JSON fields the caller sent rather than
+// the Go struct fields
+func init() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "form", "uri"} {
+			name := strings.Split(field.Tag.Get(tag), ",")[0]
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return field.Name
+	})
+}
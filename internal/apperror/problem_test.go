@@ -0,0 +1,103 @@
+package apperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func respond(t *testing.T, handler gin.HandlerFunc) (*httptest.ResponseRecorder, Problem, *gin.Context) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/pay_1/refund", nil)
+	c.Set("request_id", "req_1")
+	handler(c)
+
+	var problem Problem
+	if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("body %s: %v", w.Body, err)
+	}
+	return w, problem, c
+}
+
+func TestRespondWritesAProblem(t *testing.T) {
+	w, problem, c := respond(t, func(c *gin.Context) {
+		Respond(c, "Failed to refund payment", New(InvalidRefundAmount, "refund exceeds the amount left"))
+	})
+	if w.Code != http.StatusBadRequest || w.Header().Get("Content-Type") != ContentType {
+		t.Errorf("status %d, content type %s", w.Code, w.Header().Get("Content-Type"))
+	}
+	want := Problem{
+		Type: "urn:evently:error:invalid_refund_amount", Title: "Invalid refund amount", Status: http.StatusBadRequest,
+		Detail: "refund exceeds the amount left", Instance: "/api/v1/payments/pay_1/refund", Code: InvalidRefundAmount, RequestID: "req_1",
+	}
+	if problem.Type != want.Type || problem.Title != want.Title || problem.Status != want.Status || problem.Detail != want.Detail ||
+		problem.Instance != want.Instance || problem.Code != want.Code || problem.RequestID != want.RequestID {
+		t.Errorf("problem = %+v, want %+v", problem, want)
+	}
+	if len(c.Errors) != 0 {
+		t.Errorf("the caller's mistake was logged as an error: %v", c.Errors)
+	}
+}
+
+func TestRespondHidesInternalErrors(t *testing.T) {
+	cause := errors.New("dial tcp 10.0.0.5:3306: connection refused")
+	w, problem, c := respond(t, func(c *gin.Context) {
+		Respond(c, "Failed to refund payment", cause)
+	})
+	if w.Code != http.StatusInternalServerError || problem.Code != Internal || problem.Detail != "Failed to refund payment" {
+		t.Errorf("status %d, problem %+v", w.Code, problem)
+	}
+	if strings.Contains(w.Body.String(), "10.0.0.5") {
+		t.Error("the cause reached the caller")
+	}
+	if len(c.Errors) != 1 || !errors.Is(c.Errors[0].Err, cause) {
+		t.Errorf("cause not kept for the logs: %v", c.Errors)
+	}
+}
+
+func TestAbortStopsTheChain(t *testing.T) {
+	w, problem, c := respond(t, func(c *gin.Context) {
+		Abort(c, New(RateLimited, "slow down"))
+	})
+	if !c.IsAborted() || w.Code != http.StatusTooManyRequests || problem.Code != RateLimited {
+		t.Errorf("aborted %v, status %d, problem %+v", c.IsAborted(), w.Code, problem)
+	}
+}
+
+func TestInvalidNamesTheJSONFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var req struct {
+		Amount   float64 `json:"amount" binding:"required,gt=0"`
+		Currency string  `json:"currency" binding:"required,len=3"`
+	}
+	bind := func(body string) *Error {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		err := c.ShouldBindJSON(&req)
+		if err == nil {
+			t.Fatalf("%s bound", body)
+		}
+		return Invalid(err)
+	}
+
+	e := bind(`{"amount": -1, "currency": "us"}`)
+	if len(e.Params) != 2 || e.Params[0] != (InvalidParam{"amount", "must be greater than 0"}) ||
+		e.Params[1] != (InvalidParam{"currency", "must be 3 characters long"}) {
+		t.Errorf("params = %+v", e.Params)
+	}
+	if e := bind(`{"amount": "ten"}`); len(e.Params) != 1 || e.Params[0] != (InvalidParam{"amount", "must be a number"}) {
+		t.Errorf("params = %+v", e.Params)
+	}
+	if e := bind(`{"amount": `); e.Message != "the request body is not valid JSON" || e.Code != InvalidRequest {
+		t.Errorf("truncated body: %s %q", e.Code, e.Message)
+	}
+}
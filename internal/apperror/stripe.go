@@ -0,0 +1,72 @@
+package apperror
+
+import (
+	"github.com/stripe/stripe-go/v82"
+)
+
+// Messages for declines are shown to cardholders. They say what the
+// cardholder can do about it and nothing about why the issuer refused, so
+// fraud checks cannot be probed through them.
+const (
+	declinedMessage       = "Your card was declined. Please use a different card or contact your bank."
+	insufficientMessage   = "Your card has insufficient funds. Please use a different card."
+	expiredMessage        = "Your card has expired. Please use a different card."
+	incorrectCVCMessage   = "Your card's security code is incorrect."
+	incorrectNumMessage   = "Your card number is incorrect."
+	invalidExpiryMessage  = "Your card's expiry date is incorrect."
+	processingMessage     = "An error occurred while processing your card. Please try again."
+	authenticationMessage = "Your bank requires you to authenticate this payment."
+)
+
+// declineCodes translates the decline codes the cardholder can act on.
+// Every other decline, fraud-related ones included, is a plain
+// card_declined.
+var declineCodes = map[stripe.DeclineCode]*Error{
+	stripe.DeclineCodeInsufficientFunds:            New(InsufficientFunds, insufficientMessage),
+	stripe.DeclineCodeWithdrawalCountLimitExceeded: New(InsufficientFunds, insufficientMessage),
+	stripe.DeclineCodeExpiredCard:                  New(ExpiredCard, expiredMessage),
+	stripe.DeclineCodeIncorrectCVC:                 New(IncorrectCVC, incorrectCVCMessage),
+	stripe.DeclineCodeInvalidCVC:                   New(IncorrectCVC, incorrectCVCMessage),
+	stripe.DeclineCodeIncorrectNumber:              New(IncorrectNumber, incorrectNumMessage),
+	stripe.DeclineCodeInvalidNumber:                New(IncorrectNumber, incorrectNumMessage),
+	stripe.DeclineCodeInvalidExpiryMonth:           New(InvalidExpiry, invalidExpiryMessage),
+	stripe.DeclineCodeInvalidExpiryYear:            New(InvalidExpiry, invalidExpiryMessage),
+	stripe.DeclineCodeProcessingError:              New(ProcessingError, processingMessage),
+	stripe.DeclineCodeTryAgainLater:                New(ProcessingError, processingMessage),
+	stripe.DeclineCodeReenterTransaction:           New(ProcessingError, processingMessage),
+	stripe.DeclineCodeIssuerNotAvailable:           New(ProcessingError, processingMessage),
+	stripe.DeclineCodeAuthenticationRequired:       New(AuthenticationRequired, authenticationMessage),
+}
+
+// errorCodes translates card error codes Stripe reports without a decline
+// code, such as a malformed number caught before the issuer is asked.
+var errorCodes = map[stripe.ErrorCode]*Error{
+	stripe.ErrorCodeExpiredCard:            New(ExpiredCard, expiredMessage),
+	stripe.ErrorCodeIncorrectCVC:           New(IncorrectCVC, incorrectCVCMessage),
+	stripe.ErrorCodeInvalidCVC:             New(IncorrectCVC, incorrectCVCMessage),
+	stripe.ErrorCodeIncorrectNumber:        New(IncorrectNumber, incorrectNumMessage),
+	stripe.ErrorCodeInvalidNumber:          New(IncorrectNumber, incorrectNumMessage),
+	stripe.ErrorCodeInvalidExpiryMonth:     New(InvalidExpiry, invalidExpiryMessage),
+	stripe.ErrorCodeInvalidExpiryYear:      New(InvalidExpiry, invalidExpiryMessage),
+	stripe.ErrorCodeProcessingError:        New(ProcessingError, processingMessage),
+	stripe.ErrorCodeAuthenticationRequired: New(AuthenticationRequired, authenticationMessage),
+}
+
+// FromStripe translates a Stripe error. Card errors become the matching
+// card code with a message for the cardholder; anything else is Stripe's
+// problem or ours and becomes provider_unavailable. Stripe's own message is
+// kept as the cause for the logs.
+func FromStripe(err *stripe.Error) *Error {
+	if err.Type != stripe.ErrorTypeCard {
+		return Wrap(ProviderUnavailable, "the payment provider could not complete the request", err)
+	}
+
+	known, ok := declineCodes[err.DeclineCode]
+	if !ok {
+		known, ok = errorCodes[err.Code]
+	}
+	if !ok {
+		known = New(CardDeclined, declinedMessage)
+	}
+	return Wrap(known.Code, known.Message, err)
+}
@@ -0,0 +1,26 @@
+package audit
+
+import "context"
+
+// Source describes where a request came from, for the audit log. It is set
+// by the request middleware before authentication, so OnBehalfOf is only
+// what the caller claimed; it is recorded for platform callers alone.
+type Source struct {
+	IP         string
+	RequestID  string
+	OnBehalfOf string // X-Actor: the staff member or service using a platform key
+}
+
+type contextKey struct{}
+
+// WithSource returns a context carrying the request's source.
+func WithSource(ctx context.Context, src Source) context.Context {
+	return context.WithValue(ctx, contextKey{}, src)
+}
+
+// SourceFrom returns the source stored by the request middleware. Internal
+// jobs have none.
+func SourceFrom(ctx context.Context) (Source, bool) {
+	src, ok := ctx.Value(contextKey{}).(Source)
+	return src, ok
+}
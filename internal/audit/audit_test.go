@@ -0,0 +1,18 @@
+package audit
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSourceTravelsWithTheContext(t *testing.T) {
+	if _, ok := SourceFrom(context.Background()); ok {
+		t.Error("background context has a source")
+	}
+
+	src := Source{IP: "203.0.113.7", RequestID: "req_1", OnBehalfOf: "ops@evently.test"}
+	got, ok := SourceFrom(WithSource(context.Background(), src))
+	if !ok || got != src {
+		t.Errorf("source = %+v, %v", got, ok)
+	}
+}
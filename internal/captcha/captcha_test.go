@@ -0,0 +1,43 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSiteVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("secret") != "captcha-secret" || r.PostForm.Get("remoteip") != "198.51.100.1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.PostForm.Get("response") {
+		case "solved":
+			_, _ = w.Write([]byte(`{"success": true}`))
+		case "garbled":
+			_, _ = w.Write([]byte(`<html>`))
+		default:
+			_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+		}
+	}))
+	defer server.Close()
+	verifier := NewSiteVerifier(server.URL, "captcha-secret")
+
+	for token, want := range map[string]bool{"solved": true, "expired": false, "": false} {
+		if ok, err := verifier.Verify(context.Background(), token, "198.51.100.1"); ok != want || err != nil {
+			t.Errorf("token %q: ok %v, err %v; want %v", token, ok, err, want)
+		}
+	}
+	if ok, err := verifier.Verify(context.Background(), "garbled", "198.51.100.1"); ok || err == nil {
+		t.Errorf("garbled response: ok %v, err %v", ok, err)
+	}
+	if ok, err := verifier.Verify(context.Background(), "solved", "203.0.113.9"); ok || err == nil {
+		t.Errorf("rejected request: ok %v, err %v", ok, err)
+	}
+	if ok, err := NewSiteVerifier(server.URL, "").Verify(context.Background(), "solved", ""); ok || !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("no secret: ok %v, err %v", ok, err)
+	}
+}
@@ -0,0 +1,274 @@
+// Package card validates card details locally, before anything is sent to
+// Stripe: Luhn checksum, brand detection from IIN ranges, length and CVC
+// rules per brand, and expiry against the current month.
+package card
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+// Brand names match the values Stripe reports on a card PaymentMethod.
+type Brand string
+
+const (
+	Visa       Brand = "visa"
+	Mastercard Brand = "mastercard"
+	Amex       Brand = "amex"
+	Discover   Brand = "discover"
+	Diners     Brand = "diners"
+	JCB        Brand = "jcb"
+	UnionPay   Brand = "unionpay"
+	Unknown    Brand = "unknown"
+)
+
+// Field error codes returned in models.CardFieldError.Code
+const (
+	CodeRequired         = "required"
+	CodeInvalidFormat    = "invalid_format"
+	CodeInvalidLength    = "invalid_length"
+	CodeInvalidChecksum  = "invalid_checksum"
+	CodeUnsupportedBrand = "unsupported_brand"
+	CodeInvalidMonth     = "invalid_month"
+	CodeInvalidYear      = "invalid_year"
+	CodeExpired          = "expired"
+)
+
+// maxYearsAhead rejects expiry years no issuer would print
+const maxYearsAhead = 20
+
+type brandRule struct {
+	brand   Brand
+	lengths []int
+	cvc     int
+	// prefixes are inclusive IIN ranges compared on the leading digits
+	prefixes [][2]int
+}
+
+// rules are checked in order; the first matching range wins, so the narrow
+// Discover ranges inside 62 come before UnionPay.
+var rules = []brandRule{
+	{brand: Amex, lengths: []int{15}, cvc: 4, prefixes: [][2]int{{34, 34}, {37, 37}}},
+	{brand: Visa, lengths: []int{13, 16, 19}, cvc: 3, prefixes: [][2]int{{4, 4}}},
+	{brand: Mastercard, lengths: []int{16}, cvc: 3, prefixes: [][2]int{{51, 55}, {2221, 2720}}},
+	{brand: Discover, lengths: []int{16, 17, 18, 19}, cvc: 3, prefixes: [][2]int{{6011, 6011}, {644, 649}, {65, 65}, {622126, 622925}}},
+	{brand: JCB, lengths: []int{16, 17, 18, 19}, cvc: 3, prefixes: [][2]int{{3528, 3589}}},
+	{brand: Diners, lengths: []int{14, 15, 16, 17, 18, 19}, cvc: 3, prefixes: [][2]int{{300, 305}, {36, 36}, {38, 39}}},
+	{brand: UnionPay, lengths: []int{16, 17, 18, 19}, cvc: 3, prefixes: [][2]int{{62, 62}, {81, 81}}},
+}
+
+// Details is the raw card as entered by the customer.
+type Details struct {
+	Number   string
+	ExpMonth string
+	ExpYear  string
+	CVC      string
+}
+
+// Result is the outcome of Validate. ExpMonth and ExpYear are only set when
+// they parsed; ExpYear is always four digits.
+type Result struct {
+	Brand    Brand
+	Last4    string
+	ExpMonth int64
+	ExpYear  int64
+	Errors   []models.CardFieldError
+}
+
+// Valid reports whether every local check passed.
+func (r *Result) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Error joins the field errors into one message.
+func (r *Result) Error() string {
+	messages := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Field+": "+e.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (r *Result) fail(field, code, message string) {
+	r.Errors = append(r.Errors, models.CardFieldError{Field: field, Code: code, Message: message})
+}
+
+// Validate checks a card against the rules of its brand. now decides
+// whether the card has expired; a card is valid through the end of its
+// expiry month.
+func Validate(d Details, now time.Time) *Result {
+	r := &Result{Brand: Unknown}
+
+	rule := r.validateNumber(strings.NewReplacer(" ", "", "-", "").Replace(d.Number))
+	r.validateExpiry(strings.TrimSpace(d.ExpMonth), strings.TrimSpace(d.ExpYear), now)
+	r.validateCVC(strings.TrimSpace(d.CVC), rule)
+	return r
+}
+
+func (r *Result) validateNumber(number string) *brandRule {
+	if number == "" {
+		r.fail("number", CodeRequired, "card number is required")
+		return nil
+	}
+	if !isDigits(number) {
+		r.fail("number", CodeInvalidFormat, "card number must contain only digits")
+		return nil
+	}
+	if len(number) >= 4 {
+		r.Last4 = number[len(number)-4:]
+	}
+
+	rule := ruleFor(number)
+	if rule == nil {
+		r.fail("number", CodeUnsupportedBrand, "card brand is not supported")
+		return nil
+	}
+	r.Brand = rule.brand
+
+	if !containsInt(rule.lengths, len(number)) {
+		r.fail("number", CodeInvalidLength, fmt.Sprintf("%s card numbers must be %s digits long", rule.brand, joinInts(rule.lengths)))
+		return rule
+	}
+	if !Luhn(number) {
+		r.fail("number", CodeInvalidChecksum, "card number is invalid")
+	}
+	return rule
+}
+
+func (r *Result) validateExpiry(month, year string, now time.Time) {
+	if month == "" {
+		r.fail("exp_month", CodeRequired, "expiry month is required")
+	} else if m, err := strconv.Atoi(month); err != nil || !isDigits(month) || m < 1 || m > 12 {
+		r.fail("exp_month", CodeInvalidMonth, "expiry month must be between 1 and 12")
+	} else {
+		r.ExpMonth = int64(m)
+	}
+
+	switch {
+	case year == "":
+		r.fail("exp_year", CodeRequired, "expiry year is required")
+	case !isDigits(year) || (len(year) != 2 && len(year) != 4):
+		r.fail("exp_year", CodeInvalidYear, "expiry year must be 2 or 4 digits")
+	default:
+		y, _ := strconv.Atoi(year)
+		if len(year) == 2 {
+			y += 2000
+		}
+		if y > now.Year()+maxYearsAhead {
+			r.fail("exp_year", CodeInvalidYear, "expiry year is too far in the future")
+			return
+		}
+		r.ExpYear = int64(y)
+	}
+
+	if r.ExpMonth == 0 || r.ExpYear == 0 {
+		return
+	}
+	if r.ExpYear < int64(now.Year()) || (r.ExpYear == int64(now.Year()) && r.ExpMonth < int64(now.Month())) {
+		r.fail("exp_year", CodeExpired, "card has expired")
+	}
+}
+
+func (r *Result) validateCVC(cvc string, rule *brandRule) {
+	if cvc == "" {
+		r.fail("cvc", CodeRequired, "CVC is required")
+		return
+	}
+	if !isDigits(cvc) {
+		r.fail("cvc", CodeInvalidFormat, "CVC must contain only digits")
+		return
+	}
+
+	if rule == nil {
+		// Brand unknown: accept either length rather than guess
+		if len(cvc) != 3 && len(cvc) != 4 {
+			r.fail("cvc", CodeInvalidLength, "CVC must be 3 or 4 digits")
+		}
+		return
+	}
+	if len(cvc) != rule.cvc {
+		r.fail("cvc", CodeInvalidLength, fmt.Sprintf("%s CVC must be %d digits", rule.brand, rule.cvc))
+	}
+}
+
+// DetectBrand returns the card brand for a card number's IIN, or Unknown.
+func DetectBrand(number string) Brand {
+	if rule := ruleFor(number); rule != nil {
+		return rule.brand
+	}
+	return Unknown
+}
+
+func ruleFor(number string) *brandRule {
+	for i := range rules {
+		for _, r := range rules[i].prefixes {
+			width := len(strconv.Itoa(r[0]))
+			if len(number) < width {
+				continue
+			}
+			prefix, _ := strconv.Atoi(number[:width])
+			if prefix >= r[0] && prefix <= r[1] {
+				return &rules[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Luhn reports whether a string of 13-19 digits passes the Luhn checksum.
+func Luhn(digits string) bool {
+	if len(digits) < 13 || len(digits) > 19 || !isDigits(digits) {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func containsInt(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
@@ -0,0 +1,101 @@
+package card
+
+import (
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+func TestDetectBrand(t *testing.T) {
+	for number, want := range map[string]Brand{
+		"4242424242424242": Visa,
+		"5555555555554444": Mastercard,
+		"2223003122003222": Mastercard,
+		"378282246310005":  Amex,
+		"6011111111111117": Discover,
+		"6221260000000000": Discover,
+		"6229260000000000": UnionPay,
+		"3056930009020004": Diners,
+		"36227206271667":   Diners,
+		"3566002020360505": JCB,
+		"6200000000000005": UnionPay,
+		"9999999999999995": Unknown,
+		"5":                Unknown,
+	} {
+		if got := DetectBrand(number); got != want {
+			t.Errorf("DetectBrand(%s) = %s, want %s", number, got, want)
+		}
+	}
+}
+
+func TestLuhn(t *testing.T) {
+	for digits, want := range map[string]bool{
+		"4242424242424242":     true,
+		"4242424242424241":     false,
+		"378282246310005":      true,
+		"4222222222222":        true,
+		"424242424242":         false,
+		"42424242424242424242": false,
+		"4242a24242424242":     false,
+	} {
+		if got := Luhn(digits); got != want {
+			t.Errorf("Luhn(%s) = %v, want %v", digits, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		details Details
+		field   string
+		code    string
+	}{
+		{"valid visa", Details{"4242 4242 4242 4242", "12", "2030", "123"}, "", ""},
+		{"valid amex with dashes", Details{"3782-822463-10005", "1", "30", "1234"}, "", ""},
+		{"valid through the expiry month", Details{"4242424242424242", "10", "2026", "123"}, "", ""},
+		{"missing number", Details{"", "12", "2030", "123"}, "number", CodeRequired},
+		{"letters in number", Details{"4242x24242424242", "12", "2030", "123"}, "number", CodeInvalidFormat},
+		{"unknown brand", Details{"9999999999999995", "12", "2030", "123"}, "number", CodeUnsupportedBrand},
+		{"short visa", Details{"42424242424242", "12", "2030", "123"}, "number", CodeInvalidLength},
+		{"bad checksum", Details{"4242424242424241", "12", "2030", "123"}, "number", CodeInvalidChecksum},
+		{"month zero", Details{"4242424242424242", "0", "2030", "123"}, "exp_month", CodeInvalidMonth},
+		{"month thirteen", Details{"4242424242424242", "13", "2030", "123"}, "exp_month", CodeInvalidMonth},
+		{"signed month", Details{"4242424242424242", "+1", "2030", "123"}, "exp_month", CodeInvalidMonth},
+		{"three digit year", Details{"4242424242424242", "12", "203", "123"}, "exp_year", CodeInvalidYear},
+		{"year too far ahead", Details{"4242424242424242", "12", "2099", "123"}, "exp_year", CodeInvalidYear},
+		{"expired last month", Details{"4242424242424242", "9", "2026", "123"}, "exp_year", CodeExpired},
+		{"expired last year", Details{"4242424242424242", "12", "25", "123"}, "exp_year", CodeExpired},
+		{"amex needs four digit cvc", Details{"378282246310005", "12", "2030", "123"}, "cvc", CodeInvalidLength},
+		{"visa needs three digit cvc", Details{"4242424242424242", "12", "2030", "1234"}, "cvc", CodeInvalidLength},
+		{"missing cvc", Details{"4242424242424242", "12", "2030", ""}, "cvc", CodeRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Validate(tt.details, now)
+			if tt.field == "" {
+				if !r.Valid() {
+					t.Fatalf("errors: %s", r.Error())
+				}
+				return
+			}
+			if len(r.Errors) != 1 || r.Errors[0].Field != tt.field || r.Errors[0].Code != tt.code {
+				t.Errorf("errors = %+v, want %s on %s", r.Errors, tt.code, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidateReportsWhatParsed(t *testing.T) {
+	r := Validate(Details{"3782 822463 10005", "07", "29", "1234"}, now)
+	if !r.Valid() || r.Brand != Amex || r.Last4 != "0005" || r.ExpMonth != 7 || r.ExpYear != 2029 {
+		t.Errorf("result = %+v", r)
+	}
+
+	// A bad month is reported, never turned into 0 and sent on
+	r = Validate(Details{"4242424242424242", "ab", "2030", "123"}, now)
+	if r.Valid() || r.ExpMonth != 0 || r.ExpYear != 2030 {
+		t.Errorf("result = %+v", r)
+	}
+}
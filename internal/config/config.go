@@ -3,15 +3,31 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Email    EmailConfig
-	Redis    RedisConfig // Assuming RedisConfig is defined in the redis package
-	Kafka    KafkaConfig
-	Database DatabaseConfig // Added database configuration
+	Server    ServerConfig
+	Email     EmailConfig
+	Redis     RedisConfig // Assuming RedisConfig is defined in the redis package
+	Kafka     KafkaConfig
+	Database  DatabaseConfig // Added database configuration
+	Sweeper   SweeperConfig
+	Reconcile ReconciliationConfig
+	Auth      AuthConfig
+	Fees      FeeConfig
+	Payouts   PayoutConfig
+	Disputes  DisputeConfig
+	Cards     CardDataConfig
+	FX        FXConfig
+	Webhooks  WebhookConfig
+	Risk      RiskConfig
+	CardTests CardTestingConfig
+	GRPC      GRPCConfig
+	OpenAPI   OpenAPIConfig
+	Streams   StreamConfig
+	Checkout  CheckoutConfig
 }
 
 type ServerConfig struct {
@@ -43,6 +59,170 @@ type DatabaseConfig struct {
 	MaxLifetime  time.Duration
 }
 
+// SweeperConfig controls the background job that expires stale pending payments.
+type SweeperConfig struct {
+	Enabled          bool
+	Interval         time.Duration
+	PendingTTL       time.Duration // OTP-confirmed payments
+	StripePendingTTL time.Duration // payments backed by a Stripe PaymentIntent
+	BatchSize        int
+}
+
+// ReconciliationConfig controls the daily Stripe-to-ledger reconciliation job.
+type ReconciliationConfig struct {
+	Enabled bool
+	RunHour int // UTC hour at which the previous day is reconciled
+}
+
+// AuthConfig controls API key authentication. The platform key is used by
+// other Evently services and may act on behalf of any organizer. Support
+// keys, keyed by key and naming the staff member, only open the admin
+// console API.
+type AuthConfig struct {
+	Enabled        bool
+	PlatformAPIKey string
+	SupportAPIKeys map[string]string
+}
+
+// FeeConfig is the platform commission applied to organizers without a plan.
+type FeeConfig struct {
+	DefaultPercent float64
+	DefaultFixed   float64
+}
+
+// PayoutConfig controls the scheduled organizer payout job.
+type PayoutConfig struct {
+	Enabled        bool
+	Interval       time.Duration // how often due payouts are checked
+	ReservePercent float64       // share of the balance held back until the organizer's event ends
+	MinimumAmount  float64       // balances below this roll over to the next payout
+}
+
+// DisputeConfig controls the dispute evidence deadline tracker.
+type DisputeConfig struct {
+	CheckInterval  time.Duration
+	ReminderWindow time.Duration // warn this long before evidence is due
+}
+
+// CardDataConfig controls whether raw card numbers may reach this service.
+// Production only accepts Stripe tokens and PaymentMethod IDs so the service
+// stays out of PCI scope; raw cards are for local testing against Stripe's
+// test keys.
+type CardDataConfig struct {
+	RawCardTestMode bool
+	MaxBodyBytes    int64 // largest JSON body the card data guard will inspect
+}
+
+// FXConfig selects where exchange rates come from. Rates are snapshotted on
+// every payment, refund and payout so reports convert to BaseCurrency the
+// same way every time they are run.
+type FXConfig struct {
+	BaseCurrency string
+	Provider     string // static, file or http
+	StaticRates  string // "eur=0.92,gbp=0.79", quoted against BaseCurrency
+	RatesFile    string
+	RatesURL     string
+	CacheTTL     time.Duration
+}
+
+// WebhookConfig controls outbound webhook delivery. Failed attempts are
+// retried with exponential backoff from RetryBase up to RetryMax; an
+// endpoint is disabled after DisableAfter failed attempts in a row.
+type WebhookConfig struct {
+	Enabled      bool
+	PollInterval time.Duration // how often due deliveries are sent
+	Timeout      time.Duration
+	MaxAttempts  int
+	RetryBase    time.Duration
+	RetryMax     time.Duration
+	DisableAfter int
+	// AllowPrivateNetworks lets deliveries reach private and loopback
+	// addresses, for partners on the internal network
+	AllowPrivateNetworks bool
+}
+
+// RiskConfig controls fraud scoring before payments reach the provider.
+// Without a RulesFile the built-in rules apply. FailOpen lets payments
+// through when scoring is impossible; otherwise they are held for review.
+type RiskConfig struct {
+	Enabled        bool
+	RulesFile      string
+	ReloadInterval time.Duration // how often the rules file is checked for changes
+	FailOpen       bool
+}
+
+// CardTestingConfig limits card checks so validate-card cannot be used to
+// test stolen cards. Checks are counted per IP, per checkout session and
+// per card over Window; past a threshold the caller must solve a CAPTCHA,
+// and an IP whose checks fail BanAfterFailures times is banned for
+// BanDuration.
+type CardTestingConfig struct {
+	Enabled             bool
+	Window              time.Duration
+	CaptchaAfterIP      int
+	CaptchaAfterSession int
+	CaptchaAfterCard    int
+	BanAfterFailures    int
+	BanDuration         time.Duration
+	CaptchaVerifyURL    string // siteverify endpoint of reCAPTCHA, hCaptcha or Turnstile
+	CaptchaSecret       string
+	FingerprintKey      string // HMAC key for card number fingerprints; random per process when unset
+}
+
+// GRPCConfig controls the gRPC API served beside the REST API. Calls
+// without a deadline get DefaultTimeout, and no call may run longer than
+// MaxTimeout; WatchPayment streams are bounded by WatchTimeout instead and
+// re-read the payment every WatchPollInterval to catch changes made by
+// other instances.
+type GRPCConfig struct {
+	Enabled           bool
+	Port              string
+	DefaultTimeout    time.Duration
+	MaxTimeout        time.Duration
+	WatchTimeout      time.Duration
+	WatchPollInterval time.Duration
+}
+
+// OpenAPIConfig controls checking REST traffic against api/openapi.yaml.
+// In "log" mode mismatches are only logged; "enforce", the default, rejects
+// requests that break the contract or are not in it and replaces responses
+// that break it with a 500. Responses are buffered to be checked, so
+// ValidateResponses is meant for tests and development and best left off in
+// production.
+type OpenAPIConfig struct {
+	Validation        string // off, log or enforce
+	ValidateResponses bool
+}
+
+// StreamConfig controls the payment status streams pushed over SSE and
+// WebSocket. Instances share events through the Redis channel Channel. Idle
+// streams get a keepalive every KeepAlive, and every stream ends after
+// MaxDuration; clients reconnect and resume from their last event ID.
+//
+// Browsers cannot send an API key with EventSource or WebSocket, so they
+// open a stream with a token signed by TokenSecret that the organizer's
+// backend requests for them; tokens last TokenTTL. Requests carrying an
+// Origin header are only served for AllowedOrigins, where "*" allows any.
+type StreamConfig struct {
+	Channel        string
+	KeepAlive      time.Duration
+	MaxDuration    time.Duration
+	TokenSecret    string
+	TokenTTL       time.Duration
+	AllowedOrigins []string
+}
+
+// CheckoutConfig controls hosted checkout sessions and payment links.
+// Attendees pay on the hosted checkout page at LinkBaseURL followed by the
+// session ID. Sessions for a purchase in progress default to SessionExpiry
+// and shared payment links to LinkExpiry; neither may outlive MaxExpiry.
+type CheckoutConfig struct {
+	LinkBaseURL   string
+	SessionExpiry time.Duration
+	LinkExpiry    time.Duration
+	MaxExpiry     time.Duration
+}
+
 type TopicConfig struct {
 	PaymentEvents   string
 	PaymentSuccess  string
@@ -100,6 +280,102 @@ func Load() *Config {
 				PaymentRefunded: getEnv("KAFKA_TOPIC_REFUNDED", "payment-refunded"),
 			},
 		},
+		Sweeper: SweeperConfig{
+			Enabled:          getEnvBool("SWEEPER_ENABLED", true),
+			Interval:         time.Duration(getEnvPositiveInt("SWEEPER_INTERVAL_SECONDS", 60)) * time.Second,
+			PendingTTL:       time.Duration(getEnvPositiveInt("PENDING_PAYMENT_TTL_MINUTES", 15)) * time.Minute,
+			StripePendingTTL: time.Duration(getEnvPositiveInt("STRIPE_PENDING_TTL_MINUTES", 60)) * time.Minute,
+			BatchSize:        getEnvPositiveInt("SWEEPER_BATCH_SIZE", 100),
+		},
+		Reconcile: ReconciliationConfig{
+			Enabled: getEnvBool("RECONCILIATION_ENABLED", true),
+			RunHour: getEnvInt("RECONCILIATION_HOUR_UTC", 2),
+		},
+		Auth: AuthConfig{
+			Enabled:        getEnvBool("AUTH_ENABLED", true),
+			PlatformAPIKey: getEnv("PLATFORM_API_KEY", ""),
+			SupportAPIKeys: getEnvKeys("SUPPORT_API_KEYS"),
+		},
+		Fees: FeeConfig{
+			DefaultPercent: getEnvFloat("FEE_DEFAULT_PERCENT", 5.0),
+			DefaultFixed:   getEnvFloat("FEE_DEFAULT_FIXED", 0),
+		},
+		Payouts: PayoutConfig{
+			Enabled:        getEnvBool("PAYOUTS_ENABLED", true),
+			Interval:       time.Duration(getEnvInt("PAYOUT_INTERVAL_MINUTES", 60)) * time.Minute,
+			ReservePercent: getEnvFloat("PAYOUT_RESERVE_PERCENT", 10.0),
+			MinimumAmount:  getEnvFloat("PAYOUT_MINIMUM_AMOUNT", 1.0),
+		},
+		Disputes: DisputeConfig{
+			CheckInterval:  time.Duration(getEnvInt("DISPUTE_CHECK_INTERVAL_MINUTES", 60)) * time.Minute,
+			ReminderWindow: time.Duration(getEnvInt("DISPUTE_REMINDER_HOURS", 72)) * time.Hour,
+		},
+		Cards: CardDataConfig{
+			RawCardTestMode: getEnvBool("STRIPE_RAW_CARD_TEST_MODE", false),
+			MaxBodyBytes:    int64(getEnvInt("CARD_GUARD_MAX_BODY_BYTES", 1<<20)),
+		},
+		FX: FXConfig{
+			BaseCurrency: getEnv("FX_BASE_CURRENCY", "usd"),
+			Provider:     getEnv("FX_PROVIDER", "static"),
+			StaticRates:  getEnv("FX_STATIC_RATES", ""),
+			RatesFile:    getEnv("FX_RATES_FILE", ""),
+			RatesURL:     getEnv("FX_RATES_URL", ""),
+			CacheTTL:     time.Duration(getEnvInt("FX_CACHE_MINUTES", 60)) * time.Minute,
+		},
+		Webhooks: WebhookConfig{
+			Enabled:              getEnvBool("WEBHOOKS_ENABLED", true),
+			PollInterval:         time.Duration(getEnvInt("WEBHOOK_POLL_SECONDS", 5)) * time.Second,
+			Timeout:              time.Duration(getEnvInt("WEBHOOK_TIMEOUT_SECONDS", 10)) * time.Second,
+			MaxAttempts:          getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			RetryBase:            time.Duration(getEnvInt("WEBHOOK_RETRY_BASE_SECONDS", 30)) * time.Second,
+			RetryMax:             time.Duration(getEnvInt("WEBHOOK_RETRY_MAX_MINUTES", 360)) * time.Minute,
+			DisableAfter:         getEnvInt("WEBHOOK_DISABLE_AFTER_FAILURES", 20),
+			AllowPrivateNetworks: getEnvBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false),
+		},
+		Risk: RiskConfig{
+			Enabled:        getEnvBool("RISK_ENABLED", true),
+			RulesFile:      getEnv("RISK_RULES_FILE", ""),
+			ReloadInterval: time.Duration(getEnvInt("RISK_RULES_RELOAD_SECONDS", 30)) * time.Second,
+			FailOpen:       getEnvBool("RISK_FAIL_OPEN", true),
+		},
+		CardTests: CardTestingConfig{
+			Enabled:             getEnvBool("CARD_TESTING_GUARD_ENABLED", true),
+			Window:              time.Duration(getEnvInt("CARD_TESTING_WINDOW_MINUTES", 10)) * time.Minute,
+			CaptchaAfterIP:      getEnvInt("CARD_TESTING_CAPTCHA_AFTER_IP", 5),
+			CaptchaAfterSession: getEnvInt("CARD_TESTING_CAPTCHA_AFTER_SESSION", 3),
+			CaptchaAfterCard:    getEnvInt("CARD_TESTING_CAPTCHA_AFTER_CARD", 3),
+			BanAfterFailures:    getEnvInt("CARD_TESTING_BAN_AFTER_FAILURES", 10),
+			BanDuration:         time.Duration(getEnvInt("CARD_TESTING_BAN_MINUTES", 60)) * time.Minute,
+			CaptchaVerifyURL:    getEnv("CAPTCHA_VERIFY_URL", ""),
+			CaptchaSecret:       getEnv("CAPTCHA_SECRET", ""),
+			FingerprintKey:      getEnv("CARD_FINGERPRINT_KEY", ""),
+		},
+		GRPC: GRPCConfig{
+			Enabled:           getEnvBool("GRPC_ENABLED", true),
+			Port:              getEnv("GRPC_PORT", ":9090"),
+			DefaultTimeout:    time.Duration(getEnvInt("GRPC_DEFAULT_TIMEOUT_SECONDS", 10)) * time.Second,
+			MaxTimeout:        time.Duration(getEnvInt("GRPC_MAX_TIMEOUT_SECONDS", 60)) * time.Second,
+			WatchTimeout:      time.Duration(getEnvInt("GRPC_WATCH_TIMEOUT_MINUTES", 30)) * time.Minute,
+			WatchPollInterval: time.Duration(getEnvInt("GRPC_WATCH_POLL_SECONDS", 5)) * time.Second,
+		},
+		OpenAPI: OpenAPIConfig{
+			Validation:        getEnv("OPENAPI_VALIDATION", "enforce"),
+			ValidateResponses: getEnvBool("OPENAPI_VALIDATE_RESPONSES", false),
+		},
+		Streams: StreamConfig{
+			Channel:        getEnv("STREAM_REDIS_CHANNEL", "payment-gateway:payment-events"),
+			KeepAlive:      time.Duration(getEnvInt("STREAM_KEEPALIVE_SECONDS", 15)) * time.Second,
+			MaxDuration:    time.Duration(getEnvInt("STREAM_MAX_MINUTES", 30)) * time.Minute,
+			TokenSecret:    getEnv("STREAM_TOKEN_SECRET", ""),
+			TokenTTL:       time.Duration(getEnvInt("STREAM_TOKEN_TTL_SECONDS", 300)) * time.Second,
+			AllowedOrigins: getEnvList("STREAM_ALLOWED_ORIGINS", "http://localhost:3000"),
+		},
+		Checkout: CheckoutConfig{
+			LinkBaseURL:   strings.TrimRight(getEnv("CHECKOUT_LINK_BASE_URL", "http://localhost:3000/pay"), "/"),
+			SessionExpiry: time.Duration(getEnvInt("CHECKOUT_SESSION_EXPIRY_MINUTES", 30)) * time.Minute,
+			LinkExpiry:    time.Duration(getEnvInt("CHECKOUT_LINK_EXPIRY_DAYS", 7)) * 24 * time.Hour,
+			MaxExpiry:     time.Duration(getEnvInt("CHECKOUT_MAX_EXPIRY_DAYS", 30)) * 24 * time.Hour,
+		},
 	}
 }
 
@@ -128,6 +404,49 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvPositiveInt is getEnvInt for values that must be above zero, such
+// as intervals, which time.NewTicker refuses
+func getEnvPositiveInt(key string, defaultValue int) int {
+	if value := getEnvInt(key, defaultValue); value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// getEnvKeys reads comma-separated name:key pairs into a map from key to
+// name. Malformed pairs are skipped.
+func getEnvKeys(key string) map[string]string {
+	keys := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || name == "" || value == "" {
+			continue
+		}
+		keys[value] = name
+	}
+	return keys
+}
+
+// getEnvList reads a comma-separated list, skipping empty entries
+func getEnvList(key, defaultValue string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnv(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func getRedisAddr() string {
 	redisAddr := os.Getenv("REDIS_ADDR")
 	if redisAddr == "" {
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSweeperFallsBackFromUnusableValues(t *testing.T) {
+	for _, value := range []string{"0", "-30", "soon"} {
+		t.Setenv("SWEEPER_INTERVAL_SECONDS", value)
+		t.Setenv("SWEEPER_BATCH_SIZE", value)
+		cfg := Load().Sweeper
+		if cfg.Interval != time.Minute || cfg.BatchSize != 100 {
+			t.Errorf("%q: interval %s, batch size %d", value, cfg.Interval, cfg.BatchSize)
+		}
+	}
+	t.Setenv("SWEEPER_INTERVAL_SECONDS", "15")
+	if interval := Load().Sweeper.Interval; interval != 15*time.Second {
+		t.Errorf("interval = %s, want 15s", interval)
+	}
+}
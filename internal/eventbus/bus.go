@@ -0,0 +1,223 @@
+// Package eventbus delivers payment events to subscribers inside the
+// gateway, such as status streams held open by checkout pages. Events are
+// delivered on the instance that published them at once and relayed to
+// every other instance through a broker, so a subscriber sees a payment's
+// events whichever replica handled the payment.
+package eventbus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+)
+
+const (
+	subscriberBuffer = 32
+	publishTimeout   = 2 * time.Second
+	minRetryDelay    = time.Second
+	maxRetryDelay    = 30 * time.Second
+)
+
+// Broker relays events between instances. internal/redis implements it
+// with Redis pub/sub.
+type Broker interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
+
+// Topic names what a subscriber follows: one payment or one order.
+type Topic string
+
+func Payment(paymentID string) Topic {
+	return Topic("payment:" + paymentID)
+}
+
+func Order(orderID string) Topic {
+	return Topic("order:" + orderID)
+}
+
+// topics lists the topics an event is delivered to
+func topics(event *models.PaymentEvent) []Topic {
+	found := []Topic{Payment(event.PaymentID)}
+	if event.Payment != nil && event.Payment.OrderID != "" {
+		found = append(found, Order(event.Payment.OrderID))
+	}
+	return found
+}
+
+// envelope is an event as relayed through the broker. Source lets an
+// instance skip the events it published itself, which it has already
+// delivered.
+type envelope struct {
+	Source string               `json:"source"`
+	Event  *models.PaymentEvent `json:"event"`
+}
+
+type subscriber struct {
+	events chan *models.PaymentEvent
+	topics []Topic
+	closed bool
+}
+
+// Bus fans events out to subscribers. Without a broker it only delivers
+// events published on this instance.
+type Bus struct {
+	broker  Broker
+	channel string
+	source  string
+	log     *logger.Logger
+
+	mu   sync.Mutex
+	subs map[Topic]map[*subscriber]struct{}
+}
+
+func New(broker Broker, channel string, log *logger.Logger) *Bus {
+	return &Bus{
+		broker:  broker,
+		channel: channel,
+		source:  instanceID(),
+		log:     log,
+		subs:    make(map[Topic]map[*subscriber]struct{}),
+	}
+}
+
+// Publish delivers an event to this instance's subscribers and relays it
+// to the other instances. The relay error is returned for the caller to
+// log; local subscribers have the event either way.
+func (b *Bus) Publish(event *models.PaymentEvent) error {
+	b.deliver(event)
+	if b.broker == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(envelope{Source: b.source, Event: event})
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", event.EventID, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	if err := b.broker.Publish(ctx, b.channel, payload); err != nil {
+		return fmt.Errorf("failed to relay event %s: %w", event.EventID, err)
+	}
+	return nil
+}
+
+// Subscribe returns a channel receiving the events of the given topics and
+// a function to stop. A subscriber that falls behind is dropped and its
+// channel closed, so it can resume from the event log rather than silently
+// miss events.
+func (b *Bus) Subscribe(topics ...Topic) (<-chan *models.PaymentEvent, func()) {
+	sub := &subscriber{events: make(chan *models.PaymentEvent, subscriberBuffer), topics: topics}
+
+	b.mu.Lock()
+	for _, topic := range topics {
+		if b.subs[topic] == nil {
+			b.subs[topic] = make(map[*subscriber]struct{})
+		}
+		b.subs[topic][sub] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	return sub.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.drop(sub)
+	}
+}
+
+func (b *Bus) deliver(event *models.PaymentEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sent := make(map[*subscriber]bool)
+	for _, topic := range topics(event) {
+		for sub := range b.subs[topic] {
+			if sent[sub] {
+				continue
+			}
+			sent[sub] = true
+			select {
+			case sub.events <- event:
+			default:
+				b.drop(sub)
+			}
+		}
+	}
+}
+
+// drop removes a subscriber and closes its channel. b.mu must be held.
+func (b *Bus) drop(sub *subscriber) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.events)
+	for _, topic := range sub.topics {
+		delete(b.subs[topic], sub)
+		if len(b.subs[topic]) == 0 {
+			delete(b.subs, topic)
+		}
+	}
+}
+
+// Run delivers the events other instances publish until ctx is cancelled,
+// resubscribing with backoff whenever the broker connection is lost.
+func (b *Bus) Run(ctx context.Context) {
+	if b.broker == nil {
+		return
+	}
+
+	delay := minRetryDelay
+	for {
+		messages, err := b.broker.Subscribe(ctx, b.channel)
+		if err != nil {
+			b.log.Warn("EVENTBUS", fmt.Sprintf("Failed to subscribe to %s, retrying in %s: %v", b.channel, delay, err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxRetryDelay {
+				delay = maxRetryDelay
+			}
+			continue
+		}
+
+		b.log.LogProcess("EVENTBUS", "Subscribed to "+b.channel)
+		delay = minRetryDelay
+		for payload := range messages {
+			b.receive(payload)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		b.log.Warn("EVENTBUS", "Lost the subscription to "+b.channel+", resubscribing")
+	}
+}
+
+func (b *Bus) receive(payload []byte) {
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil || env.Event == nil {
+		b.log.Warn("EVENTBUS", fmt.Sprintf("Ignoring malformed event on %s", b.channel))
+		return
+	}
+	if env.Source == b.source {
+		return
+	}
+	b.deliver(env.Event)
+}
+
+func instanceID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
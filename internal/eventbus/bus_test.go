@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+)
+
+// memBroker relays payloads to every subscriber of a channel, as Redis
+// pub/sub does between instances
+type memBroker struct {
+	mu   sync.Mutex
+	subs []chan []byte
+	down bool
+}
+
+func (m *memBroker) Publish(ctx context.Context, channel string, payload []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.down {
+		return errors.New("redis: connection refused")
+	}
+	for _, sub := range m.subs {
+		sub <- payload
+	}
+	return nil
+}
+
+func (m *memBroker) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	messages := make(chan []byte, 16)
+	m.subs = append(m.subs, messages)
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		close(messages)
+	}()
+	return messages, nil
+}
+
+func (m *memBroker) subscribers() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.subs)
+}
+
+func statusEvent(id, paymentID, orderID string, status models.PaymentStatus) *models.PaymentEvent {
+	return &models.PaymentEvent{
+		EventID:   id,
+		Type:      "payment." + string(status),
+		PaymentID: paymentID,
+		Payment:   &models.Payment{PaymentID: paymentID, OrderID: orderID, Status: status},
+	}
+}
+
+func receive(t *testing.T, events <-chan *models.PaymentEvent) *models.PaymentEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event delivered")
+		return nil
+	}
+}
+
+func TestSubscribersGetTheirTopicsOnce(t *testing.T) {
+	bus := New(nil, "", logger.NewFileLogger())
+	payment, stopPayment := bus.Subscribe(Payment("pay_1"))
+	both, stopBoth := bus.Subscribe(Payment("pay_1"), Order("order_1"))
+	defer stopPayment()
+	defer stopBoth()
+
+	if err := bus.Publish(statusEvent("evt_1", "pay_1", "order_1", models.StatusSuccess)); err != nil {
+		t.Fatal(err)
+	}
+	_ = bus.Publish(statusEvent("evt_2", "pay_2", "order_2", models.StatusSuccess))
+
+	if event := receive(t, payment); event.EventID != "evt_1" {
+		t.Errorf("payment subscriber got %s", event.EventID)
+	}
+	if event := receive(t, both); event.EventID != "evt_1" {
+		t.Errorf("order subscriber got %s", event.EventID)
+	}
+	select {
+	case event := <-both:
+		t.Errorf("unexpected %s: an event matching two topics is delivered once", event.EventID)
+	default:
+	}
+}
+
+func TestStopAndSlowSubscribersAreClosed(t *testing.T) {
+	bus := New(nil, "", logger.NewFileLogger())
+	events, stop := bus.Subscribe(Order("order_1"))
+	stop()
+	stop()
+	if _, open := <-events; open {
+		t.Error("channel open after stop")
+	}
+
+	slow, stopSlow := bus.Subscribe(Payment("pay_1"))
+	defer stopSlow()
+	for i := 0; i <= subscriberBuffer; i++ {
+		_ = bus.Publish(statusEvent("evt", "pay_1", "", models.StatusPending))
+	}
+	count := 0
+	for range slow {
+		count++
+	}
+	if count != subscriberBuffer {
+		t.Errorf("slow subscriber got %d events before being dropped, want %d", count, subscriberBuffer)
+	}
+	if len(bus.subs) != 0 {
+		t.Errorf("dropped subscribers still registered: %v", bus.subs)
+	}
+}
+
+func TestEventsReachOtherInstances(t *testing.T) {
+	broker := &memBroker{}
+	log := logger.NewFileLogger()
+	first, second := New(broker, "payments", log), New(broker, "payments", log)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go first.Run(ctx)
+	go second.Run(ctx)
+	for broker.subscribers() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	onFirst, stopFirst := first.Subscribe(Payment("pay_1"))
+	onSecond, stopSecond := second.Subscribe(Payment("pay_1"))
+	defer stopFirst()
+	defer stopSecond()
+
+	if err := first.Publish(statusEvent("evt_1", "pay_1", "", models.StatusSuccess)); err != nil {
+		t.Fatal(err)
+	}
+	if event := receive(t, onSecond); event.EventID != "evt_1" || event.Payment.Status != models.StatusSuccess {
+		t.Errorf("second instance got %+v", event)
+	}
+	receive(t, onFirst)
+	// The first instance skips its own relayed copy
+	time.Sleep(20 * time.Millisecond)
+	select {
+	case event := <-onFirst:
+		t.Errorf("publisher got %s twice", event.EventID)
+	default:
+	}
+
+	// Local subscribers are served even when the relay fails
+	broker.mu.Lock()
+	broker.down = true
+	broker.mu.Unlock()
+	if err := first.Publish(statusEvent("evt_2", "pay_1", "", models.StatusRefunded)); err == nil {
+		t.Error("relay failure not reported")
+	}
+	if event := receive(t, onFirst); event.EventID != "evt_2" {
+		t.Errorf("local subscriber got %s", event.EventID)
+	}
+}
+
+func TestMalformedRelaysAreIgnored(t *testing.T) {
+	bus := New(nil, "payments", logger.NewFileLogger())
+	events, stop := bus.Subscribe(Payment("pay_1"))
+	defer stop()
+	bus.receive([]byte(`{"source":"other"}`))
+	bus.receive([]byte(`not json`))
+	select {
+	case event := <-events:
+		t.Errorf("delivered %+v", event)
+	default:
+	}
+}
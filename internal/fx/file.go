@@ -0,0 +1,48 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// FileProvider serves rates from a JSON Table on disk, re-reading it once
+// the cached copy is older than ttl so a cron job can refresh the file.
+type FileProvider struct {
+	path  string
+	cache *cachedTable
+}
+
+func NewFileProvider(path string, ttl time.Duration) *FileProvider {
+	p := &FileProvider{path: path}
+	p.cache = &cachedTable{ttl: ttl, load: p.load}
+	return p
+}
+
+func (p *FileProvider) load(ctx context.Context) (*Table, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRateUnavailable, err)
+	}
+
+	var table Table
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("%w: invalid rate file %s: %v", ErrRateUnavailable, p.path, err)
+	}
+	if table.AsOf.IsZero() {
+		if info, err := os.Stat(p.path); err == nil {
+			table.AsOf = info.ModTime().UTC()
+		}
+	}
+	return &table, nil
+}
+
+func (p *FileProvider) Rate(ctx context.Context, from, to string) (*Rate, error) {
+	table, err := p.cache.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return table.cross(from, to, "file:"+p.path)
+}
@@ -0,0 +1,201 @@
+// Package fx provides exchange rates and snapshots the rate used for each
+// payment, refund and payout.
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+)
+
+var ErrRateUnavailable = errors.New("exchange rate unavailable")
+
+// Rate converts one unit of From into Rate units of To.
+type Rate struct {
+	From   string
+	To     string
+	Rate   float64
+	Source string
+	AsOf   time.Time
+}
+
+// Provider returns the current rate between two currencies.
+type Provider interface {
+	Rate(ctx context.Context, from, to string) (*Rate, error)
+}
+
+// Table is a set of rates quoted against one base currency, the shape used
+// by rate files and HTTP feeds:
+//
+//	{"base": "usd", "as_of": "2024-05-01T00:00:00Z", "rates": {"eur": 0.92, "gbp": 0.79}}
+type Table struct {
+	Base  string             `json:"base"`
+	AsOf  time.Time          `json:"as_of"`
+	Rates map[string]float64 `json:"rates"` // units of the currency per one unit of Base
+}
+
+// cross derives any pair from the base-quoted rates.
+func (t *Table) cross(from, to, source string) (*Rate, error) {
+	perBase := func(currency string) (float64, bool) {
+		if currency == t.Base {
+			return 1, true
+		}
+		rate, ok := t.Rates[currency]
+		return rate, ok && rate > 0
+	}
+
+	fromRate, ok := perBase(from)
+	if !ok {
+		return nil, fmt.Errorf("%w: no %s rate from %s", ErrRateUnavailable, from, source)
+	}
+	toRate, ok := perBase(to)
+	if !ok {
+		return nil, fmt.Errorf("%w: no %s rate from %s", ErrRateUnavailable, to, source)
+	}
+	return &Rate{From: from, To: to, Rate: toRate / fromRate, Source: source, AsOf: t.AsOf}, nil
+}
+
+func (t *Table) normalize() {
+	t.Base = strings.ToLower(t.Base)
+	rates := make(map[string]float64, len(t.Rates))
+	for currency, rate := range t.Rates {
+		rates[strings.ToLower(currency)] = rate
+	}
+	t.Rates = rates
+}
+
+// StaticProvider serves a fixed table. It stands in for a real feed in
+// development and tests.
+type StaticProvider struct {
+	table Table
+}
+
+func NewStaticProvider(base string, rates map[string]float64) *StaticProvider {
+	table := Table{Base: base, AsOf: time.Now().UTC(), Rates: rates}
+	table.normalize()
+	return &StaticProvider{table: table}
+}
+
+// ParseStaticRates reads rates written as "eur=0.92,gbp=0.79".
+func ParseStaticRates(spec string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid rate %q, want currency=rate", pair)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate %q", pair)
+		}
+		rates[strings.ToLower(strings.TrimSpace(parts[0]))] = rate
+	}
+	return rates, nil
+}
+
+func (p *StaticProvider) Rate(ctx context.Context, from, to string) (*Rate, error) {
+	return p.table.cross(from, to, "static")
+}
+
+// cachedTable reloads a table through load once it is older than ttl. A
+// failed reload keeps serving the previous table so a flaky feed does not
+// stop payments.
+type cachedTable struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	load     func(ctx context.Context) (*Table, error)
+	table    *Table
+	loadedAt time.Time
+}
+
+func (c *cachedTable) get(ctx context.Context) (*Table, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.table != nil && time.Since(c.loadedAt) < c.ttl {
+		return c.table, nil
+	}
+
+	table, err := c.load(ctx)
+	if err != nil {
+		if c.table != nil {
+			return c.table, nil
+		}
+		return nil, err
+	}
+	table.normalize()
+	c.table = table
+	c.loadedAt = time.Now()
+	return table, nil
+}
+
+// NewProvider builds the provider selected in config.
+func NewProvider(cfg config.FXConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "", "static":
+		rates, err := ParseStaticRates(cfg.StaticRates)
+		if err != nil {
+			return nil, err
+		}
+		return NewStaticProvider(cfg.BaseCurrency, rates), nil
+	case "file":
+		if cfg.RatesFile == "" {
+			return nil, errors.New("FX_RATES_FILE is required for the file provider")
+		}
+		return NewFileProvider(cfg.RatesFile, cfg.CacheTTL), nil
+	case "http":
+		if cfg.RatesURL == "" {
+			return nil, errors.New("FX_RATES_URL is required for the http provider")
+		}
+		return NewHTTPProvider(cfg.RatesURL, cfg.CacheTTL), nil
+	default:
+		return nil, fmt.Errorf("unknown FX provider %q", cfg.Provider)
+	}
+}
+
+// Converter snapshots rates into the platform's base currency.
+type Converter struct {
+	provider Provider
+	base     string
+}
+
+func NewConverter(provider Provider, base string) *Converter {
+	return &Converter{provider: provider, base: strings.ToLower(base)}
+}
+
+// Base returns the currency reports are converted to.
+func (c *Converter) Base() string {
+	return c.base
+}
+
+// Snapshot returns the rate from currency to the base currency.
+func (c *Converter) Snapshot(ctx context.Context, currency string) (*models.FXSnapshot, error) {
+	return c.SnapshotTo(ctx, currency, c.base)
+}
+
+// SnapshotTo returns the rate from currency to target. Converting a currency
+// to itself needs no provider.
+func (c *Converter) SnapshotTo(ctx context.Context, currency, target string) (*models.FXSnapshot, error) {
+	currency = strings.ToLower(currency)
+	target = strings.ToLower(target)
+	if currency == target {
+		return &models.FXSnapshot{BaseCurrency: target, Rate: 1, Source: "identity", AsOf: time.Now().UTC()}, nil
+	}
+
+	rate, err := c.provider.Rate(ctx, currency, target)
+	if err != nil {
+		return nil, err
+	}
+	return &models.FXSnapshot{BaseCurrency: target, Rate: rate.Rate, Source: rate.Source, AsOf: rate.AsOf}, nil
+}
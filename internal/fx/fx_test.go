@@ -0,0 +1,166 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCrossRates(t *testing.T) {
+	provider := NewStaticProvider("USD", map[string]float64{"EUR": 0.8, "gbp": 0.5})
+	for _, tt := range []struct {
+		from, to string
+		want     float64
+	}{
+		{"usd", "eur", 0.8},
+		{"eur", "usd", 1.25},
+		{"eur", "gbp", 0.625},
+		{"gbp", "eur", 1.6},
+	} {
+		rate, err := provider.Rate(context.Background(), tt.from, tt.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !near(rate.Rate, tt.want) || rate.From != tt.from || rate.To != tt.to || rate.Source != "static" {
+			t.Errorf("%s->%s = %+v, want %v", tt.from, tt.to, rate, tt.want)
+		}
+	}
+
+	if _, err := provider.Rate(context.Background(), "usd", "jpy"); !errors.Is(err, ErrRateUnavailable) {
+		t.Errorf("unknown currency: err = %v, want ErrRateUnavailable", err)
+	}
+}
+
+func TestParseStaticRates(t *testing.T) {
+	rates, err := ParseStaticRates(" EUR=0.92, gbp = 0.79 ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 2 || rates["eur"] != 0.92 || rates["gbp"] != 0.79 {
+		t.Errorf("rates = %v", rates)
+	}
+	for _, spec := range []string{"eur", "eur=abc", "eur=0", "eur=-1"} {
+		if _, err := ParseStaticRates(spec); err == nil {
+			t.Errorf("ParseStaticRates(%q) succeeded", spec)
+		}
+	}
+}
+
+func TestSnapshotConvertsToBase(t *testing.T) {
+	converter := NewConverter(NewStaticProvider("usd", map[string]float64{"eur": 0.8}), "USD")
+
+	snapshot, err := converter.Snapshot(context.Background(), "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.BaseCurrency != "usd" || !near(snapshot.Rate, 1.25) || snapshot.Source != "static" {
+		t.Errorf("snapshot = %+v", snapshot)
+	}
+
+	// The base currency needs no rate, even from a provider without one
+	converter = NewConverter(NewStaticProvider("eur", nil), "usd")
+	snapshot, err = converter.Snapshot(context.Background(), "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Rate != 1 || snapshot.Source != "identity" {
+		t.Errorf("snapshot = %+v", snapshot)
+	}
+	if _, err := converter.SnapshotTo(context.Background(), "usd", "gbp"); !errors.Is(err, ErrRateUnavailable) {
+		t.Errorf("missing rate: err = %v, want ErrRateUnavailable", err)
+	}
+}
+
+func TestHTTPProviderKeepsLastTableWhenFeedFails(t *testing.T) {
+	var calls, failing atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failing.Load() == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"base": "USD", "as_of": "2026-10-01T00:00:00Z", "rates": {"EUR": 0.9}}`))
+	}))
+	defer server.Close()
+
+	provider := NewHTTPProvider(server.URL, time.Hour)
+	for i := 0; i < 2; i++ {
+		rate, err := provider.Rate(context.Background(), "usd", "eur")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rate.Rate != 0.9 || rate.Source != "http" || rate.AsOf.Month() != time.October {
+			t.Errorf("rate = %+v", rate)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("feed called %d times within the TTL, want 1", calls.Load())
+	}
+
+	failing.Store(1)
+	provider.cache.ttl = 0
+	if rate, err := provider.Rate(context.Background(), "usd", "eur"); err != nil || rate.Rate != 0.9 {
+		t.Errorf("after a failed reload: rate %+v, err %v", rate, err)
+	}
+
+	if _, err := NewHTTPProvider(server.URL, time.Hour).Rate(context.Background(), "usd", "eur"); !errors.Is(err, ErrRateUnavailable) {
+		t.Errorf("no table yet: err = %v, want ErrRateUnavailable", err)
+	}
+}
+
+func TestFileProviderRereadsAfterTTL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	write := func(body string) {
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"base": "usd", "rates": {"eur": 0.9}}`)
+
+	provider := NewFileProvider(path, time.Hour)
+	rate, err := provider.Rate(context.Background(), "eur", "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(rate.Rate, 1/0.9) || rate.AsOf.IsZero() {
+		t.Errorf("rate = %+v", rate)
+	}
+
+	write(`{"base": "usd", "rates": {"eur": 0.5}}`)
+	if rate, _ := provider.Rate(context.Background(), "eur", "usd"); !near(rate.Rate, 1/0.9) {
+		t.Errorf("re-read within the TTL: rate = %v", rate.Rate)
+	}
+	provider.cache.ttl = 0
+	if rate, _ := provider.Rate(context.Background(), "eur", "usd"); !near(rate.Rate, 2) {
+		t.Errorf("after the TTL: rate = %v, want 2", rate.Rate)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	for _, cfg := range []config.FXConfig{
+		{Provider: "file"},
+		{Provider: "http"},
+		{Provider: "ecb"},
+		{StaticRates: "eur=x"},
+	} {
+		if _, err := NewProvider(cfg); err == nil {
+			t.Errorf("NewProvider(%+v) succeeded", cfg)
+		}
+	}
+	if _, err := NewProvider(config.FXConfig{BaseCurrency: "usd", StaticRates: "eur=0.9"}); err != nil {
+		t.Error(err)
+	}
+}
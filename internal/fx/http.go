@@ -0,0 +1,58 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPProvider fetches a JSON Table from a rate feed and caches it for ttl.
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+	cache  *cachedTable
+}
+
+func NewHTTPProvider(url string, ttl time.Duration) *HTTPProvider {
+	p := &HTTPProvider{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	p.cache = &cachedTable{ttl: ttl, load: p.load}
+	return p
+}
+
+func (p *HTTPProvider) load(ctx context.Context) (*Table, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRateUnavailable, err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRateUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: rate feed returned %s", ErrRateUnavailable, resp.Status)
+	}
+
+	var table Table
+	if err := json.NewDecoder(resp.Body).Decode(&table); err != nil {
+		return nil, fmt.Errorf("%w: invalid rate feed response: %v", ErrRateUnavailable, err)
+	}
+	if table.AsOf.IsZero() {
+		table.AsOf = time.Now().UTC()
+	}
+	return &table, nil
+}
+
+func (p *HTTPProvider) Rate(ctx context.Context, from, to string) (*Rate, error) {
+	table, err := p.cache.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return table.cross(from, to, "http")
+}
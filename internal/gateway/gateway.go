@@ -0,0 +1,112 @@
+// Package gateway builds the gateway's services from its configuration, the
+// same way for the server and for paymentctl.
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/eventbus"
+	"payment-gateway/internal/fx"
+	"payment-gateway/internal/kafka"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/logger"
+	rediswrap "payment-gateway/internal/redis"
+	"payment-gateway/internal/risk"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/storage"
+)
+
+// Services are the services that work from the database, Redis and Kafka
+// alone. The ones that call Stripe are added by WithStripe.
+type Services struct {
+	Store      *storage.MySQLStore
+	Redis      *rediswrap.Redis
+	Ledger     *ledger.Ledger
+	Converter  *fx.Converter
+	Bus        *eventbus.Bus
+	Audit      *services.AuditService
+	Webhooks   *services.WebhookService
+	Events     *services.EventService
+	Organizers *services.OrganizerService
+	Fees       *services.FeeService
+	Risk       *services.RiskService
+	Payments   *services.PaymentService
+
+	cfg *config.Config
+	log *logger.Logger
+}
+
+// StripeServices are the services that call Stripe
+type StripeServices struct {
+	Stripe         *services.StripeService
+	Reviews        *services.ReviewService
+	Admin          *services.AdminService
+	Reconciliation *services.ReconciliationService
+	Payouts        *services.PayoutService
+	Disputes       *services.DisputeService
+	Checkout       *services.CheckoutService
+	Customers      *services.CustomerService
+}
+
+// New builds the services that do not need Stripe. Events they publish go
+// to producer and, through Redis, to the status streams open on every
+// instance.
+func New(cfg *config.Config, store *storage.MySQLStore, producer *kafka.Producer, log *logger.Logger) (*Services, error) {
+	redisStore := rediswrap.NewRedis(redis.NewClient(&redis.Options{
+		Addr: cfg.Redis.Addr,
+	}))
+
+	fxProvider, err := fx.NewProvider(cfg.FX)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure FX rates: %w", err)
+	}
+
+	var riskRules risk.RuleSource = risk.NewStaticRules(risk.DefaultRules())
+	if cfg.Risk.RulesFile != "" {
+		fileRules, err := risk.NewFileRules(cfg.Risk.RulesFile, cfg.Risk.ReloadInterval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load risk rules: %w", err)
+		}
+		riskRules = fileRules
+	}
+
+	s := &Services{
+		Store:     store,
+		Redis:     redisStore,
+		Ledger:    ledger.New(store, log),
+		Converter: fx.NewConverter(fxProvider, cfg.FX.BaseCurrency),
+		Bus:       eventbus.New(redisStore, cfg.Streams.Channel, log),
+		Audit:     services.NewAuditService(store, log),
+		cfg:       cfg,
+		log:       log,
+	}
+	s.Webhooks = services.NewWebhookService(store, s.Audit, cfg.Webhooks, log)
+	s.Events = services.NewEventService(store, store, store, store, producer, s.Bus, s.Webhooks, s.Audit, log)
+	s.Organizers = services.NewOrganizerService(store, s.Audit, log)
+	s.Fees = services.NewFeeService(store, s.Ledger, s.Audit, cfg.Fees, log)
+	s.Risk = services.NewRiskService(risk.NewEngine(riskRules, redisStore, store), store, s.Audit, cfg.Risk, log)
+	s.Payments = services.NewPaymentService(store, s.Events, log, redisStore, s.Ledger, s.Organizers, s.Fees, store, store, s.Converter, s.Audit, s.Risk)
+	return s, nil
+}
+
+// WithStripe creates the Stripe client and the services that use it. It
+// fails when STRIPE_SECRET_KEY is not set.
+func (s *Services) WithStripe() (*StripeServices, error) {
+	stripeService, err := services.NewStripeService(s.log, s.Ledger, s.Organizers, s.Fees, s.Audit, s.Risk, s.cfg.Cards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Stripe service: %w", err)
+	}
+	return &StripeServices{
+		Stripe:         stripeService,
+		Reviews:        services.NewReviewService(s.Store, s.Payments, stripeService, s.Audit, s.log),
+		Admin:          services.NewAdminService(s.Payments, stripeService, s.Events, s.Audit, s.Store, s.Store, s.log),
+		Reconciliation: services.NewReconciliationService(s.Store, s.Store, stripeService, s.cfg.Reconcile, s.log),
+		Payouts:        services.NewPayoutService(s.Store, s.Store, s.Ledger, stripeService, s.Converter, s.Audit, s.cfg.Payouts, s.log),
+		Disputes:       services.NewDisputeService(s.Store, s.Store, s.Ledger, stripeService, s.Events, s.cfg.Disputes, s.log),
+		Checkout:       services.NewCheckoutService(s.Store, s.Store, s.Organizers, stripeService, s.Payments, s.Audit, s.cfg.Checkout, s.log),
+		Customers:      services.NewCustomerService(s.Store, stripeService, s.log),
+	}, nil
+}
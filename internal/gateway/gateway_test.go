@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/services"
+)
+
+func TestServicesDoNotNeedStripe(t *testing.T) {
+	t.Setenv("STRIPE_SECRET_KEY", "")
+	core, err := New(config.Load(), nil, nil, logger.NewFileLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if core.Payments == nil || core.Events == nil || core.Webhooks == nil {
+		t.Fatal("services missing")
+	}
+
+	if _, err := core.WithStripe(); !errors.Is(err, services.ErrStripeClientInitFailed) {
+		t.Errorf("WithStripe without a key = %v", err)
+	}
+
+	t.Setenv("STRIPE_SECRET_KEY", "sk_test_gateway")
+	backed, err := core.WithStripe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backed.Admin == nil || backed.Reconciliation == nil {
+		t.Error("Stripe services missing")
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminHandler serves the admin console API used by support staff
+type AdminHandler struct {
+	adminService *services.AdminService
+}
+
+func NewAdminHandler(adminService *services.AdminService) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+	}
+}
+
+// LookupPayments finds payments by ?q=, which may be a payment ID, order
+// ID, Stripe PaymentIntent ID or attendee email
+func (h *AdminHandler) LookupPayments(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	payments, err := h.adminService.Lookup(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		apperror.Respond(c, "Payment lookup failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payments retrieved", payments))
+}
+
+// GetTimeline returns a payment with everything that has happened to it
+func (h *AdminHandler) GetTimeline(c *gin.Context) {
+	timeline, err := h.adminService.Timeline(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to build payment timeline", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment timeline retrieved", timeline))
+}
+
+// OverrideStatus sets a payment's status by hand
+func (h *AdminHandler) OverrideStatus(c *gin.Context) {
+	var req models.StatusOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payment, err := h.adminService.OverrideStatus(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		apperror.Respond(c, "Status override failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment status overridden", payment))
+}
+
+// ForceRefund refunds a payment regardless of the organizer's refund rules
+func (h *AdminHandler) ForceRefund(c *gin.Context) {
+	var req models.ForceRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payment, err := h.adminService.ForceRefund(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		apperror.Respond(c, "Forced refund failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Refund processed", payment))
+}
+
+// ResetOTP clears an order's OTP lock
+func (h *AdminHandler) ResetOTP(c *gin.Context) {
+	var req models.OTPActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payment, err := h.adminService.ResetOTP(c.Request.Context(), c.Param("id"), req.Reason)
+	if err != nil {
+		apperror.Respond(c, "OTP reset failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("OTP reset", payment))
+}
+
+// ResendOTP mails a new OTP to the attendee
+func (h *AdminHandler) ResendOTP(c *gin.Context) {
+	var req models.OTPActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payment, err := h.adminService.ResendOTP(c.Request.Context(), c.Param("id"), req.Reason)
+	if err != nil {
+		apperror.Respond(c, "OTP resend failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("OTP resent", payment))
+}
+
+// Resync applies the status Stripe reports for a payment
+func (h *AdminHandler) Resync(c *gin.Context) {
+	payment, err := h.adminService.Resync(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Stripe resync failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment resynced with Stripe", payment))
+}
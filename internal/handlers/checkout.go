@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CheckoutHandler struct {
+	checkoutService *services.CheckoutService
+	cardTesting     *services.CardTestingService
+}
+
+func NewCheckoutHandler(checkoutService *services.CheckoutService, cardTesting *services.CardTestingService) *CheckoutHandler {
+	return &CheckoutHandler{
+		checkoutService: checkoutService,
+		cardTesting:     cardTesting,
+	}
+}
+
+// CreateSession opens a hosted checkout session or payment link and
+// returns its URL
+func (h *CheckoutHandler) CreateSession(c *gin.Context) {
+	var req models.CreateCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	session, err := h.checkoutService.Create(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to create checkout session", err)
+		return
+	}
+	c.JSON(http.StatusCreated, utils.SuccessResponse("Checkout session created", session))
+}
+
+// ListSessions lists checkout sessions newest first, filtered by ?status=;
+// platform callers may pass ?organizer_id=
+func (h *CheckoutHandler) ListSessions(c *gin.Context) {
+	limit, offset := pagination(c)
+	status := models.CheckoutStatus(c.Query("status"))
+
+	sessions, err := h.checkoutService.List(c.Request.Context(), c.Query("organizer_id"), status, limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list checkout sessions", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout sessions retrieved", sessions))
+}
+
+func (h *CheckoutHandler) GetSession(c *gin.Context) {
+	session, err := h.checkoutService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to get checkout session", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout session retrieved", session))
+}
+
+// CancelSession closes an open session so its link can no longer be paid
+func (h *CheckoutHandler) CancelSession(c *gin.Context) {
+	session, err := h.checkoutService.Cancel(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to cancel checkout session", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout session cancelled", session))
+}
+
+// GetPage returns what the hosted checkout page shows the attendee
+func (h *CheckoutHandler) GetPage(c *gin.Context) {
+	page, err := h.checkoutService.Page(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to load checkout", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout retrieved", page))
+}
+
+// Pay pays a checkout from the hosted page. Anyone holding the link can
+// try cards against it, so attempts count towards the card-testing limits
+// with the checkout as the session.
+func (h *CheckoutHandler) Pay(c *gin.Context) {
+	var req models.CheckoutPayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	captchaToken := req.CaptchaToken
+	if captchaToken == "" {
+		captchaToken = c.GetHeader("X-Captcha-Token")
+	}
+	check := services.CardCheck{
+		IP:           c.ClientIP(),
+		SessionID:    c.Param("id"),
+		CaptchaToken: captchaToken,
+	}
+	if req.Card != nil {
+		check.CardNumber = req.Card.Number
+	}
+	if err := h.cardTesting.Admit(c.Request.Context(), check); errors.Is(err, services.ErrCaptchaRequired) {
+		apperror.Respond(c, "Verification required", err)
+		return
+	}
+
+	// Card declines come back as Stripe card errors and are reported with
+	// their decline code, not as failures of ours
+	result, err := h.checkoutService.Pay(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		if apperror.From(err).Status() == http.StatusPaymentRequired {
+			h.cardTesting.RecordFailure(c.Request.Context(), c.ClientIP())
+		}
+		apperror.Respond(c, "Payment processing failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout payment processed", result))
+}
+
+// Confirm completes a checkout's payment after 3-D Secure
+func (h *CheckoutHandler) Confirm(c *gin.Context) {
+	var req models.StripeConfirmRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+			return
+		}
+	}
+
+	result, err := h.checkoutService.Confirm(c.Request.Context(), c.Param("id"), req.ReturnURL)
+	if err != nil {
+		apperror.Respond(c, "Payment confirmation failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Checkout payment confirmation processed", result))
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/api"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/eventbus"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/middleware"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// contractEngine checks every request and response against api/openapi.yaml,
+// rejecting those that break it, and acts as the given principal
+func contractEngine(t *testing.T, principal tenant.Principal) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	doc, err := api.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := middleware.ValidateOpenAPI(doc, config.OpenAPIConfig{Validation: "enforce", ValidateResponses: true}, logger.NewFileLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(tenant.WithPrincipal(c.Request.Context(), principal))
+	}, contract)
+	return router
+}
+
+func call(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func expectStatus(t *testing.T, w *httptest.ResponseRecorder, what string, want int) {
+	t.Helper()
+	if w.Code != want {
+		t.Errorf("%s: status = %d, want %d; body %s", what, w.Code, want, w.Body)
+	}
+}
+
+type organizerStore struct {
+	storage.OrganizerStore
+	mu   sync.Mutex
+	orgs map[string]*models.Organizer
+}
+
+func (s *organizerStore) SaveOrganizer(org *models.Organizer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *org
+	s.orgs[org.ID] = &copied
+	return nil
+}
+
+func (s *organizerStore) UpdateOrganizer(org *models.Organizer) error {
+	return s.SaveOrganizer(org)
+}
+
+func (s *organizerStore) GetOrganizer(id string) (*models.Organizer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org, ok := s.orgs[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copied := *org
+	return &copied, nil
+}
+
+type auditLog struct {
+	storage.AuditStore
+	mu      sync.Mutex
+	entries []*models.AuditEntry
+}
+
+func (s *auditLog) AppendAuditEntry(entry *models.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry.Seq = int64(len(s.entries) + 1)
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *auditLog) ListAuditEntries(filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entries, nil
+}
+
+func TestOrganizerResponsesMatchContract(t *testing.T) {
+	log := logger.NewFileLogger()
+	audit := services.NewAuditService(&auditLog{}, log)
+	organizers := NewOrganizerHandler(services.NewOrganizerService(&organizerStore{orgs: map[string]*models.Organizer{}}, audit, log), nil)
+	router := contractEngine(t, tenant.Principal{Platform: true})
+	router.POST("/api/v1/organizers", organizers.CreateOrganizer)
+	router.GET("/api/v1/organizers/:id", organizers.GetOrganizer)
+	router.PATCH("/api/v1/organizers/:id", organizers.UpdateOrganizer)
+	router.GET("/api/v1/audit", NewAuditHandler(audit).ListEntries)
+
+	expectStatus(t, call(router, http.MethodPost, "/api/v1/organizers",
+		`{"id": "org_1", "name": "Evently Live", "email": "ops@example.com", "payout_schedule": "weekly"}`), "create", http.StatusCreated)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/organizers/org_1", ""), "get", http.StatusOK)
+	expectStatus(t, call(router, http.MethodPatch, "/api/v1/organizers/org_1", `{"name": "Evently"}`), "update", http.StatusOK)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/organizers/org_2", ""), "unknown organizer", http.StatusNotFound)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/audit?action=organizer.created", ""), "audit", http.StatusOK)
+
+	expectStatus(t, call(router, http.MethodPost, "/api/v1/organizers", `{"name": "Evently Live"}`), "no email", http.StatusBadRequest)
+	expectStatus(t, call(router, http.MethodPatch, "/api/v1/organizers/org_1", `{"payout_schedule": "hourly"}`), "unknown schedule", http.StatusBadRequest)
+}
+
+func TestReconciliationResponsesMatchContract(t *testing.T) {
+	completed := time.Now()
+	store := &runStore{
+		run: &models.ReconciliationRun{
+			ID: "rec_1", Status: models.ReconciliationCompleted, PeriodStart: completed.Add(-24 * time.Hour),
+			PeriodEnd: completed, StartedAt: completed, CompletedAt: &completed, DiscrepancyCount: 1,
+		},
+		items: []*models.ReconciliationItem{
+			{RunID: "rec_1", Type: models.DiscrepancyMissingStripe, PaymentID: "pay_1", LocalAmount: 40, Details: "no charge"},
+		},
+	}
+	reconciliation := NewReconciliationHandler(services.NewReconciliationService(nil, store, nil, config.ReconciliationConfig{}, logger.NewFileLogger()))
+	router := contractEngine(t, tenant.Principal{Platform: true})
+	router.POST("/api/v1/reconciliation/runs", reconciliation.StartRun)
+	router.GET("/api/v1/reconciliation/runs", reconciliation.ListRuns)
+	router.GET("/api/v1/reconciliation/runs/:id", reconciliation.GetRun)
+	router.GET("/api/v1/reconciliation/runs/:id/export", reconciliation.ExportRun)
+
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/reconciliation/runs?limit=10", ""), "list", http.StatusOK)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/reconciliation/runs/rec_1", ""), "get", http.StatusOK)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/reconciliation/runs/rec_1/export", ""), "export", http.StatusOK)
+	expectStatus(t, call(router, http.MethodGet, "/api/v1/reconciliation/runs?limit=9999", ""), "limit too large", http.StatusBadRequest)
+	expectStatus(t, call(router, http.MethodPost, "/api/v1/reconciliation/runs", `{"from": "last week", "to": "2026-10-01"}`), "bad date", http.StatusBadRequest)
+}
+
+func TestStreamResponsesMatchContract(t *testing.T) {
+	store := &paymentStore{payment: &models.Payment{PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1", Status: models.StatusSuccess}}
+	log := logger.NewFileLogger()
+	events := services.NewEventService(nil, store, nil, nil, nil, eventbus.New(nil, "", log), nil, nil, log)
+	streams := NewStreamHandler(events, config.StreamConfig{
+		TokenSecret: "stream-secret", TokenTTL: time.Minute, KeepAlive: time.Second, MaxDuration: time.Second,
+	})
+	router := contractEngine(t, tenant.Principal{OrganizerID: "org_1"})
+	router.POST("/api/v1/payments/:id/stream-token", streams.IssuePaymentToken)
+	router.GET("/api/v1/payments/:id/stream", streams.StreamPayment)
+
+	w := call(router, http.MethodPost, "/api/v1/payments/pay_1/stream-token", "")
+	expectStatus(t, w, "issue token", http.StatusOK)
+
+	// A settled payment's stream sends its status and ends
+	w = call(router, http.MethodGet, "/api/v1/payments/pay_1/stream", "")
+	expectStatus(t, w, "stream", http.StatusOK)
+	if got := w.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("content type = %q", got)
+	}
+	if !strings.Contains(w.Body.String(), `"status":"success"`) {
+		t.Errorf("stream did not carry the status: %s", w.Body)
+	}
+}
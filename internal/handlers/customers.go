@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerHandler struct {
+	customerService *services.CustomerService
+}
+
+func NewCustomerHandler(customerService *services.CustomerService) *CustomerHandler {
+	return &CustomerHandler{
+		customerService: customerService,
+	}
+}
+
+// EnsureCustomer creates the Stripe Customer for a user, or returns the
+// existing one with its contact details refreshed
+func (h *CustomerHandler) EnsureCustomer(c *gin.Context) {
+	var req models.CustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	customer, err := h.customerService.EnsureCustomer(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to save customer", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Customer saved", customer))
+}
+
+func (h *CustomerHandler) GetCustomer(c *gin.Context) {
+	customer, err := h.customerService.GetCustomer(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve customer", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Customer retrieved", customer))
+}
+
+// CreateSetupIntent returns a client secret the frontend uses to save a card
+func (h *CustomerHandler) CreateSetupIntent(c *gin.Context) {
+	intent, err := h.customerService.CreateSetupIntent(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to create setup intent", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Setup intent created", intent))
+}
+
+func (h *CustomerHandler) ListPaymentMethods(c *gin.Context) {
+	methods, err := h.customerService.ListPaymentMethods(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to list payment methods", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment methods retrieved", methods))
+}
+
+func (h *CustomerHandler) SetDefaultPaymentMethod(c *gin.Context) {
+	err := h.customerService.SetDefaultPaymentMethod(c.Request.Context(), c.Param("id"), c.Param("pm"))
+	if err != nil {
+		apperror.Respond(c, "Failed to set default payment method", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Default payment method updated", gin.H{"payment_method_id": c.Param("pm")}))
+}
+
+func (h *CustomerHandler) DeletePaymentMethod(c *gin.Context) {
+	err := h.customerService.DeletePaymentMethod(c.Request.Context(), c.Param("id"), c.Param("pm"))
+	if err != nil {
+		apperror.Respond(c, "Failed to remove payment method", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment method removed", gin.H{"payment_method_id": c.Param("pm")}))
+}
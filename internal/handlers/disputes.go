@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxEvidenceFileSize is Stripe's limit for dispute evidence uploads
+const maxEvidenceFileSize = 5 << 20
+
+type DisputeHandler struct {
+	disputeService *services.DisputeService
+}
+
+func NewDisputeHandler(disputeService *services.DisputeService) *DisputeHandler {
+	return &DisputeHandler{
+		disputeService: disputeService,
+	}
+}
+
+// ListDisputes lists the caller's disputes, optionally filtered by ?status=
+func (h *DisputeHandler) ListDisputes(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	disputes, err := h.disputeService.ListDisputes(c.Request.Context(), c.Query("status"), limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list disputes", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Disputes retrieved", disputes))
+}
+
+func (h *DisputeHandler) GetDispute(c *gin.Context) {
+	dispute, err := h.disputeService.GetDispute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve dispute", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Dispute retrieved", dispute))
+}
+
+// SubmitEvidence stages or submits dispute evidence. Accepts JSON, or a
+// multipart form with an optional attendance_records file.
+func (h *DisputeHandler) SubmitEvidence(c *gin.Context) {
+	var req models.DisputeEvidenceRequest
+	if err := c.ShouldBind(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	var dispute *models.Dispute
+	var err error
+	if header, fileErr := c.FormFile("attendance_records"); fileErr == nil {
+		if header.Size > maxEvidenceFileSize {
+			apperror.Respond(c, "Evidence file too large", apperror.New(apperror.RequestTooLarge, "attendance_records must be 5MB or smaller"))
+			return
+		}
+		file, openErr := header.Open()
+		if openErr != nil {
+			apperror.Respond(c, "Invalid evidence file", apperror.Wrap(apperror.InvalidRequest, "attendance_records could not be read", openErr))
+			return
+		}
+		defer file.Close()
+		dispute, err = h.disputeService.SubmitEvidence(c.Request.Context(), c.Param("id"), &req, file, header.Filename)
+	} else {
+		dispute, err = h.disputeService.SubmitEvidence(c.Request.Context(), c.Param("id"), &req, nil, "")
+	}
+
+	if err != nil {
+		if errors.Is(err, services.ErrStripeAPIError) {
+			apperror.Respond(c, "Stripe rejected the evidence", err)
+			return
+		}
+		apperror.Respond(c, "Failed to submit evidence", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Dispute evidence saved", dispute))
+}
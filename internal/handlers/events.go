@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventHandler struct {
+	eventService *services.EventService
+}
+
+func NewEventHandler(eventService *services.EventService) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+	}
+}
+
+// ListEvents lists logged events filtered by ?payment_id=, ?order_id=,
+// ?from=, ?to= (RFC 3339) and repeated ?event_type=
+func (h *EventHandler) ListEvents(c *gin.Context) {
+	var filter models.EventFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		apperror.Respond(c, "Invalid filter", apperror.Invalid(err))
+		return
+	}
+
+	entries, err := h.eventService.ListEvents(c.Request.Context(), filter)
+	if err != nil {
+		apperror.Respond(c, "Failed to list events", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Events retrieved", entries))
+}
+
+// Replay republishes past events to Kafka, flagged as replays
+func (h *EventHandler) Replay(c *gin.Context) {
+	var req models.ReplayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	result, err := h.eventService.Replay(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Replay failed", err)
+		return
+	}
+
+	message := "Events replayed"
+	if result.DryRun {
+		message = "Replay dry run"
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse(message, result))
+}
+
+// Timeline returns a payment's events in order
+func (h *EventHandler) Timeline(c *gin.Context) {
+	events, source, err := h.eventService.Timeline(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to build payment timeline", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment timeline retrieved", gin.H{
+		"payment_id": c.Param("id"),
+		"source":     source,
+		"events":     events,
+	}))
+}
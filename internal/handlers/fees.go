@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeeHandler struct {
+	feeService     *services.FeeService
+	paymentService *services.PaymentService
+}
+
+func NewFeeHandler(feeService *services.FeeService, paymentService *services.PaymentService) *FeeHandler {
+	return &FeeHandler{
+		feeService:     feeService,
+		paymentService: paymentService,
+	}
+}
+
+// SavePlan creates a fee plan, or replaces it when the ID already exists
+func (h *FeeHandler) SavePlan(c *gin.Context) {
+	var req models.FeePlanRequest
+	if id := c.Param("id"); id != "" {
+		req.ID = id
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+	if id := c.Param("id"); id != "" {
+		req.ID = id
+	}
+
+	plan, err := h.feeService.SavePlan(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to save fee plan", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Fee plan saved", plan))
+}
+
+func (h *FeeHandler) ListPlans(c *gin.Context) {
+	plans, err := h.feeService.ListPlans()
+	if err != nil {
+		apperror.Respond(c, "Failed to list fee plans", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Fee plans retrieved", plans))
+}
+
+func (h *FeeHandler) GetPlan(c *gin.Context) {
+	plan, err := h.feeService.GetPlan(c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve fee plan", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Fee plan retrieved", plan))
+}
+
+// GetPaymentFees returns the commission lines recorded for a payment
+func (h *FeeHandler) GetPaymentFees(c *gin.Context) {
+	payment, err := h.paymentService.GetPayment(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve payment", err)
+		return
+	}
+
+	lines, err := h.feeService.FeeLines(payment.PaymentID)
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve fees", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment fees retrieved", lines))
+}
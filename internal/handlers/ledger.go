@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LedgerHandler struct {
+	ledger *ledger.Ledger
+}
+
+func NewLedgerHandler(ledger *ledger.Ledger) *LedgerHandler {
+	return &LedgerHandler{
+		ledger: ledger,
+	}
+}
+
+// GetBalances returns platform-wide balances for every ledger account
+func (h *LedgerHandler) GetBalances(c *gin.Context) {
+	balances, err := h.ledger.Balances("")
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve balances", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Ledger balances retrieved", balances))
+}
+
+// GetOrganizerBalances returns balances for a single organizer
+func (h *LedgerHandler) GetOrganizerBalances(c *gin.Context) {
+	organizerID := c.Param("id")
+	if !tenant.CanAccess(c.Request.Context(), organizerID) {
+		apperror.Respond(c, "Forbidden", apperror.New(apperror.Forbidden, "cannot read another organizer's balances"))
+		return
+	}
+
+	balances, err := h.ledger.Balances(organizerID)
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve balances", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Organizer balances retrieved", balances))
+}
+
+// GetPaymentEntries returns the journal entries posted for a payment
+func (h *LedgerHandler) GetPaymentEntries(c *gin.Context) {
+	paymentID := c.Param("id")
+
+	entries, err := h.ledger.EntriesForPayment(paymentID)
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve journal entries", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Journal entries retrieved", entries))
+}
+
+// CheckInvariants verifies that all journals balance
+func (h *LedgerHandler) CheckInvariants(c *gin.Context) {
+	report, err := h.ledger.CheckInvariants()
+	if err != nil {
+		apperror.Respond(c, "Failed to check ledger", err)
+		return
+	}
+
+	status := http.StatusOK
+	if !report.Balanced {
+		status = http.StatusConflict
+	}
+	c.JSON(status, utils.SuccessResponse("Ledger invariant check completed", report))
+}
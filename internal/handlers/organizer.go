@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizerHandler struct {
+	organizerService *services.OrganizerService
+	paymentService   *services.PaymentService
+}
+
+func NewOrganizerHandler(organizerService *services.OrganizerService, paymentService *services.PaymentService) *OrganizerHandler {
+	return &OrganizerHandler{
+		organizerService: organizerService,
+		paymentService:   paymentService,
+	}
+}
+
+// CreateOrganizer registers an organizer and returns its API key once
+func (h *OrganizerHandler) CreateOrganizer(c *gin.Context) {
+	var req models.CreateOrganizerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	creds, err := h.organizerService.CreateOrganizer(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to create organizer", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse("Organizer created", creds))
+}
+
+func (h *OrganizerHandler) GetOrganizer(c *gin.Context) {
+	org, err := h.organizerService.GetOrganizer(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve organizer", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Organizer retrieved", org))
+}
+
+func (h *OrganizerHandler) UpdateOrganizer(c *gin.Context) {
+	var req models.UpdateOrganizerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	org, err := h.organizerService.UpdateOrganizer(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to update organizer", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Organizer updated", org))
+}
+
+// RotateAPIKey issues a new API key and invalidates the old one
+func (h *OrganizerHandler) RotateAPIKey(c *gin.Context) {
+	creds, err := h.organizerService.RotateAPIKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to rotate API key", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("API key rotated", creds))
+}
+
+// ListPayments lists the organizer's own payments
+func (h *OrganizerHandler) ListPayments(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	payments, err := h.paymentService.ListOrganizerPayments(c.Request.Context(), c.Param("id"), limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list payments", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payments retrieved", payments))
+}
@@ -2,15 +2,18 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
+	"payment-gateway/internal/apperror"
 	"payment-gateway/internal/models"
+	"payment-gateway/internal/otp"
 	"payment-gateway/internal/services"
 	"payment-gateway/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
 
+var errPaymentIDRequired = apperror.New(apperror.InvalidRequest, "payment ID is required")
+
 type PaymentHandler struct {
 	paymentService *services.PaymentService
 }
@@ -25,28 +28,31 @@ func (h *PaymentHandler) ProcessPayment(c *gin.Context) {
 	var req models.PaymentRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request payload", err.Error()))
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
 		return
 	}
 
 	// Validate payment request
 	if err := h.validatePaymentRequest(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Validation failed", err.Error()))
+		apperror.Respond(c, "Validation failed", err)
 		return
 	}
 
 	payment, err := h.paymentService.ProcessPayment(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Payment processing failed", err.Error()))
+		apperror.Respond(c, "Payment processing failed", err)
 		return
 	}
 
 	response := &models.Payment{
-		PaymentID: payment.PaymentID,
-		OrderID:   payment.OrderID,
-		Status:    payment.Status,
-		Price:     payment.Price,
-		Date:      payment.Date,
+		PaymentID:   payment.PaymentID,
+		OrganizerID: payment.OrganizerID,
+		OrderID:     payment.OrderID,
+		Status:      payment.Status,
+		Price:       payment.Price,
+		Currency:    payment.Currency,
+		Date:        payment.Date,
+		LineItems:   payment.LineItems,
 	}
 
 	c.JSON(http.StatusOK, utils.SuccessResponse("Payment processed", response))
@@ -55,17 +61,13 @@ func (h *PaymentHandler) ProcessPayment(c *gin.Context) {
 func (h *PaymentHandler) GetPayment(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Payment ID is required", ""))
+		apperror.Respond(c, "Payment ID is required", errPaymentIDRequired)
 		return
 	}
 
 	payment, err := h.paymentService.GetPayment(c.Request.Context(), paymentID)
 	if err != nil {
-		if err == services.ErrPaymentNotFound {
-			c.JSON(http.StatusNotFound, utils.ErrorResponse("Payment not found", err.Error()))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to retrieve payment", err.Error()))
+		apperror.Respond(c, "Failed to retrieve payment", err)
 		return
 	}
 
@@ -75,17 +77,13 @@ func (h *PaymentHandler) GetPayment(c *gin.Context) {
 func (h *PaymentHandler) GetPaymentStatus(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Payment ID is required", ""))
+		apperror.Respond(c, "Payment ID is required", errPaymentIDRequired)
 		return
 	}
 
 	payment, err := h.paymentService.GetPayment(c.Request.Context(), paymentID)
 	if err != nil {
-		if err == services.ErrPaymentNotFound {
-			c.JSON(http.StatusNotFound, utils.ErrorResponse("Payment not found", err.Error()))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to retrieve payment status", err.Error()))
+		apperror.Respond(c, "Failed to retrieve payment status", err)
 		return
 	}
 
@@ -100,42 +98,34 @@ func (h *PaymentHandler) GetPaymentStatus(c *gin.Context) {
 func (h *PaymentHandler) RefundPayment(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Payment ID is required", ""))
+		apperror.Respond(c, "Payment ID is required", errPaymentIDRequired)
 		return
 	}
 
 	var req models.RefundRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid refund request", err.Error()))
+		apperror.Respond(c, "Invalid refund request", apperror.Invalid(err))
 		return
 	}
 
-	var refundAmount *float64
-	if req.Amount != "" {
-		amount, err := strconv.ParseFloat(req.Amount, 64)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid refund amount", err.Error()))
-			return
-		}
-		refundAmount = &amount
+	payment, err := h.paymentService.RefundPayment(c.Request.Context(), paymentID, &req)
+	if err != nil {
+		apperror.Respond(c, "Refund processing failed", err)
+		return
 	}
 
-	payment, err := h.paymentService.RefundPayment(c.Request.Context(), paymentID, refundAmount, req.Reason)
+	c.JSON(http.StatusOK, utils.SuccessResponse("Refund processed successfully", payment))
+}
+
+// ListRefunds lists the refunds issued on a payment
+func (h *PaymentHandler) ListRefunds(c *gin.Context) {
+	refunds, err := h.paymentService.ListRefunds(c.Request.Context(), c.Param("id"))
 	if err != nil {
-		switch err {
-		case services.ErrPaymentNotFound:
-			c.JSON(http.StatusNotFound, utils.ErrorResponse("Payment not found", err.Error()))
-		case services.ErrInvalidRefundAmount:
-			c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid refund amount", err.Error()))
-		case services.ErrPaymentNotRefundable:
-			c.JSON(http.StatusBadRequest, utils.ErrorResponse("Payment cannot be refunded", err.Error()))
-		default:
-			c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Refund processing failed", err.Error()))
-		}
+		apperror.Respond(c, "Failed to list refunds", err)
 		return
 	}
 
-	c.JSON(http.StatusOK, utils.SuccessResponse("Refund processed successfully", payment))
+	c.JSON(http.StatusOK, utils.SuccessResponse("Refunds retrieved", refunds))
 }
 
 func (h *PaymentHandler) validatePaymentRequest(req *models.PaymentRequest) error {
@@ -144,24 +134,25 @@ func (h *PaymentHandler) validatePaymentRequest(req *models.PaymentRequest) erro
 }
 
 func (h *PaymentHandler) OTP(c *gin.Context) {
-	if err := c.ShouldBindJSON(&models.Req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid email", err.Error()))
+	var req models.OTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid email", apperror.Invalid(err))
 		return
 	}
 
-	h.paymentService.OtpSender(models.Req.Email)
-	c.JSON(http.StatusOK, utils.SuccessResponse("OTP sent Successfully.", "TTl= 5min"))
+	h.paymentService.OtpSender(req.Email)
+	c.JSON(http.StatusOK, utils.SuccessResponse("OTP sent Successfully.", models.OTPSentResponse{TTLSeconds: int(otp.TTL.Seconds())}))
 }
 
 func (h *PaymentHandler) ValidateOTP(c *gin.Context) {
 	var req models.ValidateOTPRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid OTP request", err.Error()))
+		apperror.Respond(c, "Invalid OTP request", apperror.Invalid(err))
 		return
 	}
 
-	if ok := h.paymentService.VerifyOTP(req.OrderID, req.OTP); !ok {
-		c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Invalid OTP", "The OTP provided is incorrect or expired. Please try again."))
+	if err := h.paymentService.CheckOTP(c.Request.Context(), req.OrderID, req.OTP); err != nil {
+		apperror.Respond(c, "Failed to validate OTP", err)
 		return
 	}
 
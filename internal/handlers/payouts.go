@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayoutHandler struct {
+	payoutService *services.PayoutService
+}
+
+func NewPayoutHandler(payoutService *services.PayoutService) *PayoutHandler {
+	return &PayoutHandler{
+		payoutService: payoutService,
+	}
+}
+
+// RunPayouts pays due organizers now instead of waiting for the scheduler
+func (h *PayoutHandler) RunPayouts(c *gin.Context) {
+	var req models.PayoutRunRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+			return
+		}
+	}
+
+	payouts, err := h.payoutService.Run(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to run payouts", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse(fmt.Sprintf("%d payouts created", len(payouts)), payouts))
+}
+
+// ListPayouts lists an organizer's payouts, newest first
+func (h *PayoutHandler) ListPayouts(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	payouts, err := h.payoutService.ListPayouts(c.Request.Context(), c.Param("id"), limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list payouts", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payouts retrieved", payouts))
+}
+
+func (h *PayoutHandler) GetPayout(c *gin.Context) {
+	payout, err := h.payoutService.GetPayout(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve payout", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payout retrieved", payout))
+}
+
+// GetStatement returns the settlement statement behind a payout
+func (h *PayoutHandler) GetStatement(c *gin.Context) {
+	statement, err := h.payoutService.Statement(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to build statement", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Settlement statement retrieved", statement))
+}
+
+// ExportStatement streams a settlement statement as CSV
+func (h *PayoutHandler) ExportStatement(c *gin.Context) {
+	statement, err := h.payoutService.Statement(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to build statement", err)
+		return
+	}
+	payout := statement.Payout
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=statement_%s.csv", payout.ID))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"date", "kind", "reference", "payment_id", "order_id", "amount"})
+	_ = w.Write([]string{payout.PeriodStart.Format(time.RFC3339), "opening_balance", "", "", "", formatAmount(statement.OpeningBalance)})
+	for _, line := range statement.Lines {
+		_ = w.Write([]string{
+			line.Date.Format(time.RFC3339), line.Kind, line.Reference, line.PaymentID, line.OrderID,
+			formatAmount(line.Amount),
+		})
+	}
+	end := payout.PeriodEnd.Format(time.RFC3339)
+	_ = w.Write([]string{end, "closing_balance", "", "", "", formatAmount(statement.ClosingBalance)})
+	_ = w.Write([]string{end, "reserve", "", "", "", formatAmount(-statement.Reserve)})
+	_ = w.Write([]string{end, "payout", payout.ID, "", "", formatAmount(statement.PayoutAmount)})
+	w.Flush()
+}
+
+// BankExport streams pending bank payouts as CSV for the finance team
+func (h *PayoutHandler) BankExport(c *gin.Context) {
+	lines, err := h.payoutService.BankExport()
+	if err != nil {
+		apperror.Respond(c, "Failed to export payouts", err)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=bank_payouts_%s.csv", time.Now().UTC().Format("20060102")))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{
+		"payout_id", "organizer_id", "organizer_name", "organizer_email", "currency", "amount",
+		"settlement_currency", "settlement_amount", "fx_rate", "created_at",
+	})
+	for _, line := range lines {
+		rate := "1"
+		if line.Payout.FX != nil {
+			rate = strconv.FormatFloat(line.Payout.FX.Rate, 'f', -1, 64)
+		}
+		_ = w.Write([]string{
+			line.Payout.ID, line.Payout.OrganizerID, line.OrganizerName, line.OrganizerEmail, line.Payout.Currency,
+			formatAmount(line.Payout.Amount), line.Payout.SettlementCurrency, formatAmount(line.Payout.SettlementAmount),
+			rate, line.Payout.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	w.Flush()
+}
+
+// ConfirmPayout records that a bank payout has been sent
+func (h *PayoutHandler) ConfirmPayout(c *gin.Context) {
+	var req models.ConfirmPayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payout, err := h.payoutService.ConfirmPayout(c.Request.Context(), c.Param("id"), req.Reference)
+	if err != nil {
+		apperror.Respond(c, "Failed to confirm payout", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payout confirmed", payout))
+}
+
+// FailPayout records that a bank payout could not be sent
+func (h *PayoutHandler) FailPayout(c *gin.Context) {
+	var req models.FailPayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	payout, err := h.payoutService.FailPayout(c.Request.Context(), c.Param("id"), req.Reason)
+	if err != nil {
+		apperror.Respond(c, "Failed to update payout", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payout marked as failed", payout))
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReconciliationHandler struct {
+	reconciliationService *services.ReconciliationService
+}
+
+func NewReconciliationHandler(reconciliationService *services.ReconciliationService) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationService: reconciliationService,
+	}
+}
+
+// StartRun starts a reconciliation run for a date range
+func (h *ReconciliationHandler) StartRun(c *gin.Context) {
+	var req models.ReconciliationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	run, err := h.reconciliationService.StartRun(&req)
+	if err != nil {
+		apperror.Respond(c, "Failed to start reconciliation", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, utils.SuccessResponse("Reconciliation started", run))
+}
+
+// ListRuns lists reconciliation runs, newest first
+func (h *ReconciliationHandler) ListRuns(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	runs, err := h.reconciliationService.ListRuns(limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list reconciliation runs", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Reconciliation runs retrieved", runs))
+}
+
+// GetRun returns a run together with its discrepancies
+func (h *ReconciliationHandler) GetRun(c *gin.Context) {
+	runID := c.Param("id")
+
+	run, err := h.reconciliationService.GetRun(runID)
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve reconciliation run", err)
+		return
+	}
+
+	items, err := h.reconciliationService.ListItems(runID)
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve discrepancies", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Reconciliation run retrieved", gin.H{
+		"run":           run,
+		"discrepancies": items,
+	}))
+}
+
+// ExportRun streams the discrepancies of a run as CSV
+func (h *ReconciliationHandler) ExportRun(c *gin.Context) {
+	runID := c.Param("id")
+
+	items, err := h.reconciliationService.ListItems(runID)
+	if err != nil {
+		apperror.Respond(c, "Failed to export discrepancies", err)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", runID))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{
+		"type", "payment_id", "order_id", "charge_id", "payment_intent_id", "currency",
+		"local_amount", "stripe_amount", "stripe_fee", "local_status", "stripe_status", "details",
+	})
+	for _, item := range items {
+		_ = w.Write([]string{
+			string(item.Type), item.PaymentID, item.OrderID, item.ChargeID, item.PaymentIntentID, item.Currency,
+			strconv.FormatFloat(item.LocalAmount, 'f', 2, 64),
+			strconv.FormatFloat(item.StripeAmount, 'f', 2, 64),
+			strconv.FormatFloat(item.StripeFee, 'f', 2, 64),
+			item.LocalStatus, item.StripeStatus, item.Details,
+		})
+	}
+	w.Flush()
+}
+
+// pagination reads limit/offset query parameters with sane defaults
+func pagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// runStore holds one finished run and its discrepancies
+type runStore struct {
+	run   *models.ReconciliationRun
+	items []*models.ReconciliationItem
+}
+
+func (s *runStore) SaveReconciliationRun(run *models.ReconciliationRun) error { return nil }
+
+func (s *runStore) GetReconciliationRun(id string) (*models.ReconciliationRun, error) {
+	if id != s.run.ID {
+		return nil, errors.New("not found")
+	}
+	return s.run, nil
+}
+
+func (s *runStore) ListReconciliationRuns(limit, offset int) ([]*models.ReconciliationRun, error) {
+	return []*models.ReconciliationRun{s.run}, nil
+}
+
+func (s *runStore) SaveReconciliationItems(items []*models.ReconciliationItem) error { return nil }
+
+func (s *runStore) ListReconciliationItems(runID string) ([]*models.ReconciliationItem, error) {
+	return s.items, nil
+}
+
+func TestExportRunWritesCSV(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &runStore{
+		run: &models.ReconciliationRun{ID: "rec_1", Status: models.ReconciliationCompleted},
+		items: []*models.ReconciliationItem{
+			{
+				RunID: "rec_1", Type: models.DiscrepancyAmountMismatch, PaymentID: "pay_1", OrderID: "order_1",
+				ChargeID: "ch_1", PaymentIntentID: "pi_1", Currency: "usd", LocalAmount: 40, StripeAmount: 45,
+				StripeFee: 1.6, LocalStatus: "success", StripeStatus: "success", Details: "local 40.00 vs stripe 45.00",
+			},
+			{
+				RunID: "rec_1", Type: models.DiscrepancyMissingStripe, PaymentID: "pay_2", Details: "no charge, in period",
+			},
+		},
+	}
+	service := services.NewReconciliationService(nil, store, nil, config.ReconciliationConfig{}, logger.NewFileLogger())
+	router := gin.New()
+	router.GET("/reconciliation/runs/:id/export", NewReconciliationHandler(service).ExportRun)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reconciliation/runs/rec_1/export", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/csv" {
+		t.Errorf("content type = %q", got)
+	}
+	if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, "rec_1.csv") {
+		t.Errorf("content disposition = %q", got)
+	}
+
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"type", "payment_id", "order_id", "charge_id", "payment_intent_id", "currency",
+			"local_amount", "stripe_amount", "stripe_fee", "local_status", "stripe_status", "details"},
+		{"amount_mismatch", "pay_1", "order_1", "ch_1", "pi_1", "usd", "40.00", "45.00", "1.60", "success", "success", "local 40.00 vs stripe 45.00"},
+		{"missing_stripe", "pay_2", "", "", "", "", "0.00", "0.00", "0.00", "", "", "no charge, in period"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %v", len(rows), len(want), rows)
+	}
+	for i := range want {
+		if strings.Join(rows[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d = %v, want %v", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestExportUnknownRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &runStore{run: &models.ReconciliationRun{ID: "rec_1"}}
+	service := services.NewReconciliationService(nil, store, nil, config.ReconciliationConfig{}, logger.NewFileLogger())
+	router := gin.New()
+	router.GET("/reconciliation/runs/:id/export", NewReconciliationHandler(service).ExportRun)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reconciliation/runs/rec_2/export", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", w.Code)
+	}
+}
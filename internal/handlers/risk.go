@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RiskHandler struct {
+	riskService   *services.RiskService
+	reviewService *services.ReviewService
+	cardTesting   *services.CardTestingService
+}
+
+func NewRiskHandler(riskService *services.RiskService, reviewService *services.ReviewService, cardTesting *services.CardTestingService) *RiskHandler {
+	return &RiskHandler{
+		riskService:   riskService,
+		reviewService: reviewService,
+		cardTesting:   cardTesting,
+	}
+}
+
+// GetRules returns the scoring rules in force and where they were loaded from
+func (h *RiskHandler) GetRules(c *gin.Context) {
+	rules, err := h.riskService.Rules(c.Request.Context())
+	if err != nil && rules == nil {
+		apperror.Respond(c, "Failed to load risk rules", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Risk rules retrieved", rules))
+}
+
+// ListReviews lists held payments oldest first. ?status= defaults to
+// pending; ?organizer_id= narrows the queue to one organizer.
+func (h *RiskHandler) ListReviews(c *gin.Context) {
+	limit, offset := pagination(c)
+	status := models.RiskReviewStatus(c.Query("status"))
+
+	reviews, err := h.reviewService.List(c.Request.Context(), c.Query("organizer_id"), status, limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list risk reviews", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Risk reviews retrieved", reviews))
+}
+
+func (h *RiskHandler) GetReview(c *gin.Context) {
+	review, err := h.reviewService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to get risk review", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Risk review retrieved", review))
+}
+
+// ApproveReview releases a held payment
+func (h *RiskHandler) ApproveReview(c *gin.Context) {
+	var req models.RiskReviewDecisionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+			return
+		}
+	}
+
+	review, err := h.reviewService.Approve(c.Request.Context(), c.Param("id"), req.Note)
+	if err != nil {
+		apperror.Respond(c, "Failed to approve payment", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment approved", review))
+}
+
+// RejectReview fails a held payment
+func (h *RiskHandler) RejectReview(c *gin.Context) {
+	var req models.RiskReviewDecisionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+			return
+		}
+	}
+
+	review, err := h.reviewService.Reject(c.Request.Context(), c.Param("id"), req.Note)
+	if err != nil {
+		apperror.Respond(c, "Failed to reject payment", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment rejected", review))
+}
+
+// ListBans lists the IPs currently banned from the checkout endpoints
+func (h *RiskHandler) ListBans(c *gin.Context) {
+	bans, err := h.cardTesting.ListBans(c.Request.Context())
+	if err != nil {
+		apperror.Respond(c, "Failed to list IP bans", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("IP bans retrieved", bans))
+}
+
+// BanIP bans an IP by hand
+func (h *RiskHandler) BanIP(c *gin.Context) {
+	var req models.IPBanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	ban, err := h.cardTesting.Ban(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to ban IP", err)
+		return
+	}
+	c.JSON(http.StatusCreated, utils.SuccessResponse("IP banned", ban))
+}
+
+// UnbanIP lifts a ban before its cooling-off period ends
+func (h *RiskHandler) UnbanIP(c *gin.Context) {
+	if err := h.cardTesting.Unban(c.Request.Context(), c.Param("ip")); err != nil {
+		apperror.Respond(c, "Failed to lift IP ban", err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.SuccessResponse("IP ban lifted", nil))
+}
@@ -0,0 +1,346 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const streamWriteTimeout = 10 * time.Second
+
+// Stream tokens name the kind of stream they open, so a payment ID cannot
+// be replayed as an order ID
+const (
+	StreamKindPayment = "payment"
+	StreamKindOrder   = "order"
+)
+
+var (
+	errStreamTokensOff    = apperror.New(apperror.ServiceUnavailable, "stream tokens are not configured")
+	errInvalidStreamToken = apperror.New(apperror.Unauthenticated, "invalid or expired stream token")
+	errStreamOrigin       = apperror.New(apperror.Forbidden, "origin may not open status streams")
+)
+
+// StreamHandler pushes payment status changes to checkout pages over
+// Server-Sent Events, or over a WebSocket when the request asks for an
+// upgrade. A stream opens with the payment's current status, sends each
+// status event as it happens and ends once the payment has settled.
+// Clients that reconnect pass the last event ID they saw, in the
+// Last-Event-ID header or ?last_event_id=, to get the events they missed.
+type StreamHandler struct {
+	eventService *services.EventService
+	cfg          config.StreamConfig
+}
+
+func NewStreamHandler(eventService *services.EventService, cfg config.StreamConfig) *StreamHandler {
+	return &StreamHandler{
+		eventService: eventService,
+		cfg:          cfg,
+	}
+}
+
+// IssuePaymentToken issues a token that opens the payment's status stream
+func (h *StreamHandler) IssuePaymentToken(c *gin.Context) {
+	h.issueToken(c, StreamKindPayment, c.Param("id"), "")
+}
+
+// IssueOrderToken issues a token that opens the order's status stream
+func (h *StreamHandler) IssueOrderToken(c *gin.Context) {
+	h.issueToken(c, StreamKindOrder, "", c.Param("id"))
+}
+
+func (h *StreamHandler) issueToken(c *gin.Context, kind, paymentID, orderID string) {
+	if h.cfg.TokenSecret == "" {
+		apperror.Respond(c, "Failed to issue stream token", errStreamTokensOff)
+		return
+	}
+	if _, err := h.eventService.StreamedPayment(c.Request.Context(), paymentID, orderID); err != nil {
+		apperror.Respond(c, "Failed to issue stream token", err)
+		return
+	}
+
+	expires := time.Now().Add(h.cfg.TokenTTL).Truncate(time.Second)
+	c.JSON(http.StatusOK, utils.SuccessResponse("Stream token issued", &models.StreamToken{
+		Token:     h.signStream(kind, paymentID+orderID, expires),
+		ExpiresAt: expires,
+	}))
+}
+
+// Authorize admits a request to a stream of the given kind on a valid
+// ?token= for the ID in the path, and otherwise hands it to auth for
+// callers that send an API key. Requests from origins that are not
+// allowed are refused either way.
+func (h *StreamHandler) Authorize(kind string, auth gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.originAllowed(c.GetHeader("Origin")) {
+			apperror.Abort(c, errStreamOrigin)
+			return
+		}
+		token := c.Query("token")
+		if token == "" {
+			auth(c)
+			return
+		}
+		if !h.verifyStream(kind, c.Param("id"), token, time.Now()) {
+			apperror.Abort(c, errInvalidStreamToken)
+			return
+		}
+		c.Next()
+	}
+}
+
+// signStream returns "<expiry>.<mac>", the MAC covering the stream kind,
+// the ID and the expiry
+func (h *StreamHandler) signStream(kind, id string, expires time.Time) string {
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + h.streamMAC(kind, id, exp)
+}
+
+func (h *StreamHandler) verifyStream(kind, id, token string, now time.Time) bool {
+	if h.cfg.TokenSecret == "" {
+		return false
+	}
+	exp, mac, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	expiry, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || now.Unix() >= expiry {
+		return false
+	}
+	return hmac.Equal([]byte(mac), []byte(h.streamMAC(kind, id, exp)))
+}
+
+func (h *StreamHandler) streamMAC(kind, id, exp string) string {
+	mac := hmac.New(sha256.New, []byte(h.cfg.TokenSecret))
+	mac.Write([]byte(kind + ":" + id + ":" + exp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// originAllowed reports whether a request from origin may open a stream.
+// Requests without an Origin header do not come from a browser page.
+func (h *StreamHandler) originAllowed(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	origin = strings.TrimRight(origin, "/")
+	for _, allowed := range h.cfg.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimRight(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamPayment streams a payment's status
+func (h *StreamHandler) StreamPayment(c *gin.Context) {
+	h.stream(c, c.Param("id"), "")
+}
+
+// StreamOrder streams the status of an order's payment
+func (h *StreamHandler) StreamOrder(c *gin.Context) {
+	h.stream(c, "", c.Param("id"))
+}
+
+func (h *StreamHandler) stream(c *gin.Context, paymentID, orderID string) {
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+
+	feed, err := h.eventService.Follow(c.Request.Context(), paymentID, orderID, lastEventID)
+	if err != nil {
+		apperror.Respond(c, "Failed to open status stream", err)
+		return
+	}
+	defer feed.Stop()
+
+	if c.IsWebsocket() {
+		h.streamWebSocket(c, feed)
+		return
+	}
+	h.streamSSE(c, feed)
+}
+
+func (h *StreamHandler) streamSSE(c *gin.Context, feed *models.StatusFeed) {
+	// The server's write timeout is meant for ordinary responses
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // stop nginx buffering the stream
+	c.Status(http.StatusOK)
+	fmt.Fprint(c.Writer, "retry: 3000\n\n") // reconnect after 3s
+	c.Writer.Flush()
+
+	_ = h.pump(c.Request.Context(), feed, &sseWriter{c: c})
+}
+
+func (h *StreamHandler) streamWebSocket(c *gin.Context, feed *models.StatusFeed) {
+	server := websocket.Server{
+		// Authorize has checked the origin already; checking it again keeps
+		// the upgrade safe wherever the handler is mounted
+		Handshake: func(_ *websocket.Config, r *http.Request) error {
+			if !h.originAllowed(r.Header.Get("Origin")) {
+				return errStreamOrigin
+			}
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+
+			// Clients send nothing; reading only notices them leaving
+			go func() {
+				var discard []byte
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+				cancel()
+			}()
+
+			if h.pump(ctx, feed, &wsWriter{ws: ws}) == nil {
+				_ = ws.WriteClose(1000)
+			}
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// pump sends the missed events, the current status and then live events
+// until the payment settles, the client leaves or the stream has run for
+// MaxDuration. It returns nil when the stream ended on our side.
+func (h *StreamHandler) pump(ctx context.Context, feed *models.StatusFeed, w streamWriter) error {
+	seen := make(map[string]bool, len(feed.Missed))
+	for _, event := range feed.Missed {
+		seen[event.EventID] = true
+		if err := w.Send(statusUpdate(event)); err != nil {
+			return err
+		}
+	}
+
+	payment := feed.Payment
+	err := w.Send(&models.StatusUpdate{
+		Type:      models.StatusUpdateSnapshot,
+		PaymentID: payment.PaymentID,
+		OrderID:   payment.OrderID,
+		Status:    payment.Status,
+		Timestamp: time.Now(),
+	})
+	if err != nil || payment.Status.Settled() {
+		return err
+	}
+
+	keepAlive := time.NewTicker(h.cfg.KeepAlive)
+	defer keepAlive.Stop()
+	deadline := time.NewTimer(h.cfg.MaxDuration)
+	defer deadline.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return nil
+		case <-keepAlive.C:
+			if err := w.KeepAlive(); err != nil {
+				return err
+			}
+		case event, ok := <-feed.Events:
+			if !ok {
+				// Fell behind; the client resumes from its last event ID
+				return nil
+			}
+			if event.Payment == nil || seen[event.EventID] {
+				continue
+			}
+			if err := w.Send(statusUpdate(event)); err != nil {
+				return err
+			}
+			if event.Payment.Status.Settled() {
+				return nil
+			}
+		}
+	}
+}
+
+func statusUpdate(event *models.PaymentEvent) *models.StatusUpdate {
+	return &models.StatusUpdate{
+		EventID:   event.EventID,
+		Type:      event.Type,
+		PaymentID: event.PaymentID,
+		OrderID:   event.Payment.OrderID,
+		Status:    event.Payment.Status,
+		Timestamp: event.Timestamp,
+	}
+}
+
+type streamWriter interface {
+	Send(update *models.StatusUpdate) error
+	KeepAlive() error
+}
+
+// sseWriter sends updates as "status" events so EventSource clients need a
+// single listener. Snapshots carry no ID, which leaves the client's last
+// event ID as it was.
+type sseWriter struct {
+	c *gin.Context
+}
+
+func (w *sseWriter) Send(update *models.StatusUpdate) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	if update.EventID != "" {
+		if _, err := fmt.Fprintf(w.c.Writer, "id: %s\n", update.EventID); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w.c.Writer, "event: status\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+func (w *sseWriter) KeepAlive() error {
+	if _, err := fmt.Fprint(w.c.Writer, ": keepalive\n\n"); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+// wsWriter sends updates as JSON text messages and keepalives as pings
+type wsWriter struct {
+	ws *websocket.Conn
+}
+
+func (w *wsWriter) Send(update *models.StatusUpdate) error {
+	_ = w.ws.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	return websocket.JSON.Send(w.ws, update)
+}
+
+func (w *wsWriter) KeepAlive() error {
+	_ = w.ws.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	w.ws.PayloadType = websocket.PingFrame
+	defer func() { w.ws.PayloadType = websocket.TextFrame }()
+	_, err := w.ws.Write(nil)
+	return err
+}
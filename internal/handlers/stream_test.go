@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// paymentStore holds one payment, found by its ID or order ID
+type paymentStore struct {
+	storage.Store
+	payment *models.Payment
+}
+
+func (s *paymentStore) GetPayment(paymentID string) (*models.Payment, error) {
+	if paymentID != s.payment.PaymentID {
+		return nil, errors.New("not found")
+	}
+	return s.payment, nil
+}
+
+func (s *paymentStore) GetTicketByOrderID(orderID string) (*models.Payment, error) {
+	if orderID != s.payment.OrderID {
+		return nil, errors.New("not found")
+	}
+	return s.payment, nil
+}
+
+var streamConfig = config.StreamConfig{
+	TokenSecret:    "stream-secret",
+	TokenTTL:       time.Minute,
+	AllowedOrigins: []string{"https://tickets.example.com"},
+}
+
+// streamRouter serves the token routes as the given organizer, and stream
+// routes that answer 204 once authorized. Header-authenticated requests
+// carry X-Test-Auth.
+func streamRouter(cfg config.StreamConfig, organizerID string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	store := &paymentStore{payment: &models.Payment{PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1"}}
+	events := services.NewEventService(nil, store, nil, nil, nil, nil, nil, nil, logger.NewFileLogger())
+	h := NewStreamHandler(events, cfg)
+
+	auth := func(c *gin.Context) {
+		if c.GetHeader("X-Test-Auth") == "" {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Next()
+	}
+	opened := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+
+	router := gin.New()
+	router.GET("/payments/:id/stream", h.Authorize(StreamKindPayment, auth), opened)
+	router.GET("/orders/:id/stream", h.Authorize(StreamKindOrder, auth), opened)
+	issuer := router.Group("", func(c *gin.Context) {
+		ctx := tenant.WithPrincipal(c.Request.Context(), tenant.Principal{OrganizerID: organizerID})
+		c.Request = c.Request.WithContext(ctx)
+	})
+	issuer.POST("/payments/:id/stream-token", h.IssuePaymentToken)
+	issuer.POST("/orders/:id/stream-token", h.IssueOrderToken)
+	return router
+}
+
+func issueStreamToken(t *testing.T, router *gin.Engine, path string) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("issue %s: status = %d, body %s", path, w.Code, w.Body)
+	}
+	var resp struct {
+		Data models.StreamToken `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.Token == "" || !resp.Data.ExpiresAt.After(time.Now()) {
+		t.Fatalf("token = %+v", resp.Data)
+	}
+	return resp.Data.Token
+}
+
+func openStream(router *gin.Engine, path string, header http.Header) int {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestStreamTokenOpensOnlyItsStream(t *testing.T) {
+	router := streamRouter(streamConfig, "org_1")
+	paymentToken := issueStreamToken(t, router, "/payments/pay_1/stream-token")
+	orderToken := issueStreamToken(t, router, "/orders/order_1/stream-token")
+
+	cases := []struct {
+		path string
+		want int
+	}{
+		{"/payments/pay_1/stream?token=" + paymentToken, http.StatusNoContent},
+		{"/orders/order_1/stream?token=" + orderToken, http.StatusNoContent},
+		{"/payments/pay_2/stream?token=" + paymentToken, http.StatusUnauthorized},
+		{"/orders/pay_1/stream?token=" + paymentToken, http.StatusUnauthorized},
+		{"/payments/pay_1/stream?token=" + orderToken, http.StatusUnauthorized},
+		{"/payments/pay_1/stream?token=garbage", http.StatusUnauthorized},
+		{"/payments/pay_1/stream", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		if got := openStream(router, tc.path, nil); got != tc.want {
+			t.Errorf("GET %s: status = %d, want %d", tc.path, got, tc.want)
+		}
+	}
+
+	if got := openStream(router, "/payments/pay_1/stream", http.Header{"X-Test-Auth": {"key"}}); got != http.StatusNoContent {
+		t.Errorf("API key caller: status = %d, want 204", got)
+	}
+}
+
+func TestStreamTokenExpires(t *testing.T) {
+	h := NewStreamHandler(nil, streamConfig)
+	expires := time.Now().Add(time.Minute)
+	token := h.signStream(StreamKindPayment, "pay_1", expires)
+
+	if !h.verifyStream(StreamKindPayment, "pay_1", token, expires.Add(-time.Second)) {
+		t.Error("token rejected before expiry")
+	}
+	if h.verifyStream(StreamKindPayment, "pay_1", token, expires.Add(time.Second)) {
+		t.Error("token accepted after expiry")
+	}
+
+	other := NewStreamHandler(nil, config.StreamConfig{TokenSecret: "another-secret"})
+	if other.verifyStream(StreamKindPayment, "pay_1", token, time.Now()) {
+		t.Error("token accepted under another secret")
+	}
+}
+
+func TestStreamTokenNeedsAccessToPayment(t *testing.T) {
+	router := streamRouter(streamConfig, "org_2")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/payments/pay_1/stream-token", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("another organizer's payment: status = %d, want 404", w.Code)
+	}
+}
+
+func TestStreamTokensNeedSecret(t *testing.T) {
+	router := streamRouter(config.StreamConfig{TokenTTL: time.Minute}, "org_1")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/payments/pay_1/stream-token", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", w.Code)
+	}
+}
+
+func TestStreamChecksOrigin(t *testing.T) {
+	router := streamRouter(streamConfig, "org_1")
+	token := issueStreamToken(t, router, "/payments/pay_1/stream-token")
+	path := "/payments/pay_1/stream?token=" + token
+
+	cases := []struct {
+		origin string
+		want   int
+	}{
+		{"https://tickets.example.com", http.StatusNoContent},
+		{"https://tickets.example.com/", http.StatusNoContent},
+		{"https://evil.example.com", http.StatusForbidden},
+		{"", http.StatusNoContent},
+	}
+	for _, tc := range cases {
+		header := http.Header{}
+		if tc.origin != "" {
+			header.Set("Origin", tc.origin)
+		}
+		if got := openStream(router, path, header); got != tc.want {
+			t.Errorf("origin %q: status = %d, want %d", tc.origin, got, tc.want)
+		}
+	}
+
+	open := NewStreamHandler(nil, config.StreamConfig{AllowedOrigins: []string{"*"}})
+	if !open.originAllowed("https://anywhere.example.com") {
+		t.Error(`"*" did not allow any origin`)
+	}
+}
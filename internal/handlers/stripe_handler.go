@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
+	"payment-gateway/internal/apperror"
 	"payment-gateway/internal/models"
 	"payment-gateway/internal/services"
 	"payment-gateway/internal/utils"
@@ -11,23 +13,47 @@ import (
 )
 
 type StripeHandler struct {
-	stripeService  *services.StripeService
-	paymentService *services.PaymentService
+	stripeService   *services.StripeService
+	paymentService  *services.PaymentService
+	disputeService  *services.DisputeService
+	customerService *services.CustomerService
+	cardTesting     *services.CardTestingService
 }
 
-func NewStripeHandler(stripeService *services.StripeService, paymentService *services.PaymentService) *StripeHandler {
+func NewStripeHandler(stripeService *services.StripeService, paymentService *services.PaymentService, disputeService *services.DisputeService, customerService *services.CustomerService, cardTesting *services.CardTestingService) *StripeHandler {
 	return &StripeHandler{
-		stripeService:  stripeService,
-		paymentService: paymentService,
+		stripeService:   stripeService,
+		paymentService:  paymentService,
+		disputeService:  disputeService,
+		customerService: customerService,
+		cardTesting:     cardTesting,
 	}
 }
 
-// ValidateCard validates credit card details without creating a charge
+// ValidateCard validates credit card details without creating a charge.
+// Callers that check too many cards must send a solved CAPTCHA in
+// captcha_token (or X-Captcha-Token). There is no checkout session here, and
+// a session ID the caller names could be changed on every check, so checks
+// are counted by IP and card only.
 func (h *StripeHandler) ValidateCard(c *gin.Context) {
 	var req models.StripeCardValidationRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request payload", err.Error()))
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	captchaToken := req.CaptchaToken
+	if captchaToken == "" {
+		captchaToken = c.GetHeader("X-Captcha-Token")
+	}
+	err := h.cardTesting.Admit(c.Request.Context(), services.CardCheck{
+		IP:           c.ClientIP(),
+		CardNumber:   req.Card.Number,
+		CaptchaToken: captchaToken,
+	})
+	if errors.Is(err, services.ErrCaptchaRequired) {
+		apperror.Respond(c, "Verification required", err)
 		return
 	}
 
@@ -41,9 +67,12 @@ func (h *StripeHandler) ValidateCard(c *gin.Context) {
 	}
 	result, err := h.stripeService.ValidateCard(card)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Card validation failed", err.Error()))
+		apperror.Respond(c, "Card could not be validated", err)
 		return
 	}
+	if !result.Valid {
+		h.cardTesting.RecordFailure(c.Request.Context(), c.ClientIP())
+	}
 
 	c.JSON(http.StatusOK, utils.SuccessResponse("Card validation result", result))
 }
@@ -52,29 +81,46 @@ func (h *StripeHandler) ValidateCard(c *gin.Context) {
 func (h *StripeHandler) ProcessPayment(c *gin.Context) {
 	var req models.StripePaymentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request payload", err.Error()))
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
 		return
 	}
 
-	// Require either token or card
-	if req.Token == "" && req.Card == nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request payload", "Either token or card must be provided"))
+	// Require either token or card, unless a saved card will be charged
+	if req.Token == "" && req.Card == nil && req.UserID == "" {
+		apperror.Respond(c, "Invalid request payload", apperror.New(apperror.InvalidRequest, "either token, card or user_id must be provided"))
+		return
+	}
+	if req.OffSession && req.UserID == "" {
+		apperror.Respond(c, "Invalid request payload", apperror.New(apperror.InvalidRequest, "off_session requires user_id"))
 		return
 	}
 
+	if err := h.customerService.PrepareCharge(c.Request.Context(), &req); err != nil {
+		apperror.Respond(c, "Payment processing failed", err)
+		return
+	}
+
+	// Card declines come back as Stripe card errors and are reported with
+	// their decline code, not as failures of ours
 	result, err := h.stripeService.ProcessPayment(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Payment processing failed", err.Error()))
+		apperror.Respond(c, "Payment processing failed", err)
 		return
 	}
 
-	if result.Status == models.StatusSuccess || result.Status == models.StatusPending {
+	switch result.Status {
+	case models.StatusSuccess, models.StatusPending, models.StatusInReview:
 		paymentReq := &models.PaymentRequest{
-			PaymentID: result.PaymentID,
-			OrderID:   result.OrderID,
-			Status:    result.Status,
-			Price:     result.Amount,
-			Date:      utils.UnixTimeToTime(result.Created),
+			PaymentID:     result.PaymentID,
+			OrganizerID:   result.OrganizerID,
+			OrderID:       result.OrderID,
+			Status:        result.Status,
+			Price:         result.Amount,
+			Currency:      result.Currency,
+			Date:          utils.UnixTimeToTime(result.Created),
+			TransactionID: result.TransactionID,
+			Email:         req.Email,
+			LineItems:     req.LineItems,
 		}
 		_, err := h.paymentService.ProcessPayment(c.Request.Context(), paymentReq)
 		if err != nil {
@@ -84,21 +130,76 @@ func (h *StripeHandler) ProcessPayment(c *gin.Context) {
 	c.JSON(http.StatusOK, utils.SuccessResponse("Payment processed", result))
 }
 
+// ConfirmPayment re-confirms a payment after 3-D Secure authentication and
+// settles the local record. Calling it again returns the current status.
+func (h *StripeHandler) ConfirmPayment(c *gin.Context) {
+	var req models.StripeConfirmRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+			return
+		}
+	}
+
+	result, err := h.stripeService.ConfirmPayment(c.Request.Context(), c.Param("id"), req.ReturnURL)
+	if err != nil {
+		apperror.Respond(c, "Payment confirmation failed", err)
+		return
+	}
+
+	if _, err := h.paymentService.SettleStripePayment(c.Request.Context(), result.TransactionID, result.Status); err != nil && !errors.Is(err, services.ErrPaymentNotFound) {
+		apperror.Respond(c, "Failed to update payment", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Payment confirmation processed", result))
+}
+
 // RefundPayment refunds a payment through Stripe
 func (h *StripeHandler) RefundPayment(c *gin.Context) {
 	var req models.StripeRefundRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request payload", err.Error()))
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
 		return
 	}
 
+	// Line items are set aside first and the refund made for their amount
+	var planned *models.Refund
+	if len(req.LineItems) > 0 {
+		if req.Amount != nil {
+			apperror.Respond(c, "Invalid request payload", apperror.New(apperror.InvalidRefundAmount, "give an amount or line items, not both"))
+			return
+		}
+		var err error
+		planned, err = h.paymentService.PlanLineItemRefund(c.Request.Context(), req.PaymentID, req.LineItems)
+		if err != nil {
+			apperror.Respond(c, "Refund processing failed", err)
+			return
+		}
+		req.Amount = &planned.Amount
+	}
+
 	// Process refund using Stripe
 	result, err := h.stripeService.RefundPayment(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Refund processing failed", err.Error()))
+		if planned != nil {
+			h.paymentService.CancelLineItemRefund(planned)
+		}
+		apperror.Respond(c, "Refund processing failed", err)
 		return
 	}
+	if planned != nil {
+		result.LineItems = planned.LineItems
+	}
+
+	// Intents created outside the gateway have no local payment to update
+	if result.PaymentID != "" {
+		if _, err := h.paymentService.RecordRefund(c.Request.Context(), result); err != nil && !errors.Is(err, services.ErrPaymentNotFound) {
+			apperror.Respond(c, "Failed to record refund", err)
+			return
+		}
+	}
 
 	c.JSON(http.StatusOK, utils.SuccessResponse("Refund processed", result))
 }
@@ -107,33 +208,55 @@ func (h *StripeHandler) RefundPayment(c *gin.Context) {
 func (h *StripeHandler) GetPaymentDetails(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Payment ID is required", ""))
+		apperror.Respond(c, "Payment ID is required", errPaymentIDRequired)
 		return
 	}
 
 	// Get payment details from Stripe
 	result, err := h.stripeService.GetPaymentDetails(c.Request.Context(), paymentID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to retrieve payment details", err.Error()))
+		apperror.Respond(c, "Failed to retrieve payment details", err)
 		return
 	}
 
 	c.JSON(http.StatusOK, utils.SuccessResponse("Payment details retrieved", result))
 }
 
-// HandleStripeWebhook handles webhook events from Stripe
+// HandleStripeWebhook verifies and dispatches webhook events from Stripe.
+// Unhandled event types are acknowledged so Stripe does not retry them.
 func (h *StripeHandler) HandleStripeWebhook(c *gin.Context) {
-	// Read the request body
-	_, err := c.GetRawData()
+	payload, err := c.GetRawData()
 	if err != nil {
-		c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to read request body", err.Error()))
+		apperror.Respond(c, "Failed to read request body", apperror.Wrap(apperror.InvalidRequest, "failed to read request body", err))
 		return
 	}
 
-	// Get the Stripe-Signature header
-	// stripeSignature := c.GetHeader("Stripe-Signature")
+	event, err := h.stripeService.ConstructWebhookEvent(payload, c.GetHeader("Stripe-Signature"))
+	if err != nil {
+		apperror.Respond(c, "Invalid webhook", err)
+		return
+	}
+
+	if services.IsDisputeEvent(event.Type) {
+		if err := h.disputeService.HandleEvent(c.Request.Context(), event); err != nil {
+			// A non-2xx response makes Stripe redeliver the event
+			apperror.Respond(c, "Failed to process webhook", err)
+			return
+		}
+	}
+
+	if services.IsPaymentIntentEvent(event.Type) {
+		result, err := h.stripeService.HandleIntentEvent(event)
+		if err != nil {
+			apperror.Respond(c, "Invalid webhook", err)
+			return
+		}
+		// Intents created outside this service have no local payment to settle
+		if _, err := h.paymentService.SettleStripePayment(c.Request.Context(), result.TransactionID, result.Status); err != nil && !errors.Is(err, services.ErrPaymentNotFound) {
+			apperror.Respond(c, "Failed to process webhook", err)
+			return
+		}
+	}
 
-	// This is just a placeholder for webhook handling
-	// In a real application, you would verify the signature and process the event
 	c.JSON(http.StatusOK, gin.H{"received": true})
 }
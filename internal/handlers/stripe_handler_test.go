@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// checkCounters records which card-check counters were incremented
+type checkCounters struct {
+	services.CardTestingStore
+	counts map[string]int64
+}
+
+func (s *checkCounters) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	s.counts[key]++
+	return s.counts[key], nil
+}
+
+func (s *checkCounters) IPBan(ctx context.Context, ip string) (*models.IPBan, error) {
+	return nil, nil
+}
+
+func TestValidateCardIgnoresClientSessionIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("STRIPE_SECRET_KEY", "sk_test_handlers")
+	log := logger.NewFileLogger()
+	stripe, err := services.NewStripeService(log, nil, nil, nil, nil, nil, config.CardDataConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counters := &checkCounters{counts: map[string]int64{}}
+	cardTesting := services.NewCardTestingService(counters, nil, nil, config.CardTestingConfig{
+		Enabled: true, Window: time.Hour, CaptchaAfterIP: 10, CaptchaAfterSession: 1, CaptchaAfterCard: 10, FingerprintKey: "card-testing-key",
+	}, log)
+	router := gin.New()
+	router.POST("/validate-card", NewStripeHandler(stripe, nil, nil, nil, cardTesting).ValidateCard)
+
+	for _, session := range []string{"cs_1", "cs_2"} {
+		req := httptest.NewRequest(http.MethodPost, "/validate-card",
+			strings.NewReader(`{"card":{"number":"4242424242424242","exp_month":"12","exp_year":"2030","cvc":"123"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Session-ID", session)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if len(counters.counts) != 2 {
+		t.Errorf("counters = %v, want the IP and the card", counters.counts)
+	}
+	for key, count := range counters.counts {
+		if strings.HasPrefix(key, "card_checks:session:") || count != 2 {
+			t.Errorf("counter %s = %d", key, count)
+		}
+	}
+}
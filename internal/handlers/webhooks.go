@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/services"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+}
+
+func NewWebhookHandler(webhookService *services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateEndpoint registers a webhook endpoint and returns its signing
+// secret, which is not shown again
+func (h *WebhookHandler) CreateEndpoint(c *gin.Context) {
+	var req models.CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	created, err := h.webhookService.CreateEndpoint(c.Request.Context(), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to create webhook endpoint", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse("Webhook endpoint created", created))
+}
+
+func (h *WebhookHandler) ListEndpoints(c *gin.Context) {
+	endpoints, err := h.webhookService.ListEndpoints(c.Request.Context())
+	if err != nil {
+		apperror.Respond(c, "Failed to list webhook endpoints", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook endpoints retrieved", endpoints))
+}
+
+func (h *WebhookHandler) GetEndpoint(c *gin.Context) {
+	endpoint, err := h.webhookService.GetEndpoint(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve webhook endpoint", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook endpoint retrieved", endpoint))
+}
+
+func (h *WebhookHandler) UpdateEndpoint(c *gin.Context) {
+	var req models.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Respond(c, "Invalid request payload", apperror.Invalid(err))
+		return
+	}
+
+	endpoint, err := h.webhookService.UpdateEndpoint(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		apperror.Respond(c, "Failed to update webhook endpoint", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook endpoint updated", endpoint))
+}
+
+func (h *WebhookHandler) DeleteEndpoint(c *gin.Context) {
+	if err := h.webhookService.DeleteEndpoint(c.Request.Context(), c.Param("id")); err != nil {
+		apperror.Respond(c, "Failed to delete webhook endpoint", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook endpoint deleted", gin.H{"id": c.Param("id")}))
+}
+
+// RotateSecret issues a new signing secret for an endpoint
+func (h *WebhookHandler) RotateSecret(c *gin.Context) {
+	rotated, err := h.webhookService.RotateSecret(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to rotate webhook secret", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook secret rotated", rotated))
+}
+
+// ListDeliveries returns an endpoint's delivery log
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request.Context(), c.Param("id"), limit, offset)
+	if err != nil {
+		apperror.Respond(c, "Failed to list webhook deliveries", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook deliveries retrieved", deliveries))
+}
+
+func (h *WebhookHandler) GetDelivery(c *gin.Context) {
+	delivery, err := h.webhookService.GetDelivery(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to retrieve webhook delivery", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook delivery retrieved", delivery))
+}
+
+// Redeliver sends a past delivery again and returns the new attempt
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	delivery, err := h.webhookService.Redeliver(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		apperror.Respond(c, "Failed to redeliver webhook", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.SuccessResponse("Webhook redelivered", delivery))
+}
@@ -46,8 +46,24 @@ func NewProducer(brokers []string, mockMode bool, log *logger.Logger) (*Producer
 	}, nil
 }
 
+// Message headers. Every event carries its event ID; republished events
+// also carry replay=true so consumers can tell them from live traffic.
+const (
+	HeaderEventID = "event-id"
+	HeaderReplay  = "replay"
+)
+
 // PublishPaymentEvent publishes a payment event to Kafka.
 func (p *Producer) PublishPaymentEvent(event *models.PaymentEvent) error {
+	return p.publish(event, false)
+}
+
+// RepublishPaymentEvent publishes a past event again, flagged as a replay.
+func (p *Producer) RepublishPaymentEvent(event *models.PaymentEvent) error {
+	return p.publish(event, true)
+}
+
+func (p *Producer) publish(event *models.PaymentEvent, replay bool) error {
 	data, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
@@ -55,16 +71,22 @@ func (p *Producer) PublishPaymentEvent(event *models.PaymentEvent) error {
 
 	topic := p.getTopicForEvent(event.Type)
 
+	headers := []sarama.RecordHeader{{Key: []byte(HeaderEventID), Value: []byte(event.EventID)}}
+	if replay {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(HeaderReplay), Value: []byte("true")})
+	}
+
 	if p.mockMode {
-		p.log.LogKafka("MOCK_PUBLISH", topic, fmt.Sprintf("Mock publishing event: %s for payment: %s", event.Type, event.PaymentID))
+		p.log.LogKafka("MOCK_PUBLISH", topic, fmt.Sprintf("Mock publishing event: %s for payment: %s (replay: %t)", event.Type, event.PaymentID, replay))
 		p.log.LogKafka("MOCK_DATA", topic, string(data))
 		return nil
 	}
 
 	msg := &sarama.ProducerMessage{
-		Topic: topic,
-		Key:   sarama.StringEncoder(event.PaymentID),
-		Value: sarama.ByteEncoder(data),
+		Topic:   topic,
+		Key:     sarama.StringEncoder(event.PaymentID),
+		Value:   sarama.ByteEncoder(data),
+		Headers: headers,
 	}
 
 	partition, offset, err := p.producer.SendMessage(msg)
@@ -0,0 +1,242 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/utils"
+)
+
+// DefaultCurrency is used for payments that do not carry their own currency.
+const DefaultCurrency = "usd"
+
+var (
+	ErrUnbalancedEntry = errors.New("journal entry does not balance")
+	ErrInvalidEntry    = errors.New("invalid journal entry")
+)
+
+// Ledger posts balanced journal entries for every money movement.
+type Ledger struct {
+	store storage.LedgerStore
+	log   *logger.Logger
+}
+
+func New(store storage.LedgerStore, log *logger.Logger) *Ledger {
+	return &Ledger{
+		store: store,
+		log:   log,
+	}
+}
+
+// Movement describes a money movement tied to a payment. Amount is in the
+// major currency unit, as stored on models.Payment.
+type Movement struct {
+	PaymentID   string
+	OrderID     string
+	OrganizerID string
+	Currency    string
+	Amount      float64
+	Reference   string // optional; defaults to kind:payment_id
+}
+
+// Post validates and stores an entry. Re-posting an entry whose reference
+// already exists is a no-op, so callers can post on every retry.
+func (l *Ledger) Post(entry *models.JournalEntry) error {
+	if err := validate(entry); err != nil {
+		l.log.Error("LEDGER", fmt.Sprintf("Rejected %s entry %s: %v", entry.Kind, entry.Reference, err))
+		return err
+	}
+
+	if entry.ID == "" {
+		entry.ID = utils.GenerateJournalEntryID()
+	}
+	if entry.PostedAt.IsZero() {
+		entry.PostedAt = time.Now()
+	}
+	entry.Currency = strings.ToLower(entry.Currency)
+
+	if err := l.store.PostJournalEntry(entry); err != nil {
+		if errors.Is(err, storage.ErrDuplicateReference) {
+			l.log.Debug("LEDGER", fmt.Sprintf("Entry %s already posted, skipping", entry.Reference))
+			return nil
+		}
+		return err
+	}
+
+	l.log.LogPayment("LEDGER", entry.PaymentID, fmt.Sprintf("Posted %s entry %s", entry.Kind, entry.ID))
+	return nil
+}
+
+// PaymentCaptured records funds collected from an attendee: the processor
+// holds the cash and it is owed to the organizer.
+func (l *Ledger) PaymentCaptured(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("payment.captured", "Payment captured",
+		debit(models.AccountCustomerClearing, "", amount),
+		credit(models.AccountOrganizerPayable, m.OrganizerID, amount),
+	))
+}
+
+// ProcessorFee records the fee Stripe deducted from a charge.
+func (l *Ledger) ProcessorFee(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("processor.fee", "Processor fee",
+		debit(models.AccountProcessorFees, "", amount),
+		credit(models.AccountCustomerClearing, "", amount),
+	))
+}
+
+// PaymentRefunded records money returned to the attendee. The refunds
+// account is tagged with the organizer so it nets against their payable.
+func (l *Ledger) PaymentRefunded(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("payment.refunded", "Payment refunded",
+		debit(models.AccountRefunds, m.OrganizerID, amount),
+		credit(models.AccountCustomerClearing, "", amount),
+	))
+}
+
+// PlatformFee moves Evently's commission out of the organizer's payable
+// balance into platform revenue.
+func (l *Ledger) PlatformFee(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("platform.fee", "Platform commission",
+		debit(models.AccountOrganizerPayable, m.OrganizerID, amount),
+		credit(models.AccountPlatformRevenue, m.OrganizerID, amount),
+	))
+}
+
+// PlatformFeeReversed returns part of the commission to the organizer when
+// a payment is refunded.
+func (l *Ledger) PlatformFeeReversed(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("platform.fee_reversal", "Platform commission reversal",
+		debit(models.AccountPlatformRevenue, m.OrganizerID, amount),
+		credit(models.AccountOrganizerPayable, m.OrganizerID, amount),
+	))
+}
+
+// DisputeFundsWithdrawn records Stripe pulling a disputed amount back from
+// the platform. It is charged against the organizer's balance.
+func (l *Ledger) DisputeFundsWithdrawn(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("dispute.funds_withdrawn", "Dispute funds withdrawn",
+		debit(models.AccountDisputes, m.OrganizerID, amount),
+		credit(models.AccountCustomerClearing, "", amount),
+	))
+}
+
+// DisputeFundsReinstated returns disputed funds after a dispute is won.
+func (l *Ledger) DisputeFundsReinstated(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("dispute.funds_reinstated", "Dispute funds reinstated",
+		debit(models.AccountCustomerClearing, "", amount),
+		credit(models.AccountDisputes, m.OrganizerID, amount),
+	))
+}
+
+// PayoutSent records a settlement paid out to an organizer. Payouts are not
+// tied to a payment, so m.Reference should name the payout.
+func (l *Ledger) PayoutSent(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("payout.sent", "Organizer payout",
+		debit(models.AccountOrganizerPayable, m.OrganizerID, amount),
+		credit(models.AccountCustomerClearing, "", amount),
+	))
+}
+
+// PayoutReversed records money pulled back from an organizer's Connect
+// account, as when a destination charge is refunded.
+func (l *Ledger) PayoutReversed(m Movement) error {
+	amount := models.ToMinor(m.Amount, m.Currency)
+	return l.Post(m.entry("payout.reversed", "Organizer payout reversed",
+		debit(models.AccountCustomerClearing, "", amount),
+		credit(models.AccountOrganizerPayable, m.OrganizerID, amount),
+	))
+}
+
+func (l *Ledger) EntriesForPayment(paymentID string) ([]*models.JournalEntry, error) {
+	return l.store.ListJournalEntries(paymentID)
+}
+
+// OrganizerEntries returns the entries that touched an organizer's accounts
+// in [from, to), carrying only that organizer's lines.
+func (l *Ledger) OrganizerEntries(organizerID, currency string, from, to time.Time) ([]*models.JournalEntry, error) {
+	return l.store.ListOrganizerJournalEntries(organizerID, strings.ToLower(currency), from, to)
+}
+
+// Balances returns per-account balances, optionally for a single organizer.
+func (l *Ledger) Balances(organizerID string) ([]*models.AccountBalance, error) {
+	return l.store.AccountBalances(organizerID)
+}
+
+// CheckInvariants verifies that all journals sum to zero.
+func (l *Ledger) CheckInvariants() (*models.LedgerInvariantReport, error) {
+	report, err := l.store.CheckLedgerInvariants()
+	if err != nil {
+		return nil, err
+	}
+	report.CheckedAt = time.Now()
+
+	if !report.Balanced {
+		l.log.Error("LEDGER", fmt.Sprintf("Ledger invariant violated: debits=%d credits=%d unbalanced=%v",
+			report.TotalDebits, report.TotalCredits, report.UnbalancedEntries))
+	}
+	return report, nil
+}
+
+func (m Movement) entry(kind, description string, lines ...*models.JournalLine) *models.JournalEntry {
+	currency := m.Currency
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	reference := m.Reference
+	if reference == "" {
+		reference = kind + ":" + m.PaymentID
+	}
+	return &models.JournalEntry{
+		Kind:        kind,
+		Reference:   reference,
+		PaymentID:   m.PaymentID,
+		OrderID:     m.OrderID,
+		Currency:    currency,
+		Description: description,
+		Lines:       lines,
+	}
+}
+
+func validate(entry *models.JournalEntry) error {
+	if entry.Reference == "" || entry.Kind == "" || len(entry.Lines) < 2 {
+		return ErrInvalidEntry
+	}
+
+	var debits, credits int64
+	for _, line := range entry.Lines {
+		if line.Debit < 0 || line.Credit < 0 || (line.Debit > 0 && line.Credit > 0) {
+			return fmt.Errorf("%w: line on %s must be a single non-negative side", ErrInvalidEntry, line.Account)
+		}
+		debits += line.Debit
+		credits += line.Credit
+	}
+
+	if debits == 0 {
+		return fmt.Errorf("%w: zero amount", ErrInvalidEntry)
+	}
+	if debits != credits {
+		return fmt.Errorf("%w: debits %d, credits %d", ErrUnbalancedEntry, debits, credits)
+	}
+	return nil
+}
+
+func debit(account models.LedgerAccount, organizerID string, amount int64) *models.JournalLine {
+	return &models.JournalLine{Account: account, OrganizerID: organizerID, Debit: amount}
+}
+
+func credit(account models.LedgerAccount, organizerID string, amount int64) *models.JournalLine {
+	return &models.JournalLine{Account: account, OrganizerID: organizerID, Credit: amount}
+}
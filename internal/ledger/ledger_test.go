@@ -0,0 +1,169 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+)
+
+// journal keeps posted entries in memory and refuses a reference twice, as
+// the unique key on journal_entries does
+type journal struct {
+	storage.LedgerStore
+	entries []*models.JournalEntry
+}
+
+func (j *journal) PostJournalEntry(entry *models.JournalEntry) error {
+	for _, existing := range j.entries {
+		if existing.Reference == entry.Reference {
+			return storage.ErrDuplicateReference
+		}
+	}
+	j.entries = append(j.entries, entry)
+	return nil
+}
+
+// balance nets the lines posted to an account for an organizer
+func (j *journal) balance(account models.LedgerAccount, organizerID string) int64 {
+	var balance int64
+	for _, entry := range j.entries {
+		for _, line := range entry.Lines {
+			if line.Account == account && line.OrganizerID == organizerID {
+				balance += line.Debit - line.Credit
+			}
+		}
+	}
+	return balance
+}
+
+func TestMovementsBalance(t *testing.T) {
+	store := &journal{}
+	l := New(store, logger.NewFileLogger())
+	m := Movement{PaymentID: "pay_1", OrganizerID: "org_1", Currency: "USD"}
+
+	steps := []struct {
+		post   func(Movement) error
+		amount float64
+	}{
+		{l.PaymentCaptured, 100},
+		{l.ProcessorFee, 3.20},
+		{l.PlatformFee, 10},
+		{l.PaymentRefunded, 40},
+		{l.PlatformFeeReversed, 4},
+		{l.DisputeFundsWithdrawn, 60},
+		{l.DisputeFundsReinstated, 60},
+	}
+	for _, step := range steps {
+		m.Amount = step.amount
+		if err := step.post(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.PayoutSent(Movement{OrganizerID: "org_1", Amount: 54, Reference: "payout.sent:po_1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var debits, credits int64
+	for _, entry := range store.entries {
+		if entry.Currency != "usd" {
+			t.Errorf("%s: currency %q, want usd", entry.Kind, entry.Currency)
+		}
+		for _, line := range entry.Lines {
+			debits += line.Debit
+			credits += line.Credit
+		}
+	}
+	if debits != credits {
+		t.Errorf("journal does not balance: debits %d, credits %d", debits, credits)
+	}
+
+	// 100 captured, 10 commission of which 4 came back, 40 refunded and
+	// 54 paid out leaves nothing owed
+	payable := store.balance(models.AccountOrganizerPayable, "org_1") + store.balance(models.AccountRefunds, "org_1")
+	if payable != 0 {
+		t.Errorf("organizer payable net = %d, want 0", payable)
+	}
+	if revenue := store.balance(models.AccountPlatformRevenue, "org_1"); revenue != -600 {
+		t.Errorf("platform revenue = %d, want -600", revenue)
+	}
+	if disputes := store.balance(models.AccountDisputes, "org_1"); disputes != 0 {
+		t.Errorf("disputes = %d after reinstatement, want 0", disputes)
+	}
+}
+
+func TestPostIsIdempotentByReference(t *testing.T) {
+	store := &journal{}
+	l := New(store, logger.NewFileLogger())
+	m := Movement{PaymentID: "pay_1", OrganizerID: "org_1", Amount: 25}
+
+	for i := 0; i < 2; i++ {
+		if err := l.PaymentCaptured(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(store.entries) != 1 {
+		t.Fatalf("%d entries posted, want 1", len(store.entries))
+	}
+
+	// A different reference for the same kind and payment is a new entry
+	m.Reference = "payment.refunded:re_2"
+	if err := l.PaymentRefunded(m); err != nil {
+		t.Fatal(err)
+	}
+	m.Reference = "payment.refunded:re_3"
+	if err := l.PaymentRefunded(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.entries) != 3 {
+		t.Errorf("%d entries posted, want 3", len(store.entries))
+	}
+}
+
+func TestPostRejectsInvalidEntries(t *testing.T) {
+	line := func(account models.LedgerAccount, debit, credit int64) *models.JournalLine {
+		return &models.JournalLine{Account: account, Debit: debit, Credit: credit}
+	}
+	tests := []struct {
+		name  string
+		entry *models.JournalEntry
+		err   error
+	}{
+		{"unbalanced", &models.JournalEntry{Kind: "k", Reference: "r", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, 100, 0), line(models.AccountOrganizerPayable, 0, 99)}}, ErrUnbalancedEntry},
+		{"one line", &models.JournalEntry{Kind: "k", Reference: "r", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, 100, 0)}}, ErrInvalidEntry},
+		{"no reference", &models.JournalEntry{Kind: "k", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, 100, 0), line(models.AccountOrganizerPayable, 0, 100)}}, ErrInvalidEntry},
+		{"both sides on a line", &models.JournalEntry{Kind: "k", Reference: "r", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, 100, 100), line(models.AccountOrganizerPayable, 0, 0)}}, ErrInvalidEntry},
+		{"negative", &models.JournalEntry{Kind: "k", Reference: "r", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, -100, 0), line(models.AccountOrganizerPayable, 0, -100)}}, ErrInvalidEntry},
+		{"zero", &models.JournalEntry{Kind: "k", Reference: "r", Lines: []*models.JournalLine{
+			line(models.AccountCustomerClearing, 0, 0), line(models.AccountOrganizerPayable, 0, 0)}}, ErrInvalidEntry},
+	}
+
+	store := &journal{}
+	l := New(store, logger.NewFileLogger())
+	for _, tt := range tests {
+		if err := l.Post(tt.entry); !errors.Is(err, tt.err) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+	if len(store.entries) != 0 {
+		t.Errorf("%d invalid entries were stored", len(store.entries))
+	}
+}
+
+func TestZeroDecimalCurrenciesPostWholeUnits(t *testing.T) {
+	store := &journal{}
+	l := New(store, logger.NewFileLogger())
+	if err := l.PaymentCaptured(Movement{PaymentID: "pay_1", OrganizerID: "org_1", Currency: "JPY", Amount: 1000}); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.balance(models.AccountOrganizerPayable, "org_1"); got != -1000 {
+		t.Errorf("organizer payable = %d, want -1000", got)
+	}
+}
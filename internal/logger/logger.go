@@ -36,10 +36,21 @@ type LogEntry struct {
 type Logger struct {
 	fileLogger   *log.Logger
 	logFile      *os.File
+	terminal     io.Writer
 	colorEnabled bool
 }
 
 func NewLogger() *Logger {
+	return newLogger(os.Stdout)
+}
+
+// NewFileLogger logs to the day's log file only, for command-line tools
+// whose standard output is their result.
+func NewFileLogger() *Logger {
+	return newLogger(nil)
+}
+
+func newLogger(terminal io.Writer) *Logger {
 	// Create logs directory if it doesn't exist
 	if err := os.MkdirAll("logs", 0755); err != nil {
 		log.Fatal("Failed to create logs directory:", err)
@@ -54,13 +65,18 @@ func NewLogger() *Logger {
 		log.Fatal("Failed to create log file:", err)
 	}
 
-	// Create multi-writer for both file and stdout
-	multiWriter := io.MultiWriter(logFile, os.Stdout)
-	fileLogger := log.New(multiWriter, "", 0)
+	// Write to the file and, unless quiet, the terminal
+	var fileLogger *log.Logger
+	if terminal != nil {
+		fileLogger = log.New(io.MultiWriter(logFile, terminal), "", 0)
+	} else {
+		fileLogger = log.New(logFile, "", 0)
+	}
 
 	logger := &Logger{
 		fileLogger:   fileLogger,
 		logFile:      logFile,
+		terminal:     terminal,
 		colorEnabled: true,
 	}
 
@@ -94,7 +110,9 @@ func (l *Logger) log(level LogLevel, category, message string) {
 	jsonOutput := l.formatJSONOutput(entry)
 
 	// Write to terminal (colored)
-	fmt.Print(terminalOutput)
+	if l.terminal != nil {
+		fmt.Fprint(l.terminal, terminalOutput)
+	}
 	
 	// Write to file (JSON format)
 	if l.logFile != nil {
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errMissingAPIKey = apperror.New(apperror.Unauthenticated, "missing API key")
+	errInvalidAPIKey = apperror.New(apperror.InvalidCredentials, "invalid API key")
+)
+
+// OrganizerKeyResolver maps an organizer API key to its organizer
+type OrganizerKeyResolver interface {
+	ResolveAPIKey(apiKey string) (*models.Organizer, error)
+}
+
+// Credentials are what a caller presented: an API key, and the organizer a
+// platform key acts for. IP is where the call came from, for the security
+// log.
+type Credentials struct {
+	APIKey      string
+	OrganizerID string
+	IP          string
+}
+
+// ResolvePrincipal decides who a caller is from their credentials, for REST
+// and gRPC calls alike. The platform key grants cross-tenant access unless
+// it names an organizer to act for; organizer keys act for their organizer.
+// With authentication disabled every caller is the platform.
+func ResolvePrincipal(resolver OrganizerKeyResolver, cfg config.AuthConfig, creds Credentials, log *logger.Logger) (tenant.Principal, error) {
+	if !cfg.Enabled {
+		return tenant.Principal{Platform: true}, nil
+	}
+	if creds.APIKey == "" {
+		return tenant.Principal{}, errMissingAPIKey
+	}
+
+	if cfg.PlatformAPIKey != "" && subtle.ConstantTimeCompare([]byte(creds.APIKey), []byte(cfg.PlatformAPIKey)) == 1 {
+		if creds.OrganizerID != "" {
+			return tenant.Principal{OrganizerID: creds.OrganizerID}, nil
+		}
+		return tenant.Principal{Platform: true}, nil
+	}
+
+	org, err := resolver.ResolveAPIKey(creds.APIKey)
+	if err != nil {
+		log.LogSecurity("AUTH_FAILED", fmt.Sprintf("Rejected API key from IP %s: %v", creds.IP, err))
+		return tenant.Principal{}, errInvalidAPIKey
+	}
+	return tenant.Principal{OrganizerID: org.ID}, nil
+}
+
+// Authenticate resolves the caller from the X-API-Key header (or a Bearer
+// token) and stores the principal on the request context. The platform key
+// may narrow itself with X-Organizer-ID.
+func Authenticate(resolver OrganizerKeyResolver, cfg config.AuthConfig, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := ResolvePrincipal(resolver, cfg, Credentials{
+			APIKey:      apiKey(c),
+			OrganizerID: c.GetHeader("X-Organizer-ID"),
+			IP:          c.ClientIP(),
+		}, log)
+		if err != nil {
+			apperror.Abort(c, apperror.From(err))
+			return
+		}
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// AuthenticateSupport admits support staff to the admin console API. Only
+// support keys are accepted, so neither organizer nor platform keys reach
+// the console and support keys open nothing else. The staff member's name is
+// kept on the principal for the audit log.
+func AuthenticateSupport(cfg config.AuthConfig, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !cfg.Enabled {
+			setPrincipal(c, tenant.Principal{Platform: true})
+			c.Next()
+			return
+		}
+
+		key := apiKey(c)
+		if key == "" {
+			apperror.Abort(c, errMissingAPIKey)
+			return
+		}
+
+		staff := ""
+		for supportKey, name := range cfg.SupportAPIKeys {
+			if subtle.ConstantTimeCompare([]byte(key), []byte(supportKey)) == 1 {
+				staff = name
+			}
+		}
+		if staff == "" {
+			log.LogSecurity("AUTH_FAILED", fmt.Sprintf("Rejected admin console key from IP %s", c.ClientIP()))
+			apperror.Abort(c, errInvalidAPIKey)
+			return
+		}
+
+		setPrincipal(c, tenant.Principal{Platform: true, Staff: staff})
+		c.Next()
+	}
+}
+
+// RequirePlatform restricts a route group to platform principals.
+func RequirePlatform() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !tenant.IsPlatform(c.Request.Context()) {
+			apperror.Abort(c, apperror.New(apperror.Forbidden, "platform credentials required"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// apiKey is the key from the X-API-Key header or a Bearer token
+func apiKey(c *gin.Context) string {
+	if key := c.GetHeader("X-API-Key"); key != "" {
+		return key
+	}
+	return strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+}
+
+func setPrincipal(c *gin.Context, p tenant.Principal) {
+	c.Set("organizer_id", p.OrganizerID)
+	c.Request = c.Request.WithContext(tenant.WithPrincipal(c.Request.Context(), p))
+}
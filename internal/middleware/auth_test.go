@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type organizerKeys map[string]string
+
+func (k organizerKeys) ResolveAPIKey(apiKey string) (*models.Organizer, error) {
+	if id, ok := k[apiKey]; ok {
+		return &models.Organizer{ID: id}, nil
+	}
+	return nil, errors.New("unknown key")
+}
+
+var authConfig = config.AuthConfig{Enabled: true, PlatformAPIKey: "platform-key"}
+
+func TestResolvePrincipal(t *testing.T) {
+	keys := organizerKeys{"org-key": "org_1"}
+	tests := []struct {
+		name  string
+		cfg   config.AuthConfig
+		creds Credentials
+		want  tenant.Principal
+		err   error
+	}{
+		{"auth disabled", config.AuthConfig{}, Credentials{}, tenant.Principal{Platform: true}, nil},
+		{"no key", authConfig, Credentials{}, tenant.Principal{}, errMissingAPIKey},
+		{"platform key", authConfig, Credentials{APIKey: "platform-key"}, tenant.Principal{Platform: true}, nil},
+		{"platform key for an organizer", authConfig, Credentials{APIKey: "platform-key", OrganizerID: "org_2"}, tenant.Principal{OrganizerID: "org_2"}, nil},
+		{"organizer key", authConfig, Credentials{APIKey: "org-key"}, tenant.Principal{OrganizerID: "org_1"}, nil},
+		{"organizer key cannot pick an organizer", authConfig, Credentials{APIKey: "org-key", OrganizerID: "org_2"}, tenant.Principal{OrganizerID: "org_1"}, nil},
+		{"unknown key", authConfig, Credentials{APIKey: "guess"}, tenant.Principal{}, errInvalidAPIKey},
+	}
+	for _, tt := range tests {
+		got, err := ResolvePrincipal(keys, tt.cfg, tt.creds, logger.NewFileLogger())
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("%s: got %+v, %v; want %+v, %v", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestAuthenticateReadsHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	var got tenant.Principal
+	router.GET("/", Authenticate(organizerKeys{"org-key": "org_1"}, authConfig, logger.NewFileLogger()), func(c *gin.Context) {
+		got, _ = tenant.FromContext(c.Request.Context())
+	})
+
+	tests := []struct {
+		headers map[string]string
+		status  int
+		want    tenant.Principal
+	}{
+		{map[string]string{"X-API-Key": "org-key"}, http.StatusOK, tenant.Principal{OrganizerID: "org_1"}},
+		{map[string]string{"Authorization": "Bearer org-key"}, http.StatusOK, tenant.Principal{OrganizerID: "org_1"}},
+		{map[string]string{"X-API-Key": "platform-key", "X-Organizer-ID": "org_2"}, http.StatusOK, tenant.Principal{OrganizerID: "org_2"}},
+		{map[string]string{}, http.StatusUnauthorized, tenant.Principal{}},
+		{map[string]string{"X-API-Key": "guess"}, http.StatusUnauthorized, tenant.Principal{}},
+	}
+	for _, tt := range tests {
+		got = tenant.Principal{}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for name, value := range tt.headers {
+			req.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tt.status || got != tt.want {
+			t.Errorf("%v: status %d, principal %+v; want %d, %+v", tt.headers, w.Code, got, tt.status, tt.want)
+		}
+	}
+}
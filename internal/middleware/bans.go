@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errTooManyAttempts is the answer to banned IPs, the same as the rate
+// limiter's so a ban cannot be told apart from throttling
+var errTooManyAttempts = apperror.New(apperror.RateLimited, "too many attempts, try again later")
+
+// IPBanChecker reports whether an IP is serving a ban
+type IPBanChecker interface {
+	IsBanned(ctx context.Context, ip string) (bool, error)
+}
+
+// BlockBannedIPs refuses requests from banned IPs with the same generic
+// answer as any other throttled request. Lookup failures let the request
+// through so a Redis outage does not stop checkout.
+func BlockBannedIPs(checker IPBanChecker, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		banned, err := checker.IsBanned(c.Request.Context(), c.ClientIP())
+		if err == nil && banned {
+			log.LogSecurity("IP_BLOCKED", fmt.Sprintf("Request from banned IP %s to %s refused", c.ClientIP(), c.FullPath()))
+			apperror.Abort(c, errTooManyAttempts)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"payment-gateway/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type bannedIPs struct {
+	ips map[string]bool
+	err error
+}
+
+func (b bannedIPs) IsBanned(ctx context.Context, ip string) (bool, error) {
+	return b.ips[ip], b.err
+}
+
+func TestBlockBannedIPs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, tt := range []struct {
+		name    string
+		checker bannedIPs
+		ip      string
+		want    int
+	}{
+		{"banned", bannedIPs{ips: map[string]bool{"203.0.113.9": true}}, "203.0.113.9", http.StatusTooManyRequests},
+		{"not banned", bannedIPs{ips: map[string]bool{"203.0.113.9": true}}, "198.51.100.1", http.StatusOK},
+		{"lookup failed", bannedIPs{err: errors.New("redis down")}, "203.0.113.9", http.StatusOK},
+	} {
+		router := gin.New()
+		router.GET("/", BlockBannedIPs(tt.checker, logger.NewFileLogger()), func(c *gin.Context) { c.Status(http.StatusOK) })
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.ip + ":4242"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s: status %d, want %d", tt.name, w.Code, tt.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// cardSecretFields are never needed once a card is tokenized, wherever they
+// appear in a request.
+var cardSecretFields = map[string]bool{
+	"cvc":           true,
+	"cvv":           true,
+	"cvv2":          true,
+	"security_code": true,
+}
+
+// cardNumberFields hold a card number when a client sends one. Only these
+// are searched for card numbers, so IDs and amounts elsewhere that happen to
+// pass the Luhn check are left as sent.
+var cardNumberFields = map[string]bool{
+	"card_number": true,
+	"cardnumber":  true,
+	"card_no":     true,
+	"pan":         true,
+}
+
+// CardDataGuard keeps raw card data out of the service. JSON bodies carrying
+// a "card" object, or a card number sent as a JSON number, are rejected
+// unless raw-card test mode is on. Card numbers in string card number
+// fields and CVCs anywhere are redacted before handlers, logs or panics can
+// see them.
+func CardDataGuard(cfg config.CardDataConfig, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || !strings.Contains(c.GetHeader("Content-Type"), "application/json") {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, cfg.MaxBodyBytes+1))
+		if err != nil {
+			apperror.Abort(c, apperror.Wrap(apperror.InvalidRequest, "failed to read request body", err))
+			return
+		}
+		if int64(len(body)) > cfg.MaxBodyBytes {
+			apperror.Abort(c, apperror.New(apperror.RequestTooLarge, "request body exceeds the allowed size"))
+			return
+		}
+
+		var payload interface{}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&payload); err != nil {
+			// Not valid JSON; let the handler's binding report it
+			restoreBody(c, body)
+			c.Next()
+			return
+		}
+
+		guard := &cardScan{allowRawCards: cfg.RawCardTestMode}
+		payload = guard.scan(payload, "")
+		if guard.rawCard != "" {
+			log.LogSecurity("RAW_CARD_REJECTED", fmt.Sprintf("%s %s from IP %s sent raw card data in %s", c.Request.Method, c.Request.URL.Path, c.ClientIP(), guard.rawCard))
+			apperror.Abort(c, apperror.New(apperror.RawCardData, "tokenize the card with Stripe.js and send the PaymentMethod ID as token"))
+			return
+		}
+
+		if len(guard.redacted) > 0 {
+			log.LogSecurity("CARD_DATA_REDACTED", fmt.Sprintf("%s %s from IP %s: redacted %s", c.Request.Method, c.Request.URL.Path, c.ClientIP(), strings.Join(guard.redacted, ", ")))
+			if body, err = json.Marshal(payload); err != nil {
+				apperror.Abort(c, apperror.Wrap(apperror.InvalidRequest, "failed to redact request body", err))
+				return
+			}
+		}
+
+		restoreBody(c, body)
+		c.Next()
+	}
+}
+
+// cardScan walks a decoded JSON body. It records the path of a rejected
+// card object and the paths of every value it redacted, never the values.
+type cardScan struct {
+	allowRawCards bool
+	rawCard       string
+	redacted      []string
+}
+
+func (g *cardScan) scan(value interface{}, path string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := joinPath(path, key)
+			lower := strings.ToLower(key)
+
+			if _, isObject := child.(map[string]interface{}); isObject && lower == "card" {
+				if !g.allowRawCards {
+					g.rawCard = childPath
+					return value
+				}
+				continue // test mode: the card reaches Stripe's test environment as sent
+			}
+			if cardSecretFields[lower] {
+				v[key] = "[REDACTED]"
+				g.redacted = append(g.redacted, childPath)
+				continue
+			}
+			if cardNumberFields[lower] {
+				if g.cardNumber(v, key, child, childPath) {
+					return value
+				}
+				continue
+			}
+			v[key] = g.scan(child, childPath)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = g.scan(child, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+	return value
+}
+
+// cardNumber handles a card number field of object. String card numbers are
+// masked in place. A card number sent as a JSON number cannot be masked
+// without changing its type, so it is treated like a card object; cardNumber
+// reports whether the body is to be rejected for it.
+func (g *cardScan) cardNumber(object map[string]interface{}, key string, value interface{}, path string) bool {
+	switch v := value.(type) {
+	case string:
+		if utils.ContainsPAN(v) {
+			object[key] = utils.RedactPAN(v)
+			g.redacted = append(g.redacted, path)
+		}
+	case json.Number:
+		if utils.ContainsPAN(v.String()) && !g.allowRawCards {
+			g.rawCard = path
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func restoreBody(c *gin.Context, body []byte) {
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	c.Request.ContentLength = int64(len(body))
+}
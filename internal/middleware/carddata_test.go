@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// guardedBody sends body through CardDataGuard and returns the response and
+// the body the handler received
+func guardedBody(t *testing.T, cfg config.CardDataConfig, body string) (*httptest.ResponseRecorder, string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg.MaxBodyBytes = 1 << 20
+	router := gin.New()
+	var received string
+	router.POST("/", CardDataGuard(cfg, logger.NewFileLogger()), func(c *gin.Context) {
+		raw, _ := io.ReadAll(c.Request.Body)
+		received = string(raw)
+	})
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w, received
+}
+
+func TestCardDataGuardLeavesOtherFieldsAlone(t *testing.T) {
+	body := `{"order_id": 4242424242424242, "reference": "4242424242424242", "amount": 40}`
+	w, received := guardedBody(t, config.CardDataConfig{}, body)
+	if w.Code != http.StatusOK || received != body {
+		t.Errorf("status %d, handler got %s; want the body as sent", w.Code, received)
+	}
+}
+
+func TestCardDataGuardMasksCardNumberStrings(t *testing.T) {
+	w, received := guardedBody(t, config.CardDataConfig{}, `{"card_number": "4242 4242 4242 4242", "cvc": "123", "email": "a@example.com"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d", w.Code)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(received), &payload); err != nil {
+		t.Fatal(err)
+	}
+	number, isString := payload["card_number"].(string)
+	if !isString || strings.Contains(strings.ReplaceAll(number, " ", ""), "424242424242") || !strings.HasSuffix(number, "4242") {
+		t.Errorf("card_number = %#v", payload["card_number"])
+	}
+	if payload["cvc"] != "[REDACTED]" || payload["email"] != "a@example.com" {
+		t.Errorf("payload = %v", payload)
+	}
+}
+
+func TestCardDataGuardRejectsNumericCardNumbers(t *testing.T) {
+	body := `{"card_number": 4242424242424242}`
+	w, _ := guardedBody(t, config.CardDataConfig{}, body)
+	if w.Code == http.StatusOK || !strings.Contains(w.Body.String(), "raw_card_data") {
+		t.Errorf("status %d, body %s; want a raw_card_data problem", w.Code, w.Body)
+	}
+
+	// Test mode sends cards to Stripe's test environment as they are
+	w, received := guardedBody(t, config.CardDataConfig{RawCardTestMode: true}, body)
+	if w.Code != http.StatusOK || received != body {
+		t.Errorf("test mode: status %d, handler got %s", w.Code, received)
+	}
+}
+
+func TestCardDataGuardRejectsCardObjects(t *testing.T) {
+	w, _ := guardedBody(t, config.CardDataConfig{}, `{"payment": {"card": {"number": "4242424242424242", "exp_month": 12}}}`)
+	if w.Code == http.StatusOK || !strings.Contains(w.Body.String(), "raw_card_data") {
+		t.Errorf("status %d, body %s; want a raw_card_data problem", w.Code, w.Body)
+	}
+}
@@ -2,12 +2,13 @@ package middleware
 
 import (
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/time/rate"
+	"payment-gateway/internal/apperror"
 	"payment-gateway/internal/logger"
+	"payment-gateway/internal/utils"
 )
 
 func EnhancedLogger(log *logger.Logger) gin.HandlerFunc {
@@ -38,14 +39,10 @@ func EnhancedLogger(log *logger.Logger) gin.HandlerFunc {
 
 func Recovery(log *logger.Logger) gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
-		if err, ok := recovered.(string); ok {
-			log.Error("PANIC", fmt.Sprintf("Recovered from panic: %s", err))
-			c.String(http.StatusInternalServerError, fmt.Sprintf("error: %s", err))
-		} else {
-			log.Error("PANIC", fmt.Sprintf("Recovered from panic: %v", recovered))
-			c.String(http.StatusInternalServerError, "Internal server error")
-		}
-		c.AbortWithStatus(http.StatusInternalServerError)
+		// Panic values can carry request data; never let a card number reach the
+		// log, nor the panic reach the caller
+		log.Error("PANIC", utils.RedactPAN(fmt.Sprintf("Recovered from panic: %v", recovered)))
+		apperror.Abort(c, apperror.New(apperror.Internal, "an unexpected error occurred"))
 	})
 }
 
@@ -70,11 +67,8 @@ func RateLimit(log *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if !limiter.Allow() {
 			log.LogSecurity("RATE_LIMIT", fmt.Sprintf("Rate limit exceeded for IP: %s", c.ClientIP()))
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error": "Rate limit exceeded",
-				"retry_after": "1s",
-			})
-			c.Abort()
+			c.Header("Retry-After", "1")
+			apperror.Abort(c, apperror.New(apperror.RateLimited, "rate limit exceeded, retry after 1s"))
 			return
 		}
 		c.Next()
@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/utils"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+)
+
+// ValidateOpenAPI checks requests against the OpenAPI document, and their
+// responses when cfg.ValidateResponses is set. The document describes every
+// route, so requests it does not describe are refused when validation is
+// enforced. Event streams and WebSocket upgrades are never buffered for
+// response checks. Authentication is left to Authenticate.
+func ValidateOpenAPI(doc *openapi3.T, cfg config.OpenAPIConfig, log *logger.Logger) (gin.HandlerFunc, error) {
+	switch cfg.Validation {
+	case "", "off":
+		return func(c *gin.Context) { c.Next() }, nil
+	case "log", "enforce":
+	default:
+		return nil, fmt.Errorf("unknown OpenAPI validation mode %q", cfg.Validation)
+	}
+
+	// kin-openapi has no email format of its own; binding checks it properly
+	openapi3.DefineStringFormatValidator("email", openapi3.NewRegexpFormatValidator(openapi3.FormatOfStringForEmail))
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to route OpenAPI document: %w", err)
+	}
+	options := &openapi3filter.Options{
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		SkipSettingDefaults: true, // handlers apply their own defaults
+	}
+	options.WithCustomSchemaErrorFunc(func(err *openapi3.SchemaError) string {
+		return err.Reason
+	})
+	v := &contractValidator{
+		router:    router,
+		options:   options,
+		enforce:   cfg.Validation == "enforce",
+		responses: cfg.ValidateResponses,
+		log:       log,
+	}
+	return v.handle, nil
+}
+
+var errNotInContract = apperror.New(apperror.NotFound, "no such route")
+
+type contractValidator struct {
+	router    routers.Router
+	options   *openapi3filter.Options
+	enforce   bool
+	responses bool
+	log       *logger.Logger
+}
+
+func (v *contractValidator) handle(c *gin.Context) {
+	route, pathParams, err := v.router.FindRoute(c.Request)
+	if err != nil {
+		v.log.Warn("OPENAPI", fmt.Sprintf("Request %s %s is not in the contract", c.Request.Method, c.Request.URL.Path))
+		if v.enforce {
+			apperror.Abort(c, errNotInContract)
+			return
+		}
+		c.Next()
+		return
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    c.Request,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    v.options,
+	}
+	if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+		// Card data has not been redacted yet and schema errors can quote it
+		message := utils.RedactPAN(err.Error())
+		v.log.Warn("OPENAPI", fmt.Sprintf("Request %s %s breaks the contract: %s", c.Request.Method, c.Request.URL.Path, message))
+		if v.enforce {
+			apperror.Abort(c, apperror.New(apperror.InvalidRequest, message))
+			return
+		}
+	}
+
+	if !v.responses || c.IsWebsocket() || streams(route.Operation) {
+		c.Next()
+		return
+	}
+
+	original := c.Writer
+	buffered := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = buffered
+	c.Next()
+	c.Writer = original
+
+	err = openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 buffered.status,
+		Header:                 original.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(buffered.body.Bytes())),
+		Options:                v.options,
+	})
+	if err != nil {
+		message := utils.RedactPAN(err.Error())
+		v.log.Error("OPENAPI", fmt.Sprintf("Response %d to %s %s breaks the contract: %s", buffered.status, c.Request.Method, c.Request.URL.Path, message))
+		if v.enforce {
+			original.Header().Del("Content-Length")
+			apperror.Abort(c, apperror.New(apperror.Internal, "response does not match the API contract"))
+			return
+		}
+	}
+
+	original.WriteHeader(buffered.status)
+	_, _ = original.Write(buffered.body.Bytes())
+}
+
+// streams reports whether an operation answers with an event stream, which
+// has to reach the client as it is written
+func streams(op *openapi3.Operation) bool {
+	ok := op.Responses.Status(http.StatusOK)
+	return ok != nil && ok.Value != nil && ok.Value.Content.Get("text/event-stream") != nil
+}
+
+// bufferedWriter holds a handler's response so it can be checked before it
+// is sent
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.written
+}
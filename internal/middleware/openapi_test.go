@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"payment-gateway/api"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+func contractRouter(t *testing.T, cfg config.OpenAPIConfig) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	doc, err := api.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := ValidateOpenAPI(doc, cfg, logger.NewFileLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := gin.New()
+	router.Use(contract)
+	return router
+}
+
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestContractRefusesRoutesNotInIt(t *testing.T) {
+	router := contractRouter(t, config.OpenAPIConfig{Validation: "enforce"})
+	reached := false
+	router.GET("/api/v1/undocumented", func(c *gin.Context) { reached = true })
+
+	w := serve(router, http.MethodGet, "/api/v1/undocumented", "")
+	if w.Code != http.StatusNotFound || reached {
+		t.Errorf("enforce: status = %d, handler reached = %v", w.Code, reached)
+	}
+
+	router = contractRouter(t, config.OpenAPIConfig{Validation: "log"})
+	router.GET("/api/v1/undocumented", func(c *gin.Context) { reached = true })
+	serve(router, http.MethodGet, "/api/v1/undocumented", "")
+	if !reached {
+		t.Error("log mode stopped a request that is not in the contract")
+	}
+}
+
+func TestContractRejectsBrokenRequest(t *testing.T) {
+	router := contractRouter(t, config.OpenAPIConfig{Validation: "enforce"})
+	reached := false
+	router.POST("/api/v1/organizers", func(c *gin.Context) {
+		reached = true
+		c.Status(http.StatusCreated)
+	})
+
+	w := serve(router, http.MethodPost, "/api/v1/organizers", `{"name": "Evently"}`)
+	if w.Code != http.StatusBadRequest || reached {
+		t.Errorf("missing email: status = %d, handler reached = %v", w.Code, reached)
+	}
+	if !strings.Contains(w.Body.String(), "email") {
+		t.Errorf("problem does not name the field: %s", w.Body)
+	}
+
+	w = serve(router, http.MethodPost, "/api/v1/organizers", `{"name": "Evently", "email": "ops@example.com"}`)
+	if w.Code != http.StatusCreated {
+		t.Errorf("valid request: status = %d, body %s", w.Code, w.Body)
+	}
+}
+
+func TestContractReplacesBrokenResponse(t *testing.T) {
+	router := contractRouter(t, config.OpenAPIConfig{Validation: "enforce", ValidateResponses: true})
+	router.GET("/api/v1/payouts/:id", func(c *gin.Context) {
+		if c.Param("id") == "po_broken" {
+			c.JSON(http.StatusOK, utils.SuccessResponse("Payout retrieved", gin.H{"id": 42}))
+			return
+		}
+		c.JSON(http.StatusOK, utils.SuccessResponse("Payout retrieved", &models.Payout{
+			ID: "po_1", OrganizerID: "org_1", Currency: "usd", Amount: 90, SettlementCurrency: "usd",
+			SettlementAmount: 90, Method: models.PayoutStripeTransfer, Status: models.PayoutPaid,
+		}))
+	})
+
+	if w := serve(router, http.MethodGet, "/api/v1/payouts/po_1", ""); w.Code != http.StatusOK {
+		t.Errorf("payout: status = %d, body %s", w.Code, w.Body)
+	}
+	if w := serve(router, http.MethodGet, "/api/v1/payouts/po_broken", ""); w.Code != http.StatusInternalServerError {
+		t.Errorf("broken payout: status = %d, want 500", w.Code)
+	}
+}
+
+func TestContractDoesNotBufferStreams(t *testing.T) {
+	router := contractRouter(t, config.OpenAPIConfig{Validation: "enforce", ValidateResponses: true})
+	router.GET("/api/v1/payments/:id/stream", func(c *gin.Context) {
+		if _, buffered := c.Writer.(*bufferedWriter); buffered {
+			t.Error("event stream was buffered")
+		}
+		c.Header("Content-Type", "text/event-stream")
+		c.String(http.StatusOK, "event: status\ndata: {}\n\n")
+	})
+
+	if w := serve(router, http.MethodGet, "/api/v1/payments/pay_1/stream?token=t", ""); w.Code != http.StatusOK {
+		t.Errorf("status = %d, body %s", w.Code, w.Body)
+	}
+}
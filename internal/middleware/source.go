@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	maxRequestIDLength = 64
+	maxActorLength     = 100
+)
+
+// RequestSource tags each request with an ID, echoed back in X-Request-ID,
+// and stores it with the client IP on the request context for the audit
+// log. Callers may send their own X-Request-ID to correlate with their logs.
+func RequestSource() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-ID")
+		if !validRequestID(requestID) {
+			requestID = utils.GenerateRequestID()
+		}
+		c.Header("X-Request-ID", requestID)
+		c.Set("request_id", requestID)
+
+		c.Request = c.Request.WithContext(audit.WithSource(c.Request.Context(), audit.Source{
+			IP:         c.ClientIP(),
+			RequestID:  requestID,
+			OnBehalfOf: truncate(c.GetHeader("X-Actor"), maxActorLength),
+		}))
+		c.Next()
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func truncate(value string, n int) string {
+	if len(value) > n {
+		return value[:n]
+	}
+	return value
+}
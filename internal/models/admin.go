@@ -0,0 +1,32 @@
+package models
+
+// StatusOverrideRequest sets a payment's status by hand from the admin
+// console. The reason is kept in the audit log.
+type StatusOverrideRequest struct {
+	Status PaymentStatus `json:"status" binding:"required"`
+	Reason string        `json:"reason" binding:"required"`
+}
+
+// ForceRefundRequest refunds a payment from the admin console. Amount
+// defaults to the full payment.
+type ForceRefundRequest struct {
+	Amount *float64 `json:"amount,omitempty"`
+	Reason string   `json:"reason" binding:"required"`
+}
+
+// OTPActionRequest resets or resends an order's OTP from the admin console.
+type OTPActionRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// PaymentTimeline is everything support needs to answer a query about one
+// payment, gathered in one place.
+type PaymentTimeline struct {
+	Payment  *Payment        `json:"payment"`
+	Source   ReplaySource    `json:"source"` // where Events came from
+	Events   []*PaymentEvent `json:"events"`
+	Refunds  []*Refund       `json:"refunds"`
+	Disputes []*Dispute      `json:"disputes"`
+	Review   *RiskReview     `json:"risk_review,omitempty"`
+	Audit    []*AuditEntry   `json:"audit"`
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type AuditActorType string
+
+const (
+	ActorPlatform  AuditActorType = "platform"  // platform API key: Evently services and staff tooling
+	ActorOrganizer AuditActorType = "organizer" // an organizer's API key
+	ActorSupport   AuditActorType = "support"   // support staff using the admin console
+	ActorAttendee  AuditActorType = "attendee"  // unauthenticated checkout calls
+	ActorSystem    AuditActorType = "system"    // background jobs, Kafka and Stripe webhooks
+)
+
+// Audited actions
+const (
+	AuditPaymentStatusChanged   = "payment.status_changed"
+	AuditPaymentRefunded        = "payment.refunded"
+	AuditOTPVerified            = "otp.verified"
+	AuditOTPFailed              = "otp.failed"
+	AuditOTPReset               = "otp.reset"
+	AuditOTPResent              = "otp.resent"
+	AuditPaymentStatusOverride  = "payment.status_overridden"
+	AuditPaymentForceRefunded   = "payment.force_refunded"
+	AuditPaymentResynced        = "payment.resynced"
+	AuditStripeRefunded         = "stripe.refund_created"
+	AuditStripeConfirmed        = "stripe.payment_confirmed"
+	AuditOrganizerCreated       = "organizer.created"
+	AuditOrganizerUpdated       = "organizer.updated"
+	AuditOrganizerKeyRotated    = "organizer.api_key_rotated"
+	AuditFeePlanSaved           = "fee_plan.saved"
+	AuditPayoutCreated          = "payout.created"
+	AuditPayoutConfirmed        = "payout.confirmed"
+	AuditPayoutFailed           = "payout.failed"
+	AuditWebhookEndpointCreated = "webhook_endpoint.created"
+	AuditWebhookEndpointUpdated = "webhook_endpoint.updated"
+	AuditWebhookEndpointDeleted = "webhook_endpoint.deleted"
+	AuditWebhookSecretRotated   = "webhook_endpoint.secret_rotated"
+	AuditWebhookRedelivered     = "webhook_delivery.redelivered"
+	AuditEventsReplayed         = "events.replayed"
+	AuditOutboxDrained          = "events.outbox_drained"
+	AuditPaymentCancelled       = "payment.cancelled"
+	AuditRiskDenied             = "risk.denied"
+	AuditRiskHeld               = "risk.held"
+	AuditRiskApproved           = "risk_review.approved"
+	AuditRiskRejected           = "risk_review.rejected"
+	AuditRiskExpired            = "risk_review.expired"
+	AuditIPBanned               = "ip.banned"
+	AuditIPUnbanned             = "ip.unbanned"
+	AuditCheckoutCreated        = "checkout_session.created"
+	AuditCheckoutCancelled      = "checkout_session.cancelled"
+)
+
+// AuditEntry is one row of the append-only audit log. Entries form a hash
+// chain: PrevHash is the previous entry's Hash and Hash covers every field
+// of the entry, so editing, inserting or deleting a row breaks the chain
+// from that point on.
+type AuditEntry struct {
+	Seq         int64           `json:"seq"`
+	Actor       string          `json:"actor"`
+	ActorType   AuditActorType  `json:"actor_type"`
+	Action      string          `json:"action"`
+	TargetType  string          `json:"target_type"`
+	TargetID    string          `json:"target_id"`
+	PaymentID   string          `json:"payment_id,omitempty"`
+	OrderID     string          `json:"order_id,omitempty"`
+	OrganizerID string          `json:"organizer_id,omitempty"`
+	Before      json.RawMessage `json:"before,omitempty"`
+	After       json.RawMessage `json:"after,omitempty"`
+	SourceIP    string          `json:"source_ip,omitempty"`
+	RequestID   string          `json:"request_id,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	PrevHash    string          `json:"prev_hash"`
+	Hash        string          `json:"hash"`
+}
+
+// ComputeHash returns the SHA-256 of the entry's fields and PrevHash. The
+// fields are encoded as a JSON array of strings so no two entries share an
+// encoding. CreatedAt is hashed at the microsecond precision MySQL keeps.
+func (e *AuditEntry) ComputeHash() string {
+	fields := []string{
+		strconv.FormatInt(e.Seq, 10),
+		e.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		e.Actor,
+		string(e.ActorType),
+		e.Action,
+		e.TargetType,
+		e.TargetID,
+		e.PaymentID,
+		e.OrderID,
+		e.OrganizerID,
+		string(e.Before),
+		string(e.After),
+		e.SourceIP,
+		e.RequestID,
+		e.PrevHash,
+	}
+	canonical, _ := json.Marshal(fields)
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditFilter selects audit entries. From and To take RFC 3339 times.
+type AuditFilter struct {
+	Actor       string     `form:"actor"`
+	ActorType   string     `form:"actor_type"`
+	Action      string     `form:"action"`
+	TargetType  string     `form:"target_type"`
+	TargetID    string     `form:"target_id"`
+	PaymentID   string     `form:"payment_id"`
+	OrderID     string     `form:"order_id"`
+	OrganizerID string     `form:"organizer_id"`
+	From        *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit       int        `form:"limit"`
+	Offset      int        `form:"offset"`
+}
+
+// AuditVerification is the result of walking the hash chain. HeadHash is
+// worth keeping outside the database: a later verification that reaches
+// the same sequence number must report the same hash.
+type AuditVerification struct {
+	Valid      bool      `json:"valid"`
+	Checked    int64     `json:"checked"`
+	HeadSeq    int64     `json:"head_seq"`
+	HeadHash   string    `json:"head_hash"`
+	BrokenAt   int64     `json:"broken_at,omitempty"`
+	Problem    string    `json:"problem,omitempty"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAuditHashCoversEveryField(t *testing.T) {
+	entry := AuditEntry{
+		Seq: 7, Actor: "platform:ops", ActorType: ActorPlatform, Action: AuditPaymentRefunded, TargetType: "payment",
+		TargetID: "pay_1", PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1",
+		Before: json.RawMessage(`{"status":"success"}`), After: json.RawMessage(`{"status":"refunded"}`),
+		SourceIP: "203.0.113.7", RequestID: "req_1", CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 123456000, time.UTC), PrevHash: "abc",
+	}
+	hash := entry.ComputeHash()
+
+	for name, edit := range map[string]func(e *AuditEntry){
+		"seq":         func(e *AuditEntry) { e.Seq++ },
+		"actor":       func(e *AuditEntry) { e.Actor = "platform:eve" },
+		"action":      func(e *AuditEntry) { e.Action = AuditPaymentStatusChanged },
+		"target":      func(e *AuditEntry) { e.TargetID = "pay_2" },
+		"organizer":   func(e *AuditEntry) { e.OrganizerID = "org_2" },
+		"before":      func(e *AuditEntry) { e.Before = json.RawMessage(`{"status":"pending"}`) },
+		"after":       func(e *AuditEntry) { e.After = nil },
+		"source ip":   func(e *AuditEntry) { e.SourceIP = "198.51.100.1" },
+		"time":        func(e *AuditEntry) { e.CreatedAt = e.CreatedAt.Add(time.Microsecond) },
+		"chain":       func(e *AuditEntry) { e.PrevHash = "abd" },
+		"field split": func(e *AuditEntry) { e.TargetType, e.TargetID = "paymentpay_1", "" },
+	} {
+		edited := entry
+		edit(&edited)
+		if edited.ComputeHash() == hash {
+			t.Errorf("changing the %s keeps the hash", name)
+		}
+	}
+
+	// What MySQL drops and the zone it is read back in do not change it
+	stored := entry
+	stored.CreatedAt = entry.CreatedAt.Add(999 * time.Nanosecond).In(time.FixedZone("CEST", 2*60*60))
+	stored.Hash = "ignored"
+	if stored.ComputeHash() != hash {
+		t.Error("hash depends on sub-microsecond time, zone or the stored hash")
+	}
+}
@@ -0,0 +1,100 @@
+package models
+
+import "time"
+
+// CheckoutKind tells a hosted checkout for a purchase in progress from a
+// payment link an organizer shares
+type CheckoutKind string
+
+const (
+	CheckoutKindSession CheckoutKind = "session" // hosted checkout started by the ticketing frontend
+	CheckoutKindLink    CheckoutKind = "link"    // pay-by-link for group bookings and invoices
+)
+
+type CheckoutStatus string
+
+const (
+	CheckoutOpen       CheckoutStatus = "open"
+	CheckoutProcessing CheckoutStatus = "processing" // a payment is under way, such as one awaiting 3-D Secure
+	CheckoutCompleted  CheckoutStatus = "completed"
+	CheckoutExpired    CheckoutStatus = "expired"
+	CheckoutCancelled  CheckoutStatus = "cancelled"
+)
+
+// CheckoutSession is a checkout an attendee completes on the hosted
+// page behind URL. The session converts into a payment when it is paid;
+// PaymentID is its latest payment attempt.
+type CheckoutSession struct {
+	ID          string         `json:"id"`
+	Kind        CheckoutKind   `json:"kind"`
+	OrganizerID string         `json:"organizer_id"`
+	OrderID     string         `json:"order_id"`
+	Status      CheckoutStatus `json:"status"`
+	Currency    string         `json:"currency"`
+	Amount      float64        `json:"amount"`
+	LineItems   []*LineItem    `json:"line_items"`
+	Description string         `json:"description,omitempty"`
+	Reference   string         `json:"reference,omitempty"` // the organizer's booking or invoice number
+	Email       string         `json:"email,omitempty"`
+	SuccessURL  string         `json:"success_url,omitempty"`
+	CancelURL   string         `json:"cancel_url,omitempty"`
+	URL         string         `json:"url"`
+	PaymentID   string         `json:"payment_id,omitempty"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// CreateCheckoutRequest opens a hosted checkout or a payment link. Without
+// an order ID the session's own ID is used; without ExpiresInMinutes the
+// default for the kind applies.
+type CreateCheckoutRequest struct {
+	Kind             CheckoutKind       `json:"kind,omitempty" binding:"omitempty,oneof=session link"`
+	OrganizerID      string             `json:"organizer_id,omitempty"`
+	OrderID          string             `json:"order_id,omitempty" binding:"max=64"`
+	Currency         string             `json:"currency,omitempty"`
+	LineItems        []*LineItemRequest `json:"line_items" binding:"required,min=1,max=50,dive"`
+	Description      string             `json:"description,omitempty" binding:"max=255"`
+	Reference        string             `json:"reference,omitempty" binding:"max=100"`
+	Email            string             `json:"email,omitempty" binding:"omitempty,email"`
+	SuccessURL       string             `json:"success_url,omitempty" binding:"omitempty,url"`
+	CancelURL        string             `json:"cancel_url,omitempty" binding:"omitempty,url"`
+	ExpiresInMinutes int                `json:"expires_in_minutes,omitempty" binding:"gte=0"`
+}
+
+// CheckoutPage is what the hosted page shows the attendee. Organizer
+// internals such as the order ID are left out.
+type CheckoutPage struct {
+	ID            string         `json:"id"`
+	Status        CheckoutStatus `json:"status"`
+	OrganizerName string         `json:"organizer_name"`
+	Currency      string         `json:"currency"`
+	Amount        float64        `json:"amount"`
+	LineItems     []*LineItem    `json:"line_items"`
+	Description   string         `json:"description,omitempty"`
+	Reference     string         `json:"reference,omitempty"`
+	Email         string         `json:"email,omitempty"`
+	SuccessURL    string         `json:"success_url,omitempty"`
+	CancelURL     string         `json:"cancel_url,omitempty"`
+	ExpiresAt     time.Time      `json:"expires_at"`
+}
+
+// CheckoutPayRequest pays a checkout with a Stripe token from Stripe.js,
+// or raw card details in test mode
+type CheckoutPayRequest struct {
+	Token          string             `json:"token,omitempty"`
+	Card           *StripeCardDetails `json:"card,omitempty"`
+	Email          string             `json:"email,omitempty" binding:"omitempty,email"`
+	BillingCountry string             `json:"billing_country,omitempty" binding:"omitempty,len=2"`
+	ReturnURL      string             `json:"return_url,omitempty" binding:"omitempty,url"`
+	CaptchaToken   string             `json:"captcha_token,omitempty"` // required once the caller has tried too many cards
+}
+
+// CheckoutPayResult is the checkout after a payment attempt. Payment is
+// set when an attempt was made; a payment that needs 3-D Secure carries
+// the next action and the checkout stays processing until it is confirmed.
+type CheckoutPayResult struct {
+	Checkout *CheckoutPage          `json:"checkout"`
+	Payment  *StripePaymentResponse `json:"payment,omitempty"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// Customer links one of our users (an attendee) to their Stripe Customer,
+// which holds the cards they have saved for later purchases.
+type Customer struct {
+	UserID           string    `json:"user_id"`
+	StripeCustomerID string    `json:"stripe_customer_id"`
+	Email            string    `json:"email,omitempty"`
+	Name             string    `json:"name,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// CustomerRequest creates the Stripe Customer for a user, or refreshes its
+// contact details if one already exists
+type CustomerRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Email  string `json:"email,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// SavedPaymentMethod is a card saved on a customer. Only display details
+// are returned; the card itself stays with Stripe.
+type SavedPaymentMethod struct {
+	ID        string `json:"id"`
+	Brand     string `json:"brand"`
+	Last4     string `json:"last4"`
+	ExpMonth  int64  `json:"exp_month"`
+	ExpYear   int64  `json:"exp_year"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// SetupIntentResponse carries what the frontend needs to collect and
+// authenticate a card with Stripe.js so it can be charged off-session later
+type SetupIntentResponse struct {
+	SetupIntentID string `json:"setup_intent_id"`
+	ClientSecret  string `json:"client_secret"`
+	Status        string `json:"status"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Dispute mirrors a Stripe dispute (chargeback) on one of our payments.
+// Status is Stripe's dispute status, e.g. needs_response, under_review,
+// won or lost.
+type Dispute struct {
+	ID                  string     `json:"id"`
+	PaymentID           string     `json:"payment_id"`
+	OrganizerID         string     `json:"organizer_id"`
+	ChargeID            string     `json:"charge_id"`
+	PaymentIntentID     string     `json:"payment_intent_id"`
+	Amount              float64    `json:"amount"`
+	Currency            string     `json:"currency"`
+	Reason              string     `json:"reason"`
+	Status              string     `json:"status"`
+	EvidenceDueBy       *time.Time `json:"evidence_due_by,omitempty"`
+	EvidenceSubmittedAt *time.Time `json:"evidence_submitted_at,omitempty"`
+	ReminderSentAt      *time.Time `json:"-"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	ClosedAt            *time.Time `json:"closed_at,omitempty"`
+}
+
+// DisputeEvidenceRequest is the evidence an organizer provides for a
+// dispute. It is accepted as JSON or multipart form; the form may carry an
+// attendance_records file.
+type DisputeEvidenceRequest struct {
+	ProductDescription string `json:"product_description" form:"product_description"`
+	CustomerName       string `json:"customer_name" form:"customer_name"`
+	CustomerEmail      string `json:"customer_email" form:"customer_email"`
+	ServiceDate        string `json:"service_date" form:"service_date"`         // event date
+	TicketScanLog      string `json:"ticket_scan_log" form:"ticket_scan_log"`   // entry scans for the disputed tickets
+	AttendanceNotes    string `json:"attendance_notes" form:"attendance_notes"` // anything else that shows the attendee was there
+	Submit             bool   `json:"submit" form:"submit"`                     // false stages the evidence without sending it to the bank
+}
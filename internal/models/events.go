@@ -0,0 +1,91 @@
+package models
+
+import "time"
+
+// EventLogEntry is a payment event as recorded in the event log when it was
+// first published. Published is false when the Kafka publish failed.
+type EventLogEntry struct {
+	ID          string        `json:"id"`
+	Type        string        `json:"type"`
+	PaymentID   string        `json:"payment_id"`
+	OrderID     string        `json:"order_id,omitempty"`
+	OrganizerID string        `json:"organizer_id,omitempty"`
+	Event       *PaymentEvent `json:"event"`
+	Published   bool          `json:"published"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+// EventFilter selects events by payment, order or time window. Empty fields
+// do not filter.
+type EventFilter struct {
+	PaymentID  string     `json:"payment_id,omitempty" form:"payment_id"`
+	OrderID    string     `json:"order_id,omitempty" form:"order_id"`
+	From       *time.Time `json:"from,omitempty" form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         *time.Time `json:"to,omitempty" form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	EventTypes []string   `json:"event_types,omitempty" form:"event_type"`
+	Limit      int        `json:"limit,omitempty" form:"limit"`
+}
+
+type ReplaySource string
+
+const (
+	ReplayFromLog     ReplaySource = "log"     // events exactly as they were first published
+	ReplayFromHistory ReplaySource = "history" // regenerated from payments, refunds and disputes
+)
+
+// ReplayRequest republishes past events to Kafka. At least one of
+// PaymentID, OrderID or a From/To window is required.
+type ReplayRequest struct {
+	EventFilter
+	Source ReplaySource `json:"source,omitempty"`  // defaults to log
+	DryRun bool         `json:"dry_run,omitempty"` // list the events without publishing
+}
+
+// OutboxDrainResult reports a pass over the events whose Kafka publish
+// failed when they happened.
+type OutboxDrainResult struct {
+	Pending   int             `json:"pending"`
+	Published int             `json:"published"`
+	Failed    int             `json:"failed"`
+	Events    []*PaymentEvent `json:"events"`
+}
+
+type ReplayResult struct {
+	Source    ReplaySource    `json:"source"`
+	DryRun    bool            `json:"dry_run"`
+	Matched   int             `json:"matched"`
+	Published int             `json:"published"`
+	Failed    int             `json:"failed"`
+	Events    []*PaymentEvent `json:"events"`
+}
+
+// StatusFeed follows a payment's events for a status stream. Events is
+// closed if the subscriber falls behind; Stop must be called when done.
+type StatusFeed struct {
+	Payment *Payment        // as it was when the feed opened
+	Missed  []*PaymentEvent // logged after the subscriber's last event ID
+	Events  <-chan *PaymentEvent
+	Stop    func()
+}
+
+// StatusUpdateSnapshot is the type of the update giving a payment's status
+// when a stream opens. It carries no event ID.
+const StatusUpdateSnapshot = "snapshot"
+
+// StatusUpdate is one message of a payment status stream
+type StatusUpdate struct {
+	EventID   string        `json:"event_id,omitempty"`
+	Type      string        `json:"type"`
+	PaymentID string        `json:"payment_id"`
+	OrderID   string        `json:"order_id,omitempty"`
+	Status    PaymentStatus `json:"status"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// StreamToken opens one payment's or order's status stream without an API
+// key. Browsers pass it as ?token= because EventSource and WebSocket
+// cannot set headers.
+type StreamToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// FeeTier applies to payments up to UpTo (inclusive). The last tier may
+// leave UpTo at zero to cover every larger amount.
+type FeeTier struct {
+	UpTo    float64 `json:"up_to"`
+	Percent float64 `json:"percent"`
+	Fixed   float64 `json:"fixed"`
+}
+
+// FeePlan describes Evently's commission: a percentage plus a fixed amount
+// per payment, optionally varying by payment size.
+type FeePlan struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Percent   float64   `json:"percent"`
+	Fixed     float64   `json:"fixed"`
+	Tiers     []FeeTier `json:"tiers,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FeePlanRequest struct {
+	ID      string    `json:"id" binding:"required"`
+	Name    string    `json:"name" binding:"required"`
+	Percent float64   `json:"percent" binding:"gte=0,lte=100"`
+	Fixed   float64   `json:"fixed" binding:"gte=0"`
+	Tiers   []FeeTier `json:"tiers,omitempty"`
+}
+
+type FeeLineKind string
+
+const (
+	FeeLineCommission FeeLineKind = "platform_commission"
+	FeeLineReversal   FeeLineKind = "platform_commission_reversal"
+)
+
+// FeeLine is one platform fee movement on a payment. Reversals are negative.
+type FeeLine struct {
+	ID        int64       `json:"id"`
+	PaymentID string      `json:"payment_id"`
+	Kind      FeeLineKind `json:"kind"`
+	PlanID    string      `json:"plan_id"`
+	Reference string      `json:"reference"`
+	Amount    float64     `json:"amount"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// FeeBreakdown is the split of a payment between Evently and the organizer
+type FeeBreakdown struct {
+	PaymentID string  `json:"payment_id,omitempty"`
+	PlanID    string  `json:"plan_id"`
+	Gross     float64 `json:"gross"`
+	Percent   float64 `json:"percent"`
+	Fixed     float64 `json:"fixed"`
+	Fee       float64 `json:"fee"`
+	Net       float64 `json:"net"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// FXSnapshot records the exchange rate used when money moved, so reports can
+// convert to the base currency later and always get the same answer. Rate
+// is units of BaseCurrency per one unit of the transaction currency.
+type FXSnapshot struct {
+	BaseCurrency string    `json:"base_currency"`
+	Rate         float64   `json:"rate"`
+	Source       string    `json:"source"`
+	AsOf         time.Time `json:"as_of"`
+}
+
+// ToBase converts an amount in the transaction currency to the base
+// currency, rounded to the base currency's smallest unit.
+func (s *FXSnapshot) ToBase(amount float64) float64 {
+	return RoundToMinor(amount*s.Rate, s.BaseCurrency)
+}
+
+// Refund is money returned on a payment. ID is the provider's refund ID for
+// Stripe refunds. LineItems is set when the refund targeted line items.
+type Refund struct {
+	ID          string              `json:"id"`
+	PaymentID   string              `json:"payment_id"`
+	OrganizerID string              `json:"organizer_id"`
+	Amount      float64             `json:"amount"`
+	Currency    string              `json:"currency"`
+	Reason      string              `json:"reason,omitempty"`
+	LineItems   []*RefundedLineItem `json:"line_items,omitempty"`
+	FX          *FXSnapshot         `json:"fx,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
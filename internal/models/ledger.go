@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// LedgerAccount names one of the fixed accounts of the double-entry ledger
+type LedgerAccount string
+
+const (
+	AccountCustomerClearing LedgerAccount = "customer_clearing" // funds collected and held at the processor
+	AccountOrganizerPayable LedgerAccount = "organizer_payable" // owed to event organizers
+	AccountPlatformRevenue  LedgerAccount = "platform_revenue"  // Evently commission
+	AccountProcessorFees    LedgerAccount = "processor_fees"    // fees charged by Stripe
+	AccountRefunds          LedgerAccount = "refunds"           // money returned to attendees
+	AccountDisputes         LedgerAccount = "disputes"          // funds withdrawn by chargebacks
+)
+
+// JournalEntry is an immutable, balanced set of ledger lines. Reference is
+// unique so the same business event is never posted twice.
+type JournalEntry struct {
+	ID          string         `json:"id"`
+	Kind        string         `json:"kind"`
+	Reference   string         `json:"reference"`
+	PaymentID   string         `json:"payment_id,omitempty"`
+	OrderID     string         `json:"order_id,omitempty"`
+	Currency    string         `json:"currency"`
+	Description string         `json:"description,omitempty"`
+	Lines       []*JournalLine `json:"lines"`
+	PostedAt    time.Time      `json:"posted_at"`
+}
+
+// JournalLine debits or credits one account. Amounts are in minor units.
+type JournalLine struct {
+	ID          int64         `json:"id"`
+	EntryID     string        `json:"entry_id"`
+	Account     LedgerAccount `json:"account"`
+	OrganizerID string        `json:"organizer_id,omitempty"`
+	Debit       int64         `json:"debit"`
+	Credit      int64         `json:"credit"`
+}
+
+// AccountBalance is the net position of an account (debits minus credits)
+type AccountBalance struct {
+	Account     LedgerAccount `json:"account"`
+	OrganizerID string        `json:"organizer_id,omitempty"`
+	Currency    string        `json:"currency"`
+	Debits      int64         `json:"debits"`
+	Credits     int64         `json:"credits"`
+	Balance     int64         `json:"balance"`
+}
+
+// LedgerInvariantReport is the result of verifying that all journals sum to zero
+type LedgerInvariantReport struct {
+	Balanced          bool      `json:"balanced"`
+	EntriesChecked    int       `json:"entries_checked"`
+	TotalDebits       int64     `json:"total_debits"`
+	TotalCredits      int64     `json:"total_credits"`
+	UnbalancedEntries []string  `json:"unbalanced_entries,omitempty"`
+	CheckedAt         time.Time `json:"checked_at"`
+}
@@ -0,0 +1,57 @@
+package models
+
+type LineItemKind string
+
+const (
+	LineItemTicket LineItemKind = "ticket"
+	LineItemFee    LineItemKind = "fee" // booking or service fee charged to the attendee
+	LineItemTax    LineItemKind = "tax" // tax charged on the order as a whole
+)
+
+// LineItem is one line of what the attendee pays for, such as three
+// tickets of a tier. Amount is UnitAmount times Quantity, less Discount,
+// plus Tax and Fee. Lines of a payment carry an ID so refunds can name
+// them, and track how much of them has been refunded.
+type LineItem struct {
+	ID               string       `json:"id,omitempty"`
+	Kind             LineItemKind `json:"kind"`
+	SKU              string       `json:"sku,omitempty"`
+	Name             string       `json:"name"`
+	TicketTier       string       `json:"ticket_tier,omitempty"`
+	UnitAmount       float64      `json:"unit_amount"`
+	Quantity         int          `json:"quantity"`
+	Discount         float64      `json:"discount,omitempty"`
+	Tax              float64      `json:"tax,omitempty"`
+	Fee              float64      `json:"fee,omitempty"`
+	Amount           float64      `json:"amount"`
+	RefundedQuantity int          `json:"refunded_quantity,omitempty"`
+	RefundedAmount   float64      `json:"refunded_amount,omitempty"`
+}
+
+// LineItemRequest is a line of a checkout or payment. Discount, Tax and Fee
+// are totals for the line, not per unit.
+type LineItemRequest struct {
+	Kind       LineItemKind `json:"kind,omitempty" binding:"omitempty,oneof=ticket fee tax"` // defaults to ticket
+	SKU        string       `json:"sku,omitempty" binding:"max=100"`
+	Name       string       `json:"name" binding:"required,max=255"`
+	TicketTier string       `json:"ticket_tier,omitempty" binding:"max=100"`
+	UnitAmount float64      `json:"unit_amount" binding:"gte=0"`
+	Quantity   int          `json:"quantity" binding:"required,gte=1,lte=100"`
+	Discount   float64      `json:"discount,omitempty" binding:"gte=0"`
+	Tax        float64      `json:"tax,omitempty" binding:"gte=0"`
+	Fee        float64      `json:"fee,omitempty" binding:"gte=0"`
+}
+
+// RefundLineItemRequest refunds Quantity units of a payment line item, or
+// all of its unrefunded units when Quantity is zero
+type RefundLineItemRequest struct {
+	LineItemID string `json:"line_item_id" binding:"required"`
+	Quantity   int    `json:"quantity,omitempty" binding:"gte=0"`
+}
+
+// RefundedLineItem is the part of a payment line item a refund returned
+type RefundedLineItem struct {
+	LineItemID string  `json:"line_item_id"`
+	Quantity   int     `json:"quantity"`
+	Amount     float64 `json:"amount"`
+}
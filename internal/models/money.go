@@ -0,0 +1,39 @@
+package models
+
+import (
+	"math"
+	"strings"
+)
+
+// zeroDecimal lists the currencies Stripe charges in whole units, with no
+// minor unit to convert to.
+var zeroDecimal = map[string]bool{
+	"bif": true, "clp": true, "djf": true, "gnf": true, "jpy": true, "kmf": true,
+	"krw": true, "mga": true, "pyg": true, "rwf": true, "ugx": true, "vnd": true,
+	"vuv": true, "xaf": true, "xof": true, "xpf": true,
+}
+
+// MinorUnits is how many of a currency's smallest unit make one unit:
+// 100 cents to the euro, but one yen to the yen.
+func MinorUnits(currency string) float64 {
+	if zeroDecimal[strings.ToLower(currency)] {
+		return 1
+	}
+	return 100
+}
+
+// ToMinor converts an amount to the currency's smallest unit, rounding to
+// the nearest so 19.99 is 1999 cents rather than 1998.
+func ToMinor(amount float64, currency string) int64 {
+	return int64(math.Round(amount * MinorUnits(currency)))
+}
+
+// FromMinor converts an amount in the currency's smallest unit back to units.
+func FromMinor(amount int64, currency string) float64 {
+	return float64(amount) / MinorUnits(currency)
+}
+
+// RoundToMinor rounds an amount to the currency's smallest unit.
+func RoundToMinor(amount float64, currency string) float64 {
+	return FromMinor(ToMinor(amount, currency), currency)
+}
@@ -0,0 +1,41 @@
+package models
+
+import "testing"
+
+func TestAmountsRoundToMinorUnits(t *testing.T) {
+	for _, tt := range []struct {
+		amount   float64
+		currency string
+		want     int64
+	}{
+		{19.99, "usd", 1999},
+		{0.1 + 0.2, "eur", 30},
+		{1.005, "eur", 100},
+		{2.675, "gbp", 268},
+		{1e6, "usd", 1e8},
+		{1000, "jpy", 1000},
+		{1000, "JPY", 1000},
+		{15000.4, "krw", 15000},
+	} {
+		if got := ToMinor(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("ToMinor(%v, %s) = %d, want %d", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+	if got := FromMinor(1000, "jpy"); got != 1000 {
+		t.Errorf("FromMinor(1000, jpy) = %v, want 1000", got)
+	}
+	if got := FromMinor(1999, "usd"); got != 19.99 {
+		t.Errorf("FromMinor(1999, usd) = %v, want 19.99", got)
+	}
+}
+
+func TestToBaseRoundsToTheBaseCurrency(t *testing.T) {
+	toYen := &FXSnapshot{BaseCurrency: "jpy", Rate: 161.37}
+	if got := toYen.ToBase(10); got != 1614 {
+		t.Errorf("10 eur in jpy = %v, want 1614", got)
+	}
+	toEuro := &FXSnapshot{BaseCurrency: "eur", Rate: 0.0062}
+	if got := toEuro.ToBase(1614); got != 10.01 {
+		t.Errorf("1614 jpy in eur = %v, want 10.01", got)
+	}
+}
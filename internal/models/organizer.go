@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+type OrganizerStatus string
+
+const (
+	OrganizerActive    OrganizerStatus = "active"
+	OrganizerSuspended OrganizerStatus = "suspended"
+)
+
+// Organizer is an event organizer (merchant) that owns payments
+type Organizer struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Email           string `json:"email"`
+	StripeAccountID string `json:"stripe_account_id,omitempty"` // Stripe Connect account
+	DefaultCurrency string `json:"default_currency"`
+	// SettlementCurrency is what payouts are converted to before they are
+	// sent; defaults to DefaultCurrency
+	SettlementCurrency string          `json:"settlement_currency"`
+	FeePlan            string          `json:"fee_plan"`
+	PayoutSchedule     PayoutSchedule  `json:"payout_schedule"`
+	EventEndsAt        *time.Time      `json:"event_ends_at,omitempty"` // end of the latest scheduled event
+	Status             OrganizerStatus `json:"status"`
+	APIKeyHash         string          `json:"-"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+type CreateOrganizerRequest struct {
+	ID                 string `json:"id,omitempty"` // optional, reuse the ID from the event service
+	Name               string `json:"name" binding:"required"`
+	Email              string `json:"email" binding:"required,email"`
+	StripeAccountID    string `json:"stripe_account_id,omitempty"`
+	DefaultCurrency    string `json:"default_currency,omitempty"`
+	SettlementCurrency string `json:"settlement_currency,omitempty"`
+	FeePlan            string `json:"fee_plan,omitempty"`
+	PayoutSchedule     string `json:"payout_schedule,omitempty"`
+}
+
+// UpdateOrganizerRequest only changes the fields that are present
+type UpdateOrganizerRequest struct {
+	Name               *string          `json:"name,omitempty"`
+	Email              *string          `json:"email,omitempty" binding:"omitempty,email"`
+	StripeAccountID    *string          `json:"stripe_account_id,omitempty"`
+	DefaultCurrency    *string          `json:"default_currency,omitempty"`
+	SettlementCurrency *string          `json:"settlement_currency,omitempty"`
+	FeePlan            *string          `json:"fee_plan,omitempty"`
+	PayoutSchedule     *PayoutSchedule  `json:"payout_schedule,omitempty"`
+	EventEndsAt        *time.Time       `json:"event_ends_at,omitempty"`
+	Status             *OrganizerStatus `json:"status,omitempty"`
+}
+
+// OrganizerCredentials is returned once when an API key is issued
+type OrganizerCredentials struct {
+	Organizer *Organizer `json:"organizer"`
+	APIKey    string     `json:"api_key"`
+}
@@ -1,6 +1,10 @@
 package models
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -12,21 +16,59 @@ const (
 	StatusFailed    PaymentStatus = "failed"
 	StatusRefunded  PaymentStatus = "refunded"
 	StatusCancelled PaymentStatus = "cancelled"
+	StatusDisputed  PaymentStatus = "disputed"  // the cardholder opened a chargeback
+	StatusInReview  PaymentStatus = "in_review" // held by the risk engine for manual review
 )
 
+// StaleFilter selects payments that have sat in one status since before a
+// cutoff, oldest first. Stripe picks the payments backed by a PaymentIntent,
+// or all the others. AfterDate and AfterID continue from the last payment
+// of the previous page.
+type StaleFilter struct {
+	Status    PaymentStatus
+	Before    time.Time
+	Stripe    bool
+	AfterDate time.Time
+	AfterID   string
+	Limit     int
+}
+
+// Settled reports whether the attendee's checkout has completed or ended,
+// so the payment no longer changes on its own.
+func (s PaymentStatus) Settled() bool {
+	return s != StatusPending && s != StatusInReview
+}
+
 type Payment struct {
-	PaymentID string        `json:"payment_id"`
-	OrderID   string        `json:"order_id"`
-	Status    PaymentStatus `json:"status"`
-	Price     float64       `json:"price"`
-	Date      time.Time     `json:"date"`
+	PaymentID     string        `json:"payment_id"`
+	OrganizerID   string        `json:"organizer_id"`
+	OrderID       string        `json:"order_id"`
+	Status        PaymentStatus `json:"status"`
+	Price         float64       `json:"price"`
+	Currency      string        `json:"currency"`
+	Date          time.Time     `json:"date"`
+	TransactionID string        `json:"transaction_id,omitempty"` // Stripe PaymentIntent ID for card payments
+	Email         string        `json:"email,omitempty"`          // the attendee's, for support lookups and OTP mail
+	FX            *FXSnapshot   `json:"fx,omitempty"`             // rate to the base currency when the payment was made
+	LineItems     []*LineItem   `json:"line_items,omitempty"`     // only loaded when a single payment is read
 }
 type PaymentRequest struct {
-	PaymentID string        `json:"payment_id"`
-	OrderID   string        `json:"order_id"`
-	Status    PaymentStatus `json:"status"`
-	Price     float64       `json:"price"`
-	Date      time.Time     `json:"date"`
+	PaymentID     string        `json:"payment_id"`
+	OrganizerID   string        `json:"organizer_id"`
+	OrderID       string        `json:"order_id"`
+	Status        PaymentStatus `json:"status"`
+	Price         float64       `json:"price"`
+	Currency      string        `json:"currency,omitempty"` // defaults to the organizer's currency
+	Date          time.Time     `json:"date"`
+	TransactionID string        `json:"transaction_id,omitempty"`
+
+	// Risk signals; the client IP is taken from the request
+	Email          string `json:"email,omitempty"`
+	BillingCountry string `json:"billing_country,omitempty"`
+	TicketCount    int    `json:"ticket_count,omitempty"`
+
+	// Optional itemization; the lines must add up to Price
+	LineItems []*LineItemRequest `json:"line_items,omitempty" binding:"omitempty,max=100,dive"`
 }
 type PaymentResponse struct {
 	ID            string        `json:"id"`
@@ -38,22 +80,75 @@ type PaymentResponse struct {
 	CreatedAt     time.Time     `json:"created_at"`
 }
 
+// PaymentEvent is published to Kafka and to webhook endpoints. EventID is
+// stable across replays so consumers can drop events they have seen.
 type PaymentEvent struct {
+	EventID   string    `json:"event_id,omitempty"`
 	Type      string    `json:"type"`
 	PaymentID string    `json:"payment_id"`
 	Payment   *Payment  `json:"payment"`
+	Dispute   *Dispute  `json:"dispute,omitempty"`
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// RefundRequest refunds all of a payment, Amount of it when set, or the
+// given line items of an itemized payment
 type RefundRequest struct {
-	Amount string `json:"amount,omitempty"`
-	Reason string `json:"reason"`
+	Amount    *float64                 `json:"amount,omitempty"`
+	Reason    string                   `json:"reason"`
+	LineItems []*RefundLineItemRequest `json:"line_items,omitempty" binding:"omitempty,max=100,dive"`
+}
+
+// UnmarshalJSON also accepts the amount as a numeric string, which older
+// clients still send.
+func (r *RefundRequest) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Amount    json.RawMessage          `json:"amount"`
+		Reason    string                   `json:"reason"`
+		LineItems []*RefundLineItemRequest `json:"line_items"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Amount, r.Reason, r.LineItems = nil, raw.Reason, raw.LineItems
+
+	amount := bytes.TrimSpace(raw.Amount)
+	if len(amount) == 0 || bytes.Equal(amount, []byte("null")) {
+		return nil
+	}
+	if amount[0] == '"' {
+		var text string
+		if err := json.Unmarshal(amount, &text); err != nil {
+			return err
+		}
+		if text == "" {
+			return nil
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return fmt.Errorf("invalid refund amount %q", text)
+		}
+		r.Amount = &value
+		return nil
+	}
+
+	var value float64
+	if err := json.Unmarshal(amount, &value); err != nil {
+		return err
+	}
+	r.Amount = &value
+	return nil
 }
 
-var Req struct {
+type OTPRequest struct {
 	Email string `json:"email" binding:"required,email"`
 }
 
+// OTPSentResponse tells the attendee how long the mailed OTP stays valid
+type OTPSentResponse struct {
+	TTLSeconds int `json:"ttl_seconds"`
+}
+
 type ValidateOTPRequest struct {
 	OrderID string `json:"order_id" binding:"required"`
 	OTP     string `json:"otp" binding:"required"`
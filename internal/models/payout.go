@@ -0,0 +1,106 @@
+package models
+
+import "time"
+
+// PayoutSchedule controls how often an organizer's balance is paid out
+type PayoutSchedule string
+
+const (
+	PayoutDaily      PayoutSchedule = "daily"
+	PayoutWeekly     PayoutSchedule = "weekly"
+	PayoutAfterEvent PayoutSchedule = "after_event" // once the organizer's event has ended
+	PayoutManual     PayoutSchedule = "manual"      // only when triggered by the platform
+	// PayoutDestination sends each charge straight to the organizer's
+	// Connect account, less the commission, instead of holding it for a
+	// scheduled payout
+	PayoutDestination PayoutSchedule = "destination"
+)
+
+type PayoutMethod string
+
+const (
+	PayoutStripeTransfer PayoutMethod = "stripe_transfer" // Stripe Connect transfer
+	PayoutBankExport     PayoutMethod = "bank_export"     // exported for a manual bank transfer
+)
+
+type PayoutStatus string
+
+const (
+	PayoutPending PayoutStatus = "pending" // waiting for the bank transfer to be confirmed
+	PayoutPaid    PayoutStatus = "paid"
+	PayoutFailed  PayoutStatus = "failed"
+)
+
+// Payout is one settlement of an organizer's balance. Available is what the
+// organizer was owed at the time; Reserve is held back for refund risk on
+// events that have not happened yet. Amount is in Currency, the currency the
+// balance was earned in; SettlementAmount is what the organizer receives in
+// their settlement currency, converted at the FX snapshot rate.
+type Payout struct {
+	ID                 string       `json:"id"`
+	OrganizerID        string       `json:"organizer_id"`
+	Currency           string       `json:"currency"`
+	Available          float64      `json:"available"`
+	Reserve            float64      `json:"reserve"`
+	Amount             float64      `json:"amount"`
+	SettlementCurrency string       `json:"settlement_currency"`
+	SettlementAmount   float64      `json:"settlement_amount"`
+	FX                 *FXSnapshot  `json:"fx,omitempty"`
+	Method             PayoutMethod `json:"method"`
+	Status             PayoutStatus `json:"status"`
+	ProviderReference  string       `json:"provider_reference,omitempty"`
+	FailureReason      string       `json:"failure_reason,omitempty"`
+	PeriodStart        time.Time    `json:"period_start"`
+	PeriodEnd          time.Time    `json:"period_end"`
+	CreatedAt          time.Time    `json:"created_at"`
+	PaidAt             *time.Time   `json:"paid_at,omitempty"`
+}
+
+// PayoutRunRequest triggers payouts outside the schedule. Without an
+// organizer every organizer that is due is paid.
+type PayoutRunRequest struct {
+	OrganizerID string `json:"organizer_id,omitempty"`
+	Force       bool   `json:"force,omitempty"` // ignore the organizer's schedule
+}
+
+type ConfirmPayoutRequest struct {
+	Reference string `json:"reference" binding:"required"` // bank transfer reference
+}
+
+type FailPayoutRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// BankExportLine is a pending bank payout with the details the finance team
+// needs to send it.
+type BankExportLine struct {
+	Payout         *Payout `json:"payout"`
+	OrganizerName  string  `json:"organizer_name"`
+	OrganizerEmail string  `json:"organizer_email"`
+}
+
+// StatementLine is one ledger movement on an organizer's balance. Amount is
+// positive when it increases what the organizer is owed.
+type StatementLine struct {
+	Date      time.Time `json:"date"`
+	Kind      string    `json:"kind"`
+	Reference string    `json:"reference"`
+	PaymentID string    `json:"payment_id,omitempty"`
+	OrderID   string    `json:"order_id,omitempty"`
+	Amount    float64   `json:"amount"`
+}
+
+// SettlementStatement explains how a payout amount was reached
+type SettlementStatement struct {
+	Payout         *Payout          `json:"payout"`
+	OpeningBalance float64          `json:"opening_balance"`
+	Sales          float64          `json:"sales"`
+	Refunds        float64          `json:"refunds"`
+	PlatformFees   float64          `json:"platform_fees"`
+	Adjustments    float64          `json:"adjustments"`
+	PreviousPayout float64          `json:"previous_payouts"`
+	ClosingBalance float64          `json:"closing_balance"`
+	Reserve        float64          `json:"reserve"`
+	PayoutAmount   float64          `json:"payout_amount"`
+	Lines          []*StatementLine `json:"lines"`
+}
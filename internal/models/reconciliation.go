@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+type ReconciliationStatus string
+
+const (
+	ReconciliationRunning   ReconciliationStatus = "running"
+	ReconciliationCompleted ReconciliationStatus = "completed"
+	ReconciliationFailed    ReconciliationStatus = "failed"
+)
+
+// DiscrepancyType classifies a mismatch between Stripe and the payments table
+type DiscrepancyType string
+
+const (
+	DiscrepancyMissingLocal   DiscrepancyType = "missing_local"  // charge in Stripe, no local payment
+	DiscrepancyMissingStripe  DiscrepancyType = "missing_stripe" // local Stripe payment, no charge
+	DiscrepancyAmountMismatch DiscrepancyType = "amount_mismatch"
+	DiscrepancyStatusMismatch DiscrepancyType = "status_mismatch"
+)
+
+// ReconciliationRun is one comparison of Stripe against local payments for a period
+type ReconciliationRun struct {
+	ID               string               `json:"id"`
+	PeriodStart      time.Time            `json:"period_start"`
+	PeriodEnd        time.Time            `json:"period_end"`
+	Status           ReconciliationStatus `json:"status"`
+	StripeCount      int                  `json:"stripe_count"`
+	LocalCount       int                  `json:"local_count"`
+	MatchedCount     int                  `json:"matched_count"`
+	DiscrepancyCount int                  `json:"discrepancy_count"`
+	Error            string               `json:"error,omitempty"`
+	StartedAt        time.Time            `json:"started_at"`
+	CompletedAt      *time.Time           `json:"completed_at,omitempty"`
+}
+
+// ReconciliationItem is a single discrepancy found during a run
+type ReconciliationItem struct {
+	ID              int64           `json:"id"`
+	RunID           string          `json:"run_id"`
+	Type            DiscrepancyType `json:"type"`
+	PaymentID       string          `json:"payment_id,omitempty"`
+	OrderID         string          `json:"order_id,omitempty"`
+	ChargeID        string          `json:"charge_id,omitempty"`
+	PaymentIntentID string          `json:"payment_intent_id,omitempty"`
+	LocalAmount     float64         `json:"local_amount"`
+	StripeAmount    float64         `json:"stripe_amount"`
+	StripeFee       float64         `json:"stripe_fee"`
+	Currency        string          `json:"currency,omitempty"`
+	LocalStatus     string          `json:"local_status,omitempty"`
+	StripeStatus    string          `json:"stripe_status,omitempty"`
+	Details         string          `json:"details,omitempty"`
+}
+
+// ReconciliationRequest starts a run for a date range (YYYY-MM-DD, end inclusive)
+type ReconciliationRequest struct {
+	From string `json:"from" binding:"required"`
+	To   string `json:"to" binding:"required"`
+}
@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+// RiskDecision is the outcome of scoring a payment before it reaches the
+// provider.
+type RiskDecision string
+
+const (
+	DecisionAllow  RiskDecision = "allow"
+	DecisionReview RiskDecision = "review" // held until staff approve or reject it
+	DecisionDeny   RiskDecision = "deny"
+)
+
+// Risk signals that rules can test
+const (
+	SignalEmailVelocity   = "email_velocity"   // payments from the email in the velocity window
+	SignalIPVelocity      = "ip_velocity"      // payments from the IP in the velocity window
+	SignalCardVelocity    = "card_velocity"    // payments with the card fingerprint in the velocity window
+	SignalAmount          = "amount"           // payment amount in its own currency
+	SignalAmountRatio     = "amount_ratio"     // amount over the organizer's average, 0 without enough history
+	SignalTicketCount     = "ticket_count"     // tickets in the order
+	SignalCountryMismatch = "country_mismatch" // 1 when the billing and card countries differ
+	SignalBlocklisted     = "blocklisted"      // 1 when the email, IP, card or a country is on a known-bad list
+)
+
+// RiskRuleHit records a rule that matched and what it contributed.
+type RiskRuleHit struct {
+	Rule    string       `json:"rule"`
+	Signal  string       `json:"signal"`
+	Value   float64      `json:"value"`
+	Score   int          `json:"score,omitempty"`
+	Outcome RiskDecision `json:"outcome,omitempty"`
+}
+
+// RiskAssessment explains a decision: every signal value, the rules that
+// matched and the lists that were hit.
+type RiskAssessment struct {
+	Decision  RiskDecision       `json:"decision"`
+	Score     int                `json:"score"`
+	Signals   map[string]float64 `json:"signals"`
+	Hits      []RiskRuleHit      `json:"hits,omitempty"`
+	Listed    []string           `json:"listed,omitempty"` // which lists matched, e.g. "email" or "ip"
+	Warnings  []string           `json:"warnings,omitempty"`
+	RulesFrom string             `json:"rules_from"`
+}
+
+type RiskReviewStatus string
+
+const (
+	ReviewPending  RiskReviewStatus = "pending"
+	ReviewApproved RiskReviewStatus = "approved"
+	ReviewRejected RiskReviewStatus = "rejected"
+	ReviewExpired  RiskReviewStatus = "expired" // the payment settled or was cancelled before a decision
+)
+
+// RiskReview is a payment held for manual review. Stripe payments are held
+// as uncaptured authorizations, which card networks release after about
+// seven days, so reviews should be decided well within that.
+type RiskReview struct {
+	PaymentID     string           `json:"payment_id"`
+	OrganizerID   string           `json:"organizer_id"`
+	OrderID       string           `json:"order_id"`
+	TransactionID string           `json:"transaction_id,omitempty"`
+	Amount        float64          `json:"amount"`
+	Currency      string           `json:"currency"`
+	Score         int              `json:"score"`
+	Assessment    *RiskAssessment  `json:"assessment"`
+	Status        RiskReviewStatus `json:"status"`
+	Reviewer      string           `json:"reviewer,omitempty"`
+	Note          string           `json:"note,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	DecidedAt     *time.Time       `json:"decided_at,omitempty"`
+}
+
+type RiskReviewDecisionRequest struct {
+	Note string `json:"note"`
+}
+
+// IPBan blocks an IP from the checkout endpoints for a cooling-off period,
+// set automatically when it fails too many card checks or by staff.
+type IPBan struct {
+	IP        string    `json:"ip"`
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type IPBanRequest struct {
+	IP      string `json:"ip" binding:"required,ip"`
+	Reason  string `json:"reason" binding:"required"`
+	Minutes int    `json:"minutes,omitempty"` // defaults to the configured cooling-off period
+}
@@ -1,6 +1,8 @@
 package models
 
-// StripeCardDetails represents credit card information
+// StripeCardDetails represents credit card information. Raw card data is
+// only accepted in raw-card test mode; production callers tokenize with
+// Stripe.js and send a token or PaymentMethod ID instead.
 type StripeCardDetails struct {
 	Number   string         `json:"number" binding:"required"`
 	ExpMonth string         `json:"exp_month" binding:"required"`
@@ -23,46 +25,93 @@ type StripeAddress struct {
 // StripePaymentRequest represents a request to process a payment through Stripe
 type StripePaymentRequest struct {
 	PaymentID   string             `json:"payment_id" binding:"required"`
+	OrganizerID string             `json:"organizer_id"`
 	OrderID     string             `json:"order_id" binding:"required"`
 	Amount      float64            `json:"amount" binding:"required,gt=0"`
 	Currency    string             `json:"currency" binding:"required"`
 	Description string             `json:"description,omitempty"`
 	Token       string             `json:"token,omitempty"` // Stripe token or PaymentMethod ID
-	Card        *StripeCardDetails `json:"card,omitempty"`  // Optional for legacy/test
+	Card        *StripeCardDetails `json:"card,omitempty"`  // Test mode only
 	Metadata    map[string]string  `json:"metadata,omitempty"`
+	ReturnURL   string             `json:"return_url,omitempty"` // Where the bank sends the customer after 3-D Secure
+
+	// Risk signals; the client IP is taken from the request
+	Email          string `json:"email,omitempty"`
+	BillingCountry string `json:"billing_country,omitempty"`
+	TicketCount    int    `json:"ticket_count,omitempty"`
+
+	// Repeat attendees: charge a card saved on the user's Stripe Customer.
+	// Token may name a saved method; without one the default is used.
+	UserID            string `json:"user_id,omitempty"`
+	OffSession        bool   `json:"off_session,omitempty"`         // customer is not present to authenticate
+	SavePaymentMethod bool   `json:"save_payment_method,omitempty"` // keep the card for later off-session charges
+	CustomerID        string `json:"-"`                             // Stripe Customer resolved from UserID
+
+	// Optional itemization; the lines must add up to Amount
+	LineItems []*LineItemRequest `json:"line_items,omitempty" binding:"omitempty,max=100,dive"`
+}
+
+// StripeConfirmRequest re-confirms a PaymentIntent once the customer has
+// completed authentication
+type StripeConfirmRequest struct {
+	ReturnURL string `json:"return_url,omitempty"`
+}
+
+// StripeNextAction tells the frontend how to complete authentication for an
+// intent in requires_action
+type StripeNextAction struct {
+	Type         string `json:"type"`
+	RedirectURL  string `json:"redirect_url,omitempty"`
+	ReturnURL    string `json:"return_url,omitempty"`
+	UseStripeSDK bool   `json:"use_stripe_sdk,omitempty"`
 }
 
 // StripePaymentResponse represents a response from a successful Stripe payment
 type StripePaymentResponse struct {
-	PaymentID     string        `json:"payment_id"`
-	OrderID       string        `json:"order_id"`
-	Status        PaymentStatus `json:"status"`
-	Amount        float64       `json:"amount"`
-	Currency      string        `json:"currency"`
-	TransactionID string        `json:"transaction_id"`
-	PaymentMethod string        `json:"payment_method"`
-	ReceiptURL    string        `json:"receipt_url,omitempty"`
-	Created       int64         `json:"created"`
+	PaymentID     string            `json:"payment_id"`
+	OrganizerID   string            `json:"organizer_id,omitempty"`
+	OrderID       string            `json:"order_id"`
+	Status        PaymentStatus     `json:"status"`
+	Amount        float64           `json:"amount"`
+	Currency      string            `json:"currency"`
+	TransactionID string            `json:"transaction_id"`
+	PaymentMethod string            `json:"payment_method"`
+	ReceiptURL    string            `json:"receipt_url,omitempty"`
+	Created       int64             `json:"created"`
+	PlatformFee   float64           `json:"platform_fee,omitempty"`
+	ClientSecret  string            `json:"client_secret,omitempty"`
+	NextAction    *StripeNextAction `json:"next_action,omitempty"`
 }
 
 // StripeCardValidationRequest represents a request to validate a credit card
 type StripeCardValidationRequest struct {
-	Card *StripeCardDetails `json:"card" binding:"required"`
+	Card         *StripeCardDetails `json:"card" binding:"required"`
+	CaptchaToken string             `json:"captcha_token,omitempty"` // required once the caller has checked too many cards
 }
 
 // StripeCardValidationResponse represents the response from a card validation request
 type StripeCardValidationResponse struct {
-	Valid    bool   `json:"valid"`
-	Message  string `json:"message,omitempty"`
-	CardType string `json:"card_type,omitempty"`
-	Last4    string `json:"last4,omitempty"`
+	Valid    bool             `json:"valid"`
+	Message  string           `json:"message,omitempty"`
+	CardType string           `json:"card_type,omitempty"`
+	Last4    string           `json:"last4,omitempty"`
+	Errors   []CardFieldError `json:"errors,omitempty"`
+}
+
+// CardFieldError describes one card field that failed local validation
+type CardFieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// StripeRefundRequest represents a request to refund a payment
+// StripeRefundRequest represents a request to refund a payment. LineItems
+// refunds lines of an itemized payment instead of an amount.
 type StripeRefundRequest struct {
-	PaymentID string   `json:"payment_id" binding:"required"`
-	Amount    *float64 `json:"amount,omitempty"`
-	Reason    string   `json:"reason,omitempty"`
+	PaymentID string                   `json:"payment_id" binding:"required"`
+	Amount    *float64                 `json:"amount,omitempty"`
+	Reason    string                   `json:"reason,omitempty"`
+	LineItems []*RefundLineItemRequest `json:"line_items,omitempty" binding:"omitempty,max=100,dive"`
 }
 
 type StripeCard struct {
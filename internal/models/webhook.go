@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// WebhookEndpoint is an HTTP callback registered by an organizer or a
+// partner. Endpoints without an organizer are platform endpoints and receive
+// events for every organizer. An empty EventTypes list means every event.
+type WebhookEndpoint struct {
+	ID                  string     `json:"id"`
+	OrganizerID         string     `json:"organizer_id,omitempty"`
+	URL                 string     `json:"url"`
+	Description         string     `json:"description,omitempty"`
+	EventTypes          []string   `json:"event_types"`
+	Secret              string     `json:"-"`
+	Enabled             bool       `json:"enabled"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledReason      string     `json:"disabled_reason,omitempty"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type CreateWebhookEndpointRequest struct {
+	OrganizerID string   `json:"organizer_id,omitempty"` // platform only; empty registers a platform endpoint
+	URL         string   `json:"url" binding:"required,url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types,omitempty"` // e.g. payment.success or payment.*
+}
+
+// UpdateWebhookEndpointRequest only changes the fields that are present.
+// Re-enabling an endpoint clears its failure count.
+type UpdateWebhookEndpointRequest struct {
+	URL         *string   `json:"url,omitempty" binding:"omitempty,url"`
+	Description *string   `json:"description,omitempty"`
+	EventTypes  *[]string `json:"event_types,omitempty"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+}
+
+// WebhookEndpointSecret is returned when an endpoint is created or its
+// signing secret is rotated. The secret is not shown again.
+type WebhookEndpointSecret struct {
+	Endpoint *WebhookEndpoint `json:"endpoint"`
+	Secret   string           `json:"secret"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	DeliveryPending   WebhookDeliveryStatus = "pending"
+	DeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	DeliveryFailed    WebhookDeliveryStatus = "failed" // gave up after the last retry
+)
+
+// WebhookDelivery is one event sent to one endpoint, with the outcome of
+// its latest attempt. Payload is the exact body that is signed and sent.
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	EndpointID     string                `json:"endpoint_id"`
+	OrganizerID    string                `json:"organizer_id,omitempty"`
+	EventID        string                `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	LastStatusCode int                   `json:"last_status_code,omitempty"`
+	LastError      string                `json:"last_error,omitempty"`
+	LastResponse   string                `json:"last_response,omitempty"` // truncated response body
+	RedeliveryOf   string                `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+}
+
+// WebhookPayload is the body posted to endpoints
+type WebhookPayload struct {
+	ID      string        `json:"id"`
+	Type    string        `json:"type"`
+	Created int64         `json:"created"`
+	Data    *PaymentEvent `json:"data"`
+}
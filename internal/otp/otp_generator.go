@@ -4,8 +4,12 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"time"
 )
 
+// TTL is how long an OTP stays valid once it has been sent
+const TTL = 5 * time.Minute
+
 // GenerateOTP returns a 6-digit random code as a string
 func GenerateOTP() (string, error) {
 	max := big.NewInt(1000000) // 10^6
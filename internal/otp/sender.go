@@ -44,5 +44,5 @@ func SendEmailOTP(toEmail, otp string) {
 	if err != nil {
 		log.Fatal("Failed to send email:", err)
 	}
-	fmt.Println("✅ OTP sent to", toEmail)
+	log.Println("✅ OTP sent to", toEmail)
 }
@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const ipBanPrefix = "ip_ban:"
+
+// BanIP blocks an IP until ttl has passed. Banning an IP again restarts
+// the cooling-off period.
+func (r *Redis) BanIP(ctx context.Context, ip, reason string, ttl time.Duration) error {
+	return r.Client.Set(ctx, ipBanPrefix+ip, reason, ttl).Err()
+}
+
+// IPBan returns the ban on an IP, or nil when it is not banned.
+func (r *Redis) IPBan(ctx context.Context, ip string) (*models.IPBan, error) {
+	pipe := r.Client.Pipeline()
+	reason := pipe.Get(ctx, ipBanPrefix+ip)
+	ttl := pipe.TTL(ctx, ipBanPrefix+ip)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	if reason.Err() == redis.Nil {
+		return nil, nil
+	}
+	return &models.IPBan{IP: ip, Reason: reason.Val(), ExpiresAt: time.Now().Add(ttl.Val())}, nil
+}
+
+// ListIPBans returns every active ban.
+func (r *Redis) ListIPBans(ctx context.Context) ([]*models.IPBan, error) {
+	var bans []*models.IPBan
+	iter := r.Client.Scan(ctx, 0, ipBanPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		ban, err := r.IPBan(ctx, strings.TrimPrefix(iter.Val(), ipBanPrefix))
+		if err != nil {
+			return nil, err
+		}
+		if ban != nil {
+			bans = append(bans, ban)
+		}
+	}
+	return bans, iter.Err()
+}
+
+func (r *Redis) UnbanIP(ctx context.Context, ip string) error {
+	return r.Client.Del(ctx, ipBanPrefix+ip).Err()
+}
@@ -3,8 +3,11 @@ package redis
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
+	"payment-gateway/internal/otp"
+
 	"github.com/go-redis/redis/v8"
 )
 
@@ -16,18 +19,13 @@ func NewRedis(client *redis.Client) *Redis {
 	return &Redis{Client: client}
 }
 
-const lockTTL = 5 * time.Minute
+// The order's OTP lock expires with the OTP itself
+const lockTTL = otp.TTL
 
 // Lock a single seat
 func (r *Redis) AddOTP(otp string, orderID string) (bool, error) {
 	key := "OTP_lock:" + orderID
-	ok, err := r.Client.SetNX(context.Background(), key, otp, lockTTL).Result()
-	if err != nil {
-		fmt.Printf("AddOTP: failed to set lock for orderID=%s, err=%v\n", orderID, err)
-	} else {
-		fmt.Printf("AddOTP: lock set for orderID=%s, success=%v\n", orderID, ok)
-	}
-	return ok, err
+	return r.Client.SetNX(context.Background(), key, otp, lockTTL).Result()
 }
 
 // nlock a single seat
@@ -36,23 +34,15 @@ func (r *Redis) RemoveOTP(orderID string) error {
 	key := fmt.Sprintf("OTP_lock:%s", orderID)
 	val, err := r.Client.Get(ctx, key).Result()
 	if err == redis.Nil {
-		fmt.Printf("RemoveOTP: lock already removed for orderID=%s\n", orderID)
 		return nil // already unlocked
 	}
 	if err != nil {
-		fmt.Printf("RemoveOTP: error getting lock for orderID=%s, err=%v\n", orderID, err)
 		return err
 	}
 	if val == orderID {
 		_, err := r.Client.Del(ctx, key).Result()
-		if err != nil {
-			fmt.Printf("RemoveOTP: failed to remove lock for orderID=%s, err=%v\n", orderID, err)
-		} else {
-			fmt.Printf("RemoveOTP: lock removed for orderID=%s\n", orderID)
-		}
 		return err
 	}
-	fmt.Printf("RemoveOTP: lock not owned by orderID=%s, current value=%s\n", orderID, val)
 	return nil // do not unlock if not owned by this order
 }
 
@@ -60,16 +50,13 @@ func (r *Redis) IsOTPLocked(orderID string) (bool, error) {
 	key := "OTP_lock:" + orderID
 	ctx := context.Background()
 
-	val, err := r.Client.Get(ctx, key).Result()
+	_, err := r.Client.Get(ctx, key).Result()
 	if err == redis.Nil {
-		fmt.Printf("IsOTPLocked: lock not found for orderID=%s\n", orderID)
 		return false, nil // Unlocked
 	}
 	if err != nil {
-		fmt.Printf("IsOTPLocked: error getting lock for orderID=%s, err=%v\n", orderID, err)
 		return false, err
 	}
-	fmt.Printf("IsOTPLocked: lock found for orderID=%s, value=%s\n", orderID, val)
 	return true, nil // Locked, return OTP value
 }
 
@@ -79,13 +66,38 @@ func (r *Redis) GetOTP(orderID string) (string, error) {
 
 	val, err := r.Client.Get(ctx, key).Result()
 	if err == redis.Nil {
-		fmt.Printf("GetOTP: lock not found for orderID=%s\n", orderID)
 		return "", nil // Unlocked
 	}
 	if err != nil {
-		fmt.Printf("GetOTP: error getting lock for orderID=%s, err=%v\n", orderID, err)
 		return "", err
 	}
-	fmt.Printf("GetOTP: lock found for orderID=%s, value=%s\n", orderID, val)
 	return val, nil // Return OTP value
 }
+
+// ReleaseOTP deletes the OTP lock for an order regardless of the stored code.
+// Used when the payment behind the lock has been cancelled or expired.
+func (r *Redis) ReleaseOTP(orderID string) error {
+	key := "OTP_lock:" + orderID
+	ctx := context.Background()
+
+	_, err := r.Client.Del(ctx, key).Result()
+	return err
+}
+
+// Increment records one event under key and returns how many events fall in
+// the last window. Events are kept in a sorted set scored by time, so the
+// window slides rather than resetting on a boundary.
+func (r *Redis) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	now := time.Now()
+	member := fmt.Sprintf("%d-%d", now.UnixNano(), rand.Int63())
+
+	pipe := r.Client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", fmt.Sprintf("%d", now.Add(-window).UnixNano()))
+	pipe.ZAdd(ctx, key, &redis.Z{Score: float64(now.UnixNano()), Member: member})
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+)
+
+// Publish sends payload to every instance subscribed to channel.
+func (r *Redis) Publish(ctx context.Context, channel string, payload []byte) error {
+	return r.Client.Publish(ctx, channel, payload).Err()
+}
+
+// Subscribe delivers the messages published to channel. The returned
+// channel is closed when ctx ends or the connection to Redis fails;
+// messages published in the meantime are lost.
+func (r *Redis) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	sub := r.Client.Subscribe(ctx, channel)
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		// Closing the subscription unblocks ReceiveMessage
+		<-ctx.Done()
+		sub.Close()
+	}()
+
+	messages := make(chan []byte, 64)
+	go func() {
+		defer close(messages)
+		defer cancel()
+		for {
+			msg, err := sub.ReceiveMessage(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case messages <- []byte(msg.Payload):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return messages, nil
+}
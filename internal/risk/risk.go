@@ -0,0 +1,202 @@
+// Package risk scores payments before they are sent to the provider and
+// decides whether to allow them, hold them for review or deny them.
+package risk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+// Input is what is known about a payment before it is authorized. Empty
+// fields contribute no signal.
+type Input struct {
+	PaymentID       string
+	OrganizerID     string
+	OrderID         string
+	Email           string
+	IP              string
+	CardFingerprint string // Stripe's fingerprint, the same for every token of one card number
+	BillingCountry  string
+	CardCountry     string
+	Amount          float64
+	Currency        string
+	TicketCount     int
+}
+
+// Counter counts events per key within a sliding window.
+type Counter interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// History reports an organizer's recent successful payments.
+type History interface {
+	AveragePaymentAmount(organizerID, currency string, since time.Time) (float64, int, error)
+}
+
+// Engine evaluates payments against the current rules.
+type Engine struct {
+	source  RuleSource
+	counter Counter
+	history History
+}
+
+func NewEngine(source RuleSource, counter Counter, history History) *Engine {
+	return &Engine{
+		source:  source,
+		counter: counter,
+		history: history,
+	}
+}
+
+// Rules returns the rules currently in force.
+func (e *Engine) Rules() (*Rules, error) {
+	return e.source.Rules()
+}
+
+// Evaluate counts the payment towards the velocity windows and scores it.
+// Signals that could not be gathered are left at zero and reported as
+// warnings; an error is returned only when there are no rules to apply.
+func (e *Engine) Evaluate(ctx context.Context, in Input) (*models.RiskAssessment, error) {
+	rules, err := e.source.Rules()
+	if rules == nil {
+		return nil, err
+	}
+
+	assessment := &models.RiskAssessment{
+		Signals:   make(map[string]float64, len(knownSignals)),
+		RulesFrom: rules.Source,
+	}
+	if err != nil {
+		assessment.Warnings = append(assessment.Warnings, err.Error())
+	}
+
+	e.velocity(ctx, rules, assessment, models.SignalEmailVelocity, "email", strings.ToLower(strings.TrimSpace(in.Email)))
+	e.velocity(ctx, rules, assessment, models.SignalIPVelocity, "ip", in.IP)
+	e.velocity(ctx, rules, assessment, models.SignalCardVelocity, "card", in.CardFingerprint)
+
+	assessment.Signals[models.SignalAmount] = in.Amount
+	assessment.Signals[models.SignalAmountRatio] = e.amountRatio(rules, assessment, in)
+	assessment.Signals[models.SignalTicketCount] = float64(in.TicketCount)
+
+	billing := strings.ToUpper(strings.TrimSpace(in.BillingCountry))
+	card := strings.ToUpper(strings.TrimSpace(in.CardCountry))
+	assessment.Signals[models.SignalCountryMismatch] = boolSignal(billing != "" && card != "" && billing != card)
+
+	if rules.emails[strings.ToLower(strings.TrimSpace(in.Email))] {
+		assessment.Listed = append(assessment.Listed, "email")
+	}
+	if rules.listedIP(in.IP) {
+		assessment.Listed = append(assessment.Listed, "ip")
+	}
+	if rules.cards[in.CardFingerprint] {
+		assessment.Listed = append(assessment.Listed, "card")
+	}
+	if rules.countries[billing] || rules.countries[card] {
+		assessment.Listed = append(assessment.Listed, "country")
+	}
+	assessment.Signals[models.SignalBlocklisted] = boolSignal(len(assessment.Listed) > 0)
+
+	score(rules, assessment)
+	return assessment, nil
+}
+
+func (e *Engine) velocity(ctx context.Context, rules *Rules, assessment *models.RiskAssessment, signal, kind, value string) {
+	assessment.Signals[signal] = 0
+	if value == "" || e.counter == nil {
+		return
+	}
+	count, err := e.counter.Increment(ctx, velocityKey(kind, value), rules.VelocityWindow)
+	if err != nil {
+		assessment.Warnings = append(assessment.Warnings, fmt.Sprintf("%s: %v", signal, err))
+		return
+	}
+	assessment.Signals[signal] = float64(count)
+}
+
+// velocityKey hashes the identity so emails and IPs are not stored in Redis
+// in the clear.
+func velocityKey(kind, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "risk:velocity:" + kind + ":" + hex.EncodeToString(sum[:16])
+}
+
+func (e *Engine) amountRatio(rules *Rules, assessment *models.RiskAssessment, in Input) float64 {
+	if e.history == nil || in.OrganizerID == "" || in.Amount <= 0 {
+		return 0
+	}
+	average, count, err := e.history.AveragePaymentAmount(in.OrganizerID, strings.ToLower(in.Currency), time.Now().Add(-rules.HistoryWindow))
+	if err != nil {
+		assessment.Warnings = append(assessment.Warnings, fmt.Sprintf("%s: %v", models.SignalAmountRatio, err))
+		return 0
+	}
+	if count < rules.MinHistory || average <= 0 {
+		return 0
+	}
+	return in.Amount / average
+}
+
+// score totals the matching rules and picks the strictest of the score
+// threshold and any forced outcome.
+func score(rules *Rules, assessment *models.RiskAssessment) {
+	decision := models.DecisionAllow
+	for _, rule := range rules.Rules {
+		value := assessment.Signals[rule.Signal]
+		if !compare(value, rule.Op, rule.Value) {
+			continue
+		}
+		assessment.Hits = append(assessment.Hits, models.RiskRuleHit{
+			Rule:    rule.Name,
+			Signal:  rule.Signal,
+			Value:   value,
+			Score:   rule.Score,
+			Outcome: rule.Outcome,
+		})
+		assessment.Score += rule.Score
+		decision = stricter(decision, rule.Outcome)
+	}
+
+	switch {
+	case assessment.Score >= rules.DenyScore:
+		decision = stricter(decision, models.DecisionDeny)
+	case assessment.Score >= rules.ReviewScore:
+		decision = stricter(decision, models.DecisionReview)
+	}
+	assessment.Decision = decision
+}
+
+func compare(value float64, op string, threshold float64) bool {
+	switch op {
+	case "gt":
+		return value > threshold
+	case "gte":
+		return value >= threshold
+	case "lt":
+		return value < threshold
+	case "lte":
+		return value <= threshold
+	case "eq":
+		return value == threshold
+	}
+	return false
+}
+
+func stricter(a, b models.RiskDecision) models.RiskDecision {
+	rank := map[models.RiskDecision]int{models.DecisionAllow: 0, models.DecisionReview: 1, models.DecisionDeny: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+func boolSignal(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
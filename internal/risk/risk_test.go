@@ -0,0 +1,208 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+// counter counts in memory, failing for keys of failKind
+type counter struct {
+	mu       sync.Mutex
+	counts   map[string]int64
+	failKind string
+}
+
+func (c *counter) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failKind != "" && strings.HasPrefix(key, "risk:velocity:"+c.failKind+":") {
+		return 0, errors.New("redis unavailable")
+	}
+	if c.counts == nil {
+		c.counts = make(map[string]int64)
+	}
+	c.counts[key]++
+	return c.counts[key], nil
+}
+
+type history struct {
+	average float64
+	count   int
+}
+
+func (h history) AveragePaymentAmount(organizerID, currency string, since time.Time) (float64, int, error) {
+	return h.average, h.count, nil
+}
+
+func hitNames(assessment *models.RiskAssessment) string {
+	names := make([]string, len(assessment.Hits))
+	for i, hit := range assessment.Hits {
+		names[i] = hit.Rule
+	}
+	return strings.Join(names, ",")
+}
+
+func TestDefaultRulesDecide(t *testing.T) {
+	base := Input{PaymentID: "pay_1", OrganizerID: "org_1", Email: "ada@example.com", Amount: 40, Currency: "usd", TicketCount: 2}
+	tests := []struct {
+		name     string
+		edit     func(in *Input)
+		hits     string
+		decision models.RiskDecision
+	}{
+		{"ordinary", func(in *Input) {}, "", models.DecisionAllow},
+		{"country mismatch alone", func(in *Input) { in.BillingCountry, in.CardCountry = "us", "GB" }, "country_mismatch", models.DecisionAllow},
+		{"mismatch on a bulk basket", func(in *Input) { in.BillingCountry, in.CardCountry, in.TicketCount = "US", "GB", 11 }, "many_tickets,country_mismatch", models.DecisionAllow},
+		{"bulk order", func(in *Input) { in.TicketCount = 26 }, "many_tickets,bulk_order", models.DecisionReview},
+		{"large amount", func(in *Input) { in.Amount = 10001 }, "amount_spike,amount_extreme,large_amount", models.DecisionReview},
+		{"amount spike", func(in *Input) { in.Amount = 200 }, "amount_spike", models.DecisionAllow},
+		{"extreme amount with mismatch", func(in *Input) { in.Amount, in.BillingCountry, in.CardCountry = 400, "US", "GB" },
+			"amount_spike,amount_extreme,country_mismatch", models.DecisionReview},
+		{"blocklisted email", func(in *Input) { in.Email = " Fraud@Example.com " }, "blocklisted", models.DecisionDeny},
+		{"blocklisted range", func(in *Input) { in.IP = "203.0.113.9" }, "blocklisted", models.DecisionDeny},
+		{"blocklisted card country", func(in *Input) { in.CardCountry = "kp" }, "blocklisted", models.DecisionDeny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultRules()
+			rules.Lists = Lists{Emails: []string{"fraud@example.com"}, IPs: []string{"203.0.113.0/24"}, Countries: []string{"KP"}}
+			if err := rules.compile(); err != nil {
+				t.Fatal(err)
+			}
+			engine := NewEngine(NewStaticRules(rules), &counter{}, history{average: 40, count: 20})
+			in := base
+			tt.edit(&in)
+
+			assessment, err := engine.Evaluate(context.Background(), in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hitNames(assessment); got != tt.hits || assessment.Decision != tt.decision {
+				t.Errorf("hits %q, decision %s; want %q, %s (score %d)", got, assessment.Decision, tt.hits, tt.decision, assessment.Score)
+			}
+		})
+	}
+}
+
+func TestVelocityCatchesCardTesting(t *testing.T) {
+	engine := NewEngine(NewStaticRules(DefaultRules()), &counter{}, nil)
+	in := Input{CardFingerprint: "fp_1", Email: "ada@example.com", Amount: 1}
+
+	var assessment *models.RiskAssessment
+	for i := 0; i < 4; i++ {
+		var err error
+		if assessment, err = engine.Evaluate(context.Background(), in); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if assessment.Signals[models.SignalCardVelocity] != 4 || hitNames(assessment) != "card_testing" {
+		t.Errorf("signals %v, hits %q", assessment.Signals, hitNames(assessment))
+	}
+
+	// Another card starts its own count
+	in.CardFingerprint = "fp_2"
+	assessment, _ = engine.Evaluate(context.Background(), in)
+	if assessment.Signals[models.SignalCardVelocity] != 1 || assessment.Signals[models.SignalEmailVelocity] != 5 {
+		t.Errorf("signals %v", assessment.Signals)
+	}
+}
+
+func TestMissingSignalsAreWarnings(t *testing.T) {
+	engine := NewEngine(NewStaticRules(DefaultRules()), &counter{failKind: "ip"}, history{average: 40, count: 3})
+	assessment, err := engine.Evaluate(context.Background(), Input{OrganizerID: "org_1", IP: "198.51.100.1", Amount: 4000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assessment.Warnings) != 1 || !strings.Contains(assessment.Warnings[0], models.SignalIPVelocity) {
+		t.Errorf("warnings = %v", assessment.Warnings)
+	}
+	// Too little history to compare against
+	if assessment.Signals[models.SignalAmountRatio] != 0 || assessment.Decision != models.DecisionAllow {
+		t.Errorf("assessment = %+v", assessment)
+	}
+}
+
+func TestVelocityKeysDoNotHoldIdentities(t *testing.T) {
+	key := velocityKey("email", "ada@example.com")
+	if strings.Contains(key, "ada") || !strings.HasPrefix(key, "risk:velocity:email:") {
+		t.Errorf("key = %s", key)
+	}
+	if key == velocityKey("ip", "ada@example.com") {
+		t.Error("kinds share a key")
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+review_score: 40
+rules:
+  - {name: card_testing, signal: card_velocity, op: gte, value: 2, score: 40}
+lists:
+  ips: [198.51.100.7, "2001:db8::1"]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.ReviewScore != 40 || rules.DenyScore != 90 || rules.VelocityWindow != time.Hour {
+		t.Errorf("rules = %+v", rules)
+	}
+	if !rules.listedIP("198.51.100.7") || rules.listedIP("198.51.100.8") || !rules.listedIP("2001:db8::1") || rules.listedIP("not an ip") {
+		t.Error("single addresses are not matched exactly")
+	}
+
+	for name, data := range map[string]string{
+		"unknown signal":  `rules: [{name: r, signal: shoe_size, op: gt, value: 1}]`,
+		"unknown op":      `rules: [{name: r, signal: amount, op: ne, value: 1}]`,
+		"allow outcome":   `rules: [{name: r, signal: amount, op: gt, value: 1, outcome: allow}]`,
+		"unnamed":         `rules: [{signal: amount, op: gt, value: 1}]`,
+		"deny below hold": `{review_score: 80, deny_score: 60}`,
+		"bad range":       `lists: {ips: [10.0.0.0/33]}`,
+		"not yaml":        `rules: [`,
+	} {
+		if _, err := ParseRules([]byte(data)); !errors.Is(err, ErrInvalidRules) {
+			t.Errorf("%s: err = %v, want ErrInvalidRules", name, err)
+		}
+	}
+}
+
+func TestFileRulesKeepWorkingRulesOnABadEdit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "risk.yaml")
+	write := func(data string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Add(-time.Hour)
+	write(`review_score: 40`, start)
+
+	source, err := NewFileRules(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	write(`review_score: [`, start.Add(time.Minute))
+	rules, err := source.Rules()
+	if err == nil || rules == nil || rules.ReviewScore != 40 {
+		t.Errorf("bad edit: rules %+v, err %v", rules, err)
+	}
+
+	write(`review_score: 30`, start.Add(2*time.Minute))
+	if rules, err := source.Rules(); err != nil || rules.ReviewScore != 30 || !strings.HasPrefix(rules.Source, "file:") {
+		t.Errorf("fixed edit: rules %+v, err %v", rules, err)
+	}
+
+	if _, err := NewFileRules(filepath.Join(t.TempDir(), "missing.yaml"), time.Minute); err == nil {
+		t.Error("missing file accepted")
+	}
+}
@@ -0,0 +1,276 @@
+package risk
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"payment-gateway/internal/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidRules = errors.New("invalid risk rules")
+
+// Rule adds Score when Signal compares true against Value. A rule with an
+// Outcome forces at least that decision whatever the total score.
+type Rule struct {
+	Name    string              `yaml:"name" json:"name"`
+	Signal  string              `yaml:"signal" json:"signal"`
+	Op      string              `yaml:"op" json:"op"` // gt, gte, lt, lte or eq
+	Value   float64             `yaml:"value" json:"value"`
+	Score   int                 `yaml:"score,omitempty" json:"score,omitempty"`
+	Outcome models.RiskDecision `yaml:"outcome,omitempty" json:"outcome,omitempty"`
+}
+
+// Lists are known-bad values. IPs may be single addresses or CIDR ranges;
+// cards are Stripe card fingerprints; countries are ISO 3166-1 alpha-2
+// codes matched against both the billing and the card country. A match sets
+// the blocklisted signal, which only counts once a rule tests it.
+type Lists struct {
+	Emails    []string `yaml:"emails" json:"emails"`
+	IPs       []string `yaml:"ips" json:"ips"`
+	Cards     []string `yaml:"cards" json:"cards"`
+	Countries []string `yaml:"countries" json:"countries"`
+}
+
+// Rules is the scoring configuration, normally read from YAML:
+//
+//	velocity_window: 1h
+//	review_score: 50
+//	deny_score: 90
+//	rules:
+//	  - {name: card_testing, signal: card_velocity, op: gt, value: 3, score: 40}
+//	  - {name: bulk_order, signal: ticket_count, op: gt, value: 25, outcome: review}
+//	lists:
+//	  emails: [fraud@example.com]
+//	  ips: [203.0.113.0/24]
+type Rules struct {
+	VelocityWindow time.Duration `yaml:"velocity_window" json:"velocity_window"`
+	HistoryWindow  time.Duration `yaml:"history_window" json:"history_window"` // period the organizer's average amount is taken over
+	MinHistory     int           `yaml:"min_history" json:"min_history"`       // payments needed before amount_ratio is scored
+	ReviewScore    int           `yaml:"review_score" json:"review_score"`
+	DenyScore      int           `yaml:"deny_score" json:"deny_score"`
+	Rules          []Rule        `yaml:"rules" json:"rules"`
+	Lists          Lists         `yaml:"lists" json:"lists"`
+	Source         string        `yaml:"-" json:"source"`
+
+	emails    map[string]bool
+	cards     map[string]bool
+	countries map[string]bool
+	ips       []*net.IPNet
+}
+
+var knownSignals = map[string]bool{
+	models.SignalEmailVelocity:   true,
+	models.SignalIPVelocity:      true,
+	models.SignalCardVelocity:    true,
+	models.SignalAmount:          true,
+	models.SignalAmountRatio:     true,
+	models.SignalTicketCount:     true,
+	models.SignalCountryMismatch: true,
+	models.SignalBlocklisted:     true,
+}
+
+// DefaultRules is used when no rules file is configured.
+func DefaultRules() *Rules {
+	rules := &Rules{
+		VelocityWindow: time.Hour,
+		HistoryWindow:  30 * 24 * time.Hour,
+		MinHistory:     10,
+		ReviewScore:    50,
+		DenyScore:      90,
+		Rules: []Rule{
+			{Name: "email_velocity", Signal: models.SignalEmailVelocity, Op: "gt", Value: 5, Score: 30},
+			{Name: "ip_velocity", Signal: models.SignalIPVelocity, Op: "gt", Value: 10, Score: 30},
+			{Name: "card_testing", Signal: models.SignalCardVelocity, Op: "gt", Value: 3, Score: 40},
+			{Name: "amount_spike", Signal: models.SignalAmountRatio, Op: "gte", Value: 5, Score: 30},
+			{Name: "amount_extreme", Signal: models.SignalAmountRatio, Op: "gte", Value: 10, Score: 30},
+			{Name: "large_amount", Signal: models.SignalAmount, Op: "gt", Value: 10000, Outcome: models.DecisionReview},
+			{Name: "many_tickets", Signal: models.SignalTicketCount, Op: "gt", Value: 10, Score: 20},
+			{Name: "bulk_order", Signal: models.SignalTicketCount, Op: "gt", Value: 25, Outcome: models.DecisionReview},
+			{Name: "country_mismatch", Signal: models.SignalCountryMismatch, Op: "eq", Value: 1, Score: 25},
+			{Name: "blocklisted", Signal: models.SignalBlocklisted, Op: "eq", Value: 1, Outcome: models.DecisionDeny},
+		},
+		Source: "defaults",
+	}
+	_ = rules.compile()
+	return rules
+}
+
+// ParseRules reads and validates YAML rules. Unset thresholds and windows
+// take the default values.
+func ParseRules(data []byte) (*Rules, error) {
+	defaults := DefaultRules()
+	rules := &Rules{}
+	if err := yaml.Unmarshal(data, rules); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRules, err)
+	}
+	if rules.VelocityWindow <= 0 {
+		rules.VelocityWindow = defaults.VelocityWindow
+	}
+	if rules.HistoryWindow <= 0 {
+		rules.HistoryWindow = defaults.HistoryWindow
+	}
+	if rules.MinHistory <= 0 {
+		rules.MinHistory = defaults.MinHistory
+	}
+	if rules.ReviewScore <= 0 {
+		rules.ReviewScore = defaults.ReviewScore
+	}
+	if rules.DenyScore <= 0 {
+		rules.DenyScore = defaults.DenyScore
+	}
+	if err := rules.compile(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// compile validates the rules and indexes the lists for lookup.
+func (r *Rules) compile() error {
+	if r.DenyScore < r.ReviewScore {
+		return fmt.Errorf("%w: deny_score %d is below review_score %d", ErrInvalidRules, r.DenyScore, r.ReviewScore)
+	}
+	for i, rule := range r.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("%w: rule %d has no name", ErrInvalidRules, i+1)
+		}
+		if !knownSignals[rule.Signal] {
+			return fmt.Errorf("%w: rule %s uses unknown signal %q", ErrInvalidRules, rule.Name, rule.Signal)
+		}
+		switch rule.Op {
+		case "gt", "gte", "lt", "lte", "eq":
+		default:
+			return fmt.Errorf("%w: rule %s uses unknown op %q", ErrInvalidRules, rule.Name, rule.Op)
+		}
+		switch rule.Outcome {
+		case "", models.DecisionReview, models.DecisionDeny:
+		default:
+			return fmt.Errorf("%w: rule %s has outcome %q, want review or deny", ErrInvalidRules, rule.Name, rule.Outcome)
+		}
+	}
+
+	r.emails = lowerSet(r.Lists.Emails)
+	r.cards = make(map[string]bool, len(r.Lists.Cards))
+	for _, card := range r.Lists.Cards {
+		r.cards[strings.TrimSpace(card)] = true
+	}
+	r.countries = make(map[string]bool, len(r.Lists.Countries))
+	for _, country := range r.Lists.Countries {
+		r.countries[strings.ToUpper(strings.TrimSpace(country))] = true
+	}
+	r.ips = nil
+	for _, entry := range r.Lists.IPs {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			if strings.Contains(entry, ":") {
+				entry += "/128"
+			} else {
+				entry += "/32"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return fmt.Errorf("%w: invalid IP list entry %q", ErrInvalidRules, entry)
+		}
+		r.ips = append(r.ips, network)
+	}
+	return nil
+}
+
+func lowerSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[strings.ToLower(strings.TrimSpace(v))] = true
+	}
+	return set
+}
+
+func (r *Rules) listedIP(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range r.ips {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleSource supplies the current rules.
+type RuleSource interface {
+	Rules() (*Rules, error)
+}
+
+// StaticRules always returns the same rules.
+type StaticRules struct {
+	rules *Rules
+}
+
+func NewStaticRules(rules *Rules) *StaticRules {
+	return &StaticRules{rules: rules}
+}
+
+func (s *StaticRules) Rules() (*Rules, error) {
+	return s.rules, nil
+}
+
+// FileRules reads rules from a YAML file, checking it for changes at most
+// once per interval so edits apply without a restart. A file that fails to
+// parse keeps the previous rules in force and the error is returned beside
+// them.
+type FileRules struct {
+	path     string
+	interval time.Duration
+
+	mu        sync.Mutex
+	rules     *Rules
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func NewFileRules(path string, interval time.Duration) (*FileRules, error) {
+	f := &FileRules{path: path, interval: interval}
+	if _, err := f.Rules(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileRules) Rules() (*Rules, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.rules != nil && time.Since(f.checkedAt) < f.interval {
+		return f.rules, nil
+	}
+	f.checkedAt = time.Now()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return f.rules, fmt.Errorf("%w: %v", ErrInvalidRules, err)
+	}
+	if f.rules != nil && info.ModTime().Equal(f.modTime) {
+		return f.rules, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return f.rules, fmt.Errorf("%w: %v", ErrInvalidRules, err)
+	}
+	rules, err := ParseRules(data)
+	if err != nil {
+		return f.rules, fmt.Errorf("%s: %w", f.path, err)
+	}
+	rules.Source = fmt.Sprintf("file:%s@%s", f.path, info.ModTime().UTC().Format(time.RFC3339))
+	f.rules = rules
+	f.modTime = info.ModTime()
+	return rules, nil
+}
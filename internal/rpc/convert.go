@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/rpc/paymentpb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errorDomain qualifies the error codes sent in ErrorInfo details
+const errorDomain = "payment-gateway"
+
+var statusToProto = map[models.PaymentStatus]paymentpb.PaymentStatus{
+	models.StatusPending:   paymentpb.PaymentStatus_PAYMENT_STATUS_PENDING,
+	models.StatusSuccess:   paymentpb.PaymentStatus_PAYMENT_STATUS_SUCCESS,
+	models.StatusFailed:    paymentpb.PaymentStatus_PAYMENT_STATUS_FAILED,
+	models.StatusRefunded:  paymentpb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+	models.StatusCancelled: paymentpb.PaymentStatus_PAYMENT_STATUS_CANCELLED,
+	models.StatusDisputed:  paymentpb.PaymentStatus_PAYMENT_STATUS_DISPUTED,
+	models.StatusInReview:  paymentpb.PaymentStatus_PAYMENT_STATUS_IN_REVIEW,
+}
+
+func paymentToProto(payment *models.Payment) *paymentpb.Payment {
+	return &paymentpb.Payment{
+		PaymentId:     payment.PaymentID,
+		OrganizerId:   payment.OrganizerID,
+		OrderId:       payment.OrderID,
+		Status:        statusToProto[payment.Status],
+		Price:         payment.Price,
+		Currency:      payment.Currency,
+		Date:          timestamppb.New(payment.Date),
+		TransactionId: payment.TransactionID,
+		Email:         payment.Email,
+	}
+}
+
+func refundToProto(refund *models.Refund) *paymentpb.Refund {
+	return &paymentpb.Refund{
+		Id:          refund.ID,
+		PaymentId:   refund.PaymentID,
+		OrganizerId: refund.OrganizerID,
+		Amount:      refund.Amount,
+		Currency:    refund.Currency,
+		Reason:      refund.Reason,
+		CreatedAt:   timestamppb.New(refund.CreatedAt),
+	}
+}
+
+// grpcCodes maps the HTTP status of an API error to the gRPC code with the
+// same meaning
+var grpcCodes = map[int]codes.Code{
+	http.StatusBadRequest:            codes.InvalidArgument,
+	http.StatusUnauthorized:          codes.Unauthenticated,
+	http.StatusPaymentRequired:       codes.FailedPrecondition,
+	http.StatusForbidden:             codes.PermissionDenied,
+	http.StatusNotFound:              codes.NotFound,
+	http.StatusConflict:              codes.FailedPrecondition,
+	http.StatusRequestEntityTooLarge: codes.InvalidArgument,
+	http.StatusUnprocessableEntity:   codes.FailedPrecondition,
+	http.StatusTooManyRequests:       codes.ResourceExhausted,
+	http.StatusBadGateway:            codes.Unavailable,
+	http.StatusServiceUnavailable:    codes.Unavailable,
+	http.StatusGatewayTimeout:        codes.DeadlineExceeded,
+}
+
+// statusError maps service errors to gRPC statuses through their API error,
+// so gRPC callers get the same error codes and messages as REST callers.
+// The code travels in an ErrorInfo detail as the reason.
+func statusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	e := apperror.From(err)
+	code, ok := grpcCodes[e.Status()]
+	if !ok {
+		code = codes.Internal
+	}
+	st := status.New(code, e.Message)
+	if detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{Reason: string(e.Code), Domain: errorDomain}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,245 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/middleware"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRequestIDLength = 64
+	maxActorLength     = 100
+)
+
+// sourceUnary and sourceStream tag each call with a request ID, sent back in
+// the x-request-id header, and store it with the peer IP for the audit log,
+// as RequestSource does for REST calls.
+func sourceUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withSource(ctx), req)
+	}
+}
+
+func sourceStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withSource(ss.Context())})
+	}
+}
+
+func withSource(ctx context.Context) context.Context {
+	requestID := incoming(ctx, "x-request-id")
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = utils.GenerateRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", requestID))
+
+	actor := incoming(ctx, "x-actor")
+	if len(actor) > maxActorLength {
+		actor = actor[:maxActorLength]
+	}
+	return audit.WithSource(ctx, audit.Source{
+		IP:         peerIP(ctx),
+		RequestID:  requestID,
+		OnBehalfOf: actor,
+	})
+}
+
+// loggingUnary and loggingStream log every call with its status code and
+// duration.
+func loggingUnary(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(log, ctx, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+func loggingStream(log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(log, ss.Context(), info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+func logCall(log *logger.Logger, ctx context.Context, method string, err error, duration time.Duration) {
+	code := status.Code(err)
+	switch code {
+	case codes.OK:
+		log.LogAPI("GRPC", method, code.String(), duration.String())
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		log.Error("GRPC", fmt.Sprintf("%s - %s (%s) - ERROR: %s", method, code, duration, status.Convert(err).Message()))
+	default:
+		log.Warn("GRPC", fmt.Sprintf("%s - %s (%s) - Client Error", method, code, duration))
+	}
+	log.Debug("REQUEST", fmt.Sprintf("IP: %s, UserAgent: %s", peerIP(ctx), incoming(ctx, "user-agent")))
+}
+
+// recoveryUnary and recoveryStream turn panics in the handlers into
+// Internal errors.
+func recoveryUnary(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(log, info.FullMethod, recovered)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func recoveryStream(log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(log, info.FullMethod, recovered)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(log *logger.Logger, method string, recovered interface{}) error {
+	// Panic values can carry request data; never let a card number reach the log
+	log.Error("PANIC", utils.RedactPAN(fmt.Sprintf("Recovered from panic in %s: %v", method, recovered)))
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func metricsUnary(metrics *Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := metrics.start(info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+func metricsStream(metrics *Metrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done := metrics.start(info.FullMethod)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+// deadlineUnary gives calls without a deadline the default timeout and cuts
+// longer deadlines down to the maximum, so a caller cannot hold a database
+// connection indefinitely.
+func deadlineUnary(cfg config.GRPCConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := boundDeadline(ctx, cfg.DefaultTimeout, cfg.MaxTimeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// deadlineStream bounds streams by the watch timeout, which is far longer
+// than any unary call should take.
+func deadlineStream(cfg config.GRPCConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := boundDeadline(ss.Context(), cfg.WatchTimeout, cfg.WatchTimeout)
+		defer cancel()
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func boundDeadline(ctx context.Context, fallback, max time.Duration) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok:
+		return context.WithTimeout(ctx, fallback)
+	case time.Until(deadline) > max:
+		return context.WithTimeout(ctx, max)
+	}
+	return ctx, func() {}
+}
+
+// authUnary and authStream resolve the caller from the x-api-key metadata
+// (or a Bearer token) with middleware.ResolvePrincipal, as Authenticate does
+// for REST calls.
+func authUnary(resolver middleware.OrganizerKeyResolver, cfg config.AuthConfig, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, resolver, cfg, log)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authStream(resolver middleware.OrganizerKeyResolver, cfg config.AuthConfig, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), resolver, cfg, log)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, resolver middleware.OrganizerKeyResolver, cfg config.AuthConfig, log *logger.Logger) (context.Context, error) {
+	apiKey := incoming(ctx, "x-api-key")
+	if apiKey == "" {
+		apiKey = strings.TrimPrefix(incoming(ctx, "authorization"), "Bearer ")
+	}
+	principal, err := middleware.ResolvePrincipal(resolver, cfg, middleware.Credentials{
+		APIKey:      apiKey,
+		OrganizerID: incoming(ctx, "x-organizer-id"),
+		IP:          peerIP(ctx),
+	}, log)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return tenant.WithPrincipal(ctx, principal), nil
+}
+
+// contextStream replaces a stream's context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func incoming(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type organizerKeys map[string]string
+
+func (k organizerKeys) ResolveAPIKey(apiKey string) (*models.Organizer, error) {
+	if id, ok := k[apiKey]; ok {
+		return &models.Organizer{ID: id}, nil
+	}
+	return nil, errors.New("unknown key")
+}
+
+func TestAuthenticateReadsMetadata(t *testing.T) {
+	cfg := config.AuthConfig{Enabled: true, PlatformAPIKey: "platform-key"}
+	keys := organizerKeys{"org-key": "org_1"}
+	tests := []struct {
+		metadata []string
+		code     codes.Code
+		want     tenant.Principal
+	}{
+		{[]string{"x-api-key", "org-key"}, codes.OK, tenant.Principal{OrganizerID: "org_1"}},
+		{[]string{"authorization", "Bearer org-key"}, codes.OK, tenant.Principal{OrganizerID: "org_1"}},
+		{[]string{"x-api-key", "platform-key"}, codes.OK, tenant.Principal{Platform: true}},
+		{[]string{"x-api-key", "platform-key", "x-organizer-id", "org_2"}, codes.OK, tenant.Principal{OrganizerID: "org_2"}},
+		{nil, codes.Unauthenticated, tenant.Principal{}},
+		{[]string{"x-api-key", "guess"}, codes.Unauthenticated, tenant.Principal{}},
+	}
+	for _, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.metadata...))
+		ctx, err := authenticate(ctx, keys, cfg, logger.NewFileLogger())
+		if code := status.Code(err); code != tt.code {
+			t.Errorf("%v: code = %s, want %s", tt.metadata, code, tt.code)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got, _ := tenant.FromContext(ctx); got != tt.want {
+			t.Errorf("%v: principal = %+v, want %+v", tt.metadata, got, tt.want)
+		}
+	}
+}
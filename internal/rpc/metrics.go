@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// MethodStats counts the calls to one gRPC method
+type MethodStats struct {
+	Calls      int64            `json:"calls"`
+	InFlight   int64            `json:"in_flight"`
+	Codes      map[string]int64 `json:"codes"` // calls by status code
+	AvgMillis  float64          `json:"avg_ms"`
+	MaxMillis  float64          `json:"max_ms"`
+	totalMilli float64
+}
+
+// Metrics keeps call counts, status codes and latencies per gRPC method,
+// for the process's lifetime.
+type Metrics struct {
+	mu      sync.Mutex
+	methods map[string]*MethodStats
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{methods: make(map[string]*MethodStats)}
+}
+
+// Snapshot returns a copy of the stats keyed by full method name.
+func (m *Metrics) Snapshot() map[string]MethodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]MethodStats, len(m.methods))
+	for method, stats := range m.methods {
+		copied := *stats
+		copied.Codes = make(map[string]int64, len(stats.Codes))
+		for code, n := range stats.Codes {
+			copied.Codes[code] = n
+		}
+		snapshot[method] = copied
+	}
+	return snapshot
+}
+
+// start counts a call as in flight and returns the function that records
+// its outcome.
+func (m *Metrics) start(method string) func(err error) {
+	m.mu.Lock()
+	stats, ok := m.methods[method]
+	if !ok {
+		stats = &MethodStats{Codes: make(map[string]int64)}
+		m.methods[method] = stats
+	}
+	stats.InFlight++
+	m.mu.Unlock()
+
+	begin := time.Now()
+	return func(err error) {
+		millis := float64(time.Since(begin).Microseconds()) / 1000
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		stats.InFlight--
+		stats.Calls++
+		stats.Codes[status.Code(err).String()]++
+		stats.totalMilli += millis
+		stats.AvgMillis = stats.totalMilli / float64(stats.Calls)
+		if millis > stats.MaxMillis {
+			stats.MaxMillis = millis
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Package rpc serves the gateway's gRPC API, defined in
+// proto/payment/v1/payment.proto, beside the Gin REST API. Calls go through
+// the same services as REST calls, so both APIs behave the same way.
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/middleware"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/otp"
+	"payment-gateway/internal/rpc/paymentpb"
+	"payment-gateway/internal/services"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewServer builds the gRPC server and registers the payment service.
+// Interceptors run in order: request source, logging, metrics, deadlines,
+// authentication and panic recovery, so failed logins and panics are
+// logged and counted too.
+func NewServer(payments *services.PaymentService, events *services.EventService, resolver middleware.OrganizerKeyResolver, metrics *Metrics, cfg config.GRPCConfig, auth config.AuthConfig, log *logger.Logger) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			sourceUnary(),
+			loggingUnary(log),
+			metricsUnary(metrics),
+			deadlineUnary(cfg),
+			authUnary(resolver, auth, log),
+			recoveryUnary(log),
+		),
+		grpc.ChainStreamInterceptor(
+			sourceStream(),
+			loggingStream(log),
+			metricsStream(metrics),
+			deadlineStream(cfg),
+			authStream(resolver, auth, log),
+			recoveryStream(log),
+		),
+	)
+	paymentpb.RegisterPaymentServiceServer(server, &paymentServer{
+		payments:     payments,
+		events:       events,
+		pollInterval: cfg.WatchPollInterval,
+	})
+	return server
+}
+
+type paymentServer struct {
+	paymentpb.UnimplementedPaymentServiceServer
+
+	payments     *services.PaymentService
+	events       *services.EventService
+	pollInterval time.Duration
+}
+
+func (s *paymentServer) ProcessPayment(ctx context.Context, req *paymentpb.ProcessPaymentRequest) (*paymentpb.Payment, error) {
+	payment, err := s.payments.ProcessPayment(ctx, &models.PaymentRequest{
+		PaymentID:      req.GetPaymentId(),
+		OrganizerID:    req.GetOrganizerId(),
+		OrderID:        req.GetOrderId(),
+		Price:          req.GetPrice(),
+		Currency:       req.GetCurrency(),
+		TransactionID:  req.GetTransactionId(),
+		Email:          req.GetEmail(),
+		BillingCountry: req.GetBillingCountry(),
+		TicketCount:    int(req.GetTicketCount()),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return paymentToProto(payment), nil
+}
+
+func (s *paymentServer) GetPayment(ctx context.Context, req *paymentpb.GetPaymentRequest) (*paymentpb.Payment, error) {
+	payment, err := s.getPayment(ctx, req.GetPaymentId())
+	if err != nil {
+		return nil, err
+	}
+	return paymentToProto(payment), nil
+}
+
+func (s *paymentServer) GetPaymentStatus(ctx context.Context, req *paymentpb.GetPaymentRequest) (*paymentpb.PaymentStatusResponse, error) {
+	payment, err := s.getPayment(ctx, req.GetPaymentId())
+	if err != nil {
+		return nil, err
+	}
+	return &paymentpb.PaymentStatusResponse{
+		PaymentId: payment.PaymentID,
+		Status:    statusToProto[payment.Status],
+	}, nil
+}
+
+func (s *paymentServer) RefundPayment(ctx context.Context, req *paymentpb.RefundPaymentRequest) (*paymentpb.Payment, error) {
+	if req.GetPaymentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "payment_id is required")
+	}
+	refund := &models.RefundRequest{Reason: req.GetReason()}
+	if req.Amount != nil {
+		value := req.GetAmount()
+		refund.Amount = &value
+	}
+
+	payment, err := s.payments.RefundPayment(ctx, req.GetPaymentId(), refund)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return paymentToProto(payment), nil
+}
+
+func (s *paymentServer) ListRefunds(ctx context.Context, req *paymentpb.ListRefundsRequest) (*paymentpb.ListRefundsResponse, error) {
+	refunds, err := s.payments.ListRefunds(ctx, req.GetPaymentId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	resp := &paymentpb.ListRefundsResponse{}
+	for _, refund := range refunds {
+		resp.Refunds = append(resp.Refunds, refundToProto(refund))
+	}
+	return resp, nil
+}
+
+func (s *paymentServer) SendOTP(ctx context.Context, req *paymentpb.SendOTPRequest) (*paymentpb.SendOTPResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	s.payments.OtpSender(req.GetEmail())
+	return &paymentpb.SendOTPResponse{TtlSeconds: int32(otp.TTL.Seconds())}, nil
+}
+
+func (s *paymentServer) ValidateOTP(ctx context.Context, req *paymentpb.ValidateOTPRequest) (*paymentpb.ValidateOTPResponse, error) {
+	if req.GetOrderId() == "" || req.GetOtp() == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id and otp are required")
+	}
+	return &paymentpb.ValidateOTPResponse{
+		Valid: s.payments.VerifyOTP(ctx, req.GetOrderId(), req.GetOtp()),
+	}, nil
+}
+
+// WatchPayment streams a payment's status changes. Published events
+// arrive at once; the payment is also re-read every poll interval in case
+// an event was lost on its way.
+func (s *paymentServer) WatchPayment(req *paymentpb.WatchPaymentRequest, stream paymentpb.PaymentService_WatchPaymentServer) error {
+	ctx := stream.Context()
+	paymentID := req.GetPaymentId()
+
+	// Watch before the first read so no change falls between the two
+	events, stop := s.events.Watch(paymentID)
+	defer stop()
+
+	payment, err := s.getPayment(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+	if err := s.sendUpdate(stream, payment, ""); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	last := payment.Status
+	for !last.Settled() {
+		eventType := ""
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-events:
+			if !ok {
+				// Dropped for falling behind; polling carries on
+				events = nil
+				continue
+			}
+			eventType = event.Type
+		case <-ticker.C:
+		}
+
+		// Re-read rather than trust the event's copy, which some events
+		// do not carry
+		if payment, err = s.getPayment(ctx, paymentID); err != nil {
+			return err
+		}
+		if payment.Status == last {
+			continue
+		}
+		if err := s.sendUpdate(stream, payment, eventType); err != nil {
+			return err
+		}
+		last = payment.Status
+	}
+	return nil
+}
+
+func (s *paymentServer) sendUpdate(stream paymentpb.PaymentService_WatchPaymentServer, payment *models.Payment, eventType string) error {
+	return stream.Send(&paymentpb.PaymentStatusUpdate{
+		Payment:   paymentToProto(payment),
+		EventType: eventType,
+		Timestamp: timestamppb.New(time.Now()),
+	})
+}
+
+func (s *paymentServer) getPayment(ctx context.Context, paymentID string) (*models.Payment, error) {
+	if paymentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "payment_id is required")
+	}
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return payment, nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+// AdminService backs the admin console used by support staff. It only
+// gathers records and routes actions: every change is made by
+// PaymentService, or by StripeService for Stripe's side, so the console
+// produces the same events and audit entries as the rest of the gateway.
+type AdminService struct {
+	payments *PaymentService
+	stripe   *StripeService
+	events   *EventService
+	audit    *AuditService
+	disputes storage.DisputeStore
+	reviews  storage.RiskStore
+	log      *logger.Logger
+}
+
+func NewAdminService(payments *PaymentService, stripe *StripeService, events *EventService, audit *AuditService, disputes storage.DisputeStore, reviews storage.RiskStore, log *logger.Logger) *AdminService {
+	return &AdminService{
+		payments: payments,
+		stripe:   stripe,
+		events:   events,
+		audit:    audit,
+		disputes: disputes,
+		reviews:  reviews,
+		log:      log,
+	}
+}
+
+// Lookup finds payments by any identifier support is likely to be given:
+// payment ID, order ID, Stripe PaymentIntent ID or attendee email.
+func (s *AdminService) Lookup(ctx context.Context, term string, limit int) ([]*models.Payment, error) {
+	return s.payments.SearchPayments(ctx, term, limit)
+}
+
+// Timeline returns a payment with its events, refunds, disputes, risk
+// review and audit trail.
+func (s *AdminService) Timeline(ctx context.Context, paymentID string) (*models.PaymentTimeline, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &models.PaymentTimeline{Payment: payment}
+	if timeline.Events, timeline.Source, err = s.events.Timeline(ctx, paymentID); err != nil {
+		return nil, err
+	}
+	if timeline.Refunds, err = s.payments.ListRefunds(ctx, paymentID); err != nil {
+		return nil, err
+	}
+	if timeline.Disputes, err = s.disputes.ListPaymentDisputes(paymentID); err != nil {
+		return nil, err
+	}
+	if timeline.Audit, err = s.audit.List(ctx, models.AuditFilter{PaymentID: paymentID, Limit: maxAuditLimit}); err != nil {
+		return nil, err
+	}
+	// Most payments were never held, so a missing review is not an error
+	timeline.Review, _ = s.reviews.GetRiskReview(paymentID)
+	return timeline, nil
+}
+
+func (s *AdminService) OverrideStatus(ctx context.Context, paymentID string, req *models.StatusOverrideRequest) (*models.Payment, error) {
+	return s.payments.OverrideStatus(ctx, paymentID, req.Status, req.Reason)
+}
+
+// ForceRefund refunds a payment whatever the organizer's refund rules.
+// Stripe payments are refunded through Stripe first; the local payment and
+// refund are then recorded the same way as a refund made from the API.
+func (s *AdminService) ForceRefund(ctx context.Context, paymentID string, req *models.ForceRefundRequest) (*models.Payment, error) {
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if !isStripePaymentIntent(payment.TransactionID) {
+		return s.payments.ForceRefund(ctx, paymentID, req.Amount, req.Reason)
+	}
+
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if req.Reason == "" {
+		return nil, ErrReasonRequired
+	}
+	s.log.LogSecurity("FORCED_REFUND", fmt.Sprintf("Stripe refund of payment %s forced: %s", paymentID, req.Reason))
+	refund, err := s.stripe.RefundPayment(ctx, &models.StripeRefundRequest{
+		PaymentID: payment.TransactionID,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.payments.RecordRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+	return s.payments.GetPayment(ctx, paymentID)
+}
+
+// Cancel cancels a payment that has not completed. Stripe intents are
+// cancelled with Stripe first so the attendee cannot still complete them.
+func (s *AdminService) Cancel(ctx context.Context, paymentID, reason string) (*models.Payment, error) {
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != models.StatusPending && payment.Status != models.StatusInReview {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotCancelable, payment.Status)
+	}
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	if isStripePaymentIntent(payment.TransactionID) {
+		if err := s.stripe.CancelPaymentIntent(ctx, payment.TransactionID, stripe.PaymentIntentCancellationReasonRequestedByCustomer); err != nil {
+			return nil, err
+		}
+	}
+	return s.payments.CancelPayment(ctx, payment, reason)
+}
+
+func (s *AdminService) ResetOTP(ctx context.Context, paymentID, reason string) (*models.Payment, error) {
+	return s.payments.ResetOTP(ctx, paymentID, reason)
+}
+
+func (s *AdminService) ResendOTP(ctx context.Context, paymentID, reason string) (*models.Payment, error) {
+	return s.payments.ResendOTP(ctx, paymentID, reason)
+}
+
+// Resync reloads a Stripe payment's intent and applies its status, posting
+// the capture to the ledger if Stripe has taken the money.
+func (s *AdminService) Resync(ctx context.Context, paymentID string) (*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if !isStripePaymentIntent(payment.TransactionID) {
+		return nil, ErrNotStripePayment
+	}
+
+	pi, err := s.stripe.GetPaymentIntent(ctx, payment.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	s.stripe.RecordIntentCapture(pi)
+	return s.payments.ResyncStripePayment(ctx, payment, intentStatus(pi.Status), string(pi.Status))
+}
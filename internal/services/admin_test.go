@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+)
+
+// noReviews holds no risk reviews, as for a payment that was never held
+type noReviews struct {
+	storage.RiskStore
+}
+
+func (noReviews) GetRiskReview(paymentID string) (*models.RiskReview, error) {
+	return nil, errors.New("risk review not found")
+}
+
+func newAdmin(env *testEnv) *AdminService {
+	disputes := &disputeStore{disputes: make(map[string]*models.Dispute)}
+	env.events.disputes = disputes
+	return NewAdminService(env.payments, nil, env.events, env.audit, disputes, noReviews{}, env.log)
+}
+
+func staff() context.Context {
+	return tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true, Staff: "ana"})
+}
+
+func (e *testEnv) audited(action string) []*models.AuditEntry {
+	var entries []*models.AuditEntry
+	for _, entry := range e.store.audit {
+		if entry.Action == action {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func TestAdminActionsNeedStaffAndAReason(t *testing.T) {
+	env := newTestEnv(t)
+	admin := newAdmin(env)
+	env.capturedPayment(t, "pay_1", 100)
+	env.pendingPayment(t, "pay_2", "txn_2", time.Now())
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+	amount := 10.0
+
+	calls := map[string]func(ctx context.Context, reason string) error{
+		"lookup": func(ctx context.Context, reason string) error {
+			_, err := admin.Lookup(ctx, "pay_1", 0)
+			return err
+		},
+		"timeline": func(ctx context.Context, reason string) error {
+			_, err := admin.Timeline(ctx, "pay_1")
+			return err
+		},
+		"override": func(ctx context.Context, reason string) error {
+			_, err := admin.OverrideStatus(ctx, "pay_1", &models.StatusOverrideRequest{Status: models.StatusFailed, Reason: reason})
+			return err
+		},
+		"force refund": func(ctx context.Context, reason string) error {
+			_, err := admin.ForceRefund(ctx, "pay_1", &models.ForceRefundRequest{Amount: &amount, Reason: reason})
+			return err
+		},
+		"cancel": func(ctx context.Context, reason string) error {
+			_, err := admin.Cancel(ctx, "pay_2", reason)
+			return err
+		},
+		"reset otp": func(ctx context.Context, reason string) error {
+			_, err := admin.ResetOTP(ctx, "pay_2", reason)
+			return err
+		},
+		"resync": func(ctx context.Context, reason string) error {
+			_, err := admin.Resync(ctx, "pay_1")
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(organizer, "support ticket 1234"); !errors.Is(err, ErrForbidden) && !errors.Is(err, ErrPaymentNotFound) {
+			t.Errorf("%s as an organizer: err = %v, want ErrForbidden", name, err)
+		}
+	}
+	for _, name := range []string{"override", "force refund", "cancel"} {
+		if err := calls[name](staff(), " "); !errors.Is(err, ErrReasonRequired) {
+			t.Errorf("%s without a reason: err = %v, want ErrReasonRequired", name, err)
+		}
+	}
+	if payment, _ := env.store.GetPayment("pay_1"); payment.Status != models.StatusSuccess || len(env.store.entries("payment.refunded")) != 0 {
+		t.Errorf("refused actions changed the payment: %s", payment.Status)
+	}
+}
+
+func TestForceRefundMovesMoneyOnce(t *testing.T) {
+	env := newTestEnv(t)
+	admin := newAdmin(env)
+	payment := env.capturedPayment(t, "pay_1", 100)
+	payment.Status = models.StatusDisputed
+	_ = env.store.UpdatePayment(payment)
+
+	amount := 30.0
+	if _, err := admin.ForceRefund(staff(), "pay_1", &models.ForceRefundRequest{Amount: &amount, Reason: "chargeback settled"}); err != nil {
+		t.Fatal(err)
+	}
+	over := 70.01
+	if _, err := admin.ForceRefund(staff(), "pay_1", &models.ForceRefundRequest{Amount: &over, Reason: "again"}); !errors.Is(err, ErrInvalidRefundAmount) {
+		t.Errorf("refund past the payment: err = %v, want ErrInvalidRefundAmount", err)
+	}
+	refunded, err := admin.ForceRefund(staff(), "pay_1", &models.ForceRefundRequest{Reason: "the rest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refunded.Status != models.StatusRefunded {
+		t.Errorf("status = %s, want refunded", refunded.Status)
+	}
+
+	var total int64
+	for _, entry := range env.store.entries("payment.refunded") {
+		total += entry.Lines[0].Debit
+	}
+	if total != 10000 {
+		t.Errorf("ledger refunded %d minor units, want 10000", total)
+	}
+	audited := env.audited(models.AuditPaymentForceRefunded)
+	if len(audited) != 2 || audited[0].Actor != "support:ana" {
+		t.Errorf("audit = %+v", audited)
+	}
+}
+
+func TestOverrideStatusOnlyChangesTheRecord(t *testing.T) {
+	env := newTestEnv(t)
+	admin := newAdmin(env)
+	env.pendingPayment(t, "pay_1", "txn_1", time.Now())
+	lock := env.payments.redis.(*memLock)
+	if _, err := lock.AddOTP("123456", "order_pay_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, status := range []models.PaymentStatus{"paid", models.StatusPending} {
+		if _, err := admin.OverrideStatus(staff(), "pay_1", &models.StatusOverrideRequest{Status: status, Reason: "incident 42"}); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("override to %q: err = %v, want ErrInvalidStatus", status, err)
+		}
+	}
+	payment, err := admin.OverrideStatus(staff(), "pay_1", &models.StatusOverrideRequest{Status: models.StatusFailed, Reason: "incident 42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != models.StatusFailed {
+		t.Errorf("status = %s", payment.Status)
+	}
+	if _, locked := lock.otps["order_pay_1"]; locked {
+		t.Error("OTP lock kept after the payment was settled by hand")
+	}
+	if len(env.store.journal) != 0 {
+		t.Errorf("override posted %d journal entries", len(env.store.journal))
+	}
+	if len(env.audited(models.AuditPaymentStatusOverride)) != 1 || env.store.eventTypes()[0] != "payment.failed" {
+		t.Errorf("audit %+v, events %v", env.store.audit, env.store.eventTypes())
+	}
+}
+
+func TestCancelAndOTPActions(t *testing.T) {
+	env := newTestEnv(t)
+	admin := newAdmin(env)
+	env.capturedPayment(t, "pay_done", 100)
+	env.pendingPayment(t, "pay_otp", "txn_otp", time.Now())
+	env.pendingPayment(t, "pay_reset", "txn_reset", time.Now())
+	env.pendingPayment(t, "pay_stripe", "pi_1", time.Now())
+
+	if _, err := admin.Cancel(staff(), "pay_done", "duplicate"); !errors.Is(err, ErrPaymentNotCancelable) {
+		t.Errorf("cancel a captured payment: err = %v, want ErrPaymentNotCancelable", err)
+	}
+	cancelled, err := admin.Cancel(staff(), "pay_otp", "attendee asked")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.Status != models.StatusCancelled || len(env.audited(models.AuditPaymentCancelled)) != 1 {
+		t.Errorf("cancelled = %s, audit %+v", cancelled.Status, env.store.audit)
+	}
+
+	reset, err := admin.ResetOTP(staff(), "pay_reset", "lost the email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reset.Status != models.StatusCancelled || len(env.audited(models.AuditOTPReset)) != 1 {
+		t.Errorf("reset = %s", reset.Status)
+	}
+	if _, err := admin.ResendOTP(staff(), "pay_reset", "lost the email"); !errors.Is(err, ErrOTPNotApplicable) {
+		t.Errorf("resend for a cancelled payment: err = %v, want ErrOTPNotApplicable", err)
+	}
+	if _, err := admin.ResetOTP(staff(), "pay_stripe", "lost the email"); !errors.Is(err, ErrOTPNotApplicable) {
+		t.Errorf("reset a Stripe payment: err = %v, want ErrOTPNotApplicable", err)
+	}
+	if _, err := admin.Resync(staff(), "pay_done"); !errors.Is(err, ErrNotStripePayment) {
+		t.Errorf("resync a simulated payment: err = %v, want ErrNotStripePayment", err)
+	}
+}
+
+func TestLookupAndTimeline(t *testing.T) {
+	env := newTestEnv(t)
+	admin := newAdmin(env)
+	payment := env.capturedPayment(t, "pay_1", 100)
+	payment.Email = "Ada@Example.com"
+	payment.TransactionID = "pi_1"
+	_ = env.store.UpdatePayment(payment)
+
+	for _, term := range []string{"pay_1", "order_pay_1", "pi_1", " ada@example.com "} {
+		found, err := admin.Lookup(staff(), term, 0)
+		if err != nil || len(found) != 1 {
+			t.Errorf("lookup %q: %d found, err %v", term, len(found), err)
+		}
+	}
+	if _, err := admin.Lookup(staff(), "  ", 0); !errors.Is(err, ErrInvalidSearch) {
+		t.Errorf("empty lookup: err = %v, want ErrInvalidSearch", err)
+	}
+
+	amount := 25.0
+	if _, err := env.payments.RefundPayment(staff(), "pay_1", &models.RefundRequest{Amount: &amount, Reason: "requested_by_customer"}); err != nil {
+		t.Fatal(err)
+	}
+	timeline, err := admin.Timeline(staff(), "pay_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeline.Payment.PaymentID != "pay_1" || len(timeline.Refunds) != 1 || len(timeline.Events) == 0 ||
+		len(timeline.Audit) == 0 || timeline.Review != nil {
+		t.Errorf("timeline = %+v", timeline)
+	}
+}
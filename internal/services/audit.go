@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+	maxAuditExport    = 100000
+	auditVerifyBatch  = 1000
+)
+
+// AuditService writes the append-only audit log of money-moving and admin
+// actions and answers compliance queries over it. The actor, source IP and
+// request ID come from the request context.
+type AuditService struct {
+	store storage.AuditStore
+	log   *logger.Logger
+}
+
+func NewAuditService(store storage.AuditStore, log *logger.Logger) *AuditService {
+	return &AuditService{
+		store: store,
+		log:   log,
+	}
+}
+
+// Record appends an entry with before and after snapshots of the target;
+// either may be nil. The action has already happened by the time it is
+// audited, so a failed write is logged rather than returned.
+func (s *AuditService) Record(ctx context.Context, entry *models.AuditEntry, before, after interface{}) {
+	if entry.Actor == "" {
+		entry.Actor, entry.ActorType = auditActor(ctx)
+	}
+	if src, ok := audit.SourceFrom(ctx); ok {
+		entry.SourceIP = src.IP
+		entry.RequestID = src.RequestID
+	}
+	entry.Before = s.snapshot(entry, before)
+	entry.After = s.snapshot(entry, after)
+	entry.CreatedAt = time.Now()
+
+	if err := s.store.AppendAuditEntry(entry); err != nil {
+		s.log.Error("AUDIT", fmt.Sprintf("Failed to audit %s on %s %s by %s: %v", entry.Action, entry.TargetType, entry.TargetID, entry.Actor, err))
+	}
+}
+
+func (s *AuditService) snapshot(entry *models.AuditEntry, value interface{}) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		s.log.Warn("AUDIT", fmt.Sprintf("Failed to snapshot %s %s for %s: %v", entry.TargetType, entry.TargetID, entry.Action, err))
+		return nil
+	}
+	if string(raw) == "null" {
+		return nil // a typed nil pointer
+	}
+	return raw
+}
+
+// List returns entries matching the filter, newest first.
+func (s *AuditService) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit > maxAuditLimit {
+		filter.Limit = maxAuditLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.store.ListAuditEntries(filter)
+}
+
+// Export returns every entry matching the filter, up to maxAuditExport,
+// for compliance reviews.
+func (s *AuditService) Export(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if filter.Limit <= 0 || filter.Limit > maxAuditExport {
+		filter.Limit = maxAuditExport
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.store.ListAuditEntries(filter)
+}
+
+// Verify walks the chain from the first entry to the current head,
+// recomputing every hash. It stops at the first entry that is missing,
+// out of place or altered.
+func (s *AuditService) Verify(ctx context.Context) (*models.AuditVerification, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+
+	headSeq, headHash, err := s.store.AuditChainHead()
+	if err != nil {
+		return nil, err
+	}
+	result := &models.AuditVerification{HeadSeq: headSeq, HeadHash: headHash, VerifiedAt: time.Now()}
+	broken := func(seq int64, problem string, args ...interface{}) (*models.AuditVerification, error) {
+		result.BrokenAt = seq
+		result.Problem = fmt.Sprintf(problem, args...)
+		s.log.LogSecurity("AUDIT_CHAIN_BROKEN", fmt.Sprintf("Audit log verification failed at entry %d: %s", seq, result.Problem))
+		return result, nil
+	}
+
+	var prevSeq int64
+	var prevHash string
+	for prevSeq < headSeq {
+		batch, err := s.store.ListAuditChain(prevSeq, auditVerifyBatch)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, entry := range batch {
+			if entry.Seq != prevSeq+1 {
+				return broken(prevSeq+1, "entry %d is missing", prevSeq+1)
+			}
+			if entry.Seq > headSeq {
+				break // appended after the head was read
+			}
+			if entry.PrevHash != prevHash {
+				return broken(entry.Seq, "entry %d does not link to entry %d", entry.Seq, prevSeq)
+			}
+			if entry.ComputeHash() != entry.Hash {
+				return broken(entry.Seq, "entry %d does not match its hash", entry.Seq)
+			}
+			prevSeq, prevHash = entry.Seq, entry.Hash
+			result.Checked++
+		}
+	}
+
+	if prevSeq != headSeq {
+		return broken(prevSeq+1, "entries %d to %d are missing", prevSeq+1, headSeq)
+	}
+	if prevHash != headHash {
+		return broken(headSeq, "entry %d does not match the chain head", headSeq)
+	}
+	result.Valid = true
+	s.log.Info("AUDIT", fmt.Sprintf("Audit log verified: %d entries, head %s", result.Checked, headHash))
+	return result, nil
+}
+
+// auditActor names the caller. Platform callers may say which person or
+// service is behind the shared key with X-Actor; support staff are named by
+// their own key. Contexts without a principal come from background jobs and
+// webhooks.
+func auditActor(ctx context.Context) (string, models.AuditActorType) {
+	p, ok := tenant.FromContext(ctx)
+	switch {
+	case !ok:
+		return string(models.ActorSystem), models.ActorSystem
+	case p.Staff != "":
+		return "support:" + p.Staff, models.ActorSupport
+	case p.Platform:
+		if src, ok := audit.SourceFrom(ctx); ok && src.OnBehalfOf != "" {
+			return "platform:" + src.OnBehalfOf, models.ActorPlatform
+		}
+		return string(models.ActorPlatform), models.ActorPlatform
+	default:
+		return "organizer:" + p.OrganizerID, models.ActorOrganizer
+	}
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+// chainStore chains entries as MySQLStore.AppendAuditEntry does, and lets a
+// test tamper with them afterwards
+type chainStore struct {
+	entries  []*models.AuditEntry
+	headSeq  int64
+	headHash string
+}
+
+func (s *chainStore) AppendAuditEntry(entry *models.AuditEntry) error {
+	entry.Seq = s.headSeq + 1
+	entry.PrevHash = s.headHash
+	entry.CreatedAt = entry.CreatedAt.Truncate(time.Microsecond)
+	entry.Hash = entry.ComputeHash()
+	copied := *entry
+	s.entries = append(s.entries, &copied)
+	s.headSeq, s.headHash = entry.Seq, entry.Hash
+	return nil
+}
+
+func (s *chainStore) ListAuditEntries(filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	return s.entries, nil
+}
+
+func (s *chainStore) ListAuditChain(afterSeq int64, limit int) ([]*models.AuditEntry, error) {
+	var batch []*models.AuditEntry
+	for _, entry := range s.entries {
+		if entry.Seq > afterSeq && len(batch) < limit {
+			copied := *entry
+			batch = append(batch, &copied)
+		}
+	}
+	return batch, nil
+}
+
+func (s *chainStore) AuditChainHead() (int64, string, error) {
+	return s.headSeq, s.headHash, nil
+}
+
+// auditedChain records n refunds of pay_1 through the service
+func auditedChain(t *testing.T, n int) (*chainStore, *AuditService) {
+	t.Helper()
+	store := &chainStore{}
+	service := NewAuditService(store, logger.NewFileLogger())
+	for i := 0; i < n; i++ {
+		service.Record(context.Background(), &models.AuditEntry{
+			Action: models.AuditPaymentRefunded, TargetType: "payment", TargetID: "pay_1", PaymentID: "pay_1",
+		}, map[string]string{"status": "success"}, map[string]string{"status": "refunded"})
+	}
+	return store, service
+}
+
+func TestVerifyAcceptsAnIntactChain(t *testing.T) {
+	_, service := auditedChain(t, 5)
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+
+	result, err := service.Verify(platform)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid || result.Checked != 5 || result.HeadSeq != 5 || result.BrokenAt != 0 {
+		t.Errorf("verification = %+v", result)
+	}
+
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+	if _, err := service.Verify(organizer); !errors.Is(err, ErrForbidden) {
+		t.Errorf("organizer: err = %v, want ErrForbidden", err)
+	}
+}
+
+func TestVerifyFindsTampering(t *testing.T) {
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	tests := []struct {
+		name     string
+		tamper   func(s *chainStore)
+		brokenAt int64
+	}{
+		{"edited snapshot", func(s *chainStore) { s.entries[2].After = json.RawMessage(`{"status":"success"}`) }, 3},
+		{"rehashed edit", func(s *chainStore) {
+			s.entries[1].Actor = "platform:someone-else"
+			s.entries[1].Hash = s.entries[1].ComputeHash()
+		}, 3},
+		{"deleted entry", func(s *chainStore) { s.entries = append(s.entries[:3], s.entries[4:]...) }, 4},
+		{"truncated tail", func(s *chainStore) { s.entries = s.entries[:3] }, 4},
+		{"rewritten head", func(s *chainStore) { s.headHash = "0000" }, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, service := auditedChain(t, 5)
+			tt.tamper(store)
+
+			result, err := service.Verify(platform)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Valid || result.BrokenAt != tt.brokenAt || result.Problem == "" {
+				t.Errorf("verification = %+v, want broken at %d", result, tt.brokenAt)
+			}
+		})
+	}
+}
+
+func TestRecordNamesTheActorAndSource(t *testing.T) {
+	store, service := auditedChain(t, 0)
+	withSource := func(p *tenant.Principal, onBehalfOf string) context.Context {
+		ctx := audit.WithSource(context.Background(), audit.Source{IP: "203.0.113.7", RequestID: "req_1", OnBehalfOf: onBehalfOf})
+		if p != nil {
+			ctx = tenant.WithPrincipal(ctx, *p)
+		}
+		return ctx
+	}
+
+	for _, tt := range []struct {
+		ctx   context.Context
+		actor string
+		kind  models.AuditActorType
+	}{
+		{withSource(nil, "ops"), "system", models.ActorSystem},
+		{withSource(&tenant.Principal{Platform: true}, ""), "platform", models.ActorPlatform},
+		{withSource(&tenant.Principal{Platform: true}, "ops@evently.test"), "platform:ops@evently.test", models.ActorPlatform},
+		{withSource(&tenant.Principal{Platform: true, Staff: "ana"}, "ops@evently.test"), "support:ana", models.ActorSupport},
+		// Only platform callers may say who is behind the key
+		{withSource(&tenant.Principal{OrganizerID: "org_1"}, "ops@evently.test"), "organizer:org_1", models.ActorOrganizer},
+	} {
+		var typedNil *models.Payment
+		service.Record(tt.ctx, &models.AuditEntry{Action: models.AuditPaymentStatusChanged, TargetType: "payment", TargetID: "pay_1"}, typedNil, nil)
+		entry := store.entries[len(store.entries)-1]
+		if entry.Actor != tt.actor || entry.ActorType != tt.kind || entry.SourceIP != "203.0.113.7" || entry.RequestID != "req_1" {
+			t.Errorf("entry = %+v, want actor %s (%s)", entry, tt.actor, tt.kind)
+		}
+		if entry.Before != nil || entry.After != nil {
+			t.Errorf("nil snapshots stored as %s / %s", entry.Before, entry.After)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/captcha"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+var (
+	ErrCaptchaRequired = apperror.New(apperror.CaptchaRequired, "captcha_required")
+	ErrInvalidBan      = apperror.New(apperror.InvalidBan, "invalid IP ban")
+)
+
+// CardTestingStore keeps the velocity counters and IP bans, in Redis so
+// every gateway instance sees the same counts.
+type CardTestingStore interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+	BanIP(ctx context.Context, ip, reason string, ttl time.Duration) error
+	IPBan(ctx context.Context, ip string) (*models.IPBan, error)
+	ListIPBans(ctx context.Context) ([]*models.IPBan, error)
+	UnbanIP(ctx context.Context, ip string) error
+}
+
+// CardCheck is one attempt to check card details. SessionID is the checkout
+// being paid, from the server's own record; a session ID the client names
+// could change on every check, so it is left empty where there is no checkout.
+type CardCheck struct {
+	IP           string
+	SessionID    string
+	CardNumber   string
+	CaptchaToken string
+}
+
+// CardTestingService stops the card check endpoints being used to test
+// stolen cards. Checks are counted per IP, checkout session and card; past
+// a threshold each check needs a solved CAPTCHA, and IPs with too many
+// failed checks are banned for a cooling-off period.
+type CardTestingService struct {
+	store   CardTestingStore
+	captcha captcha.Verifier
+	audit   *AuditService
+	cfg     config.CardTestingConfig
+	key     []byte
+	log     *logger.Logger
+}
+
+func NewCardTestingService(store CardTestingStore, verifier captcha.Verifier, audit *AuditService, cfg config.CardTestingConfig, log *logger.Logger) *CardTestingService {
+	key := []byte(cfg.FingerprintKey)
+	if len(key) == 0 {
+		// Card counts then only hold within this process
+		log.Warn("CARD_TESTING", "CARD_FINGERPRINT_KEY not set, card fingerprints are not shared between instances")
+		key = make([]byte, 32)
+		_, _ = rand.Read(key)
+	}
+	return &CardTestingService{
+		store:   store,
+		captcha: verifier,
+		audit:   audit,
+		cfg:     cfg,
+		key:     key,
+		log:     log,
+	}
+}
+
+// Admit counts a card check and decides whether it may go ahead. It returns
+// ErrCaptchaRequired once a counter is over its threshold and no valid
+// CAPTCHA token was sent. Bans are enforced before this by BlockBannedIPs.
+// Redis errors let the check through rather than break checkout.
+func (s *CardTestingService) Admit(ctx context.Context, check CardCheck) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	over := s.over(ctx, "ip", check.IP, s.cfg.CaptchaAfterIP)
+	// Evaluate every counter so each one keeps counting
+	over = s.over(ctx, "session", check.SessionID, s.cfg.CaptchaAfterSession) || over
+	over = s.over(ctx, "card", cardDigits(check.CardNumber), s.cfg.CaptchaAfterCard) || over
+	if !over {
+		return nil
+	}
+
+	if check.CaptchaToken == "" {
+		return ErrCaptchaRequired
+	}
+	ok, err := s.captcha.Verify(ctx, check.CaptchaToken, check.IP)
+	if err != nil {
+		s.log.Error("CARD_TESTING", fmt.Sprintf("CAPTCHA verification for %s failed: %v", check.IP, err))
+		return ErrCaptchaRequired
+	}
+	if !ok {
+		s.log.LogSecurity("CAPTCHA_FAILED", fmt.Sprintf("Invalid CAPTCHA token from %s", check.IP))
+		return ErrCaptchaRequired
+	}
+	return nil
+}
+
+// over counts one check against value and reports whether the count is now
+// past limit. Empty values and limits of zero are not counted.
+func (s *CardTestingService) over(ctx context.Context, kind, value string, limit int) bool {
+	if value == "" || limit <= 0 {
+		return false
+	}
+	count, err := s.store.Increment(ctx, "card_checks:"+kind+":"+s.hash(value), s.cfg.Window)
+	if err != nil {
+		s.log.Error("CARD_TESTING", fmt.Sprintf("Failed to count card check by %s: %v", kind, err))
+		return false
+	}
+	if count > int64(limit) {
+		s.log.LogSecurity("CARD_TESTING_VELOCITY", fmt.Sprintf("%d card checks by one %s in %s", count, kind, s.cfg.Window))
+		return true
+	}
+	return false
+}
+
+// RecordFailure counts a card that failed its check and bans the IP once it
+// has failed BanAfterFailures times within the window.
+func (s *CardTestingService) RecordFailure(ctx context.Context, ip string) {
+	if !s.cfg.Enabled || ip == "" || s.cfg.BanAfterFailures <= 0 {
+		return
+	}
+	failures, err := s.store.Increment(ctx, "card_checks:failed:"+s.hash(ip), s.cfg.Window)
+	if err != nil {
+		s.log.Error("CARD_TESTING", fmt.Sprintf("Failed to count failed card check from %s: %v", ip, err))
+		return
+	}
+	if failures < int64(s.cfg.BanAfterFailures) {
+		return
+	}
+
+	reason := fmt.Sprintf("%d failed card checks in %s", failures, s.cfg.Window)
+	if _, err := s.ban(ctx, ip, reason, s.cfg.BanDuration); err != nil {
+		s.log.Error("CARD_TESTING", fmt.Sprintf("Failed to ban %s: %v", ip, err))
+	}
+}
+
+// IsBanned reports whether an IP is serving a ban. Lookup errors are
+// returned with false so callers fail open.
+func (s *CardTestingService) IsBanned(ctx context.Context, ip string) (bool, error) {
+	if ip == "" {
+		return false, nil
+	}
+	ban, err := s.store.IPBan(ctx, ip)
+	if err != nil {
+		s.log.Error("CARD_TESTING", fmt.Sprintf("Failed to look up ban for %s: %v", ip, err))
+		return false, err
+	}
+	return ban != nil, nil
+}
+
+func (s *CardTestingService) ListBans(ctx context.Context) ([]*models.IPBan, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.store.ListIPBans(ctx)
+}
+
+// Ban blocks an IP by hand, for the configured cooling-off period unless
+// the request gives one.
+func (s *CardTestingService) Ban(ctx context.Context, req *models.IPBanRequest) (*models.IPBan, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if req.Minutes < 0 {
+		return nil, fmt.Errorf("%w: minutes must not be negative", ErrInvalidBan)
+	}
+	ttl := s.cfg.BanDuration
+	if req.Minutes > 0 {
+		ttl = time.Duration(req.Minutes) * time.Minute
+	}
+	return s.ban(ctx, req.IP, req.Reason, ttl)
+}
+
+func (s *CardTestingService) Unban(ctx context.Context, ip string) error {
+	if !tenant.IsPlatform(ctx) {
+		return ErrForbidden
+	}
+	ban, err := s.store.IPBan(ctx, ip)
+	if err != nil {
+		return err
+	}
+	if ban == nil {
+		return nil
+	}
+	if err := s.store.UnbanIP(ctx, ip); err != nil {
+		return err
+	}
+	s.log.LogSecurity("IP_UNBANNED", fmt.Sprintf("Ban on %s lifted", ip))
+	s.audit.Record(ctx, ipBanAudit(models.AuditIPUnbanned, ip), ban, nil)
+	return nil
+}
+
+func (s *CardTestingService) ban(ctx context.Context, ip, reason string, ttl time.Duration) (*models.IPBan, error) {
+	if err := s.store.BanIP(ctx, ip, reason, ttl); err != nil {
+		return nil, err
+	}
+	ban := &models.IPBan{IP: ip, Reason: reason, ExpiresAt: time.Now().Add(ttl)}
+	s.log.LogSecurity("IP_BANNED", fmt.Sprintf("Banned %s until %s: %s", ip, ban.ExpiresAt.UTC().Format(time.RFC3339), reason))
+	s.audit.Record(ctx, ipBanAudit(models.AuditIPBanned, ip), nil, ban)
+	return ban, nil
+}
+
+// cardDigits drops everything but digits so spacing does not make a card
+// look new. The result is only ever stored hashed.
+func cardDigits(number string) string {
+	digits := make([]byte, 0, len(number))
+	for i := 0; i < len(number); i++ {
+		if number[i] >= '0' && number[i] <= '9' {
+			digits = append(digits, number[i])
+		}
+	}
+	return string(digits)
+}
+
+// hash keys the counters with an HMAC so Redis holds neither card numbers
+// nor a hash that could be reversed by enumerating them.
+func (s *CardTestingService) hash(value string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+func ipBanAudit(action, ip string) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:     action,
+		TargetType: "ip",
+		TargetID:   ip,
+	}
+}
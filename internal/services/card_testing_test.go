@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+// cardTestingStore stands in for Redis; while down every call fails
+type cardTestingStore struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	bans   map[string]*models.IPBan
+	down   bool
+}
+
+var errRedisDown = errors.New("redis: connection refused")
+
+func (s *cardTestingStore) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.down {
+		return 0, errRedisDown
+	}
+	if s.counts == nil {
+		s.counts = make(map[string]int64)
+	}
+	s.counts[key]++
+	return s.counts[key], nil
+}
+
+func (s *cardTestingStore) BanIP(ctx context.Context, ip, reason string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.bans == nil {
+		s.bans = make(map[string]*models.IPBan)
+	}
+	s.bans[ip] = &models.IPBan{IP: ip, Reason: reason, ExpiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *cardTestingStore) IPBan(ctx context.Context, ip string) (*models.IPBan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.down {
+		return nil, errRedisDown
+	}
+	return s.bans[ip], nil
+}
+
+func (s *cardTestingStore) ListIPBans(ctx context.Context) ([]*models.IPBan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var bans []*models.IPBan
+	for _, ban := range s.bans {
+		bans = append(bans, ban)
+	}
+	return bans, nil
+}
+
+func (s *cardTestingStore) UnbanIP(ctx context.Context, ip string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.bans, ip)
+	return nil
+}
+
+// solvedCaptcha accepts the token "solved"
+type solvedCaptcha struct {
+	err error
+}
+
+func (v solvedCaptcha) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	return token == "solved", v.err
+}
+
+func newCardTesting(t *testing.T, store *cardTestingStore, verifier solvedCaptcha) (*CardTestingService, *testEnv) {
+	t.Helper()
+	env := newTestEnv(t)
+	cfg := config.CardTestingConfig{
+		Enabled: true, Window: time.Hour, CaptchaAfterIP: 5, CaptchaAfterSession: 3, CaptchaAfterCard: 2,
+		BanAfterFailures: 3, BanDuration: time.Hour, FingerprintKey: "card-testing-key",
+	}
+	return NewCardTestingService(store, verifier, env.audit, cfg, env.log), env
+}
+
+func TestCardChecksNeedCaptchaPastAThreshold(t *testing.T) {
+	service, _ := newCardTesting(t, &cardTestingStore{}, solvedCaptcha{})
+	ctx := context.Background()
+	check := func(ip, session, card, token string) error {
+		return service.Admit(ctx, CardCheck{IP: ip, SessionID: session, CardNumber: card, CaptchaToken: token})
+	}
+
+	// One card, spaced differently each time, is still one card
+	for _, card := range []string{"4242424242424242", "4242 4242 4242 4242"} {
+		if err := check("198.51.100.1", "", card, ""); err != nil {
+			t.Fatalf("check of %s: %v", card, err)
+		}
+	}
+	if err := check("198.51.100.2", "", "4242-4242-4242-4242", ""); !errors.Is(err, ErrCaptchaRequired) {
+		t.Errorf("third check of one card: err = %v, want ErrCaptchaRequired", err)
+	}
+	if err := check("198.51.100.2", "", "4242424242424242", "wrong"); !errors.Is(err, ErrCaptchaRequired) {
+		t.Errorf("wrong captcha: err = %v, want ErrCaptchaRequired", err)
+	}
+	if err := check("198.51.100.2", "", "4242424242424242", "solved"); err != nil {
+		t.Errorf("solved captcha: %v", err)
+	}
+
+	// Many cards from one session
+	for i, card := range []string{"4000056655665556", "5555555555554444", "378282246310005"} {
+		if err := check("198.51.100.3", "cs_1", card, ""); err != nil {
+			t.Fatalf("check %d in session: %v", i+1, err)
+		}
+	}
+	if err := check("198.51.100.4", "cs_1", "6011111111111117", ""); !errors.Is(err, ErrCaptchaRequired) {
+		t.Errorf("fourth card in a session: err = %v, want ErrCaptchaRequired", err)
+	}
+}
+
+func TestCaptchaOutageKeepsTheGateClosed(t *testing.T) {
+	store := &cardTestingStore{}
+	service, _ := newCardTesting(t, store, solvedCaptcha{err: errors.New("siteverify timed out")})
+	for i := 0; i < 2; i++ {
+		_ = service.Admit(context.Background(), CardCheck{CardNumber: "4242424242424242"})
+	}
+	if err := service.Admit(context.Background(), CardCheck{CardNumber: "4242424242424242", CaptchaToken: "solved"}); !errors.Is(err, ErrCaptchaRequired) {
+		t.Errorf("err = %v, want ErrCaptchaRequired", err)
+	}
+
+	// Redis being down is not a reason to stop checkout
+	store.down = true
+	if err := service.Admit(context.Background(), CardCheck{CardNumber: "4242424242424242"}); err != nil {
+		t.Errorf("redis down: %v", err)
+	}
+	if banned, err := service.IsBanned(context.Background(), "198.51.100.1"); banned || err == nil {
+		t.Errorf("redis down: banned %v, err %v", banned, err)
+	}
+}
+
+func TestCountersHoldNoCardNumbers(t *testing.T) {
+	store := &cardTestingStore{}
+	service, _ := newCardTesting(t, store, solvedCaptcha{})
+	_ = service.Admit(context.Background(), CardCheck{IP: "198.51.100.1", SessionID: "cs_1", CardNumber: "4242424242424242"})
+
+	if len(store.counts) != 3 {
+		t.Errorf("counters = %v, want one each for the IP, session and card", store.counts)
+	}
+	for key := range store.counts {
+		if strings.Contains(key, "4242") || strings.Contains(key, "198.51") || strings.Contains(key, "cs_1") {
+			t.Errorf("counter %s holds the value it counts", key)
+		}
+	}
+}
+
+func TestFailedChecksBanTheIP(t *testing.T) {
+	store := &cardTestingStore{}
+	service, env := newCardTesting(t, store, solvedCaptcha{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		service.RecordFailure(ctx, "203.0.113.9")
+	}
+	if banned, _ := service.IsBanned(ctx, "203.0.113.9"); banned {
+		t.Fatal("banned before the threshold")
+	}
+	service.RecordFailure(ctx, "203.0.113.9")
+	if banned, _ := service.IsBanned(ctx, "203.0.113.9"); !banned {
+		t.Fatal("not banned at the threshold")
+	}
+	if banned, _ := service.IsBanned(ctx, "203.0.113.10"); banned {
+		t.Error("a neighbour was banned")
+	}
+	if len(env.store.audit) != 1 || env.store.audit[0].Action != models.AuditIPBanned || env.store.audit[0].ActorType != models.ActorSystem {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+}
+
+func TestBansAreManagedByThePlatform(t *testing.T) {
+	store := &cardTestingStore{}
+	service, env := newCardTesting(t, store, solvedCaptcha{})
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+
+	if _, err := service.Ban(organizer, &models.IPBanRequest{IP: "203.0.113.9"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("organizer ban: err = %v, want ErrForbidden", err)
+	}
+	if _, err := service.Ban(platform, &models.IPBanRequest{IP: "203.0.113.9", Minutes: -1}); !errors.Is(err, ErrInvalidBan) {
+		t.Errorf("negative ban: err = %v, want ErrInvalidBan", err)
+	}
+	ban, err := service.Ban(platform, &models.IPBanRequest{IP: "203.0.113.9", Reason: "chargebacks", Minutes: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if until := time.Until(ban.ExpiresAt); until < 9*time.Minute || until > 10*time.Minute {
+		t.Errorf("ban expires in %v, want 10m", until)
+	}
+
+	if err := service.Unban(organizer, "203.0.113.9"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("organizer unban: err = %v, want ErrForbidden", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := service.Unban(platform, "203.0.113.9"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if banned, _ := service.IsBanned(platform, "203.0.113.9"); banned {
+		t.Error("still banned")
+	}
+	// Lifting a ban that is not there is not audited
+	if len(env.store.audit) != 2 || env.store.audit[1].Action != models.AuditIPUnbanned {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+}
@@ -0,0 +1,439 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+var (
+	ErrCheckoutNotFound = apperror.New(apperror.CheckoutNotFound, "checkout not found")
+	ErrCheckoutNotOpen  = apperror.New(apperror.CheckoutNotOpen, "checkout is not open for payment")
+	ErrCheckoutExpired  = apperror.New(apperror.CheckoutExpired, "checkout has expired")
+	ErrInvalidCheckout  = apperror.New(apperror.InvalidCheckout, "invalid checkout")
+)
+
+const (
+	defaultCheckoutLimit = 50
+	maxCheckoutLimit     = 500
+)
+
+// CheckoutService runs hosted checkouts. An organizer, or the ticketing
+// frontend on their behalf, creates a session with the line items to pay
+// for and shares its URL; the attendee pays on the hosted page without an
+// API key. Paying goes through Stripe like any other payment, and the
+// session tracks the payment until it completes.
+type CheckoutService struct {
+	store      storage.CheckoutStore
+	payments   storage.Store
+	organizers *OrganizerService
+	stripe     *StripeService
+	payment    *PaymentService
+	audit      *AuditService
+	cfg        config.CheckoutConfig
+	log        *logger.Logger
+}
+
+func NewCheckoutService(store storage.CheckoutStore, payments storage.Store, organizers *OrganizerService, stripe *StripeService, payment *PaymentService, audit *AuditService, cfg config.CheckoutConfig, log *logger.Logger) *CheckoutService {
+	return &CheckoutService{
+		store:      store,
+		payments:   payments,
+		organizers: organizers,
+		stripe:     stripe,
+		payment:    payment,
+		audit:      audit,
+		cfg:        cfg,
+		log:        log,
+	}
+}
+
+// Create opens a checkout session or payment link for the caller's
+// organizer.
+func (s *CheckoutService) Create(ctx context.Context, req *models.CreateCheckoutRequest) (*models.CheckoutSession, error) {
+	organizerID, err := resolveOrganizer(ctx, req.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.organizers.RequireActive(organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := req.Kind
+	if kind == "" {
+		kind = models.CheckoutKindSession
+	}
+	currency := strings.ToLower(req.Currency)
+	if currency == "" {
+		currency = org.DefaultCurrency
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be a 3-letter ISO code", ErrInvalidCurrency)
+	}
+	expiry, err := s.expiry(kind, req.ExpiresInMinutes)
+	if err != nil {
+		return nil, err
+	}
+	lineItems, amount, err := buildLineItems(req.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &models.CheckoutSession{
+		ID:          utils.GenerateCheckoutSessionID(),
+		Kind:        kind,
+		OrganizerID: organizerID,
+		OrderID:     req.OrderID,
+		Status:      models.CheckoutOpen,
+		Currency:    currency,
+		Amount:      amount,
+		LineItems:   lineItems,
+		Description: req.Description,
+		Reference:   req.Reference,
+		Email:       req.Email,
+		SuccessURL:  req.SuccessURL,
+		CancelURL:   req.CancelURL,
+		ExpiresAt:   now.Add(expiry),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if session.OrderID == "" {
+		session.OrderID = session.ID
+	}
+	session.URL = s.cfg.LinkBaseURL + "/" + session.ID
+
+	if err := s.store.SaveCheckoutSession(session); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, checkoutAudit(models.AuditCheckoutCreated, session), nil, session)
+	s.log.LogPayment("CHECKOUT", session.OrderID, fmt.Sprintf("Checkout %s (%s) opened for %.2f %s, expires %s",
+		session.ID, kind, amount, currency, session.ExpiresAt.Format(time.RFC3339)))
+	return session, nil
+}
+
+func (s *CheckoutService) expiry(kind models.CheckoutKind, minutes int) (time.Duration, error) {
+	expiry := s.cfg.SessionExpiry
+	if kind == models.CheckoutKindLink {
+		expiry = s.cfg.LinkExpiry
+	}
+	if minutes > 0 {
+		expiry = time.Duration(minutes) * time.Minute
+	}
+	if expiry > s.cfg.MaxExpiry {
+		return 0, fmt.Errorf("%w: a checkout may stay open for at most %s", ErrInvalidCheckout, s.cfg.MaxExpiry)
+	}
+	return expiry, nil
+}
+
+// buildLineItems prices each line and totals them. A checkout must sell at
+// least one ticket and cost something.
+func buildLineItems(requested []*models.LineItemRequest) ([]*models.LineItem, float64, error) {
+	items, total, err := priceLineItems(requested)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ticketCount(items) == 0 {
+		return nil, 0, fmt.Errorf("%w: at least one ticket line item is required", ErrInvalidCheckout)
+	}
+	if total <= 0 {
+		return nil, 0, fmt.Errorf("%w: the total must be greater than zero", ErrInvalidCheckout)
+	}
+	return items, total, nil
+}
+
+func (s *CheckoutService) Get(ctx context.Context, id string) (*models.CheckoutSession, error) {
+	session, err := s.store.GetCheckoutSession(id)
+	if err != nil || !tenant.CanAccess(ctx, session.OrganizerID) {
+		return nil, ErrCheckoutNotFound
+	}
+	return s.refresh(session), nil
+}
+
+// List returns the caller's sessions newest first; platform callers may
+// narrow them to one organizer.
+func (s *CheckoutService) List(ctx context.Context, organizerID string, status models.CheckoutStatus, limit, offset int) ([]*models.CheckoutSession, error) {
+	if scope := tenant.Scope(ctx); scope != "" {
+		organizerID = scope
+	}
+	if limit <= 0 {
+		limit = defaultCheckoutLimit
+	}
+	if limit > maxCheckoutLimit {
+		limit = maxCheckoutLimit
+	}
+	sessions, err := s.store.ListCheckoutSessions(organizerID, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	for i, session := range sessions {
+		sessions[i] = s.refresh(session)
+	}
+	return sessions, nil
+}
+
+// Cancel closes an open session so its link can no longer be paid. A
+// session whose payment is under way cannot be cancelled; refund the
+// payment instead.
+func (s *CheckoutService) Cancel(ctx context.Context, id string) (*models.CheckoutSession, error) {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != models.CheckoutOpen {
+		return nil, fmt.Errorf("%w: it is %s", ErrCheckoutNotOpen, session.Status)
+	}
+
+	before := *session
+	session.Status = models.CheckoutCancelled
+	session.UpdatedAt = time.Now()
+	if err := s.store.UpdateCheckoutSession(session); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, checkoutAudit(models.AuditCheckoutCancelled, session), before, session)
+	s.log.LogPayment("CHECKOUT", session.OrderID, fmt.Sprintf("Checkout %s cancelled", session.ID))
+	return session, nil
+}
+
+// Page returns what the hosted checkout page shows. It is public: the
+// session ID in the link is the attendee's only credential.
+func (s *CheckoutService) Page(ctx context.Context, id string) (*models.CheckoutPage, error) {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.page(ctx, session), nil
+}
+
+func (s *CheckoutService) page(ctx context.Context, session *models.CheckoutSession) *models.CheckoutPage {
+	page := &models.CheckoutPage{
+		ID:          session.ID,
+		Status:      session.Status,
+		Currency:    session.Currency,
+		Amount:      session.Amount,
+		LineItems:   session.LineItems,
+		Description: session.Description,
+		Reference:   session.Reference,
+		Email:       session.Email,
+		SuccessURL:  session.SuccessURL,
+		CancelURL:   session.CancelURL,
+		ExpiresAt:   session.ExpiresAt,
+	}
+	if org, err := s.organizers.GetOrganizer(ctx, session.OrganizerID); err == nil {
+		page.OrganizerName = org.Name
+	}
+	return page
+}
+
+// Pay charges an open session through Stripe and converts it into a
+// payment. Declined cards leave the session open for another try; a
+// payment that needs 3-D Secure leaves it processing until Confirm.
+func (s *CheckoutService) Pay(ctx context.Context, id string, req *models.CheckoutPayRequest) (*models.CheckoutPayResult, error) {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := payable(session); err != nil {
+		return nil, err
+	}
+	if req.Token == "" && req.Card == nil {
+		return nil, apperror.New(apperror.InvalidRequest, "a token or card is required")
+	}
+
+	// Only one payment may be under way per session
+	claimed, err := s.store.ClaimCheckoutSession(session.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		if current, err := s.Get(ctx, id); err == nil {
+			session = current
+		}
+		if err := payable(session); err != nil {
+			return nil, err
+		}
+		return nil, ErrCheckoutNotOpen
+	}
+	session.Status = models.CheckoutProcessing
+
+	email := req.Email
+	if email == "" {
+		email = session.Email
+	}
+	description := session.Description
+	if description == "" {
+		description = session.Reference
+	}
+	stripeReq := &models.StripePaymentRequest{
+		PaymentID:      utils.GenerateID(),
+		OrganizerID:    session.OrganizerID,
+		OrderID:        session.OrderID,
+		Amount:         session.Amount,
+		Currency:       session.Currency,
+		Description:    description,
+		Token:          req.Token,
+		Card:           req.Card,
+		Metadata:       map[string]string{"checkout_session_id": session.ID},
+		ReturnURL:      req.ReturnURL,
+		Email:          email,
+		BillingCountry: req.BillingCountry,
+		TicketCount:    ticketCount(session.LineItems),
+		LineItems:      lineItemRequests(session.LineItems),
+	}
+	result, err := s.stripe.ProcessPayment(ctx, stripeReq)
+	if err != nil {
+		s.reopen(session)
+		return nil, err
+	}
+
+	switch result.Status {
+	case models.StatusSuccess, models.StatusPending, models.StatusInReview:
+		_, err := s.payment.ProcessPayment(ctx, &models.PaymentRequest{
+			PaymentID:     result.PaymentID,
+			OrganizerID:   result.OrganizerID,
+			OrderID:       result.OrderID,
+			Status:        result.Status,
+			Price:         result.Amount,
+			Currency:      result.Currency,
+			Date:          utils.UnixTimeToTime(result.Created),
+			TransactionID: result.TransactionID,
+			Email:         email,
+			LineItems:     stripeReq.LineItems,
+		})
+		if err != nil {
+			// Stripe has the payment; reconciliation finds the missing record
+			s.log.Error("CHECKOUT", fmt.Sprintf("Failed to record payment %s for checkout %s: %v", result.PaymentID, session.ID, err))
+		}
+	default:
+		s.reopen(session)
+		return &models.CheckoutPayResult{Checkout: s.page(ctx, session), Payment: result}, nil
+	}
+
+	session.PaymentID = result.PaymentID
+	if result.Status == models.StatusSuccess {
+		s.complete(session)
+	}
+	session.UpdatedAt = time.Now()
+	if err := s.store.UpdateCheckoutSession(session); err != nil {
+		s.log.Error("CHECKOUT", fmt.Sprintf("Failed to record payment %s on checkout %s: %v", result.PaymentID, session.ID, err))
+	}
+
+	s.log.LogPayment("CHECKOUT", session.OrderID, fmt.Sprintf("Checkout %s paid with payment %s (%s)", session.ID, result.PaymentID, result.Status))
+	return &models.CheckoutPayResult{Checkout: s.page(ctx, session), Payment: result}, nil
+}
+
+// Confirm re-confirms a session's payment after 3-D Secure and settles the
+// session with it.
+func (s *CheckoutService) Confirm(ctx context.Context, id, returnURL string) (*models.CheckoutPayResult, error) {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != models.CheckoutProcessing || session.PaymentID == "" {
+		return nil, fmt.Errorf("%w: it has no payment awaiting confirmation", ErrCheckoutNotOpen)
+	}
+	payment, err := s.payments.GetPayment(session.PaymentID)
+	if err != nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	result, err := s.stripe.ConfirmPayment(ctx, payment.TransactionID, returnURL)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.payment.SettleStripePayment(ctx, result.TransactionID, result.Status); err != nil {
+		return nil, err
+	}
+	return &models.CheckoutPayResult{Checkout: s.page(ctx, s.refresh(session)), Payment: result}, nil
+}
+
+// payable explains why a session cannot be paid, if it cannot
+func payable(session *models.CheckoutSession) error {
+	switch session.Status {
+	case models.CheckoutOpen:
+		return nil
+	case models.CheckoutExpired:
+		return ErrCheckoutExpired
+	default:
+		return fmt.Errorf("%w: it is %s", ErrCheckoutNotOpen, session.Status)
+	}
+}
+
+// refresh brings a session up to date: open sessions past their expiry
+// expire, and processing ones follow their payment, completing when it
+// succeeds and reopening when it fails.
+func (s *CheckoutService) refresh(session *models.CheckoutSession) *models.CheckoutSession {
+	now := time.Now()
+	before := session.Status
+
+	switch session.Status {
+	case models.CheckoutOpen:
+		if now.After(session.ExpiresAt) {
+			session.Status = models.CheckoutExpired
+		}
+	case models.CheckoutProcessing:
+		if session.PaymentID == "" {
+			break
+		}
+		payment, err := s.payments.GetPayment(session.PaymentID)
+		if err != nil {
+			break
+		}
+		switch payment.Status {
+		case models.StatusPending, models.StatusInReview:
+		case models.StatusFailed, models.StatusCancelled:
+			session.Status = models.CheckoutOpen
+			if now.After(session.ExpiresAt) {
+				session.Status = models.CheckoutExpired
+			}
+		default:
+			// Refunds and disputes come after the payment succeeded
+			s.complete(session)
+		}
+	}
+
+	if session.Status != before {
+		session.UpdatedAt = now
+		if err := s.store.UpdateCheckoutSession(session); err != nil {
+			s.log.Error("CHECKOUT", fmt.Sprintf("Failed to update checkout %s to %s: %v", session.ID, session.Status, err))
+		}
+	}
+	return session
+}
+
+func (s *CheckoutService) complete(session *models.CheckoutSession) {
+	now := time.Now()
+	session.Status = models.CheckoutCompleted
+	session.CompletedAt = &now
+}
+
+// reopen returns a session to open after an attempt that did not produce
+// a payment, such as a declined card
+func (s *CheckoutService) reopen(session *models.CheckoutSession) {
+	session.Status = models.CheckoutOpen
+	session.UpdatedAt = time.Now()
+	if err := s.store.UpdateCheckoutSession(session); err != nil {
+		s.log.Error("CHECKOUT", fmt.Sprintf("Failed to reopen checkout %s: %v", session.ID, err))
+	}
+}
+
+func checkoutAudit(action string, session *models.CheckoutSession) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "checkout_session",
+		TargetID:    session.ID,
+		OrderID:     session.OrderID,
+		OrganizerID: session.OrganizerID,
+	}
+}
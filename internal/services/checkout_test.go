@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+// checkoutStore claims sessions as MySQLStore.ClaimCheckoutSession does:
+// only an open, unexpired session can be claimed
+type checkoutStore struct {
+	mu       sync.Mutex
+	sessions map[string]*models.CheckoutSession
+}
+
+func (s *checkoutStore) SaveCheckoutSession(session *models.CheckoutSession) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *session
+	s.sessions[session.ID] = &copied
+	return nil
+}
+
+func (s *checkoutStore) UpdateCheckoutSession(session *models.CheckoutSession) error {
+	return s.SaveCheckoutSession(session)
+}
+
+func (s *checkoutStore) ClaimCheckoutSession(id string, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[id]
+	if !ok || session.Status != models.CheckoutOpen || now.After(session.ExpiresAt) {
+		return false, nil
+	}
+	session.Status = models.CheckoutProcessing
+	return true, nil
+}
+
+func (s *checkoutStore) GetCheckoutSession(id string) (*models.CheckoutSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, errors.New("checkout session not found")
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (s *checkoutStore) ListCheckoutSessions(organizerID string, status models.CheckoutStatus, limit, offset int) ([]*models.CheckoutSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sessions []*models.CheckoutSession
+	for _, session := range s.sessions {
+		if (organizerID == "" || session.OrganizerID == organizerID) && (status == "" || session.Status == status) {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
+	}
+	return sessions, nil
+}
+
+func newCheckouts(t *testing.T) (*CheckoutService, *checkoutStore, *testEnv) {
+	t.Helper()
+	env := newTestEnv(t)
+	for id, status := range map[string]models.OrganizerStatus{"org_1": models.OrganizerActive, "org_2": models.OrganizerSuspended} {
+		if err := env.store.SaveOrganizer(&models.Organizer{ID: id, Name: "Evently " + id, Status: status, DefaultCurrency: "eur"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store := &checkoutStore{sessions: make(map[string]*models.CheckoutSession)}
+	cfg := config.CheckoutConfig{
+		LinkBaseURL: "https://pay.evently.test/c", SessionExpiry: 30 * time.Minute, LinkExpiry: 7 * 24 * time.Hour, MaxExpiry: 30 * 24 * time.Hour,
+	}
+	return NewCheckoutService(store, env.store, env.organizers, nil, env.payments, env.audit, cfg, env.log), store, env
+}
+
+func tickets(quantity int) []*models.LineItemRequest {
+	return []*models.LineItemRequest{
+		{Name: "General admission", TicketTier: "ga", UnitAmount: 25, Quantity: quantity},
+		{Kind: models.LineItemFee, Name: "Booking fee", UnitAmount: 2, Quantity: 1},
+	}
+}
+
+func TestCreateCheckoutPricesTheBasket(t *testing.T) {
+	checkouts, _, env := newCheckouts(t)
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+
+	session, err := checkouts.Create(organizer, &models.CreateCheckoutRequest{LineItems: tickets(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Amount != 102 || session.Currency != "eur" || session.Status != models.CheckoutOpen ||
+		session.OrderID != session.ID || session.URL != "https://pay.evently.test/c/"+session.ID {
+		t.Errorf("session = %+v", session)
+	}
+	if until := time.Until(session.ExpiresAt); until < 29*time.Minute || until > 30*time.Minute {
+		t.Errorf("session expires in %s, want 30m", until)
+	}
+	link, _ := checkouts.Create(organizer, &models.CreateCheckoutRequest{Kind: models.CheckoutKindLink, Currency: "USD", LineItems: tickets(1)})
+	if link.Currency != "usd" || time.Until(link.ExpiresAt) < 6*24*time.Hour {
+		t.Errorf("link = %+v", link)
+	}
+	if len(env.audited(models.AuditCheckoutCreated)) != 2 {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	for name, tt := range map[string]struct {
+		ctx  context.Context
+		req  *models.CreateCheckoutRequest
+		want error
+	}{
+		"another organizer": {organizer, &models.CreateCheckoutRequest{OrganizerID: "org_2", LineItems: tickets(1)}, ErrForbidden},
+		"no organizer":      {platform, &models.CreateCheckoutRequest{LineItems: tickets(1)}, ErrOrganizerRequired},
+		"suspended":         {platform, &models.CreateCheckoutRequest{OrganizerID: "org_2", LineItems: tickets(1)}, ErrOrganizerInactive},
+		"bad currency":      {organizer, &models.CreateCheckoutRequest{Currency: "euro", LineItems: tickets(1)}, ErrInvalidCurrency},
+		"open too long":     {organizer, &models.CreateCheckoutRequest{ExpiresInMinutes: 31 * 24 * 60, LineItems: tickets(1)}, ErrInvalidCheckout},
+		"fees only":         {organizer, &models.CreateCheckoutRequest{LineItems: tickets(1)[1:]}, ErrInvalidCheckout},
+		"free tickets":      {organizer, &models.CreateCheckoutRequest{LineItems: []*models.LineItemRequest{{Name: "Comp", Quantity: 2}}}, ErrInvalidCheckout},
+	} {
+		if _, err := checkouts.Create(tt.ctx, tt.req); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", name, err, tt.want)
+		}
+	}
+}
+
+func TestCheckoutsFollowTheirClockAndPayment(t *testing.T) {
+	checkouts, store, env := newCheckouts(t)
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+	open := func() *models.CheckoutSession {
+		session, err := checkouts.Create(organizer, &models.CreateCheckoutRequest{LineItems: tickets(2)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return session
+	}
+
+	stale := open()
+	store.sessions[stale.ID].ExpiresAt = time.Now().Add(-time.Minute)
+	if page, err := checkouts.Page(context.Background(), stale.ID); err != nil || page.Status != models.CheckoutExpired {
+		t.Errorf("stale session: %+v, %v", page, err)
+	}
+	if _, err := checkouts.Pay(context.Background(), stale.ID, &models.CheckoutPayRequest{Token: "tok_visa"}); !errors.Is(err, ErrCheckoutExpired) {
+		t.Errorf("pay an expired session: err = %v, want ErrCheckoutExpired", err)
+	}
+
+	// A session whose payment failed can be paid again; one whose payment
+	// went through is done
+	env.pendingPayment(t, "pay_3ds", "pi_3ds", time.Now())
+	paying := open()
+	store.sessions[paying.ID].Status = models.CheckoutProcessing
+	store.sessions[paying.ID].PaymentID = "pay_3ds"
+	if session, _ := checkouts.Get(organizer, paying.ID); session.Status != models.CheckoutProcessing {
+		t.Errorf("awaiting 3-D Secure: %s", session.Status)
+	}
+	if _, err := checkouts.Cancel(organizer, paying.ID); !errors.Is(err, ErrCheckoutNotOpen) {
+		t.Errorf("cancel while paying: err = %v, want ErrCheckoutNotOpen", err)
+	}
+	if _, err := checkouts.Pay(context.Background(), paying.ID, &models.CheckoutPayRequest{Token: "tok_visa"}); !errors.Is(err, ErrCheckoutNotOpen) {
+		t.Errorf("pay twice: err = %v, want ErrCheckoutNotOpen", err)
+	}
+	payment, _ := env.store.GetPayment("pay_3ds")
+	payment.Status = models.StatusFailed
+	_ = env.store.UpdatePayment(payment)
+	if session, _ := checkouts.Get(organizer, paying.ID); session.Status != models.CheckoutOpen {
+		t.Errorf("after a failed payment: %s", session.Status)
+	}
+
+	env.capturedPayment(t, "pay_done", 52)
+	paid := open()
+	store.sessions[paid.ID].Status = models.CheckoutProcessing
+	store.sessions[paid.ID].PaymentID = "pay_done"
+	if session, _ := checkouts.Get(organizer, paid.ID); session.Status != models.CheckoutCompleted || session.CompletedAt == nil {
+		t.Errorf("after a captured payment: %+v", session)
+	}
+	if store.sessions[paid.ID].Status != models.CheckoutCompleted {
+		t.Error("completion not stored")
+	}
+}
+
+func TestCheckoutAccessAndCancel(t *testing.T) {
+	checkouts, _, env := newCheckouts(t)
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+	other := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_3"})
+	session, _ := checkouts.Create(organizer, &models.CreateCheckoutRequest{OrderID: "order_9", LineItems: tickets(1)})
+
+	if _, err := checkouts.Get(other, session.ID); !errors.Is(err, ErrCheckoutNotFound) {
+		t.Errorf("another organizer: err = %v, want ErrCheckoutNotFound", err)
+	}
+	if listed, _ := checkouts.List(other, "org_1", "", 0, 0); len(listed) != 0 {
+		t.Errorf("another organizer listed %d sessions", len(listed))
+	}
+	// The hosted page needs no key and shows no order ID
+	page, err := checkouts.Page(context.Background(), session.ID)
+	if err != nil || page.OrganizerName != "Evently org_1" || page.Amount != 27 {
+		t.Errorf("page = %+v, %v", page, err)
+	}
+	if _, err := checkouts.Pay(context.Background(), session.ID, &models.CheckoutPayRequest{}); err == nil {
+		t.Error("paid without a token or card")
+	}
+
+	if _, err := checkouts.Cancel(other, session.ID); !errors.Is(err, ErrCheckoutNotFound) {
+		t.Errorf("cancel by another organizer: err = %v, want ErrCheckoutNotFound", err)
+	}
+	cancelled, err := checkouts.Cancel(organizer, session.ID)
+	if err != nil || cancelled.Status != models.CheckoutCancelled {
+		t.Fatalf("cancel: %+v, %v", cancelled, err)
+	}
+	if _, err := checkouts.Pay(context.Background(), session.ID, &models.CheckoutPayRequest{Token: "tok_visa"}); !errors.Is(err, ErrCheckoutNotOpen) {
+		t.Errorf("pay a cancelled session: err = %v, want ErrCheckoutNotOpen", err)
+	}
+	if _, err := checkouts.Confirm(context.Background(), session.ID, ""); !errors.Is(err, ErrCheckoutNotOpen) {
+		t.Errorf("confirm without a payment: err = %v, want ErrCheckoutNotOpen", err)
+	}
+	if len(env.audited(models.AuditCheckoutCancelled)) != 1 {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+}
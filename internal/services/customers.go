@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+)
+
+var (
+	ErrCustomerNotFound      = apperror.New(apperror.CustomerNotFound, "customer not found")
+	ErrPaymentMethodNotFound = apperror.New(apperror.PaymentMethodNotFound, "payment method not found")
+	ErrNoDefaultMethod       = apperror.New(apperror.NoDefaultPaymentMethod, "customer has no default payment method")
+)
+
+// CustomerService maps our users to Stripe Customers and manages the cards
+// they save for one-click purchases. Saved cards belong to the attendee,
+// not an organizer, so only platform principals may manage or charge them.
+type CustomerService struct {
+	store  storage.CustomerStore
+	stripe *StripeService
+	log    *logger.Logger
+}
+
+func NewCustomerService(store storage.CustomerStore, stripe *StripeService, log *logger.Logger) *CustomerService {
+	return &CustomerService{
+		store:  store,
+		stripe: stripe,
+		log:    log,
+	}
+}
+
+// EnsureCustomer returns the user's customer, creating it on Stripe the
+// first time. Contact details are refreshed when they have changed.
+func (s *CustomerService) EnsureCustomer(ctx context.Context, req *models.CustomerRequest) (*models.Customer, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+
+	if existing, err := s.store.GetCustomer(req.UserID); err == nil {
+		return s.refresh(ctx, existing, req)
+	}
+
+	sc, err := s.stripe.CreateCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	customer := &models.Customer{
+		UserID:           req.UserID,
+		StripeCustomerID: sc.ID,
+		Email:            req.Email,
+		Name:             req.Name,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := s.store.SaveCustomer(customer); err != nil {
+		if errors.Is(err, storage.ErrDuplicateReference) {
+			// Lost a race with a concurrent create; the idempotency key
+			// means both requests got the same Stripe Customer.
+			return s.store.GetCustomer(req.UserID)
+		}
+		return nil, err
+	}
+
+	s.log.LogPayment("CUSTOMER", req.UserID, fmt.Sprintf("Stripe customer %s created", sc.ID))
+	return customer, nil
+}
+
+func (s *CustomerService) refresh(ctx context.Context, customer *models.Customer, req *models.CustomerRequest) (*models.Customer, error) {
+	email, name := customer.Email, customer.Name
+	if req.Email != "" {
+		email = req.Email
+	}
+	if req.Name != "" {
+		name = req.Name
+	}
+	if email == customer.Email && name == customer.Name {
+		return customer, nil
+	}
+
+	if err := s.stripe.UpdateCustomer(ctx, customer.StripeCustomerID, email, name); err != nil {
+		return nil, err
+	}
+	customer.Email = email
+	customer.Name = name
+	customer.UpdatedAt = time.Now()
+	if err := s.store.UpdateCustomer(customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+func (s *CustomerService) GetCustomer(ctx context.Context, userID string) (*models.Customer, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+
+	customer, err := s.store.GetCustomer(userID)
+	if err != nil {
+		return nil, ErrCustomerNotFound
+	}
+	return customer, nil
+}
+
+// CreateSetupIntent starts saving a new card. The frontend confirms the
+// returned client secret with Stripe.js, which handles any 3-D Secure
+// challenge, and Stripe attaches the card to the customer.
+func (s *CustomerService) CreateSetupIntent(ctx context.Context, userID string) (*models.SetupIntentResponse, error) {
+	customer, err := s.GetCustomer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := s.stripe.CreateSetupIntent(ctx, customer.StripeCustomerID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.LogPayment("SETUP_INTENT", userID, fmt.Sprintf("Setup intent %s created", intent.ID))
+	return &models.SetupIntentResponse{
+		SetupIntentID: intent.ID,
+		ClientSecret:  intent.ClientSecret,
+		Status:        string(intent.Status),
+	}, nil
+}
+
+// ListPaymentMethods returns the user's saved cards, flagging the default.
+func (s *CustomerService) ListPaymentMethods(ctx context.Context, userID string) ([]*models.SavedPaymentMethod, error) {
+	customer, err := s.GetCustomer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := s.stripe.ListCustomerPaymentMethods(ctx, customer.StripeCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	defaultID, err := s.stripe.DefaultPaymentMethod(ctx, customer.StripeCustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make([]*models.SavedPaymentMethod, 0, len(methods))
+	for _, pm := range methods {
+		method := &models.SavedPaymentMethod{
+			ID:        pm.ID,
+			IsDefault: pm.ID == defaultID,
+		}
+		if pm.Card != nil {
+			method.Brand = string(pm.Card.Brand)
+			method.Last4 = pm.Card.Last4
+			method.ExpMonth = pm.Card.ExpMonth
+			method.ExpYear = pm.Card.ExpYear
+		}
+		saved = append(saved, method)
+	}
+	return saved, nil
+}
+
+func (s *CustomerService) SetDefaultPaymentMethod(ctx context.Context, userID, paymentMethodID string) error {
+	customer, err := s.owned(ctx, userID, paymentMethodID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.stripe.SetDefaultPaymentMethod(ctx, customer.StripeCustomerID, paymentMethodID); err != nil {
+		return err
+	}
+	s.log.LogPayment("CUSTOMER", userID, fmt.Sprintf("Default payment method set to %s", paymentMethodID))
+	return nil
+}
+
+func (s *CustomerService) DeletePaymentMethod(ctx context.Context, userID, paymentMethodID string) error {
+	if _, err := s.owned(ctx, userID, paymentMethodID); err != nil {
+		return err
+	}
+
+	if err := s.stripe.DetachPaymentMethod(ctx, paymentMethodID); err != nil {
+		return err
+	}
+	s.log.LogPayment("CUSTOMER", userID, fmt.Sprintf("Payment method %s removed", paymentMethodID))
+	return nil
+}
+
+// PrepareCharge resolves req.UserID to its Stripe Customer so
+// StripeService.ProcessPayment can charge a saved card. Without a token or
+// card the customer's default is charged; a payment method that is already
+// saved must be saved on this customer. Requests without a user are left
+// untouched.
+func (s *CustomerService) PrepareCharge(ctx context.Context, req *models.StripePaymentRequest) error {
+	if req.UserID == "" {
+		return nil
+	}
+
+	customer, err := s.GetCustomer(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case req.Token == "" && req.Card == nil:
+		defaultID, err := s.stripe.DefaultPaymentMethod(ctx, customer.StripeCustomerID)
+		if err != nil {
+			return err
+		}
+		if defaultID == "" {
+			return ErrNoDefaultMethod
+		}
+		req.Token = defaultID
+	case strings.HasPrefix(req.Token, "pm_"):
+		pm, err := s.stripe.GetPaymentMethod(ctx, req.Token)
+		if err != nil {
+			return ErrPaymentMethodNotFound
+		}
+		// Unattached methods are new cards, attached on success when saved
+		if pm.Customer != nil && pm.Customer.ID != customer.StripeCustomerID {
+			s.log.LogSecurity("PAYMENT_METHOD_DENIED", fmt.Sprintf("Payment method %s is not saved on user %s", req.Token, req.UserID))
+			return ErrPaymentMethodNotFound
+		}
+	}
+
+	req.CustomerID = customer.StripeCustomerID
+	return nil
+}
+
+// owned loads the user's customer and checks the payment method is saved on
+// it, so one user's card cannot be charged or removed through another.
+func (s *CustomerService) owned(ctx context.Context, userID, paymentMethodID string) (*models.Customer, error) {
+	customer, err := s.GetCustomer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pm, err := s.stripe.GetPaymentMethod(ctx, paymentMethodID)
+	if err != nil {
+		return nil, ErrPaymentMethodNotFound
+	}
+	if pm.Customer == nil || pm.Customer.ID != customer.StripeCustomerID {
+		s.log.LogSecurity("PAYMENT_METHOD_DENIED", fmt.Sprintf("Payment method %s is not saved on user %s", paymentMethodID, userID))
+		return nil, ErrPaymentMethodNotFound
+	}
+	return customer, nil
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+)
+
+type customerStore struct {
+	storage.CustomerStore
+	mu        sync.Mutex
+	customers map[string]*models.Customer
+}
+
+func (s *customerStore) SaveCustomer(customer *models.Customer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.customers[customer.UserID]; ok {
+		return storage.ErrDuplicateReference
+	}
+	copied := *customer
+	s.customers[customer.UserID] = &copied
+	return nil
+}
+
+func (s *customerStore) UpdateCustomer(customer *models.Customer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *customer
+	s.customers[customer.UserID] = &copied
+	return nil
+}
+
+func (s *customerStore) GetCustomer(userID string) (*models.Customer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	customer, ok := s.customers[userID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copied := *customer
+	return &copied, nil
+}
+
+// customerStripe serves customer cus_1 and the payment methods in owners,
+// keyed by ID with the customer each is saved on ("" when unattached)
+type customerStripe struct {
+	mu            sync.Mutex
+	owners        map[string]string
+	defaultMethod string
+	created       int
+	updated       int
+	detached      []string
+}
+
+func (f *customerStripe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	customer := func() map[string]interface{} {
+		settings := map[string]interface{}{}
+		if f.defaultMethod != "" {
+			settings["default_payment_method"] = f.defaultMethod
+		}
+		return map[string]interface{}{"id": "cus_1", "object": "customer", "invoice_settings": settings}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/payment_methods/"), "/detach")
+	owner, known := f.owners[id]
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/customers":
+		f.created++
+		_ = json.NewEncoder(w).Encode(customer())
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/customers/cus_1":
+		f.updated++
+		_ = json.NewEncoder(w).Encode(customer())
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/customers/cus_1":
+		_ = json.NewEncoder(w).Encode(customer())
+	case strings.HasPrefix(r.URL.Path, "/v1/payment_methods/") && known:
+		if r.Method == http.MethodPost {
+			f.detached = append(f.detached, id)
+			owner = ""
+		}
+		pm := map[string]interface{}{"id": id, "object": "payment_method", "type": "card"}
+		if owner != "" {
+			pm["customer"] = owner
+		}
+		_ = json.NewEncoder(w).Encode(pm)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "message": "no such route"}}`))
+	}
+}
+
+func newCustomerService(t *testing.T, fake *customerStripe) *CustomerService {
+	t.Helper()
+	env := newTestEnv(t)
+	store := &customerStore{customers: map[string]*models.Customer{
+		"user_1": {UserID: "user_1", StripeCustomerID: "cus_1", Email: "ada@example.com"},
+	}}
+	return NewCustomerService(store, stripeAgainst(t, env, fake), env.log)
+}
+
+func platform() context.Context {
+	return tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+}
+
+func TestSavedCardsAreForThePlatformOnly(t *testing.T) {
+	customers := newCustomerService(t, &customerStripe{owners: map[string]string{"pm_mine": "cus_1"}})
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+
+	if _, err := customers.GetCustomer(organizer, "user_1"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("get customer: err = %v, want ErrForbidden", err)
+	}
+	if err := customers.DeletePaymentMethod(organizer, "user_1", "pm_mine"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("delete card: err = %v, want ErrForbidden", err)
+	}
+	req := &models.StripePaymentRequest{UserID: "user_1"}
+	if err := customers.PrepareCharge(organizer, req); !errors.Is(err, ErrForbidden) || req.CustomerID != "" {
+		t.Errorf("prepare charge: err = %v, customer %q", err, req.CustomerID)
+	}
+}
+
+func TestEnsureCustomerCreatesOnce(t *testing.T) {
+	fake := &customerStripe{}
+	customers := newCustomerService(t, fake)
+
+	for i := 0; i < 2; i++ {
+		customer, err := customers.EnsureCustomer(platform(), &models.CustomerRequest{UserID: "user_2", Email: "grace@example.com"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if customer.StripeCustomerID != "cus_1" {
+			t.Errorf("call %d: customer = %+v", i+1, customer)
+		}
+	}
+	if fake.created != 1 || fake.updated != 0 {
+		t.Errorf("created %d, updated %d; want 1 and 0", fake.created, fake.updated)
+	}
+
+	// A changed email is pushed to Stripe
+	customer, err := customers.EnsureCustomer(platform(), &models.CustomerRequest{UserID: "user_2", Email: "grace@example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Email != "grace@example.org" || fake.updated != 1 {
+		t.Errorf("email %q after %d updates", customer.Email, fake.updated)
+	}
+}
+
+func TestPrepareChargeUsesTheCustomersCards(t *testing.T) {
+	fake := &customerStripe{owners: map[string]string{"pm_mine": "cus_1", "pm_theirs": "cus_2", "pm_new": ""}}
+	customers := newCustomerService(t, fake)
+
+	req := &models.StripePaymentRequest{UserID: "user_1"}
+	if err := customers.PrepareCharge(platform(), req); !errors.Is(err, ErrNoDefaultMethod) {
+		t.Errorf("no default: err = %v, want ErrNoDefaultMethod", err)
+	}
+
+	fake.defaultMethod = "pm_mine"
+	req = &models.StripePaymentRequest{UserID: "user_1"}
+	if err := customers.PrepareCharge(platform(), req); err != nil || req.Token != "pm_mine" || req.CustomerID != "cus_1" {
+		t.Errorf("default card: err = %v, request %+v", err, req)
+	}
+
+	// A new card is attached once the charge succeeds
+	req = &models.StripePaymentRequest{UserID: "user_1", Token: "pm_new"}
+	if err := customers.PrepareCharge(platform(), req); err != nil || req.CustomerID != "cus_1" {
+		t.Errorf("new card: err = %v, request %+v", err, req)
+	}
+
+	req = &models.StripePaymentRequest{UserID: "user_1", Token: "pm_theirs"}
+	if err := customers.PrepareCharge(platform(), req); !errors.Is(err, ErrPaymentMethodNotFound) || req.CustomerID != "" {
+		t.Errorf("another customer's card: err = %v, request %+v", err, req)
+	}
+
+	// Guest checkouts are left alone
+	req = &models.StripePaymentRequest{Token: "pm_theirs"}
+	if err := customers.PrepareCharge(platform(), req); err != nil || req.CustomerID != "" {
+		t.Errorf("guest: err = %v, request %+v", err, req)
+	}
+}
+
+func TestCardsAreManagedByTheirCustomerOnly(t *testing.T) {
+	fake := &customerStripe{owners: map[string]string{"pm_mine": "cus_1", "pm_theirs": "cus_2", "pm_new": ""}}
+	customers := newCustomerService(t, fake)
+
+	for _, pm := range []string{"pm_theirs", "pm_new", "pm_missing"} {
+		if err := customers.DeletePaymentMethod(platform(), "user_1", pm); !errors.Is(err, ErrPaymentMethodNotFound) {
+			t.Errorf("delete %s: err = %v, want ErrPaymentMethodNotFound", pm, err)
+		}
+		if err := customers.SetDefaultPaymentMethod(platform(), "user_1", pm); !errors.Is(err, ErrPaymentMethodNotFound) {
+			t.Errorf("default %s: err = %v, want ErrPaymentMethodNotFound", pm, err)
+		}
+	}
+	if len(fake.detached) != 0 {
+		t.Errorf("detached %v", fake.detached)
+	}
+
+	if err := customers.DeletePaymentMethod(platform(), "user_1", "pm_mine"); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.detached) != 1 || fake.detached[0] != "pm_mine" {
+		t.Errorf("detached %v, want [pm_mine]", fake.detached)
+	}
+	if _, err := customers.GetCustomer(platform(), "user_9"); !errors.Is(err, ErrCustomerNotFound) {
+		t.Errorf("unknown user: err = %v, want ErrCustomerNotFound", err)
+	}
+}
@@ -0,0 +1,339 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+var (
+	ErrDisputeNotFound = apperror.New(apperror.DisputeNotFound, "dispute not found")
+	ErrDisputeNotOpen  = apperror.New(apperror.DisputeNotOpen, "dispute is not accepting evidence")
+)
+
+// DisputeService tracks Stripe disputes (chargebacks) on our payments. It
+// is fed by charge.dispute.* webhooks, posts the withdrawn funds to the
+// ledger and tells the ticketing service when a dispute is lost so the
+// tickets can be invalidated.
+type DisputeService struct {
+	store    storage.DisputeStore
+	payments storage.Store
+	ledger   *ledger.Ledger
+	stripe   *StripeService
+	events   *EventService
+	cfg      config.DisputeConfig
+	log      *logger.Logger
+}
+
+func NewDisputeService(store storage.DisputeStore, payments storage.Store, ledger *ledger.Ledger, stripe *StripeService, events *EventService, cfg config.DisputeConfig, log *logger.Logger) *DisputeService {
+	return &DisputeService{
+		store:    store,
+		payments: payments,
+		ledger:   ledger,
+		stripe:   stripe,
+		events:   events,
+		cfg:      cfg,
+		log:      log,
+	}
+}
+
+// IsDisputeEvent reports whether a webhook event belongs to this service.
+func IsDisputeEvent(eventType stripe.EventType) bool {
+	switch eventType {
+	case stripe.EventTypeChargeDisputeCreated,
+		stripe.EventTypeChargeDisputeUpdated,
+		stripe.EventTypeChargeDisputeClosed,
+		stripe.EventTypeChargeDisputeFundsWithdrawn,
+		stripe.EventTypeChargeDisputeFundsReinstated:
+		return true
+	}
+	return false
+}
+
+// HandleEvent applies a charge.dispute.* webhook. Stripe may deliver events
+// more than once and out of order; every step is safe to repeat.
+func (s *DisputeService) HandleEvent(ctx context.Context, event stripe.Event) error {
+	var sd stripe.Dispute
+	if err := json.Unmarshal(event.Data.Raw, &sd); err != nil {
+		return fmt.Errorf("failed to decode dispute: %w", err)
+	}
+
+	previous, _ := s.store.GetDispute(sd.ID)
+	dispute, payment := s.fromStripe(ctx, &sd, previous)
+	s.log.LogPayment("DISPUTE", dispute.PaymentID, fmt.Sprintf("%s for dispute %s (status: %s, reason: %s)", event.Type, dispute.ID, dispute.Status, dispute.Reason))
+
+	if event.Type == stripe.EventTypeChargeDisputeClosed && dispute.ClosedAt == nil {
+		now := time.Now()
+		dispute.ClosedAt = &now
+	}
+	if err := s.store.SaveDispute(dispute); err != nil {
+		return err
+	}
+
+	movement := ledger.Movement{
+		PaymentID:   dispute.PaymentID,
+		OrganizerID: dispute.OrganizerID,
+		Currency:    dispute.Currency,
+		Amount:      dispute.Amount,
+		Reference:   string(event.Type) + ":" + dispute.ID,
+	}
+	if payment != nil {
+		movement.OrderID = payment.OrderID
+	}
+
+	switch event.Type {
+	case stripe.EventTypeChargeDisputeCreated:
+		s.setPaymentStatus(payment, models.StatusDisputed)
+		s.publish("payment.disputed", payment, dispute)
+
+	case stripe.EventTypeChargeDisputeUpdated:
+		if previous == nil || previous.Status != dispute.Status {
+			s.publish("payment.dispute_updated", payment, dispute)
+		}
+
+	case stripe.EventTypeChargeDisputeFundsWithdrawn:
+		if err := s.ledger.DisputeFundsWithdrawn(movement); err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post dispute withdrawal %s: %v", dispute.ID, err))
+		}
+		s.recordDisputeFees(&sd, movement)
+
+	case stripe.EventTypeChargeDisputeFundsReinstated:
+		if err := s.ledger.DisputeFundsReinstated(movement); err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post dispute reinstatement %s: %v", dispute.ID, err))
+		}
+
+	case stripe.EventTypeChargeDisputeClosed:
+		switch stripe.DisputeStatus(dispute.Status) {
+		case stripe.DisputeStatusWon, stripe.DisputeStatusWarningClosed:
+			if payment != nil && payment.Status == models.StatusDisputed {
+				s.setPaymentStatus(payment, models.StatusSuccess)
+			}
+			s.publish("payment.dispute_won", payment, dispute)
+		case stripe.DisputeStatusLost:
+			s.log.LogPayment("DISPUTE_LOST", dispute.PaymentID, fmt.Sprintf("Dispute %s lost, tickets for order should be invalidated", dispute.ID))
+			s.publish("payment.dispute_lost", payment, dispute)
+		}
+	}
+	return nil
+}
+
+// fromStripe maps a Stripe dispute onto our record and finds the payment it
+// belongs to.
+func (s *DisputeService) fromStripe(ctx context.Context, sd *stripe.Dispute, previous *models.Dispute) (*models.Dispute, *models.Payment) {
+	now := time.Now()
+	dispute := &models.Dispute{
+		ID:        sd.ID,
+		Amount:    models.FromMinor(sd.Amount, string(sd.Currency)),
+		Currency:  string(sd.Currency),
+		Reason:    string(sd.Reason),
+		Status:    string(sd.Status),
+		CreatedAt: time.Unix(sd.Created, 0),
+		UpdatedAt: now,
+	}
+	if sd.Charge != nil {
+		dispute.ChargeID = sd.Charge.ID
+	}
+	if sd.PaymentIntent != nil {
+		dispute.PaymentIntentID = sd.PaymentIntent.ID
+	}
+	if sd.EvidenceDetails != nil && sd.EvidenceDetails.DueBy > 0 {
+		dueBy := time.Unix(sd.EvidenceDetails.DueBy, 0)
+		dispute.EvidenceDueBy = &dueBy
+	}
+	if previous != nil {
+		dispute.PaymentID = previous.PaymentID
+		dispute.OrganizerID = previous.OrganizerID
+	}
+
+	var payment *models.Payment
+	if dispute.PaymentIntentID != "" {
+		if p, err := s.payments.GetPaymentByTransactionID(dispute.PaymentIntentID); err == nil {
+			payment = p
+			dispute.PaymentID = p.PaymentID
+			dispute.OrganizerID = p.OrganizerID
+		} else if dispute.PaymentID == "" && s.stripe != nil {
+			if pi, err := s.stripe.GetPaymentIntent(ctx, dispute.PaymentIntentID); err == nil {
+				dispute.PaymentID = pi.Metadata["payment_id"]
+				dispute.OrganizerID = pi.Metadata["organizer_id"]
+			}
+		}
+	}
+	if dispute.PaymentID == "" {
+		s.log.Warn("DISPUTE", fmt.Sprintf("Dispute %s could not be matched to a payment (intent %s)", dispute.ID, dispute.PaymentIntentID))
+	}
+	return dispute, payment
+}
+
+// recordDisputeFees posts the dispute fee Stripe charged with the withdrawal.
+func (s *DisputeService) recordDisputeFees(sd *stripe.Dispute, movement ledger.Movement) {
+	for _, txn := range sd.BalanceTransactions {
+		if txn == nil || txn.Fee <= 0 {
+			continue
+		}
+		movement.Amount = models.FromMinor(txn.Fee, string(txn.Currency))
+		movement.Reference = "dispute.fee:" + txn.ID
+		if err := s.ledger.ProcessorFee(movement); err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post dispute fee %s: %v", txn.ID, err))
+		}
+	}
+}
+
+func (s *DisputeService) setPaymentStatus(payment *models.Payment, status models.PaymentStatus) {
+	if payment == nil || payment.Status == status {
+		return
+	}
+	s.log.LogPayment("STATUS_UPDATE", payment.PaymentID, fmt.Sprintf("Updating status from %s to %s", payment.Status, status))
+
+	payment.Status = status
+	if err := s.payments.UpdatePayment(payment); err != nil {
+		s.log.Error("DISPUTE", fmt.Sprintf("Failed to update payment %s: %v", payment.PaymentID, err))
+	}
+}
+
+func (s *DisputeService) publish(eventType string, payment *models.Payment, dispute *models.Dispute) {
+	event := &models.PaymentEvent{
+		Type:      eventType,
+		PaymentID: dispute.PaymentID,
+		Payment:   payment,
+		Dispute:   dispute,
+		Timestamp: time.Now(),
+	}
+	if err := s.events.Publish(event); err != nil {
+		s.log.Error("KAFKA", fmt.Sprintf("Failed to publish %s for dispute %s: %v", eventType, dispute.ID, err))
+	}
+}
+
+// Start warns about disputes whose evidence deadline is approaching until
+// ctx is cancelled.
+func (s *DisputeService) Start(ctx context.Context) {
+	s.log.LogProcess("DISPUTES", fmt.Sprintf("Dispute deadline tracker started (interval: %v, warning window: %v)", s.cfg.CheckInterval, s.cfg.ReminderWindow))
+
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.LogProcess("DISPUTES", "Dispute deadline tracker stopped")
+			return
+		case <-ticker.C:
+			if err := s.CheckDeadlines(); err != nil {
+				s.log.Error("DISPUTES", fmt.Sprintf("Deadline check failed: %v", err))
+			}
+		}
+	}
+}
+
+// CheckDeadlines publishes one payment.dispute_deadline event for every
+// open dispute without evidence that is due within the reminder window.
+func (s *DisputeService) CheckDeadlines() error {
+	now := time.Now()
+	due, err := s.store.ListDisputesDueBefore(now.Add(s.cfg.ReminderWindow))
+	if err != nil {
+		return err
+	}
+
+	for _, dispute := range due {
+		s.log.Warn("DISPUTES", fmt.Sprintf("Evidence for dispute %s (payment %s) is due %s",
+			dispute.ID, dispute.PaymentID, dispute.EvidenceDueBy.Format(time.RFC3339)))
+
+		dispute.ReminderSentAt = &now
+		if err := s.store.SaveDispute(dispute); err != nil {
+			return err
+		}
+
+		var payment *models.Payment
+		if dispute.PaymentID != "" {
+			payment, _ = s.payments.GetPayment(dispute.PaymentID)
+		}
+		s.publish("payment.dispute_deadline", payment, dispute)
+	}
+	return nil
+}
+
+func (s *DisputeService) GetDispute(ctx context.Context, id string) (*models.Dispute, error) {
+	dispute, err := s.store.GetDispute(id)
+	if err != nil || !tenant.CanAccess(ctx, dispute.OrganizerID) {
+		return nil, ErrDisputeNotFound
+	}
+	return dispute, nil
+}
+
+// ListDisputes lists disputes visible to the caller, optionally by status.
+func (s *DisputeService) ListDisputes(ctx context.Context, status string, limit, offset int) ([]*models.Dispute, error) {
+	return s.store.ListDisputes(tenant.Scope(ctx), status, limit, offset)
+}
+
+// SubmitEvidence sends the organizer's evidence to Stripe. file, when not
+// nil, is uploaded as service documentation (e.g. attendance records).
+func (s *DisputeService) SubmitEvidence(ctx context.Context, id string, req *models.DisputeEvidenceRequest, file io.Reader, filename string) (*models.Dispute, error) {
+	dispute, err := s.GetDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch stripe.DisputeStatus(dispute.Status) {
+	case stripe.DisputeStatusNeedsResponse, stripe.DisputeStatusWarningNeedsResponse:
+	default:
+		return nil, ErrDisputeNotOpen
+	}
+	if s.stripe == nil {
+		return nil, ErrStripeClientInitFailed
+	}
+
+	evidence := &stripe.DisputeEvidenceParams{
+		ProductDescription:   optionalString(req.ProductDescription),
+		CustomerName:         optionalString(req.CustomerName),
+		CustomerEmailAddress: optionalString(req.CustomerEmail),
+		ServiceDate:          optionalString(req.ServiceDate),
+		AccessActivityLog:    optionalString(req.TicketScanLog),
+		UncategorizedText:    optionalString(req.AttendanceNotes),
+	}
+	if file != nil {
+		fileID, err := s.stripe.UploadDisputeFile(ctx, filename, file)
+		if err != nil {
+			return nil, err
+		}
+		evidence.ServiceDocumentation = stripe.String(fileID)
+	}
+
+	sd, err := s.stripe.UpdateDisputeEvidence(ctx, dispute.ID, evidence, req.Submit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dispute.Status = string(sd.Status)
+	dispute.UpdatedAt = now
+	if req.Submit {
+		dispute.EvidenceSubmittedAt = &now
+	}
+	if err := s.store.SaveDispute(dispute); err != nil {
+		return nil, err
+	}
+
+	action := "staged"
+	if req.Submit {
+		action = "submitted"
+	}
+	s.log.LogPayment("DISPUTE_EVIDENCE", dispute.PaymentID, fmt.Sprintf("Evidence for dispute %s %s", dispute.ID, action))
+	return dispute, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return stripe.String(value)
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+type disputeStore struct {
+	storage.DisputeStore
+	mu       sync.Mutex
+	disputes map[string]*models.Dispute
+}
+
+func (s *disputeStore) SaveDispute(dispute *models.Dispute) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *dispute
+	s.disputes[dispute.ID] = &copied
+	return nil
+}
+
+func (s *disputeStore) GetDispute(id string) (*models.Dispute, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dispute, ok := s.disputes[id]
+	if !ok {
+		return nil, errors.New("dispute not found")
+	}
+	copied := *dispute
+	return &copied, nil
+}
+
+func (s *disputeStore) ListPaymentDisputes(paymentID string) ([]*models.Dispute, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var disputes []*models.Dispute
+	for _, dispute := range s.disputes {
+		if dispute.PaymentID == paymentID {
+			copied := *dispute
+			disputes = append(disputes, &copied)
+		}
+	}
+	return disputes, nil
+}
+
+// disputeEvent builds a charge.dispute.* webhook for a 40.00 dispute on pi_1
+func disputeEvent(t *testing.T, eventType stripe.EventType, status stripe.DisputeStatus) stripe.Event {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{
+		"id":                   "dp_1",
+		"amount":               4000,
+		"currency":             "usd",
+		"status":               status,
+		"reason":               "fraudulent",
+		"payment_intent":       "pi_1",
+		"charge":               "ch_1",
+		"created":              time.Now().Unix(),
+		"balance_transactions": []map[string]interface{}{{"id": "txn_1", "fee": 1500}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stripe.Event{Type: eventType, Data: &stripe.EventData{Raw: raw}}
+}
+
+func TestDisputeLifecycle(t *testing.T) {
+	env := newTestEnv(t)
+	payment := env.capturedPayment(t, "pay_1", 40)
+	payment.TransactionID = "pi_1"
+	if err := env.store.UpdatePayment(payment); err != nil {
+		t.Fatal(err)
+	}
+	store := &disputeStore{disputes: make(map[string]*models.Dispute)}
+	disputes := NewDisputeService(store, env.store, env.ledger, nil, env.events, config.DisputeConfig{}, env.log)
+	ctx := context.Background()
+
+	handle := func(eventType stripe.EventType, status stripe.DisputeStatus) {
+		t.Helper()
+		if err := disputes.HandleEvent(ctx, disputeEvent(t, eventType, status)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handle(stripe.EventTypeChargeDisputeCreated, stripe.DisputeStatusNeedsResponse)
+	if got, _ := env.store.GetPayment("pay_1"); got.Status != models.StatusDisputed {
+		t.Errorf("payment status = %s after the dispute opened, want disputed", got.Status)
+	}
+	dispute, err := store.GetDispute("dp_1")
+	if err != nil || dispute.PaymentID != "pay_1" || dispute.OrganizerID != "org_1" || dispute.Amount != 40 {
+		t.Fatalf("dispute = %+v (%v), want 40.00 on pay_1 for org_1", dispute, err)
+	}
+
+	// Stripe may deliver the withdrawal more than once
+	handle(stripe.EventTypeChargeDisputeFundsWithdrawn, stripe.DisputeStatusUnderReview)
+	handle(stripe.EventTypeChargeDisputeFundsWithdrawn, stripe.DisputeStatusUnderReview)
+	if entries := env.store.entries("dispute.funds_withdrawn"); len(entries) != 1 {
+		t.Errorf("%d withdrawal entries, want 1", len(entries))
+	}
+	if entries := env.store.entries("processor.fee"); len(entries) != 1 || entries[0].Reference != "dispute.fee:txn_1" {
+		t.Errorf("dispute fee entries = %v, want one for txn_1", entries)
+	}
+
+	handle(stripe.EventTypeChargeDisputeFundsReinstated, stripe.DisputeStatusWon)
+	handle(stripe.EventTypeChargeDisputeClosed, stripe.DisputeStatusWon)
+	if got, _ := env.store.GetPayment("pay_1"); got.Status != models.StatusSuccess {
+		t.Errorf("payment status = %s after the dispute was won, want success", got.Status)
+	}
+	if dispute, _ := store.GetDispute("dp_1"); dispute.ClosedAt == nil {
+		t.Error("closed dispute has no closing time")
+	}
+	if entries := env.store.entries("dispute.funds_reinstated"); len(entries) != 1 {
+		t.Errorf("%d reinstatement entries, want 1", len(entries))
+	}
+
+	published := strings.Join(env.store.eventTypes(), ",")
+	for _, want := range []string{"payment.disputed", "payment.dispute_won"} {
+		if !strings.Contains(published, want) {
+			t.Errorf("published %s, want %s among them", published, want)
+		}
+	}
+}
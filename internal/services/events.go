@@ -0,0 +1,455 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/eventbus"
+	"payment-gateway/internal/kafka"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+var ErrInvalidReplay = apperror.New(apperror.InvalidReplay, "invalid replay request")
+
+const (
+	defaultReplayLimit = 1000
+	maxReplayLimit     = 10000
+)
+
+// EventService publishes payment events. Every event is written to the
+// event log, sent to Kafka, queued for webhook endpoints and pushed to
+// status streams through the event bus. The log is what replays, payment
+// timelines and resumed streams are built from; payments older than the log
+// are regenerated from their payment, refund and dispute records.
+type EventService struct {
+	store    storage.EventStore
+	payments storage.Store
+	refunds  storage.RefundStore
+	disputes storage.DisputeStore
+	producer *kafka.Producer
+	bus      *eventbus.Bus
+	webhooks *WebhookService
+	audit    *AuditService
+	log      *logger.Logger
+}
+
+func NewEventService(store storage.EventStore, payments storage.Store, refunds storage.RefundStore, disputes storage.DisputeStore, producer *kafka.Producer, bus *eventbus.Bus, webhooks *WebhookService, audit *AuditService, log *logger.Logger) *EventService {
+	return &EventService{
+		store:    store,
+		payments: payments,
+		refunds:  refunds,
+		disputes: disputes,
+		producer: producer,
+		bus:      bus,
+		webhooks: webhooks,
+		audit:    audit,
+		log:      log,
+	}
+}
+
+// Publish sends an event to Kafka, records it in the event log, queues it
+// for webhooks and pushes it to status streams. The Kafka error is returned
+// for the caller to log; the event is logged either way so it can be
+// replayed. It is logged before it is pushed, so a stream that resumes from
+// the log never misses it.
+func (s *EventService) Publish(event *models.PaymentEvent) error {
+	if event.EventID == "" {
+		event.EventID = utils.GenerateEventID()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	err := s.producer.PublishPaymentEvent(event)
+
+	entry := &models.EventLogEntry{
+		ID:        event.EventID,
+		Type:      event.Type,
+		PaymentID: event.PaymentID,
+		Event:     event,
+		Published: err == nil,
+		CreatedAt: event.Timestamp,
+	}
+	if event.Payment != nil {
+		entry.OrderID = event.Payment.OrderID
+	}
+	entry.OrganizerID = eventOrganizer(event)
+	if logErr := s.store.SaveEvent(entry); logErr != nil {
+		s.log.Error("EVENTS", fmt.Sprintf("Failed to log %s event %s: %v", event.Type, event.EventID, logErr))
+	}
+
+	s.webhooks.Enqueue(event)
+	if busErr := s.bus.Publish(event); busErr != nil {
+		s.log.Warn("EVENTS", fmt.Sprintf("Failed to push %s event %s to other instances: %v", event.Type, event.EventID, busErr))
+	}
+	return err
+}
+
+// Watch returns a channel receiving the payment's events as any instance
+// publishes them, and a function to stop watching. The channel is closed
+// if the watcher falls behind, and events are lost while the event bus is
+// cut off from Redis, so watchers should re-read the payment now and then.
+func (s *EventService) Watch(paymentID string) (<-chan *models.PaymentEvent, func()) {
+	return s.bus.Subscribe(eventbus.Payment(paymentID))
+}
+
+// Follow opens a status feed for a payment, or for an order's payment when
+// paymentID is empty. With lastEventID set, the events logged after it are
+// returned as missed; an ID the log does not hold replays every logged
+// event, which the subscriber dedupes.
+func (s *EventService) Follow(ctx context.Context, paymentID, orderID, lastEventID string) (*models.StatusFeed, error) {
+	filter := models.EventFilter{PaymentID: paymentID, Limit: maxReplayLimit}
+	topic := eventbus.Payment(paymentID)
+	if paymentID == "" {
+		filter = models.EventFilter{OrderID: orderID, Limit: maxReplayLimit}
+		topic = eventbus.Order(orderID)
+	}
+
+	// Subscribe before reading the log and the payment so nothing falls
+	// between them; the payment is read last so it is never older than
+	// the missed events
+	events, stop := s.bus.Subscribe(topic)
+	var missed []*models.PaymentEvent
+	if lastEventID != "" {
+		entries, err := s.store.ListEvents(filter)
+		if err != nil {
+			stop()
+			return nil, err
+		}
+		missed = eventsAfter(logEvents(entries), lastEventID)
+	}
+
+	payment, err := s.StreamedPayment(ctx, paymentID, orderID)
+	if err != nil {
+		stop()
+		return nil, err
+	}
+
+	return &models.StatusFeed{Payment: payment, Missed: missed, Events: events, Stop: stop}, nil
+}
+
+// StreamedPayment returns the payment a status stream for paymentID, or
+// for orderID when paymentID is empty, follows
+func (s *EventService) StreamedPayment(ctx context.Context, paymentID, orderID string) (*models.Payment, error) {
+	var payment *models.Payment
+	var err error
+	if paymentID != "" {
+		payment, err = s.payments.GetPayment(paymentID)
+	} else {
+		payment, err = s.payments.GetTicketByOrderID(orderID)
+	}
+	if err != nil || !tenant.CanAccess(ctx, payment.OrganizerID) {
+		return nil, ErrPaymentNotFound
+	}
+	return payment, nil
+}
+
+// eventsAfter returns the status events that follow lastEventID, or all of
+// them when it is not among them.
+func eventsAfter(events []*models.PaymentEvent, lastEventID string) []*models.PaymentEvent {
+	start := 0
+	for i, event := range events {
+		if event.EventID == lastEventID {
+			start = i + 1
+			break
+		}
+	}
+
+	var after []*models.PaymentEvent
+	for _, event := range events[start:] {
+		if event.Payment != nil {
+			after = append(after, event)
+		}
+	}
+	return after
+}
+
+// ListEvents returns logged events matching the filter, oldest first.
+func (s *EventService) ListEvents(ctx context.Context, filter models.EventFilter) ([]*models.EventLogEntry, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	filter.Limit = replayLimit(filter.Limit)
+	return s.store.ListEvents(filter)
+}
+
+// Timeline returns a payment's events in order, from the event log when the
+// payment has logged events and regenerated from its records otherwise.
+func (s *EventService) Timeline(ctx context.Context, paymentID string) ([]*models.PaymentEvent, models.ReplaySource, error) {
+	payment, err := s.payments.GetPayment(paymentID)
+	if err != nil || !tenant.CanAccess(ctx, payment.OrganizerID) {
+		return nil, "", ErrPaymentNotFound
+	}
+
+	entries, err := s.store.ListEvents(models.EventFilter{PaymentID: paymentID, Limit: maxReplayLimit})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) > 0 {
+		return logEvents(entries), models.ReplayFromLog, nil
+	}
+	return s.regenerate(payment), models.ReplayFromHistory, nil
+}
+
+// Replay republishes past events to Kafka with the replay header set. Event
+// IDs are the originals, or stable IDs for regenerated events, so replaying
+// twice never looks like new activity to a consumer that dedupes.
+func (s *EventService) Replay(ctx context.Context, req *models.ReplayRequest) (*models.ReplayResult, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if req.PaymentID == "" && req.OrderID == "" && req.From == nil && req.To == nil {
+		return nil, fmt.Errorf("%w: payment_id, order_id or a from/to window is required", ErrInvalidReplay)
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidReplay)
+	}
+	req.Limit = replayLimit(req.Limit)
+
+	source := req.Source
+	if source == "" {
+		source = models.ReplayFromLog
+	}
+
+	var events []*models.PaymentEvent
+	switch source {
+	case models.ReplayFromLog:
+		entries, err := s.store.ListEvents(req.EventFilter)
+		if err != nil {
+			return nil, err
+		}
+		events = logEvents(entries)
+	case models.ReplayFromHistory:
+		var err error
+		if events, err = s.history(req.EventFilter); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown source %s", ErrInvalidReplay, source)
+	}
+
+	result := &models.ReplayResult{Source: source, DryRun: req.DryRun, Matched: len(events), Events: events}
+	if req.DryRun {
+		return result, nil
+	}
+
+	for _, event := range events {
+		if err := s.producer.RepublishPaymentEvent(event); err != nil {
+			s.log.Error("EVENTS", fmt.Sprintf("Failed to replay %s event %s: %v", event.Type, event.EventID, err))
+			result.Failed++
+			continue
+		}
+		result.Published++
+	}
+
+	s.audit.Record(ctx, &models.AuditEntry{
+		Action:     models.AuditEventsReplayed,
+		TargetType: "events",
+		PaymentID:  req.PaymentID,
+		OrderID:    req.OrderID,
+	}, nil, map[string]interface{}{"request": req, "matched": result.Matched, "published": result.Published, "failed": result.Failed})
+	s.log.Info("EVENTS", fmt.Sprintf("Replayed %d of %d events from %s (payment %q, order %q, failed %d)",
+		result.Published, result.Matched, source, req.PaymentID, req.OrderID, result.Failed))
+	return result, nil
+}
+
+// Outbox lists the logged events that never reached Kafka, oldest first.
+func (s *EventService) Outbox(ctx context.Context, limit int) ([]*models.EventLogEntry, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.store.ListUnpublishedEvents(replayLimit(limit))
+}
+
+// DrainOutbox publishes the events whose Kafka publish failed when they
+// happened and marks them published. They go out as ordinary events, not
+// replays, because no consumer has seen them; webhooks were queued at the
+// time and are not queued again.
+func (s *EventService) DrainOutbox(ctx context.Context, limit int) (*models.OutboxDrainResult, error) {
+	entries, err := s.Outbox(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := logEvents(entries)
+	result := &models.OutboxDrainResult{Pending: len(events), Events: events}
+	for _, event := range events {
+		if err := s.producer.PublishPaymentEvent(event); err != nil {
+			s.log.Error("EVENTS", fmt.Sprintf("Failed to publish outbox event %s: %v", event.EventID, err))
+			result.Failed++
+			continue
+		}
+		if err := s.store.MarkEventPublished(event.EventID); err != nil {
+			// Published but still listed; consumers dedupe on the event ID
+			result.Failed++
+			continue
+		}
+		result.Published++
+	}
+
+	if result.Pending > 0 {
+		s.audit.Record(ctx, &models.AuditEntry{
+			Action:     models.AuditOutboxDrained,
+			TargetType: "events",
+		}, nil, map[string]interface{}{"pending": result.Pending, "published": result.Published, "failed": result.Failed})
+	}
+	s.log.Info("EVENTS", fmt.Sprintf("Drained outbox: %d of %d events published, %d failed", result.Published, result.Pending, result.Failed))
+	return result, nil
+}
+
+// history regenerates the events for the payments the filter selects.
+func (s *EventService) history(filter models.EventFilter) ([]*models.PaymentEvent, error) {
+	var payments []*models.Payment
+	switch {
+	case filter.PaymentID != "":
+		payment, err := s.payments.GetPayment(filter.PaymentID)
+		if err != nil {
+			return nil, ErrPaymentNotFound
+		}
+		payments = append(payments, payment)
+	case filter.OrderID != "":
+		payment, err := s.payments.GetTicketByOrderID(filter.OrderID)
+		if err != nil {
+			return nil, ErrPaymentNotFound
+		}
+		payments = append(payments, payment)
+	default:
+		from, to := time.Time{}, time.Now()
+		if filter.From != nil {
+			from = *filter.From
+		}
+		if filter.To != nil {
+			to = *filter.To
+		}
+		var err error
+		if payments, err = s.payments.ListPaymentsBetween(from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	wanted := make(map[string]bool, len(filter.EventTypes))
+	for _, eventType := range filter.EventTypes {
+		wanted[eventType] = true
+	}
+
+	var events []*models.PaymentEvent
+	for _, payment := range payments {
+		for _, event := range s.regenerate(payment) {
+			if len(wanted) > 0 && !wanted[event.Type] {
+				continue
+			}
+			events = append(events, event)
+			if len(events) >= filter.Limit {
+				return events, nil
+			}
+		}
+	}
+	return events, nil
+}
+
+// regenerate rebuilds a payment's events from what is stored about it: the
+// outcome of the payment, each refund and each dispute. Timestamps are the
+// best the records offer; a refunded payment's capture time is not kept, so
+// its success event is dated to the earliest later record.
+func (s *EventService) regenerate(payment *models.Payment) []*models.PaymentEvent {
+	refunds, err := s.refunds.ListRefunds(payment.PaymentID)
+	if err != nil {
+		s.log.Warn("EVENTS", fmt.Sprintf("Failed to load refunds for payment %s: %v", payment.PaymentID, err))
+	}
+	disputes, err := s.disputes.ListPaymentDisputes(payment.PaymentID)
+	if err != nil {
+		s.log.Warn("EVENTS", fmt.Sprintf("Failed to load disputes for payment %s: %v", payment.PaymentID, err))
+	}
+
+	var events []*models.PaymentEvent
+	add := func(eventType, suffix string, status models.PaymentStatus, at time.Time, dispute *models.Dispute) {
+		snapshot := *payment
+		snapshot.Status = status
+		id := "regen_" + payment.PaymentID + "_" + eventType
+		if suffix != "" {
+			id += "_" + suffix
+		}
+		events = append(events, &models.PaymentEvent{
+			EventID:   id,
+			Type:      eventType,
+			PaymentID: payment.PaymentID,
+			Payment:   &snapshot,
+			Dispute:   dispute,
+			Timestamp: at,
+		})
+	}
+
+	captured := payment.Status == models.StatusSuccess || payment.Status == models.StatusRefunded ||
+		payment.Status == models.StatusDisputed || len(refunds) > 0 || len(disputes) > 0
+	switch {
+	case captured:
+		capturedAt := payment.Date
+		for _, refund := range refunds {
+			if refund.CreatedAt.Before(capturedAt) {
+				capturedAt = refund.CreatedAt
+			}
+		}
+		for _, dispute := range disputes {
+			if dispute.CreatedAt.Before(capturedAt) {
+				capturedAt = dispute.CreatedAt
+			}
+		}
+		add("payment.success", "", models.StatusSuccess, capturedAt, nil)
+	case payment.Status == models.StatusFailed:
+		add("payment.failed", "", models.StatusFailed, payment.Date, nil)
+	case payment.Status == models.StatusCancelled:
+		add("payment.cancelled", "", models.StatusCancelled, payment.Date, nil)
+	}
+
+	// Partial refunds leave the payment successful until nothing is left
+	var refunded float64
+	for _, refund := range refunds {
+		refunded += refund.Amount
+		status := models.StatusSuccess
+		if roundMinor(payment.Price-refunded) <= 0 {
+			status = models.StatusRefunded
+		}
+		add("payment.refunded", refund.ID, status, refund.CreatedAt, nil)
+	}
+	for _, dispute := range disputes {
+		add("payment.disputed", dispute.ID, models.StatusDisputed, dispute.CreatedAt, dispute)
+		if dispute.ClosedAt == nil {
+			continue
+		}
+		switch dispute.Status {
+		case "won", "warning_closed":
+			add("payment.dispute_won", dispute.ID, models.StatusSuccess, *dispute.ClosedAt, dispute)
+		case "lost":
+			add("payment.dispute_lost", dispute.ID, models.StatusDisputed, *dispute.ClosedAt, dispute)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+	return events
+}
+
+func logEvents(entries []*models.EventLogEntry) []*models.PaymentEvent {
+	events := make([]*models.PaymentEvent, 0, len(entries))
+	for _, entry := range entries {
+		entry.Event.EventID = entry.ID
+		events = append(events, entry.Event)
+	}
+	return events
+}
+
+func replayLimit(limit int) int {
+	if limit <= 0 {
+		return defaultReplayLimit
+	}
+	if limit > maxReplayLimit {
+		return maxReplayLimit
+	}
+	return limit
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+func eventIDs(events []*models.PaymentEvent) string {
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.EventID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestEventsAfterResumesPastTheLastSeen(t *testing.T) {
+	payment := &models.Payment{PaymentID: "pay_1"}
+	events := []*models.PaymentEvent{
+		{EventID: "evt_1", Payment: payment},
+		{EventID: "evt_2", Payment: payment},
+		{EventID: "evt_3"}, // carries no status
+		{EventID: "evt_4", Payment: payment},
+	}
+	for lastEventID, want := range map[string]string{
+		"evt_1":   "evt_2,evt_4",
+		"evt_4":   "",
+		"unknown": "evt_1,evt_2,evt_4",
+	} {
+		if got := eventIDs(eventsAfter(events, lastEventID)); got != want {
+			t.Errorf("after %s = %q, want %q", lastEventID, got, want)
+		}
+	}
+}
+
+// historyEnv stores pay_1, refunded in two parts with a dispute won in
+// between, and logs no events for it
+func historyEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := newTestEnv(t)
+	disputes := &disputeStore{disputes: make(map[string]*models.Dispute)}
+	env.events.disputes = disputes
+
+	captured := time.Date(2026, time.September, 1, 10, 0, 0, 0, time.UTC)
+	payment := env.capturedPayment(t, "pay_1", 100)
+	payment.Status = models.StatusRefunded
+	payment.Date = captured.Add(72 * time.Hour)
+	if err := env.store.UpdatePayment(payment); err != nil {
+		t.Fatal(err)
+	}
+	for _, refund := range []*models.Refund{
+		{ID: "re_1", PaymentID: "pay_1", Amount: 40, CreatedAt: captured.Add(24 * time.Hour)},
+		{ID: "re_2", PaymentID: "pay_1", Amount: 60, CreatedAt: captured.Add(72 * time.Hour)},
+	} {
+		if err := env.store.SaveRefund(refund); err != nil {
+			t.Fatal(err)
+		}
+	}
+	closed := captured.Add(48 * time.Hour)
+	_ = disputes.SaveDispute(&models.Dispute{ID: "dp_1", PaymentID: "pay_1", Status: "won", CreatedAt: captured.Add(36 * time.Hour), ClosedAt: &closed})
+	return env
+}
+
+func TestTimelineIsRegeneratedWithoutLoggedEvents(t *testing.T) {
+	env := historyEnv(t)
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+
+	events, source, err := env.events.Timeline(platform, "pay_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != models.ReplayFromHistory {
+		t.Errorf("source = %s", source)
+	}
+	want := "regen_pay_1_payment.success,regen_pay_1_payment.refunded_re_1,regen_pay_1_payment.disputed_dp_1," +
+		"regen_pay_1_payment.dispute_won_dp_1,regen_pay_1_payment.refunded_re_2"
+	if got := eventIDs(events); got != want {
+		t.Errorf("timeline = %s, want %s", got, want)
+	}
+	statuses := make([]string, len(events))
+	for i, event := range events {
+		statuses[i] = string(event.Payment.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "success,success,disputed,success,refunded" {
+		t.Errorf("statuses = %s", got)
+	}
+
+	// Regenerating again gives the same IDs, so consumers dedupe a replay
+	again, _, _ := env.events.Timeline(platform, "pay_1")
+	if eventIDs(again) != want {
+		t.Errorf("second timeline = %s", eventIDs(again))
+	}
+
+	other := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_2"})
+	if _, _, err := env.events.Timeline(other, "pay_1"); !errors.Is(err, ErrPaymentNotFound) {
+		t.Errorf("another organizer: err = %v, want ErrPaymentNotFound", err)
+	}
+}
+
+func TestTimelinePrefersTheLog(t *testing.T) {
+	env := historyEnv(t)
+	payment, _ := env.store.GetPayment("pay_1")
+	if err := env.events.Publish(&models.PaymentEvent{Type: "payment.success", PaymentID: "pay_1", Payment: payment}); err != nil {
+		t.Fatal(err)
+	}
+
+	events, source, err := env.events.Timeline(context.Background(), "pay_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != models.ReplayFromLog || len(events) != 1 || strings.HasPrefix(events[0].EventID, "regen_") {
+		t.Errorf("timeline from %s: %s", source, eventIDs(events))
+	}
+}
+
+func TestReplay(t *testing.T) {
+	env := historyEnv(t)
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	from, to := time.Now(), time.Now().Add(-time.Hour)
+
+	for name, req := range map[string]*models.ReplayRequest{
+		"no selection":    {},
+		"reversed window": {EventFilter: models.EventFilter{From: &from, To: &to}},
+		"unknown source":  {EventFilter: models.EventFilter{PaymentID: "pay_1"}, Source: "kafka"},
+	} {
+		if _, err := env.events.Replay(platform, req); !errors.Is(err, ErrInvalidReplay) {
+			t.Errorf("%s: err = %v, want ErrInvalidReplay", name, err)
+		}
+	}
+	organizer := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: "org_1"})
+	if _, err := env.events.Replay(organizer, &models.ReplayRequest{EventFilter: models.EventFilter{PaymentID: "pay_1"}}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("organizer replay: err = %v, want ErrForbidden", err)
+	}
+
+	dryRun, err := env.events.Replay(platform, &models.ReplayRequest{
+		EventFilter: models.EventFilter{OrderID: "order_pay_1", EventTypes: []string{"payment.refunded"}},
+		Source:      models.ReplayFromHistory,
+		DryRun:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dryRun.Matched != 2 || dryRun.Published != 0 || len(env.store.audit) != 0 {
+		t.Errorf("dry run = %+v, %d audited", dryRun, len(env.store.audit))
+	}
+
+	result, err := env.events.Replay(platform, &models.ReplayRequest{
+		EventFilter: models.EventFilter{PaymentID: "pay_1"},
+		Source:      models.ReplayFromHistory,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Matched != 5 || result.Published != 5 || result.Failed != 0 {
+		t.Errorf("replay = %+v", result)
+	}
+	if len(env.store.audit) != 1 || env.store.audit[0].Action != models.AuditEventsReplayed {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+	// Replays are not new activity
+	if types := env.store.eventTypes(); len(types) != 0 {
+		t.Errorf("replay logged events %v", types)
+	}
+}
+
+func TestDrainOutboxPublishesWhatKafkaMissed(t *testing.T) {
+	env := newTestEnv(t)
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	payment := &models.Payment{PaymentID: "pay_1", OrganizerID: "org_1"}
+	for _, entry := range []*models.EventLogEntry{
+		{ID: "evt_1", Type: "payment.success", PaymentID: "pay_1", Published: true},
+		{ID: "evt_2", Type: "payment.refunded", PaymentID: "pay_1"},
+	} {
+		entry.Event = &models.PaymentEvent{Type: entry.Type, PaymentID: "pay_1", Payment: payment}
+		_ = env.store.SaveEvent(entry)
+	}
+
+	result, err := env.events.DrainOutbox(platform, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Pending != 1 || result.Published != 1 || eventIDs(result.Events) != "evt_2" {
+		t.Errorf("drain = %+v", result)
+	}
+	if outbox, _ := env.events.Outbox(platform, 0); len(outbox) != 0 {
+		t.Errorf("outbox still holds %d events", len(outbox))
+	}
+
+	// An empty outbox is not audited
+	if _, err := env.events.DrainOutbox(platform, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(env.store.audit) != 1 || env.store.audit[0].Action != models.AuditOutboxDrained {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+}
@@ -0,0 +1,701 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/eventbus"
+	"payment-gateway/internal/fx"
+	"payment-gateway/internal/kafka"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+)
+
+// memStore keeps what the services under test store in memory. Interfaces
+// are embedded so it satisfies every store; methods a test reaches without
+// an implementation here panic on the nil interface.
+type memStore struct {
+	storage.Store
+	storage.RefundStore
+	storage.LineItemStore
+	storage.LedgerStore
+	storage.FeeStore
+	storage.OrganizerStore
+	storage.AuditStore
+	storage.EventStore
+	storage.WebhookStore
+	storage.PayoutStore
+
+	mu         sync.Mutex
+	payments   map[string]*models.Payment
+	refunds    []*models.Refund
+	lineItems  map[string][]*models.LineItem
+	journal    []*models.JournalEntry
+	feeLines   []*models.FeeLine
+	organizers map[string]*models.Organizer
+	audit      []*models.AuditEntry
+	events     []*models.EventLogEntry
+	payouts    map[string]*models.Payout
+	runs       map[string]*models.ReconciliationRun
+	items      []*models.ReconciliationItem
+
+	staleQueries int
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		payments:   make(map[string]*models.Payment),
+		lineItems:  make(map[string][]*models.LineItem),
+		organizers: make(map[string]*models.Organizer),
+		payouts:    make(map[string]*models.Payout),
+	}
+}
+
+func (m *memStore) SavePayment(payment *models.Payment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// MySQLStore.SavePayment is a plain INSERT
+	if _, ok := m.payments[payment.PaymentID]; ok {
+		return fmt.Errorf("failed to save payment: Error 1062: Duplicate entry '%s' for key 'PRIMARY'", payment.PaymentID)
+	}
+	stored := *payment
+	stored.LineItems = nil
+	m.payments[payment.PaymentID] = &stored
+	return nil
+}
+
+func (m *memStore) UpdatePayment(payment *models.Payment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.payments[payment.PaymentID]; !ok {
+		return errors.New("payment not found")
+	}
+	stored := *payment
+	stored.LineItems = nil
+	m.payments[payment.PaymentID] = &stored
+	return nil
+}
+
+func (m *memStore) GetPayment(id string) (*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	payment, ok := m.payments[id]
+	if !ok {
+		return nil, errors.New("payment not found")
+	}
+	copied := *payment
+	return &copied, nil
+}
+
+func (m *memStore) GetPaymentByTransactionID(transactionID string) (*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, payment := range m.payments {
+		if payment.TransactionID == transactionID {
+			copied := *payment
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("payment not found")
+}
+
+func (m *memStore) ListPaymentsBetween(from, to time.Time) ([]*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var payments []*models.Payment
+	for _, payment := range m.payments {
+		if !payment.Date.Before(from) && payment.Date.Before(to) {
+			copied := *payment
+			payments = append(payments, &copied)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool { return payments[i].Date.Before(payments[j].Date) })
+	return payments, nil
+}
+
+func (m *memStore) SaveRefund(refund *models.Refund) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.refunds {
+		if existing.ID == refund.ID {
+			return storage.ErrDuplicateReference
+		}
+	}
+	copied := *refund
+	m.refunds = append(m.refunds, &copied)
+	return nil
+}
+
+func (m *memStore) ListRefunds(paymentID string) ([]*models.Refund, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var refunds []*models.Refund
+	for _, refund := range m.refunds {
+		if refund.PaymentID == paymentID {
+			copied := *refund
+			refunds = append(refunds, &copied)
+		}
+	}
+	return refunds, nil
+}
+
+func (m *memStore) SavePaymentLineItems(paymentID string, items []*models.LineItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, item := range items {
+		copied := *item
+		m.lineItems[paymentID] = append(m.lineItems[paymentID], &copied)
+	}
+	return nil
+}
+
+func (m *memStore) ListPaymentLineItems(paymentID string) ([]*models.LineItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var items []*models.LineItem
+	for _, item := range m.lineItems[paymentID] {
+		copied := *item
+		items = append(items, &copied)
+	}
+	return items, nil
+}
+
+func (m *memStore) RefundPaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	byID := make(map[string]*models.LineItem)
+	for _, item := range m.lineItems[paymentID] {
+		byID[item.ID] = item
+	}
+	for _, part := range refunded {
+		item, ok := byID[part.LineItemID]
+		if !ok || item.RefundedQuantity+part.Quantity > item.Quantity {
+			return false, nil
+		}
+	}
+	for _, part := range refunded {
+		byID[part.LineItemID].RefundedQuantity += part.Quantity
+		byID[part.LineItemID].RefundedAmount += part.Amount
+	}
+	return true, nil
+}
+
+func (m *memStore) ReleasePaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, item := range m.lineItems[paymentID] {
+		for _, part := range refunded {
+			if part.LineItemID == item.ID {
+				item.RefundedQuantity -= part.Quantity
+				item.RefundedAmount -= part.Amount
+			}
+		}
+	}
+	return nil
+}
+
+func (m *memStore) PostJournalEntry(entry *models.JournalEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.journal {
+		if existing.Reference == entry.Reference {
+			return storage.ErrDuplicateReference
+		}
+	}
+	m.journal = append(m.journal, entry)
+	return nil
+}
+
+// entries returns the journal entries of a kind, in posting order
+func (m *memStore) entries(kind string) []*models.JournalEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var entries []*models.JournalEntry
+	for _, entry := range m.journal {
+		if entry.Kind == kind {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (m *memStore) SaveFeeLine(line *models.FeeLine) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.feeLines {
+		if existing.Reference == line.Reference {
+			return storage.ErrDuplicateReference
+		}
+	}
+	m.feeLines = append(m.feeLines, line)
+	return nil
+}
+
+func (m *memStore) ListFeeLines(paymentID string) ([]*models.FeeLine, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var lines []*models.FeeLine
+	for _, line := range m.feeLines {
+		if line.PaymentID == paymentID {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+func (m *memStore) GetFeePlan(id string) (*models.FeePlan, error) {
+	return nil, errors.New("fee plan not found")
+}
+
+func (m *memStore) SaveOrganizer(org *models.Organizer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *org
+	m.organizers[org.ID] = &copied
+	return nil
+}
+
+func (m *memStore) UpdateOrganizer(org *models.Organizer) error {
+	return m.SaveOrganizer(org)
+}
+
+func (m *memStore) GetOrganizer(id string) (*models.Organizer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	org, ok := m.organizers[id]
+	if !ok {
+		return nil, errors.New("organizer not found")
+	}
+	copied := *org
+	return &copied, nil
+}
+
+func (m *memStore) ListOrganizers(status models.OrganizerStatus) ([]*models.Organizer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var orgs []*models.Organizer
+	for _, org := range m.organizers {
+		if status == "" || org.Status == status {
+			copied := *org
+			orgs = append(orgs, &copied)
+		}
+	}
+	sort.Slice(orgs, func(i, j int) bool { return orgs[i].ID < orgs[j].ID })
+	return orgs, nil
+}
+
+func (m *memStore) AppendAuditEntry(entry *models.AuditEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.audit = append(m.audit, entry)
+	return nil
+}
+
+func (m *memStore) ListAuditEntries(filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var entries []*models.AuditEntry
+	for _, entry := range m.audit {
+		if filter.PaymentID == "" || entry.PaymentID == filter.PaymentID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (m *memStore) SaveEvent(entry *models.EventLogEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, entry)
+	return nil
+}
+
+func (m *memStore) ListEvents(filter models.EventFilter) ([]*models.EventLogEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var entries []*models.EventLogEntry
+	for _, entry := range m.events {
+		if (filter.PaymentID != "" && entry.PaymentID != filter.PaymentID) || (filter.OrderID != "" && entry.OrderID != filter.OrderID) {
+			continue
+		}
+		copied := *entry
+		entries = append(entries, &copied)
+		if filter.Limit > 0 && len(entries) == filter.Limit {
+			break
+		}
+	}
+	return entries, nil
+}
+
+func (m *memStore) ListUnpublishedEvents(limit int) ([]*models.EventLogEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var entries []*models.EventLogEntry
+	for _, entry := range m.events {
+		if !entry.Published && len(entries) < limit {
+			copied := *entry
+			entries = append(entries, &copied)
+		}
+	}
+	return entries, nil
+}
+
+func (m *memStore) MarkEventPublished(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, entry := range m.events {
+		if entry.ID == id {
+			entry.Published = true
+		}
+	}
+	return nil
+}
+
+// eventTypes lists the types of the logged events, in order
+func (m *memStore) eventTypes() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var types []string
+	for _, entry := range m.events {
+		types = append(types, entry.Type)
+	}
+	return types
+}
+
+// memLock stands in for the Redis OTP lock
+type memLock struct {
+	mu   sync.Mutex
+	otps map[string]string
+}
+
+func (l *memLock) AddOTP(otp, orderID string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.otps == nil {
+		l.otps = make(map[string]string)
+	}
+	if _, ok := l.otps[orderID]; ok {
+		return false, nil
+	}
+	l.otps[orderID] = otp
+	return true, nil
+}
+
+func (l *memLock) RemoveOTP(orderID string) error { return l.ReleaseOTP(orderID) }
+
+func (l *memLock) IsOTPLocked(orderID string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.otps[orderID]
+	return ok, nil
+}
+
+func (l *memLock) GetOTP(orderID string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	otp, ok := l.otps[orderID]
+	if !ok {
+		return "", errors.New("no OTP")
+	}
+	return otp, nil
+}
+
+func (l *memLock) ReleaseOTP(orderID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.otps, orderID)
+	return nil
+}
+
+// testEnv wires the payment services over one memStore, as main.go does
+// over MySQL. Kafka runs in mock mode and webhooks are off.
+type testEnv struct {
+	store      *memStore
+	log        *logger.Logger
+	ledger     *ledger.Ledger
+	audit      *AuditService
+	organizers *OrganizerService
+	fees       *FeeService
+	events     *EventService
+	payments   *PaymentService
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	store := newMemStore()
+	log := logger.NewFileLogger()
+	producer, err := kafka.NewProducer(nil, true, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paymentLedger := ledger.New(store, log)
+	audit := NewAuditService(store, log)
+	organizers := NewOrganizerService(store, audit, log)
+	fees := NewFeeService(store, paymentLedger, audit, config.FeeConfig{DefaultPercent: 10}, log)
+	webhooks := NewWebhookService(store, audit, config.WebhookConfig{}, log)
+	events := NewEventService(store, store, store, nil, producer, eventbus.New(nil, "", log), webhooks, audit, log)
+	converter := fx.NewConverter(fx.NewStaticProvider("usd", map[string]float64{"usd": 1}), "usd")
+	payments := NewPaymentService(store, events, log, &memLock{}, paymentLedger, organizers, fees, store, store, converter, audit, nil)
+
+	return &testEnv{
+		store:      store,
+		log:        log,
+		ledger:     paymentLedger,
+		audit:      audit,
+		organizers: organizers,
+		fees:       fees,
+		events:     events,
+		payments:   payments,
+	}
+}
+
+// capturedPayment stores a successful simulated payment with its
+// commission recorded, as ProcessPayment leaves one
+func (e *testEnv) capturedPayment(t *testing.T, id string, price float64) *models.Payment {
+	t.Helper()
+	payment := &models.Payment{
+		PaymentID:   id,
+		OrganizerID: "org_1",
+		OrderID:     "order_" + id,
+		Status:      models.StatusSuccess,
+		Price:       price,
+		Currency:    "usd",
+		Date:        time.Now(),
+	}
+	if err := e.store.SavePayment(payment); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.fees.RecordCommission(id, &models.FeeBreakdown{Fee: roundMinor(price / 10)}); err != nil {
+		t.Fatal(err)
+	}
+	return payment
+}
+
+// AccountBalances nets the posted lines per account, organizer and currency
+func (m *memStore) AccountBalances(organizerID string) ([]*models.AccountBalance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	type key struct {
+		account   models.LedgerAccount
+		organizer string
+		currency  string
+	}
+	byKey := make(map[key]*models.AccountBalance)
+	var balances []*models.AccountBalance
+	for _, entry := range m.journal {
+		for _, line := range entry.Lines {
+			if organizerID != "" && line.OrganizerID != organizerID {
+				continue
+			}
+			k := key{line.Account, line.OrganizerID, entry.Currency}
+			balance, ok := byKey[k]
+			if !ok {
+				balance = &models.AccountBalance{Account: line.Account, OrganizerID: line.OrganizerID, Currency: entry.Currency}
+				byKey[k] = balance
+				balances = append(balances, balance)
+			}
+			balance.Debits += line.Debit
+			balance.Credits += line.Credit
+			balance.Balance = balance.Debits - balance.Credits
+		}
+	}
+	return balances, nil
+}
+
+// SavePayout enforces one payout per organizer, currency and period, like
+// the unique key on the payouts table
+func (m *memStore) SavePayout(payout *models.Payout) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.payouts {
+		if existing.OrganizerID == payout.OrganizerID && existing.Currency == payout.Currency &&
+			existing.PeriodStart.Equal(payout.PeriodStart) {
+			return storage.ErrDuplicateReference
+		}
+	}
+	copied := *payout
+	m.payouts[payout.ID] = &copied
+	return nil
+}
+
+func (m *memStore) UpdatePayout(payout *models.Payout) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *payout
+	m.payouts[payout.ID] = &copied
+	return nil
+}
+
+func (m *memStore) GetPayout(id string) (*models.Payout, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	payout, ok := m.payouts[id]
+	if !ok {
+		return nil, errors.New("payout not found")
+	}
+	copied := *payout
+	return &copied, nil
+}
+
+func (m *memStore) LatestPayout(organizerID, currency string) (*models.Payout, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var latest *models.Payout
+	for _, payout := range m.payouts {
+		if payout.OrganizerID == organizerID && payout.Currency == currency && payout.Status != models.PayoutFailed &&
+			(latest == nil || payout.CreatedAt.After(latest.CreatedAt)) {
+			latest = payout
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	copied := *latest
+	return &copied, nil
+}
+
+func (m *memStore) TransitionPayment(paymentID string, from, to models.PaymentStatus) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	payment, ok := m.payments[paymentID]
+	if !ok || payment.Status != from || from == to {
+		return false, nil
+	}
+	payment.Status = to
+	return true, nil
+}
+
+func (m *memStore) GetTicketByOrderID(orderID string) (*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, payment := range m.payments {
+		if payment.OrderID == orderID {
+			copied := *payment
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("payment not found")
+}
+
+func (m *memStore) SearchPayments(term string, limit int) ([]*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var found []*models.Payment
+	for _, payment := range m.payments {
+		if len(found) < limit && (payment.PaymentID == term || payment.OrderID == term ||
+			payment.TransactionID == term || strings.EqualFold(payment.Email, term)) {
+			copied := *payment
+			found = append(found, &copied)
+		}
+	}
+	return found, nil
+}
+
+func (m *memStore) ListStalePayments(filter models.StaleFilter) ([]*models.Payment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var payments []*models.Payment
+	for _, payment := range m.payments {
+		after := payment.Date.After(filter.AfterDate) ||
+			(payment.Date.Equal(filter.AfterDate) && payment.PaymentID > filter.AfterID)
+		if payment.Status == filter.Status && payment.Date.Before(filter.Before) && after &&
+			strings.HasPrefix(payment.TransactionID, "pi_") == filter.Stripe {
+			copied := *payment
+			payments = append(payments, &copied)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		if !payments[i].Date.Equal(payments[j].Date) {
+			return payments[i].Date.Before(payments[j].Date)
+		}
+		return payments[i].PaymentID < payments[j].PaymentID
+	})
+	if len(payments) > filter.Limit {
+		payments = payments[:filter.Limit]
+	}
+	m.staleQueries++
+	return payments, nil
+}
+
+// pendingPayment stores a payment waiting on its OTP since created
+func (e *testEnv) pendingPayment(t *testing.T, id, transactionID string, created time.Time) *models.Payment {
+	t.Helper()
+	payment := &models.Payment{
+		PaymentID:     id,
+		OrganizerID:   "org_1",
+		OrderID:       "order_" + id,
+		Status:        models.StatusPending,
+		Price:         100,
+		Currency:      "usd",
+		Date:          created,
+		TransactionID: transactionID,
+	}
+	if err := e.store.SavePayment(payment); err != nil {
+		t.Fatal(err)
+	}
+	return payment
+}
+
+func (m *memStore) SaveReconciliationRun(run *models.ReconciliationRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.runs == nil {
+		m.runs = make(map[string]*models.ReconciliationRun)
+	}
+	copied := *run
+	m.runs[run.ID] = &copied
+	return nil
+}
+
+func (m *memStore) GetReconciliationRun(id string) (*models.ReconciliationRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run, ok := m.runs[id]
+	if !ok {
+		return nil, errors.New("reconciliation run not found")
+	}
+	copied := *run
+	return &copied, nil
+}
+
+func (m *memStore) ListReconciliationRuns(limit, offset int) ([]*models.ReconciliationRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var runs []*models.ReconciliationRun
+	for _, run := range m.runs {
+		copied := *run
+		runs = append(runs, &copied)
+	}
+	return runs, nil
+}
+
+func (m *memStore) SaveReconciliationItems(items []*models.ReconciliationItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, item := range items {
+		copied := *item
+		m.items = append(m.items, &copied)
+	}
+	return nil
+}
+
+func (m *memStore) ListReconciliationItems(runID string) ([]*models.ReconciliationItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var items []*models.ReconciliationItem
+	for _, item := range m.items {
+		if item.RunID == runID {
+			copied := *item
+			items = append(items, &copied)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+)
+
+// DefaultFeePlanID names the built-in plan used when an organizer has none.
+const DefaultFeePlanID = "default"
+
+var (
+	ErrFeePlanNotFound = apperror.New(apperror.FeePlanNotFound, "fee plan not found")
+	ErrInvalidFeePlan  = apperror.New(apperror.InvalidFeePlan, "invalid fee plan")
+)
+
+// FeeService computes Evently's commission on each payment, records it as
+// fee lines and reverses it proportionally on refunds.
+type FeeService struct {
+	store       storage.FeeStore
+	ledger      *ledger.Ledger
+	defaultPlan *models.FeePlan
+	audit       *AuditService
+	log         *logger.Logger
+}
+
+func NewFeeService(store storage.FeeStore, ledger *ledger.Ledger, audit *AuditService, cfg config.FeeConfig, log *logger.Logger) *FeeService {
+	return &FeeService{
+		store:  store,
+		ledger: ledger,
+		audit:  audit,
+		defaultPlan: &models.FeePlan{
+			ID:      DefaultFeePlanID,
+			Name:    "Default commission",
+			Percent: cfg.DefaultPercent,
+			Fixed:   cfg.DefaultFixed,
+		},
+		log: log,
+	}
+}
+
+// SavePlan creates or replaces a fee plan.
+func (s *FeeService) SavePlan(ctx context.Context, req *models.FeePlanRequest) (*models.FeePlan, error) {
+	tiers := append([]models.FeeTier(nil), req.Tiers...)
+	sort.SliceStable(tiers, func(i, j int) bool {
+		// Open-ended tier (UpTo == 0) always sorts last
+		if tiers[i].UpTo == 0 || tiers[j].UpTo == 0 {
+			return tiers[j].UpTo == 0 && tiers[i].UpTo != 0
+		}
+		return tiers[i].UpTo < tiers[j].UpTo
+	})
+	for i, tier := range tiers {
+		if tier.Percent < 0 || tier.Percent > 100 || tier.Fixed < 0 || tier.UpTo < 0 {
+			return nil, fmt.Errorf("%w: tier %d out of range", ErrInvalidFeePlan, i)
+		}
+		if tier.UpTo == 0 && i != len(tiers)-1 {
+			return nil, fmt.Errorf("%w: only one open-ended tier is allowed", ErrInvalidFeePlan)
+		}
+	}
+
+	now := time.Now()
+	plan := &models.FeePlan{
+		ID:        req.ID,
+		Name:      req.Name,
+		Percent:   req.Percent,
+		Fixed:     req.Fixed,
+		Tiers:     tiers,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	var before *models.FeePlan
+	if existing, err := s.store.GetFeePlan(req.ID); err == nil {
+		plan.CreatedAt = existing.CreatedAt
+		before = existing
+	}
+
+	if err := s.store.SaveFeePlan(plan); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, &models.AuditEntry{Action: models.AuditFeePlanSaved, TargetType: "fee_plan", TargetID: plan.ID}, before, plan)
+	s.log.Info("FEES", fmt.Sprintf("Fee plan %s saved (%.3f%% + %.2f, %d tiers)", plan.ID, plan.Percent, plan.Fixed, len(plan.Tiers)))
+	return plan, nil
+}
+
+func (s *FeeService) GetPlan(id string) (*models.FeePlan, error) {
+	if id == DefaultFeePlanID {
+		if plan, err := s.store.GetFeePlan(id); err == nil {
+			return plan, nil
+		}
+		return s.defaultPlan, nil
+	}
+	plan, err := s.store.GetFeePlan(id)
+	if err != nil {
+		return nil, ErrFeePlanNotFound
+	}
+	return plan, nil
+}
+
+func (s *FeeService) ListPlans() ([]*models.FeePlan, error) {
+	return s.store.ListFeePlans()
+}
+
+// PlanFor returns the organizer's plan, falling back to the default plan.
+func (s *FeeService) PlanFor(org *models.Organizer) *models.FeePlan {
+	planID := DefaultFeePlanID
+	if org != nil && org.FeePlan != "" {
+		planID = org.FeePlan
+	}
+
+	plan, err := s.GetPlan(planID)
+	if err != nil {
+		s.log.Warn("FEES", fmt.Sprintf("Fee plan %s not found, using default", planID))
+		return s.defaultPlan
+	}
+	return plan
+}
+
+// Quote computes the commission for an amount under the organizer's plan.
+func (s *FeeService) Quote(org *models.Organizer, amount float64) *models.FeeBreakdown {
+	return computeFee(s.PlanFor(org), amount)
+}
+
+// RecordCommission stores the commission line for a payment. Safe to call
+// more than once for the same payment.
+func (s *FeeService) RecordCommission(paymentID string, breakdown *models.FeeBreakdown) error {
+	if breakdown == nil || breakdown.Fee <= 0 {
+		return nil
+	}
+
+	err := s.store.SaveFeeLine(&models.FeeLine{
+		PaymentID: paymentID,
+		Kind:      models.FeeLineCommission,
+		PlanID:    breakdown.PlanID,
+		Reference: "commission:" + paymentID,
+		Amount:    breakdown.Fee,
+		CreatedAt: time.Now(),
+	})
+	if err != nil && !errors.Is(err, storage.ErrDuplicateReference) {
+		return err
+	}
+	return nil
+}
+
+// PostCommission moves the recorded commission to platform revenue in the
+// ledger once the payment has been captured.
+func (s *FeeService) PostCommission(payment *models.Payment, currency string) error {
+	commission, _, err := s.totals(payment.PaymentID)
+	if err != nil || commission <= 0 {
+		return err
+	}
+
+	return s.ledger.PlatformFee(ledger.Movement{
+		PaymentID:   payment.PaymentID,
+		OrderID:     payment.OrderID,
+		OrganizerID: payment.OrganizerID,
+		Currency:    currency,
+		Amount:      commission,
+	})
+}
+
+// ReverseForRefund gives back the share of the commission that matches the
+// refunded share of the payment. reference identifies the refund so the
+// reversal is recorded only once.
+func (s *FeeService) ReverseForRefund(payment *models.Payment, currency string, refundAmount float64, reference string) (float64, error) {
+	commission, reversed, err := s.totals(payment.PaymentID)
+	if err != nil || commission <= 0 || payment.Price <= 0 {
+		return 0, err
+	}
+
+	reversal := roundMinor(commission * math.Min(refundAmount/payment.Price, 1))
+	if remaining := roundMinor(commission - reversed); reversal > remaining {
+		reversal = remaining
+	}
+	if reversal <= 0 {
+		return 0, nil
+	}
+
+	line := &models.FeeLine{
+		PaymentID: payment.PaymentID,
+		Kind:      models.FeeLineReversal,
+		Reference: "reversal:" + reference,
+		Amount:    -reversal,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.SaveFeeLine(line); err != nil {
+		if errors.Is(err, storage.ErrDuplicateReference) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	err = s.ledger.PlatformFeeReversed(ledger.Movement{
+		PaymentID:   payment.PaymentID,
+		OrderID:     payment.OrderID,
+		OrganizerID: payment.OrganizerID,
+		Currency:    currency,
+		Amount:      reversal,
+		Reference:   "platform.fee_reversal:" + reference,
+	})
+	if err != nil {
+		return reversal, err
+	}
+
+	s.log.LogPayment("FEE_REVERSAL", payment.PaymentID, fmt.Sprintf("Reversed %.2f of %.2f commission", reversal, commission))
+	return reversal, nil
+}
+
+func (s *FeeService) FeeLines(paymentID string) ([]*models.FeeLine, error) {
+	return s.store.ListFeeLines(paymentID)
+}
+
+// totals returns the commission charged and the amount already reversed.
+func (s *FeeService) totals(paymentID string) (float64, float64, error) {
+	lines, err := s.store.ListFeeLines(paymentID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var commission, reversed float64
+	for _, line := range lines {
+		switch line.Kind {
+		case models.FeeLineCommission:
+			commission += line.Amount
+		case models.FeeLineReversal:
+			reversed -= line.Amount
+		}
+	}
+	return commission, reversed, nil
+}
+
+// computeFee applies a plan to an amount. Tiers, when present, override the
+// plan's flat percentage and fixed fee. The fee never exceeds the amount.
+func computeFee(plan *models.FeePlan, amount float64) *models.FeeBreakdown {
+	percent, fixed := plan.Percent, plan.Fixed
+	for _, tier := range plan.Tiers {
+		if tier.UpTo == 0 || amount <= tier.UpTo {
+			percent, fixed = tier.Percent, tier.Fixed
+			break
+		}
+	}
+
+	fee := roundMinor(amount*percent/100 + fixed)
+	if fee > amount {
+		fee = amount
+	}
+
+	return &models.FeeBreakdown{
+		PlanID:  plan.ID,
+		Gross:   amount,
+		Percent: percent,
+		Fixed:   fixed,
+		Fee:     fee,
+		Net:     roundMinor(amount - fee),
+	}
+}
+
+func roundMinor(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
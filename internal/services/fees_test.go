@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/models"
+)
+
+func TestComputeFeeUsesTheMatchingTier(t *testing.T) {
+	plan := &models.FeePlan{
+		ID:      "tiered",
+		Percent: 5,
+		Fixed:   0.30,
+		Tiers: []models.FeeTier{
+			{UpTo: 50, Percent: 8, Fixed: 0.50},
+			{UpTo: 0, Percent: 3},
+		},
+	}
+	tests := []struct {
+		amount, fee, net float64
+	}{
+		{20, 2.10, 17.90},
+		{50, 4.50, 45.50},
+		{100, 3, 97},
+		{0.40, 0.40, 0}, // the fee never exceeds the payment
+	}
+	for _, tt := range tests {
+		got := computeFee(plan, tt.amount)
+		if got.Fee != tt.fee || got.Net != tt.net || got.PlanID != "tiered" {
+			t.Errorf("computeFee(%.2f) = fee %.2f net %.2f, want %.2f and %.2f", tt.amount, got.Fee, got.Net, tt.fee, tt.net)
+		}
+	}
+
+	flat := computeFee(&models.FeePlan{Percent: 2.9, Fixed: 0.30}, 33.33)
+	if flat.Fee != 1.27 || flat.Net != 32.06 {
+		t.Errorf("flat plan: fee %.2f net %.2f, want 1.27 and 32.06", flat.Fee, flat.Net)
+	}
+}
+
+func TestSavePlanRejectsBadTiers(t *testing.T) {
+	env := newTestEnv(t)
+	tests := map[string][]models.FeeTier{
+		"two open-ended tiers": {{UpTo: 0, Percent: 3}, {UpTo: 0, Percent: 4}},
+		"percent over 100":     {{UpTo: 10, Percent: 120}},
+		"negative fixed fee":   {{UpTo: 10, Percent: 1, Fixed: -1}},
+	}
+	for name, tiers := range tests {
+		_, err := env.fees.SavePlan(context.Background(), &models.FeePlanRequest{ID: "bad", Name: "Bad", Tiers: tiers})
+		if !errors.Is(err, ErrInvalidFeePlan) {
+			t.Errorf("%s: err = %v, want ErrInvalidFeePlan", name, err)
+		}
+	}
+}
+
+func TestCommissionIsReversedInProportionOnce(t *testing.T) {
+	env := newTestEnv(t)
+	payment := env.capturedPayment(t, "pay_1", 100)
+	if err := env.fees.PostCommission(payment, "usd"); err != nil {
+		t.Fatal(err)
+	}
+
+	reversed, err := env.fees.ReverseForRefund(payment, "usd", 30, "re_1")
+	if err != nil || reversed != 3 {
+		t.Fatalf("first refund reversed %.2f (%v), want 3", reversed, err)
+	}
+	// The same refund seen again, e.g. from a webhook retry
+	if reversed, _ := env.fees.ReverseForRefund(payment, "usd", 30, "re_1"); reversed != 0 {
+		t.Errorf("repeated refund reversed %.2f, want 0", reversed)
+	}
+	// Refunds past the payment give back no more than what is left
+	if reversed, _ := env.fees.ReverseForRefund(payment, "usd", 100, "re_2"); reversed != 7 {
+		t.Errorf("final refund reversed %.2f, want 7", reversed)
+	}
+	if reversed, _ := env.fees.ReverseForRefund(payment, "usd", 10, "re_3"); reversed != 0 {
+		t.Errorf("refund after full reversal reversed %.2f, want 0", reversed)
+	}
+
+	if entries := env.store.entries("platform.fee"); len(entries) != 1 {
+		t.Errorf("%d commission entries, want 1", len(entries))
+	}
+	if entries := env.store.entries("platform.fee_reversal"); len(entries) != 2 {
+		t.Errorf("%d reversal entries, want 2", len(entries))
+	}
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"fmt"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/models"
+)
+
+var ErrInvalidLineItems = apperror.New(apperror.InvalidLineItems, "invalid line items")
+
+// priceLineItems prices each requested line and totals them. A line costs
+// its units less its discount, plus its tax and fee, in minor units.
+func priceLineItems(requested []*models.LineItemRequest) ([]*models.LineItem, float64, error) {
+	items := make([]*models.LineItem, 0, len(requested))
+	var total float64
+	for i, req := range requested {
+		kind := req.Kind
+		if kind == "" {
+			kind = models.LineItemTicket
+		}
+		unit := roundMinor(req.UnitAmount)
+		gross := roundMinor(unit * float64(req.Quantity))
+		item := &models.LineItem{
+			Kind:       kind,
+			SKU:        req.SKU,
+			Name:       req.Name,
+			TicketTier: req.TicketTier,
+			UnitAmount: unit,
+			Quantity:   req.Quantity,
+			Discount:   roundMinor(req.Discount),
+			Tax:        roundMinor(req.Tax),
+			Fee:        roundMinor(req.Fee),
+		}
+		if item.Discount > gross {
+			return nil, 0, fmt.Errorf("%w: the discount on line %d is more than its price", ErrInvalidLineItems, i+1)
+		}
+		item.Amount = roundMinor(gross - item.Discount + item.Tax + item.Fee)
+		items = append(items, item)
+		total += item.Amount
+	}
+	return items, roundMinor(total), nil
+}
+
+// itemize prices a payment's line items and checks they add up to what
+// the payment charges. A payment without line items is not itemized.
+func itemize(requested []*models.LineItemRequest, amount float64) ([]*models.LineItem, error) {
+	if len(requested) == 0 {
+		return nil, nil
+	}
+	items, total, err := priceLineItems(requested)
+	if err != nil {
+		return nil, err
+	}
+	if roundMinor(total-amount) != 0 {
+		return nil, fmt.Errorf("%w: line items add up to %.2f, not %.2f", ErrInvalidLineItems, total, amount)
+	}
+	return items, nil
+}
+
+// lineItemRequests turns priced lines back into requests, for passing a
+// checkout's lines on to its payment
+func lineItemRequests(items []*models.LineItem) []*models.LineItemRequest {
+	requests := make([]*models.LineItemRequest, 0, len(items))
+	for _, item := range items {
+		requests = append(requests, &models.LineItemRequest{
+			Kind:       item.Kind,
+			SKU:        item.SKU,
+			Name:       item.Name,
+			TicketTier: item.TicketTier,
+			UnitAmount: item.UnitAmount,
+			Quantity:   item.Quantity,
+			Discount:   item.Discount,
+			Tax:        item.Tax,
+			Fee:        item.Fee,
+		})
+	}
+	return requests
+}
+
+// ticketCount is the number of tickets the lines sell, for risk scoring
+func ticketCount(items []*models.LineItem) int {
+	count := 0
+	for _, item := range items {
+		if item.Kind == models.LineItemTicket {
+			count += item.Quantity
+		}
+	}
+	return count
+}
+
+// refundParts works out what refunding the requested lines of a payment
+// returns. Units are refunded at their share of the line's amount, and the
+// last units of a line take whatever is left of it, so rounding never
+// strands a cent.
+func refundParts(items []*models.LineItem, requested []*models.RefundLineItemRequest) ([]*models.RefundedLineItem, float64, error) {
+	byID := make(map[string]*models.LineItem, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+
+	quantities := make(map[string]int, len(requested))
+	var order []string
+	for _, req := range requested {
+		item, ok := byID[req.LineItemID]
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: the payment has no line item %s", ErrInvalidLineItems, req.LineItemID)
+		}
+		left := item.Quantity - item.RefundedQuantity - quantities[item.ID]
+		quantity := req.Quantity
+		if quantity == 0 {
+			quantity = left
+		}
+		if quantity <= 0 || quantity > left {
+			return nil, 0, fmt.Errorf("%w: line item %s has %d units left to refund", ErrInvalidRefundAmount, item.ID, left)
+		}
+		if _, seen := quantities[item.ID]; !seen {
+			order = append(order, item.ID)
+		}
+		quantities[item.ID] += quantity
+	}
+
+	parts := make([]*models.RefundedLineItem, 0, len(order))
+	var total float64
+	for _, id := range order {
+		item, quantity := byID[id], quantities[id]
+		amount := roundMinor(item.Amount * float64(quantity) / float64(item.Quantity))
+		if item.RefundedQuantity+quantity == item.Quantity {
+			amount = roundMinor(item.Amount - item.RefundedAmount)
+		}
+		parts = append(parts, &models.RefundedLineItem{LineItemID: id, Quantity: quantity, Amount: amount})
+		total += amount
+	}
+	total = roundMinor(total)
+	if total <= 0 {
+		return nil, 0, fmt.Errorf("%w: the line items are free", ErrInvalidRefundAmount)
+	}
+	return parts, total, nil
+}
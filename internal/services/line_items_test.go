@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+func TestItemizeChecksTheLinesAddUp(t *testing.T) {
+	requested := []*models.LineItemRequest{
+		{Name: "General admission", TicketTier: "ga", UnitAmount: 25, Quantity: 3, Discount: 5, Tax: 1.4},
+		{Kind: models.LineItemFee, Name: "Booking fee", UnitAmount: 1.5, Quantity: 1},
+	}
+	items, err := itemize(requested, 72.9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items[0].Kind != models.LineItemTicket || items[0].Amount != 71.4 || items[1].Amount != 1.5 {
+		t.Errorf("items = %+v, %+v", items[0], items[1])
+	}
+	if ticketCount(items) != 3 {
+		t.Errorf("ticket count = %d, fees are not tickets", ticketCount(items))
+	}
+
+	if _, err := itemize(requested, 73); !errors.Is(err, ErrInvalidLineItems) {
+		t.Errorf("total off by 0.10: err = %v, want ErrInvalidLineItems", err)
+	}
+	overDiscounted := []*models.LineItemRequest{{Name: "Comp", UnitAmount: 10, Quantity: 1, Discount: 10.01}}
+	if _, err := itemize(overDiscounted, 0); !errors.Is(err, ErrInvalidLineItems) {
+		t.Errorf("discount over the price: err = %v, want ErrInvalidLineItems", err)
+	}
+	if items, err := itemize(nil, 50); items != nil || err != nil {
+		t.Errorf("unitemized payment: %v, %v", items, err)
+	}
+}
+
+func TestRefundPartsNeverStrandACent(t *testing.T) {
+	items := []*models.LineItem{
+		{ID: "li_1", Quantity: 3, Amount: 10},
+		{ID: "li_2", Quantity: 1, Amount: 0},
+	}
+	var total float64
+	for i := 0; i < 3; i++ {
+		parts, amount, err := refundParts(items, []*models.RefundLineItemRequest{{LineItemID: "li_1", Quantity: 1}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		items[0].RefundedQuantity += parts[0].Quantity
+		items[0].RefundedAmount += parts[0].Amount
+		total += amount
+	}
+	if roundMinor(total) != 10 || items[0].RefundedAmount < 3.34-1e-9 {
+		t.Errorf("three single refunds returned %.2f", total)
+	}
+
+	for name, requested := range map[string][]*models.RefundLineItemRequest{
+		"unknown line":      {{LineItemID: "li_9"}},
+		"already refunded":  {{LineItemID: "li_1", Quantity: 1}},
+		"free line":         {{LineItemID: "li_2"}},
+		"named twice, over": {{LineItemID: "li_2"}, {LineItemID: "li_2"}},
+	} {
+		if _, _, err := refundParts(items, requested); err == nil {
+			t.Errorf("%s: refunded", name)
+		}
+	}
+}
+
+func TestRefundingLineItemsPostsTheirShare(t *testing.T) {
+	env := newTestEnv(t)
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	env.capturedPayment(t, "pay_1", 100)
+	_ = env.store.SavePaymentLineItems("pay_1", []*models.LineItem{
+		{ID: "li_vip", Kind: models.LineItemTicket, Quantity: 2, Amount: 80},
+		{ID: "li_fee", Kind: models.LineItemFee, Quantity: 1, Amount: 20},
+	})
+
+	amount := 10.0
+	both := &models.RefundRequest{Amount: &amount, LineItems: []*models.RefundLineItemRequest{{LineItemID: "li_vip"}}}
+	if _, err := env.payments.RefundPayment(platform, "pay_1", both); !errors.Is(err, ErrInvalidRefundAmount) {
+		t.Errorf("amount and line items: err = %v, want ErrInvalidRefundAmount", err)
+	}
+
+	one := &models.RefundRequest{Reason: "cannot attend", LineItems: []*models.RefundLineItemRequest{{LineItemID: "li_vip", Quantity: 1}}}
+	payment, err := env.payments.RefundPayment(platform, "pay_1", one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != models.StatusSuccess {
+		t.Errorf("status = %s after refunding one ticket", payment.Status)
+	}
+	refunds, _ := env.store.ListRefunds("pay_1")
+	if len(refunds) != 1 || refunds[0].Amount != 40 || len(refunds[0].LineItems) != 1 {
+		t.Errorf("refunds = %+v", refunds)
+	}
+	if entries := env.store.entries("payment.refunded"); len(entries) != 1 || entries[0].Lines[0].Debit != 4000 {
+		t.Errorf("ledger = %+v", entries)
+	}
+
+	if _, err := env.payments.RefundPayment(platform, "pay_1", &models.RefundRequest{
+		LineItems: []*models.RefundLineItemRequest{{LineItemID: "li_vip", Quantity: 2}},
+	}); !errors.Is(err, ErrInvalidRefundAmount) {
+		t.Errorf("refunding a ticket twice: err = %v, want ErrInvalidRefundAmount", err)
+	}
+
+	payment, err = env.payments.RefundPayment(platform, "pay_1", &models.RefundRequest{
+		LineItems: []*models.RefundLineItemRequest{{LineItemID: "li_vip"}, {LineItemID: "li_fee"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != models.StatusRefunded {
+		t.Errorf("status = %s after refunding every line", payment.Status)
+	}
+	items, _ := env.store.ListPaymentLineItems("pay_1")
+	for _, item := range items {
+		if item.RefundedQuantity != item.Quantity || item.RefundedAmount != item.Amount {
+			t.Errorf("line %s refunded %d for %.2f", item.ID, item.RefundedQuantity, item.RefundedAmount)
+		}
+	}
+}
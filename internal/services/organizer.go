@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+var (
+	ErrOrganizerNotFound  = apperror.New(apperror.OrganizerNotFound, "organizer not found")
+	ErrOrganizerInactive  = apperror.New(apperror.OrganizerInactive, "organizer is not active")
+	ErrOrganizerRequired  = apperror.New(apperror.OrganizerRequired, "organizer_id is required")
+	ErrInvalidOrganizer   = apperror.New(apperror.InvalidOrganizer, "invalid organizer details")
+	ErrForbidden          = apperror.New(apperror.Forbidden, "access to this resource is not allowed")
+	ErrInvalidCredentials = apperror.New(apperror.InvalidCredentials, "invalid API key")
+)
+
+// DefaultOrganizerCurrency is used when an organizer is created without one.
+const DefaultOrganizerCurrency = "usd"
+
+// OrganizerService manages organizer (merchant) accounts and their API keys.
+type OrganizerService struct {
+	store storage.OrganizerStore
+	audit *AuditService
+	log   *logger.Logger
+}
+
+func NewOrganizerService(store storage.OrganizerStore, audit *AuditService, log *logger.Logger) *OrganizerService {
+	return &OrganizerService{
+		store: store,
+		audit: audit,
+		log:   log,
+	}
+}
+
+// CreateOrganizer registers an organizer and issues its first API key. The
+// plain key is only ever returned here.
+func (s *OrganizerService) CreateOrganizer(ctx context.Context, req *models.CreateOrganizerRequest) (*models.OrganizerCredentials, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+
+	currency := strings.ToLower(req.DefaultCurrency)
+	if currency == "" {
+		currency = DefaultOrganizerCurrency
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: default_currency must be a 3-letter ISO code", ErrInvalidOrganizer)
+	}
+	settlement := strings.ToLower(req.SettlementCurrency)
+	if settlement == "" {
+		settlement = currency
+	}
+	if len(settlement) != 3 {
+		return nil, fmt.Errorf("%w: settlement_currency must be a 3-letter ISO code", ErrInvalidOrganizer)
+	}
+
+	schedule := models.PayoutSchedule(req.PayoutSchedule)
+	if schedule == "" {
+		schedule = models.PayoutWeekly
+	}
+	if !validPayoutSchedule(schedule) {
+		return nil, fmt.Errorf("%w: unknown payout_schedule %s", ErrInvalidOrganizer, schedule)
+	}
+	if schedule == models.PayoutDestination && req.StripeAccountID == "" {
+		return nil, fmt.Errorf("%w: the destination payout_schedule needs a stripe_account_id", ErrInvalidOrganizer)
+	}
+
+	id := req.ID
+	if id == "" {
+		id = utils.GenerateOrganizerID()
+	}
+
+	apiKey, hash, err := newAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	now := time.Now()
+	org := &models.Organizer{
+		ID:                 id,
+		Name:               req.Name,
+		Email:              req.Email,
+		StripeAccountID:    req.StripeAccountID,
+		DefaultCurrency:    currency,
+		SettlementCurrency: settlement,
+		FeePlan:            req.FeePlan,
+		PayoutSchedule:     schedule,
+		Status:             models.OrganizerActive,
+		APIKeyHash:         hash,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if err := s.store.SaveOrganizer(org); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, organizerAudit(models.AuditOrganizerCreated, org.ID), nil, org)
+	s.log.Info("ORGANIZER", fmt.Sprintf("Organizer %s (%s) created", org.ID, org.Name))
+	return &models.OrganizerCredentials{Organizer: org, APIKey: apiKey}, nil
+}
+
+func (s *OrganizerService) GetOrganizer(ctx context.Context, id string) (*models.Organizer, error) {
+	if !tenant.CanAccess(ctx, id) {
+		return nil, ErrOrganizerNotFound
+	}
+
+	org, err := s.store.GetOrganizer(id)
+	if err != nil {
+		return nil, ErrOrganizerNotFound
+	}
+	return org, nil
+}
+
+// UpdateOrganizer applies a partial update. Organizers may edit their own
+// profile and payout schedule. Where their money goes (the Connect account
+// and settlement currency), status, fee plan and event end date can only be
+// changed by the platform.
+func (s *OrganizerService) UpdateOrganizer(ctx context.Context, id string, req *models.UpdateOrganizerRequest) (*models.Organizer, error) {
+	org, err := s.GetOrganizer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	platformOnly := req.Status != nil || req.FeePlan != nil || req.EventEndsAt != nil ||
+		req.StripeAccountID != nil || req.SettlementCurrency != nil
+	if platformOnly && !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	before := *org
+
+	if req.Name != nil {
+		org.Name = *req.Name
+	}
+	if req.Email != nil {
+		org.Email = *req.Email
+	}
+	if req.StripeAccountID != nil {
+		org.StripeAccountID = *req.StripeAccountID
+	}
+	if req.DefaultCurrency != nil {
+		currency := strings.ToLower(*req.DefaultCurrency)
+		if len(currency) != 3 {
+			return nil, fmt.Errorf("%w: default_currency must be a 3-letter ISO code", ErrInvalidOrganizer)
+		}
+		org.DefaultCurrency = currency
+	}
+	if req.SettlementCurrency != nil {
+		currency := strings.ToLower(*req.SettlementCurrency)
+		if len(currency) != 3 {
+			return nil, fmt.Errorf("%w: settlement_currency must be a 3-letter ISO code", ErrInvalidOrganizer)
+		}
+		org.SettlementCurrency = currency
+	}
+	if req.FeePlan != nil {
+		org.FeePlan = *req.FeePlan
+	}
+	if req.PayoutSchedule != nil {
+		if !validPayoutSchedule(*req.PayoutSchedule) {
+			return nil, fmt.Errorf("%w: unknown payout_schedule %s", ErrInvalidOrganizer, *req.PayoutSchedule)
+		}
+		org.PayoutSchedule = *req.PayoutSchedule
+	}
+	if req.EventEndsAt != nil {
+		org.EventEndsAt = req.EventEndsAt
+	}
+	if req.Status != nil {
+		if *req.Status != models.OrganizerActive && *req.Status != models.OrganizerSuspended {
+			return nil, fmt.Errorf("%w: unknown status %s", ErrInvalidOrganizer, *req.Status)
+		}
+		org.Status = *req.Status
+	}
+	if org.PayoutSchedule == models.PayoutDestination && org.StripeAccountID == "" {
+		return nil, fmt.Errorf("%w: the destination payout_schedule needs a stripe_account_id", ErrInvalidOrganizer)
+	}
+	org.UpdatedAt = time.Now()
+
+	if err := s.store.UpdateOrganizer(org); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, organizerAudit(models.AuditOrganizerUpdated, org.ID), before, org)
+	s.log.Info("ORGANIZER", fmt.Sprintf("Organizer %s updated", org.ID))
+	return org, nil
+}
+
+// RotateAPIKey replaces an organizer's API key; the old key stops working.
+func (s *OrganizerService) RotateAPIKey(ctx context.Context, id string) (*models.OrganizerCredentials, error) {
+	org, err := s.GetOrganizer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, hash, err := newAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	org.APIKeyHash = hash
+	org.UpdatedAt = time.Now()
+
+	if err := s.store.UpdateOrganizer(org); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, organizerAudit(models.AuditOrganizerKeyRotated, org.ID), nil, nil)
+	s.log.LogSecurity("API_KEY_ROTATED", fmt.Sprintf("API key rotated for organizer %s", org.ID))
+	return &models.OrganizerCredentials{Organizer: org, APIKey: apiKey}, nil
+}
+
+// ResolveAPIKey returns the active organizer that owns an API key.
+func (s *OrganizerService) ResolveAPIKey(apiKey string) (*models.Organizer, error) {
+	org, err := s.store.GetOrganizerByAPIKeyHash(hashAPIKey(apiKey))
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	if org.Status != models.OrganizerActive {
+		return nil, ErrOrganizerInactive
+	}
+	return org, nil
+}
+
+// RequireActive loads an organizer for a money-moving operation.
+func (s *OrganizerService) RequireActive(organizerID string) (*models.Organizer, error) {
+	if organizerID == "" {
+		return nil, ErrOrganizerRequired
+	}
+	org, err := s.store.GetOrganizer(organizerID)
+	if err != nil {
+		return nil, ErrOrganizerNotFound
+	}
+	if org.Status != models.OrganizerActive {
+		return nil, ErrOrganizerInactive
+	}
+	return org, nil
+}
+
+func validPayoutSchedule(schedule models.PayoutSchedule) bool {
+	switch schedule {
+	case models.PayoutDaily, models.PayoutWeekly, models.PayoutAfterEvent, models.PayoutManual, models.PayoutDestination:
+		return true
+	}
+	return false
+}
+
+func newAPIKey() (string, string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	key := "evk_" + hex.EncodeToString(buf)
+	return key, hashAPIKey(key), nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// resolveOrganizer decides which organizer a new payment belongs to. Tenant
+// callers are pinned to their own organizer; platform callers must name one.
+func resolveOrganizer(ctx context.Context, requested string) (string, error) {
+	if scope := tenant.Scope(ctx); scope != "" {
+		if requested != "" && requested != scope {
+			return "", ErrForbidden
+		}
+		return scope, nil
+	}
+	if requested == "" {
+		return "", ErrOrganizerRequired
+	}
+	return requested, nil
+}
+
+func organizerAudit(action, organizerID string) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "organizer",
+		TargetID:    organizerID,
+		OrganizerID: organizerID,
+	}
+}
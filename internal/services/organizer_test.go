@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/tenant"
+)
+
+func TestDestinationScheduleNeedsConnectAccount(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	_, err := env.organizers.CreateOrganizer(ctx, &models.CreateOrganizerRequest{
+		Name: "Org", Email: "org@example.com", PayoutSchedule: string(models.PayoutDestination),
+	})
+	if !errors.Is(err, ErrInvalidOrganizer) {
+		t.Fatalf("destination schedule without an account: err = %v, want %v", err, ErrInvalidOrganizer)
+	}
+
+	created, err := env.organizers.CreateOrganizer(ctx, &models.CreateOrganizerRequest{
+		Name: "Org", Email: "org@example.com", StripeAccountID: "acct_1", PayoutSchedule: string(models.PayoutDestination),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Organizer.PayoutSchedule != models.PayoutDestination {
+		t.Errorf("schedule = %s, want %s", created.Organizer.PayoutSchedule, models.PayoutDestination)
+	}
+
+	empty := ""
+	if _, err := env.organizers.UpdateOrganizer(ctx, created.Organizer.ID, &models.UpdateOrganizerRequest{StripeAccountID: &empty}); !errors.Is(err, ErrInvalidOrganizer) {
+		t.Errorf("removing the account of a destination organizer: err = %v, want %v", err, ErrInvalidOrganizer)
+	}
+}
+
+func TestOrganizerCannotRedirectPayouts(t *testing.T) {
+	env := newTestEnv(t)
+	created, err := env.organizers.CreateOrganizer(context.Background(), &models.CreateOrganizerRequest{
+		Name: "Org", Email: "org@example.com", StripeAccountID: "acct_1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Organizer.ID
+	self := tenant.WithPrincipal(context.Background(), tenant.Principal{OrganizerID: id})
+
+	account, currency := "acct_attacker", "eur"
+	for name, req := range map[string]*models.UpdateOrganizerRequest{
+		"stripe_account_id":   {StripeAccountID: &account},
+		"settlement_currency": {SettlementCurrency: &currency},
+	} {
+		if _, err := env.organizers.UpdateOrganizer(self, id, req); err != ErrForbidden {
+			t.Errorf("organizer changing %s: err = %v, want %v", name, err, ErrForbidden)
+		}
+	}
+	if org, _ := env.store.GetOrganizer(id); org.StripeAccountID != "acct_1" || org.SettlementCurrency != "usd" {
+		t.Errorf("organizer changed to %s / %s", org.StripeAccountID, org.SettlementCurrency)
+	}
+
+	name := "Renamed"
+	if _, err := env.organizers.UpdateOrganizer(self, id, &models.UpdateOrganizerRequest{Name: &name}); err != nil {
+		t.Errorf("organizer renaming itself: %v", err)
+	}
+
+	platform := tenant.WithPrincipal(context.Background(), tenant.Principal{Platform: true})
+	updated, err := env.organizers.UpdateOrganizer(platform, id, &models.UpdateOrganizerRequest{StripeAccountID: &account, SettlementCurrency: &currency})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.StripeAccountID != account || updated.SettlementCurrency != currency {
+		t.Errorf("platform update gave %s / %s", updated.StripeAccountID, updated.SettlementCurrency)
+	}
+}
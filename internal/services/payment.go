@@ -6,22 +6,45 @@ import (
 	"fmt"
 	"math/rand"
 	otp2 "payment-gateway/internal/otp"
+	"strings"
 	"time"
 
-	"payment-gateway/internal/kafka"
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/fx"
+	"payment-gateway/internal/ledger"
 	"payment-gateway/internal/logger"
 	"payment-gateway/internal/models"
+	"payment-gateway/internal/risk"
 	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
 )
 
 var (
-	ErrPaymentNotFound      = errors.New("payment not found")
-	ErrInsufficientFunds    = errors.New("insufficient funds")
-	ErrInvalidCard          = errors.New("invalid card details")
-	ErrCardExpired          = errors.New("card expired")
-	ErrPaymentDeclined      = errors.New("payment declined")
-	ErrInvalidRefundAmount  = errors.New("invalid refund amount")
-	ErrPaymentNotRefundable = errors.New("payment not refundable")
+	ErrPaymentNotFound      = apperror.New(apperror.PaymentNotFound, "payment not found")
+	ErrInsufficientFunds    = apperror.New(apperror.InsufficientFunds, "insufficient funds")
+	ErrInvalidCard          = apperror.New(apperror.CardValidationFailed, "invalid card details")
+	ErrCardExpired          = apperror.New(apperror.ExpiredCard, "card expired")
+	ErrPaymentDeclined      = apperror.New(apperror.PaymentDeclined, "payment declined")
+	ErrInvalidRefundAmount  = apperror.New(apperror.InvalidRefundAmount, "invalid refund amount")
+	ErrPaymentNotRefundable = apperror.New(apperror.PaymentNotRefundable, "payment not refundable")
+	ErrInvalidCurrency      = apperror.New(apperror.InvalidCurrency, "invalid currency")
+	ErrReasonRequired       = apperror.New(apperror.ReasonRequired, "a reason is required")
+	ErrInvalidStatus        = apperror.New(apperror.InvalidPaymentStatus, "invalid payment status")
+	ErrOTPNotApplicable     = apperror.New(apperror.OTPNotApplicable, "payment is not awaiting an OTP")
+	ErrOTPInvalid           = apperror.New(apperror.OTPInvalid, "incorrect OTP")
+	ErrOTPExpired           = apperror.New(apperror.OTPExpired, "OTP has expired")
+	ErrNotStripePayment     = apperror.New(apperror.NotStripePayment, "payment is not backed by Stripe")
+	ErrInvalidSearch        = apperror.New(apperror.InvalidSearch, "search term is required")
+	ErrPaymentNotCancelable = apperror.New(apperror.PaymentNotCancelable, "payment cannot be cancelled")
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+
+	// defaultOTPRecipient receives OTPs for payments made without an email
+	defaultOTPRecipient = "isurumuni.22@cse.mrt.ac.lk"
 )
 
 type RedisLock interface {
@@ -29,20 +52,37 @@ type RedisLock interface {
 	RemoveOTP(orderID string) error
 	IsOTPLocked(orderID string) (bool, error)
 	GetOTP(orderID string) (string, error)
+	ReleaseOTP(orderID string) error
 }
 type PaymentService struct {
-	store    storage.Store
-	producer *kafka.Producer
-	log      *logger.Logger
-	redis    RedisLock // Added logger to service
+	store      storage.Store
+	events     *EventService
+	log        *logger.Logger
+	redis      RedisLock // Added logger to service
+	ledger     *ledger.Ledger
+	organizers *OrganizerService
+	fees       *FeeService
+	refunds    storage.RefundStore
+	lineItems  storage.LineItemStore
+	fx         *fx.Converter
+	audit      *AuditService
+	risk       *RiskService
 }
 
-func NewPaymentService(store storage.Store, producer *kafka.Producer, log *logger.Logger, redis RedisLock) *PaymentService {
+func NewPaymentService(store storage.Store, events *EventService, log *logger.Logger, redis RedisLock, ledger *ledger.Ledger, organizers *OrganizerService, fees *FeeService, refunds storage.RefundStore, lineItems storage.LineItemStore, converter *fx.Converter, audit *AuditService, risk *RiskService) *PaymentService {
 	return &PaymentService{
-		store:    store,
-		producer: producer,
-		log:      log,
-		redis:    redis,
+		store:      store,
+		events:     events,
+		log:        log,
+		redis:      redis,
+		ledger:     ledger,
+		organizers: organizers,
+		fees:       fees,
+		refunds:    refunds,
+		lineItems:  lineItems,
+		fx:         converter,
+		audit:      audit,
+		risk:       risk,
 	}
 }
 
@@ -59,25 +99,73 @@ func (s *PaymentService) ProcessPayment(ctx context.Context, req *models.Payment
 	s.log.LogPayment("INIT", "new", fmt.Sprintf("Processing payment for order %s, price: %.2f",
 		req.OrderID, req.Price))
 
-	otp, _ := otp2.GenerateOTP()
-	otp2.SendEmailOTP("isurumuni.22@cse.mrt.ac.lk", otp)
-	ok, err := s.redis.AddOTP(otp, req.OrderID)
+	organizerID, err := resolveOrganizer(ctx, req.OrganizerID)
 	if err != nil {
-		fmt.Printf("Error locking OTP: %v\n", err)
-		return nil, fmt.Errorf("redis error: %w", err)
+		return nil, err
 	}
-	if !ok {
-		fmt.Println("OTP already locked for this order. Aborting payment.")
-		return nil, fmt.Errorf("OTP already locked")
+	org, err := s.organizers.RequireActive(organizerID)
+	if err != nil {
+		return nil, err
+	}
+	breakdown := s.fees.Quote(org, req.Price)
+
+	currency := strings.ToLower(req.Currency)
+	if currency == "" {
+		currency = org.DefaultCurrency
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be a 3-letter ISO code", ErrInvalidCurrency)
+	}
+	lineItems, err := itemize(req.LineItems, req.Price)
+	if err != nil {
+		return nil, err
+	}
+	tickets := req.TicketCount
+	if tickets == 0 {
+		tickets = ticketCount(lineItems)
+	}
+	snapshot := s.snapshot(ctx, currency)
+
+	if isStripePaymentIntent(req.TransactionID) {
+		return s.saveStripePayment(req, organizerID, currency, snapshot, breakdown, lineItems)
+	}
+
+	// Stripe payments are scored by StripeService before the intent exists
+	assessment := s.risk.Assess(ctx, risk.Input{
+		PaymentID:      req.PaymentID,
+		OrganizerID:    organizerID,
+		OrderID:        req.OrderID,
+		Email:          req.Email,
+		BillingCountry: req.BillingCountry,
+		Amount:         req.Price,
+		Currency:       currency,
+		TicketCount:    tickets,
+	})
+	if assessment.Decision == models.DecisionDeny {
+		return nil, ErrRiskDenied
+	}
+	held := assessment.Decision == models.DecisionReview
+
+	status := models.StatusPending
+	if held {
+		status = models.StatusInReview
+	} else if err := s.lockOTP(req.OrderID, req.Email); err != nil {
+		return nil, err
 	}
 
 	// Create payment record
 	payment := &models.Payment{
-		PaymentID: req.PaymentID,
-		OrderID:   req.OrderID,
-		Status:    models.StatusPending,
-		Price:     req.Price,
-		Date:      time.Now(),
+		PaymentID:     req.PaymentID,
+		OrganizerID:   organizerID,
+		OrderID:       req.OrderID,
+		Status:        status,
+		Price:         req.Price,
+		Currency:      currency,
+		Date:          time.Now(),
+		TransactionID: req.TransactionID,
+		Email:         req.Email,
+		FX:            snapshot,
+		LineItems:     lineItems,
 	}
 
 	s.log.LogPayment("CREATE", payment.PaymentID, fmt.Sprintf("Payment record created with status: %s", payment.Status))
@@ -89,6 +177,16 @@ func (s *PaymentService) ProcessPayment(ctx context.Context, req *models.Payment
 	}
 
 	s.log.LogDatabase("SAVE", "payments", fmt.Sprintf("Payment %s saved successfully", payment.PaymentID))
+	s.saveLineItems(payment)
+
+	if err := s.fees.RecordCommission(payment.PaymentID, breakdown); err != nil {
+		s.log.Error("FEES", fmt.Sprintf("Failed to record commission for payment %s: %v", payment.PaymentID, err))
+	}
+
+	if held {
+		s.risk.Hold(ctx, payment, assessment)
+		return payment, nil
+	}
 
 	// Simulate payment processing
 	go s.processPaymentAsync(ctx, payment, req)
@@ -97,6 +195,164 @@ func (s *PaymentService) ProcessPayment(ctx context.Context, req *models.Payment
 	return payment, nil
 }
 
+// lockOTP sends the order's OTP and locks the order until it is verified.
+func (s *PaymentService) lockOTP(orderID, email string) error {
+	if email == "" {
+		email = defaultOTPRecipient
+	}
+	otp, _ := otp2.GenerateOTP()
+	otp2.SendEmailOTP(email, otp)
+	ok, err := s.redis.AddOTP(otp, orderID)
+	if err != nil {
+		s.log.Error("OTP", fmt.Sprintf("Failed to lock OTP for order %s: %v", orderID, err))
+		return fmt.Errorf("redis error: %w", err)
+	}
+	if !ok {
+		s.log.Warn("OTP", fmt.Sprintf("OTP already locked for order %s", orderID))
+		return fmt.Errorf("OTP already locked")
+	}
+	return nil
+}
+
+// ReleaseHeldPayment continues a simulated payment approved in risk review
+// from the point the risk check stopped it.
+func (s *PaymentService) ReleaseHeldPayment(ctx context.Context, payment *models.Payment) error {
+	if err := s.lockOTP(payment.OrderID, payment.Email); err != nil {
+		return err
+	}
+	unlock := func() {
+		if err := s.redis.ReleaseOTP(payment.OrderID); err != nil {
+			s.log.Warn("PAYMENT", fmt.Sprintf("Failed to release OTP lock for order %s: %v", payment.OrderID, err))
+		}
+	}
+
+	// The pending TTL runs from the payment's date, so the attendee gets all
+	// of it after the review. Only pending payments are swept, so the date
+	// is written while the payment is still held.
+	payment.Date = time.Now()
+	if err := s.store.UpdatePayment(payment); err != nil {
+		unlock()
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+	moved, err := s.updatePaymentStatus(ctx, payment, models.StatusPending)
+	if err != nil {
+		unlock()
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !moved {
+		unlock()
+		return fmt.Errorf("payment %s is no longer held for review", payment.PaymentID)
+	}
+
+	req := &models.PaymentRequest{
+		PaymentID:   payment.PaymentID,
+		OrganizerID: payment.OrganizerID,
+		OrderID:     payment.OrderID,
+		Price:       payment.Price,
+		Currency:    payment.Currency,
+		Email:       payment.Email,
+	}
+	go s.processPaymentAsync(ctx, payment, req)
+	return nil
+}
+
+// RejectHeldPayment fails a simulated payment rejected in risk review.
+func (s *PaymentService) RejectHeldPayment(ctx context.Context, payment *models.Payment) error {
+	if !s.completePayment(ctx, payment, models.StatusFailed, "payment.failed") {
+		return fmt.Errorf("failed to reject payment %s", payment.PaymentID)
+	}
+	return nil
+}
+
+// saveStripePayment records a payment already submitted to Stripe. Stripe
+// authenticates the customer and decides the outcome, so there is no OTP and
+// no simulated gateway; pending records are settled by webhook or polling.
+func (s *PaymentService) saveStripePayment(req *models.PaymentRequest, organizerID, currency string, snapshot *models.FXSnapshot, breakdown *models.FeeBreakdown, lineItems []*models.LineItem) (*models.Payment, error) {
+	status := req.Status
+	if status == "" {
+		status = models.StatusPending
+	}
+
+	payment := &models.Payment{
+		PaymentID:     req.PaymentID,
+		OrganizerID:   organizerID,
+		OrderID:       req.OrderID,
+		Status:        status,
+		Price:         req.Price,
+		Currency:      currency,
+		Date:          time.Now(),
+		TransactionID: req.TransactionID,
+		Email:         req.Email,
+		FX:            snapshot,
+		LineItems:     lineItems,
+	}
+
+	if err := s.store.SavePayment(payment); err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to save payment %s: %v", payment.PaymentID, err))
+		return nil, fmt.Errorf("failed to save payment: %w", err)
+	}
+	s.log.LogPayment("CREATE", payment.PaymentID, fmt.Sprintf("Stripe payment %s recorded with status: %s", payment.TransactionID, payment.Status))
+	s.saveLineItems(payment)
+
+	if err := s.fees.RecordCommission(payment.PaymentID, breakdown); err != nil {
+		s.log.Error("FEES", fmt.Sprintf("Failed to record commission for payment %s: %v", payment.PaymentID, err))
+	}
+
+	if payment.Status == models.StatusSuccess {
+		s.publishPaymentEvent("payment.success", payment)
+	}
+	return payment, nil
+}
+
+// SettleStripePayment moves the payment backed by a PaymentIntent to the
+// status Stripe reported. It is safe to call repeatedly: payments already in
+// that status, or already past pending or review, are left alone.
+func (s *PaymentService) SettleStripePayment(ctx context.Context, transactionID string, status models.PaymentStatus) (*models.Payment, error) {
+	payment, err := s.store.GetPaymentByTransactionID(transactionID)
+	if err != nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	if status == models.StatusPending || status == models.StatusInReview || payment.Status == status {
+		return payment, nil
+	}
+	// A failed attempt can still succeed if the customer retries the intent
+	unsettled := payment.Status == models.StatusPending || payment.Status == models.StatusInReview
+	if !unsettled && !(payment.Status == models.StatusFailed && status == models.StatusSuccess) {
+		s.log.Warn("PAYMENT", fmt.Sprintf("Ignoring Stripe status %s for payment %s in status %s", status, payment.PaymentID, payment.Status))
+		return payment, nil
+	}
+
+	eventType := "payment." + string(status)
+	if !s.completePayment(ctx, payment, status, eventType) {
+		return nil, fmt.Errorf("failed to settle payment %s", payment.PaymentID)
+	}
+	return payment, nil
+}
+
+// completePayment persists a terminal status, releases the order's OTP lock
+// and publishes the matching event. Stripe captures are posted to the ledger
+// by StripeService, which knows the currency and processor fee.
+//
+// The status only changes if the payment is still in the status it was read
+// in; when another worker got there first it reports false and does nothing
+// else. The payment's date is left alone, as the pending TTL runs from it.
+func (s *PaymentService) completePayment(ctx context.Context, payment *models.Payment, status models.PaymentStatus, eventType string) bool {
+	if moved, err := s.updatePaymentStatus(ctx, payment, status); err != nil || !moved {
+		return false
+	}
+
+	if err := s.redis.ReleaseOTP(payment.OrderID); err != nil {
+		s.log.Warn("PAYMENT", fmt.Sprintf("Failed to release OTP lock for order %s: %v", payment.OrderID, err))
+	}
+
+	if status == models.StatusSuccess && !isStripePaymentIntent(payment.TransactionID) {
+		s.recordCapture(payment)
+	}
+	s.publishPaymentEvent(eventType, payment)
+	return true
+}
+
 func (s *PaymentService) processPaymentAsync(ctx context.Context, payment *models.Payment, req *models.PaymentRequest) {
 	s.log.LogProcess("ASYNC_PAYMENT", fmt.Sprintf("Starting async processing for payment %s", payment.PaymentID))
 
@@ -109,14 +365,18 @@ func (s *PaymentService) processPaymentAsync(ctx context.Context, payment *model
 	if s.shouldPaymentSucceed(req) {
 		s.log.LogPayment("SUCCESS", payment.PaymentID, "Payment approved by gateway")
 
-		payment.Status = models.StatusSuccess
-		payment.Date = time.Now()
-
-		s.store.SavePayment(payment)
+		// The sweeper may have expired the payment in the meantime
+		if moved, err := s.updatePaymentStatus(ctx, payment, models.StatusSuccess); err != nil || !moved {
+			return
+		}
+		s.recordCapture(payment)
 
 	} else {
 		s.log.LogPayment("DECLINED", payment.PaymentID, "Payment declined by gateway")
-		s.updatePaymentStatus(payment, models.StatusFailed, "Payment declined by bank")
+		// A payment settled meanwhile, by its OTP or the sweeper, stays as it is
+		if moved, err := s.updatePaymentStatus(ctx, payment, models.StatusFailed); err != nil || !moved {
+			return
+		}
 		s.publishPaymentEvent("payment.failed", payment)
 	}
 }
@@ -129,16 +389,27 @@ func (s *PaymentService) GetPayment(ctx context.Context, paymentID string) (*mod
 		s.log.LogPayment("NOT_FOUND", paymentID, "Payment not found in storage")
 		return nil, ErrPaymentNotFound
 	}
+	if !tenant.CanAccess(ctx, payment.OrganizerID) {
+		s.log.LogSecurity("TENANT_DENIED", fmt.Sprintf("Payment %s requested outside its organizer scope", paymentID))
+		return nil, ErrPaymentNotFound
+	}
+	payment.LineItems, err = s.lineItems.ListPaymentLineItems(paymentID)
+	if err != nil {
+		s.log.Warn("PAYMENT", fmt.Sprintf("Failed to load line items of payment %s: %v", paymentID, err))
+	}
 
 	s.log.LogPayment("FOUND", paymentID, fmt.Sprintf("Payment retrieved with status: %s", payment.Status))
 	return payment, nil
 }
 
-func (s *PaymentService) RefundPayment(ctx context.Context, paymentID string, amount *float64, reason string) (*models.Payment, error) {
-	s.log.LogPayment("REFUND_INIT", paymentID, fmt.Sprintf("Initiating refund, reason: %s", reason))
+// RefundPayment refunds a simulated payment: what is left of it, an amount
+// of it, or some of its line items. The payment stays successful until all
+// of it has been refunded.
+func (s *PaymentService) RefundPayment(ctx context.Context, paymentID string, req *models.RefundRequest) (*models.Payment, error) {
+	s.log.LogPayment("REFUND_INIT", paymentID, fmt.Sprintf("Initiating refund, reason: %s", req.Reason))
 
 	payment, err := s.store.GetPayment(paymentID)
-	if err != nil {
+	if err != nil || !tenant.CanAccess(ctx, payment.OrganizerID) {
 		s.log.LogPayment("REFUND_FAILED", paymentID, "Payment not found for refund")
 		return nil, ErrPaymentNotFound
 	}
@@ -148,32 +419,449 @@ func (s *PaymentService) RefundPayment(ctx context.Context, paymentID string, am
 		return nil, ErrPaymentNotRefundable
 	}
 
-	if amount != nil {
-		if *amount <= 0 || *amount > payment.Price {
-			s.log.LogPayment("REFUND_FAILED", paymentID, fmt.Sprintf("Invalid refund amount: %.2f", *amount))
-			return nil, ErrInvalidRefundAmount
+	if len(req.LineItems) > 0 {
+		if req.Amount != nil {
+			return nil, fmt.Errorf("%w: give an amount or line items, not both", ErrInvalidRefundAmount)
+		}
+		planned, err := s.reserveLineItems(payment, req.LineItems)
+		if err != nil {
+			s.log.LogPayment("REFUND_FAILED", paymentID, fmt.Sprintf("Line items not refundable: %v", err))
+			return nil, err
+		}
+		refunded, err := s.refund(ctx, payment, planned.Amount, planned.LineItems, req.Reason, models.AuditPaymentRefunded)
+		if err != nil {
+			s.CancelLineItemRefund(planned)
 		}
+		return refunded, err
 	}
 
-	s.log.LogPayment("REFUND_PROCESSING", paymentID, fmt.Sprintf("Processing refund of %.2f", payment.Price))
+	amount, err := s.refundAmount(payment, req.Amount)
+	if err != nil {
+		s.log.LogPayment("REFUND_FAILED", paymentID, fmt.Sprintf("Invalid refund amount: %v", err))
+		return nil, err
+	}
+	return s.refund(ctx, payment, amount, nil, req.Reason, models.AuditPaymentRefunded)
+}
 
-	// Process refund
-	payment.Status = models.StatusRefunded
-	payment.Date = time.Now()
+// ForceRefund refunds a simulated payment from the admin console. Unlike
+// RefundPayment it also refunds disputed payments, and the reason is
+// mandatory. Stripe payments are refunded through Stripe and recorded with
+// RecordRefund instead.
+func (s *PaymentService) ForceRefund(ctx context.Context, paymentID string, amount *float64, reason string) (*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrReasonRequired
+	}
+	payment, err := s.store.GetPayment(paymentID)
+	if err != nil {
+		return nil, ErrPaymentNotFound
+	}
+	if isStripePaymentIntent(payment.TransactionID) {
+		return nil, fmt.Errorf("%w: refund it through Stripe", ErrPaymentNotRefundable)
+	}
+	if payment.Status != models.StatusSuccess && payment.Status != models.StatusDisputed {
+		return nil, ErrPaymentNotRefundable
+	}
+	refunded, err := s.refundAmount(payment, amount)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.store.SavePayment(payment); err != nil {
-		s.log.Error("PAYMENT", fmt.Sprintf("Failed to save refund for payment %s: %v", paymentID, err))
-		return nil, fmt.Errorf("failed to save refund: %w", err)
+	s.log.LogSecurity("FORCED_REFUND", fmt.Sprintf("Refund of payment %s forced: %s", paymentID, reason))
+	return s.refund(ctx, payment, refunded, nil, reason, models.AuditPaymentForceRefunded)
+}
+
+// refundAmount checks a requested refund amount against what is left of
+// the payment. Without an amount, all of what is left is refunded.
+func (s *PaymentService) refundAmount(payment *models.Payment, amount *float64) (float64, error) {
+	left := roundMinor(payment.Price - s.refundedTotal(payment.PaymentID))
+	if amount == nil {
+		if left <= 0 {
+			return 0, fmt.Errorf("%w: the payment has been refunded in full", ErrInvalidRefundAmount)
+		}
+		return left, nil
+	}
+	if *amount <= 0 || roundMinor(*amount) > left {
+		return 0, ErrInvalidRefundAmount
+	}
+	return roundMinor(*amount), nil
+}
+
+// refundedTotal is how much of a payment has been refunded so far
+func (s *PaymentService) refundedTotal(paymentID string) float64 {
+	refunds, err := s.refunds.ListRefunds(paymentID)
+	if err != nil {
+		s.log.Warn("PAYMENT", fmt.Sprintf("Failed to list refunds of payment %s: %v", paymentID, err))
+	}
+	var total float64
+	for _, refund := range refunds {
+		total += refund.Amount
+	}
+	return roundMinor(total)
+}
+
+// refund records a checked refund of amount and posts it to the ledger. The
+// payment is marked refunded once nothing of it is left; until then the
+// payment itself does not change.
+func (s *PaymentService) refund(ctx context.Context, payment *models.Payment, amount float64, lineItems []*models.RefundedLineItem, reason, action string) (*models.Payment, error) {
+	paymentID := payment.PaymentID
+	s.log.LogPayment("REFUND_PROCESSING", paymentID, fmt.Sprintf("Processing refund of %.2f", amount))
+
+	before := *payment
+	if roundMinor(payment.Price-s.refundedTotal(paymentID)-amount) <= 0 {
+		// Only the refund that finds the payment unchanged may close it
+		moved, err := s.store.TransitionPayment(paymentID, payment.Status, models.StatusRefunded)
+		if err != nil {
+			s.log.Error("PAYMENT", fmt.Sprintf("Failed to save refund for payment %s: %v", paymentID, err))
+			return nil, fmt.Errorf("failed to save refund: %w", err)
+		}
+		if !moved {
+			return nil, fmt.Errorf("%w: the payment changed while it was being refunded", ErrPaymentNotRefundable)
+		}
+		payment.Status = models.StatusRefunded
 	}
 
 	s.log.LogPayment("REFUND_SUCCESS", paymentID, "Refund completed successfully")
 
+	refund := &models.Refund{
+		ID:          utils.GenerateRefundID(),
+		PaymentID:   payment.PaymentID,
+		OrganizerID: payment.OrganizerID,
+		Amount:      amount,
+		Currency:    payment.Currency,
+		Reason:      reason,
+		LineItems:   lineItems,
+		CreatedAt:   time.Now(),
+	}
+	s.saveRefund(ctx, refund)
+	s.recordRefund(payment, refund.ID, amount)
+	s.audit.Record(ctx, paymentAudit(action, payment),
+		map[string]interface{}{"payment": before},
+		map[string]interface{}{"payment": payment, "refund": refund})
+
 	// Publish refund event
 	s.publishPaymentEvent("payment.refunded", payment)
 
 	return payment, nil
 }
 
+// PlanLineItemRefund sets aside line items of the payment behind a Stripe
+// PaymentIntent for a refund and returns the refund to make with Stripe.
+// Call CancelLineItemRefund if Stripe does not make it.
+func (s *PaymentService) PlanLineItemRefund(ctx context.Context, transactionID string, requested []*models.RefundLineItemRequest) (*models.Refund, error) {
+	payment, err := s.store.GetPaymentByTransactionID(transactionID)
+	if err != nil || !tenant.CanAccess(ctx, payment.OrganizerID) {
+		return nil, ErrPaymentNotFound
+	}
+	if payment.Status != models.StatusSuccess {
+		return nil, ErrPaymentNotRefundable
+	}
+	return s.reserveLineItems(payment, requested)
+}
+
+// CancelLineItemRefund puts back line items set aside for a refund that
+// did not go through.
+func (s *PaymentService) CancelLineItemRefund(planned *models.Refund) {
+	if err := s.lineItems.ReleasePaymentLineItems(planned.PaymentID, planned.LineItems); err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to release refunded line items of payment %s: %v", planned.PaymentID, err))
+	}
+}
+
+// reserveLineItems prices a refund of line items and marks them refunded,
+// so a concurrent refund cannot return the same units.
+func (s *PaymentService) reserveLineItems(payment *models.Payment, requested []*models.RefundLineItemRequest) (*models.Refund, error) {
+	items, err := s.lineItems.ListPaymentLineItems(payment.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: the payment is not itemized", ErrInvalidLineItems)
+	}
+	parts, amount, err := refundParts(items, requested)
+	if err != nil {
+		return nil, err
+	}
+	if amount > roundMinor(payment.Price-s.refundedTotal(payment.PaymentID)) {
+		return nil, fmt.Errorf("%w: more than is left of the payment", ErrInvalidRefundAmount)
+	}
+
+	reserved, err := s.lineItems.RefundPaymentLineItems(payment.PaymentID, parts)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return nil, fmt.Errorf("%w: the line items were refunded meanwhile", ErrInvalidRefundAmount)
+	}
+	return &models.Refund{
+		PaymentID:   payment.PaymentID,
+		OrganizerID: payment.OrganizerID,
+		Amount:      amount,
+		Currency:    payment.Currency,
+		LineItems:   parts,
+	}, nil
+}
+
+// RecordRefund stores a refund issued directly with Stripe and marks the
+// local payment refunded once all of it has been. Stripe has already moved
+// the money and StripeService has posted the ledger entries, so only the
+// record and the payment status are updated here. Replays of the same
+// refund are no-ops.
+func (s *PaymentService) RecordRefund(ctx context.Context, refund *models.Refund) (*models.Refund, error) {
+	payment, err := s.store.GetPayment(refund.PaymentID)
+	if err != nil || !tenant.CanAccess(ctx, payment.OrganizerID) {
+		return nil, ErrPaymentNotFound
+	}
+	refund.OrganizerID = payment.OrganizerID
+	if refund.Currency == "" {
+		refund.Currency = payment.Currency
+	}
+	if refund.CreatedAt.IsZero() {
+		refund.CreatedAt = time.Now()
+	}
+	s.saveRefund(ctx, refund)
+
+	if payment.Status == models.StatusSuccess && roundMinor(payment.Price-s.refundedTotal(payment.PaymentID)) <= 0 {
+		before := *payment
+		payment.Status = models.StatusRefunded
+		payment.Date = time.Now()
+		if err := s.store.UpdatePayment(payment); err != nil {
+			s.log.Error("PAYMENT", fmt.Sprintf("Failed to mark payment %s refunded: %v", payment.PaymentID, err))
+			return refund, nil
+		}
+		s.audit.Record(ctx, paymentAudit(models.AuditPaymentStatusChanged, payment), before, payment)
+		s.publishPaymentEvent("payment.refunded", payment)
+	}
+	return refund, nil
+}
+
+// SearchPayments finds payments by payment ID, order ID, Stripe
+// PaymentIntent ID or attendee email, newest first.
+func (s *PaymentService) SearchPayments(ctx context.Context, term string, limit int) ([]*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, ErrInvalidSearch
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	return s.store.SearchPayments(term, limit)
+}
+
+// OverrideStatus sets a payment's status by hand, for support to correct a
+// payment left wrong by an incident. Only the record changes: no money
+// moves and Stripe is not told, so refunds go through ForceRefund. The
+// reason is kept in the audit log and the matching event is published.
+func (s *PaymentService) OverrideStatus(ctx context.Context, paymentID string, status models.PaymentStatus, reason string) (*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrReasonRequired
+	}
+	switch status {
+	case models.StatusPending, models.StatusSuccess, models.StatusFailed, models.StatusRefunded,
+		models.StatusCancelled, models.StatusDisputed, models.StatusInReview:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStatus, status)
+	}
+
+	payment, err := s.store.GetPayment(paymentID)
+	if err != nil {
+		return nil, ErrPaymentNotFound
+	}
+	if payment.Status == status {
+		return nil, fmt.Errorf("%w: payment is already %s", ErrInvalidStatus, status)
+	}
+
+	before := *payment
+	payment.Status = status
+	payment.Date = time.Now()
+	if err := s.store.UpdatePayment(payment); err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to override status of payment %s: %v", paymentID, err))
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	s.log.LogSecurity("STATUS_OVERRIDE", fmt.Sprintf("Payment %s moved from %s to %s by hand: %s", paymentID, before.Status, status, reason))
+	s.audit.Record(ctx, paymentAudit(models.AuditPaymentStatusOverride, payment), before,
+		map[string]interface{}{"payment": payment, "reason": reason})
+
+	if status != models.StatusPending && status != models.StatusInReview {
+		if err := s.redis.ReleaseOTP(payment.OrderID); err != nil {
+			s.log.Warn("PAYMENT", fmt.Sprintf("Failed to release OTP lock for order %s: %v", payment.OrderID, err))
+		}
+	}
+	s.publishPaymentEvent("payment."+string(status), payment)
+	return payment, nil
+}
+
+// CancelPayment cancels a payment that has not completed: one waiting on
+// its OTP or on Stripe, or held for review. A Stripe payment's intent must
+// already be cancelled with Stripe. The reason is kept in the audit log.
+func (s *PaymentService) CancelPayment(ctx context.Context, payment *models.Payment, reason string) (*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrReasonRequired
+	}
+	if payment.Status != models.StatusPending && payment.Status != models.StatusInReview {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotCancelable, payment.Status)
+	}
+
+	before := *payment
+	if isStripePaymentIntent(payment.TransactionID) {
+		settled, err := s.SettleStripePayment(ctx, payment.TransactionID, models.StatusCancelled)
+		if err != nil {
+			return nil, err
+		}
+		payment = settled
+	} else if !s.completePayment(ctx, payment, models.StatusCancelled, "payment.cancelled") {
+		return nil, fmt.Errorf("failed to cancel payment %s", payment.PaymentID)
+	}
+
+	s.log.LogSecurity("PAYMENT_CANCELLED", fmt.Sprintf("Payment %s cancelled by hand: %s", payment.PaymentID, reason))
+	s.audit.Record(ctx, paymentAudit(models.AuditPaymentCancelled, payment), before,
+		map[string]interface{}{"payment": payment, "reason": reason})
+	return payment, nil
+}
+
+// ResetOTP clears an order's OTP lock so the attendee can start paying
+// again. A payment still waiting on the OTP could no longer be verified, so
+// it is cancelled as if it had expired.
+func (s *PaymentService) ResetOTP(ctx context.Context, paymentID, reason string) (*models.Payment, error) {
+	payment, err := s.otpPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status == models.StatusPending {
+		if !s.completePayment(ctx, payment, models.StatusCancelled, "payment.cancelled") {
+			return nil, fmt.Errorf("failed to cancel payment %s", paymentID)
+		}
+	} else if err := s.redis.ReleaseOTP(payment.OrderID); err != nil {
+		return nil, fmt.Errorf("redis error: %w", err)
+	}
+
+	s.log.LogPayment("OTP_RESET", paymentID, fmt.Sprintf("OTP for order %s reset", payment.OrderID))
+	s.audit.Record(ctx, paymentAudit(models.AuditOTPReset, payment), nil, map[string]interface{}{"reason": reason})
+	return payment, nil
+}
+
+// ResendOTP mails a new OTP for a payment still waiting on one. The old
+// code stops working and the lock's TTL starts again.
+func (s *PaymentService) ResendOTP(ctx context.Context, paymentID, reason string) (*models.Payment, error) {
+	payment, err := s.otpPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != models.StatusPending {
+		return nil, fmt.Errorf("%w: payment is %s", ErrOTPNotApplicable, payment.Status)
+	}
+
+	if err := s.redis.ReleaseOTP(payment.OrderID); err != nil {
+		return nil, fmt.Errorf("redis error: %w", err)
+	}
+	if err := s.lockOTP(payment.OrderID, payment.Email); err != nil {
+		return nil, err
+	}
+
+	s.log.LogPayment("OTP_RESENT", paymentID, fmt.Sprintf("New OTP sent for order %s", payment.OrderID))
+	s.audit.Record(ctx, paymentAudit(models.AuditOTPResent, payment), nil, map[string]interface{}{"reason": reason})
+	return payment, nil
+}
+
+// otpPayment loads a payment for an OTP action. Stripe payments never have
+// an OTP.
+func (s *PaymentService) otpPayment(ctx context.Context, paymentID string) (*models.Payment, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	payment, err := s.store.GetPayment(paymentID)
+	if err != nil {
+		return nil, ErrPaymentNotFound
+	}
+	if isStripePaymentIntent(payment.TransactionID) {
+		return nil, fmt.Errorf("%w: Stripe authenticates card payments", ErrOTPNotApplicable)
+	}
+	return payment, nil
+}
+
+// ResyncStripePayment applies the status Stripe now reports for a payment's
+// intent, for payments whose webhook never arrived. The resync is audited
+// whether or not the status changed.
+func (s *PaymentService) ResyncStripePayment(ctx context.Context, payment *models.Payment, status models.PaymentStatus, stripeStatus string) (*models.Payment, error) {
+	before := *payment
+	settled, err := s.SettleStripePayment(ctx, payment.TransactionID, status)
+	if err != nil {
+		return nil, err
+	}
+	s.log.LogPayment("RESYNC", payment.PaymentID, fmt.Sprintf("Stripe reports %s, payment is %s", stripeStatus, settled.Status))
+	s.audit.Record(ctx, paymentAudit(models.AuditPaymentResynced, settled), before,
+		map[string]interface{}{"payment": settled, "stripe_status": stripeStatus})
+	return settled, nil
+}
+
+// ListRefunds lists the refunds issued on a payment, oldest first.
+func (s *PaymentService) ListRefunds(ctx context.Context, paymentID string) ([]*models.Refund, error) {
+	if _, err := s.GetPayment(ctx, paymentID); err != nil {
+		return nil, err
+	}
+	return s.refunds.ListRefunds(paymentID)
+}
+
+// saveLineItems gives a new payment's lines their IDs and stores them. Like
+// the commission, failures are logged rather than failing the payment.
+func (s *PaymentService) saveLineItems(payment *models.Payment) {
+	for _, item := range payment.LineItems {
+		item.ID = utils.GenerateLineItemID()
+	}
+	if err := s.lineItems.SavePaymentLineItems(payment.PaymentID, payment.LineItems); err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to save line items of payment %s: %v", payment.PaymentID, err))
+	}
+}
+
+// saveRefund snapshots the FX rate and stores the refund. The money has
+// already moved, so failures are logged rather than returned.
+func (s *PaymentService) saveRefund(ctx context.Context, refund *models.Refund) {
+	refund.FX = s.snapshot(ctx, refund.Currency)
+	err := s.refunds.SaveRefund(refund)
+	if errors.Is(err, storage.ErrDuplicateReference) {
+		return
+	}
+	if err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to save refund %s for payment %s: %v", refund.ID, refund.PaymentID, err))
+		return
+	}
+	s.log.LogPayment("REFUND_RECORDED", refund.PaymentID, fmt.Sprintf("Refund %s of %.2f %s recorded", refund.ID, refund.Amount, refund.Currency))
+}
+
+// snapshot captures the current rate from currency to the base currency. A
+// missing rate never blocks a payment; the record is kept without a
+// snapshot and the gap is logged for reporting to backfill.
+func (s *PaymentService) snapshot(ctx context.Context, currency string) *models.FXSnapshot {
+	snap, err := s.fx.Snapshot(ctx, currency)
+	if err != nil {
+		s.log.Warn("FX", fmt.Sprintf("No %s rate for %s: %v", s.fx.Base(), currency, err))
+		return nil
+	}
+	return snap
+}
+
+// ListOrganizerPayments lists an organizer's payments, newest first.
+func (s *PaymentService) ListOrganizerPayments(ctx context.Context, organizerID string, limit, offset int) ([]*models.Payment, error) {
+	if !tenant.CanAccess(ctx, organizerID) {
+		return nil, ErrForbidden
+	}
+	return s.store.ListOrganizerPayments(organizerID, limit, offset)
+}
+
 func (s *PaymentService) ProcessPaymentEvent(event *models.PaymentEvent) error {
 	s.log.LogKafka("EVENT_RECEIVED", "payment-events", fmt.Sprintf("Processing event type: %s for payment: %s", event.Type, event.PaymentID))
 
@@ -214,12 +902,25 @@ func (s *PaymentService) shouldPaymentSucceed(req *models.PaymentRequest) bool {
 	return success
 }
 
-func (s *PaymentService) updatePaymentStatus(payment *models.Payment, status models.PaymentStatus, errorMsg string) {
+// updatePaymentStatus moves a payment on from the status it was read in and
+// audits the change. It reports false, and changes nothing, when another
+// worker moved the payment first.
+func (s *PaymentService) updatePaymentStatus(ctx context.Context, payment *models.Payment, status models.PaymentStatus) (bool, error) {
 	s.log.LogPayment("STATUS_UPDATE", payment.PaymentID, fmt.Sprintf("Updating status from %s to %s", payment.Status, status))
 
+	moved, err := s.store.TransitionPayment(payment.PaymentID, payment.Status, status)
+	if err != nil {
+		s.log.Error("PAYMENT", fmt.Sprintf("Failed to update payment %s: %v", payment.PaymentID, err))
+		return false, err
+	}
+	if !moved {
+		s.log.Warn("PAYMENT", fmt.Sprintf("Payment %s left %s before it could be moved to %s", payment.PaymentID, payment.Status, status))
+		return false, nil
+	}
+	before := *payment
 	payment.Status = status
-	payment.Date = time.Now()
-	s.store.SavePayment(payment)
+	s.audit.Record(ctx, paymentAudit(models.AuditPaymentStatusChanged, payment), before, payment)
+	return true, nil
 }
 
 func (s *PaymentService) publishPaymentEvent(eventType string, payment *models.Payment) {
@@ -232,7 +933,7 @@ func (s *PaymentService) publishPaymentEvent(eventType string, payment *models.P
 		Timestamp: time.Now(),
 	}
 
-	if err := s.producer.PublishPaymentEvent(event); err != nil {
+	if err := s.events.Publish(event); err != nil {
 		s.log.Error("KAFKA", fmt.Sprintf("Failed to publish payment event %s for payment %s: %v", eventType, payment.PaymentID, err))
 		s.log.LogProcess("FALLBACK", fmt.Sprintf("Payment %s processed successfully despite Kafka publish failure", payment.PaymentID))
 	} else {
@@ -240,6 +941,45 @@ func (s *PaymentService) publishPaymentEvent(eventType string, payment *models.P
 	}
 }
 
+// recordCapture posts the ledger entry for a payment that reached success.
+// Ledger failures are logged rather than failing the payment.
+func (s *PaymentService) recordCapture(payment *models.Payment) {
+	err := s.ledger.PaymentCaptured(ledger.Movement{
+		PaymentID:   payment.PaymentID,
+		OrderID:     payment.OrderID,
+		OrganizerID: payment.OrganizerID,
+		Currency:    payment.Currency,
+		Amount:      payment.Price,
+	})
+	if err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post capture for payment %s: %v", payment.PaymentID, err))
+		return
+	}
+	if err := s.fees.PostCommission(payment, payment.Currency); err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post commission for payment %s: %v", payment.PaymentID, err))
+	}
+}
+
+// recordRefund posts a refund and its share of the commission to the
+// ledger. Both are keyed by the refund, so every partial refund posts once.
+func (s *PaymentService) recordRefund(payment *models.Payment, refundID string, amount float64) {
+	err := s.ledger.PaymentRefunded(ledger.Movement{
+		PaymentID:   payment.PaymentID,
+		OrderID:     payment.OrderID,
+		OrganizerID: payment.OrganizerID,
+		Currency:    payment.Currency,
+		Amount:      amount,
+		Reference:   "payment.refunded:" + refundID,
+	})
+	if err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post refund for payment %s: %v", payment.PaymentID, err))
+		return
+	}
+	if _, err := s.fees.ReverseForRefund(payment, payment.Currency, amount, refundID); err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to reverse commission for payment %s: %v", payment.PaymentID, err))
+	}
+}
+
 func (s *PaymentService) maskCardNumber(cardNumber string) string {
 	if len(cardNumber) < 4 {
 		return cardNumber
@@ -247,22 +987,31 @@ func (s *PaymentService) maskCardNumber(cardNumber string) string {
 	return "****-****-****-" + cardNumber[len(cardNumber)-4:]
 }
 
-func (s *PaymentService) VerifyOTP(orderID string, otp string) bool {
+// VerifyOTP checks an attendee's OTP and settles the order's payment. Both
+// outcomes are audited against the attendee.
+func (s *PaymentService) VerifyOTP(ctx context.Context, orderID string, otp string) bool {
+	return s.CheckOTP(ctx, orderID, otp) == nil
+}
+
+// CheckOTP is VerifyOTP reporting why an OTP was refused: ErrOTPExpired
+// when none is outstanding for the order, ErrOTPInvalid when it does not
+// match. Either way the OTP is spent and the payment fails.
+func (s *PaymentService) CheckOTP(ctx context.Context, orderID string, otp string) error {
 	s.log.Debug("OTP", fmt.Sprintf("🔍 Starting OTP verification for orderID=%s with provided OTP=%s", orderID, otp))
 	payment, err := s.store.GetTicketByOrderID(orderID)
 	if err != nil {
 		s.log.Error("DATABASE", fmt.Sprintf("Failed to get payment for order %s: %v", orderID, err))
 		s.publishPaymentEvent("otp.failed", payment)
 		_ = s.redis.RemoveOTP(orderID)
-		return false
+		return ErrPaymentNotFound
 	}
 	lockedOTP, err := s.redis.GetOTP(orderID)
 	if err != nil {
 		s.log.Error("OTP", fmt.Sprintf("❌ Error fetching OTP for order %s: %v", orderID, err))
-		s.handleOTPFail(orderID)
+		s.handleOTPFail(ctx, orderID)
 		s.publishPaymentEvent("otp.failed", payment)
 		_ = s.redis.RemoveOTP(orderID)
-		return false
+		return fmt.Errorf("failed to fetch OTP: %w", err)
 	}
 
 	s.log.Debug("OTP", fmt.Sprintf("📦 Redis returned OTP for orderID=%s: %s", orderID, lockedOTP))
@@ -270,40 +1019,89 @@ func (s *PaymentService) VerifyOTP(orderID string, otp string) bool {
 	if lockedOTP == "" {
 		s.log.Warn("OTP", fmt.Sprintf("⚠️ No OTP found in Redis for order %s", orderID))
 		s.publishPaymentEvent("otp.failed", payment)
-		s.handleOTPFail(orderID)
+		s.handleOTPFail(ctx, orderID)
 		_ = s.redis.RemoveOTP(orderID)
-		return false
+		return ErrOTPExpired
 	}
 
 	if lockedOTP == otp {
 		s.log.Info("OTP", fmt.Sprintf("✅ OTP matched for order %s. Provided=%s, Stored=%s", orderID, otp, lockedOTP))
 
-		payment.Status = models.StatusSuccess
-		err = s.store.UpdatePayment(payment)
+		before := *payment
+		// The simulated gateway may already have approved it
+		moved := payment.Status == models.StatusSuccess
+		if !moved {
+			moved, err = s.store.TransitionPayment(payment.PaymentID, models.StatusPending, models.StatusSuccess)
+		}
 		if err != nil {
 			s.log.Error("DATABASE", fmt.Sprintf("Failed to update payment for order %s: %v", orderID, err))
-			s.handleOTPFail(orderID)
+			s.handleOTPFail(ctx, orderID)
+			s.publishPaymentEvent("otp.failed", payment)
+			_ = s.redis.RemoveOTP(orderID)
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+		if !moved {
+			// Expired or cancelled while the attendee was entering the code
+			s.log.Warn("OTP", fmt.Sprintf("Payment for order %s is %s, not pending, so its OTP cannot complete it", orderID, payment.Status))
 			s.publishPaymentEvent("otp.failed", payment)
 			_ = s.redis.RemoveOTP(orderID)
-			return false
+			return ErrOTPExpired
 		}
+		payment.Status = models.StatusSuccess
+		s.audit.Record(ctx, otpAudit(models.AuditOTPVerified, payment), before, payment)
+		s.recordCapture(payment)
 		s.log.Debug("OTP", fmt.Sprintf("🗑 OTP removed from Redis for order %s", orderID))
 		s.publishPaymentEvent("otp.success", payment)
 		_ = s.redis.RemoveOTP(orderID)
-		return true
+		return nil
 	}
 
 	s.log.Warn("OTP", fmt.Sprintf("🚫 OTP mismatch for order %s. Provided=%s, Stored=%s", orderID, otp, lockedOTP))
-	s.handleOTPFail(orderID)
+	s.handleOTPFail(ctx, orderID)
 	s.publishPaymentEvent("otp.failed", payment)
 	_ = s.redis.RemoveOTP(orderID)
-	return false
+	return ErrOTPInvalid
 }
 
-func (s *PaymentService) handleOTPFail(orderID string) {
+// handleOTPFail fails the order's payment after a wrong or missing OTP.
+// Only a payment still waiting on its OTP fails: anyone can call
+// /payments/validate, and a payment that has completed, been refunded or
+// expired meanwhile is left alone.
+func (s *PaymentService) handleOTPFail(ctx context.Context, orderID string) {
 	payment, err := s.store.GetTicketByOrderID(orderID)
-	if err == nil && payment != nil {
-		payment.Status = models.StatusFailed
-		_ = s.store.UpdatePayment(payment)
+	if err != nil || payment == nil {
+		return
+	}
+	moved, err := s.store.TransitionPayment(payment.PaymentID, models.StatusPending, models.StatusFailed)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to fail payment for order %s: %v", orderID, err))
+		return
 	}
+	if !moved {
+		s.log.Warn("OTP", fmt.Sprintf("Payment for order %s is %s, not pending, so a failed OTP leaves it alone", orderID, payment.Status))
+		return
+	}
+	before := *payment
+	payment.Status = models.StatusFailed
+	s.audit.Record(ctx, otpAudit(models.AuditOTPFailed, payment), before, payment)
+}
+
+func paymentAudit(action string, payment *models.Payment) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "payment",
+		TargetID:    payment.PaymentID,
+		PaymentID:   payment.PaymentID,
+		OrderID:     payment.OrderID,
+		OrganizerID: payment.OrganizerID,
+	}
+}
+
+// otpAudit attributes an OTP check to the attendee; the checkout endpoints
+// carry no API key.
+func otpAudit(action string, payment *models.Payment) *models.AuditEntry {
+	entry := paymentAudit(action, payment)
+	entry.Actor = string(models.ActorAttendee)
+	entry.ActorType = models.ActorAttendee
+	return entry
 }
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/fx"
+	"payment-gateway/internal/models"
+)
+
+func refundOf(amount float64) *models.RefundRequest {
+	return &models.RefundRequest{Amount: &amount, Reason: "requested_by_customer"}
+}
+
+func TestPartialRefundsEachPostToLedger(t *testing.T) {
+	env := newTestEnv(t)
+	env.capturedPayment(t, "pay_1", 100)
+
+	for _, amount := range []float64{30, 20} {
+		if _, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(amount)); err != nil {
+			t.Fatalf("refund %.2f: %v", amount, err)
+		}
+	}
+
+	refunds, _ := env.store.ListRefunds("pay_1")
+	if len(refunds) != 2 {
+		t.Fatalf("got %d refunds, want 2", len(refunds))
+	}
+	posted := env.store.entries("payment.refunded")
+	if len(posted) != 2 {
+		t.Fatalf("got %d refund entries, want 2", len(posted))
+	}
+	for i, entry := range posted {
+		if want := "payment.refunded:" + refunds[i].ID; entry.Reference != want {
+			t.Errorf("entry %d reference = %q, want %q", i, entry.Reference, want)
+		}
+	}
+	if reversals := env.store.entries("platform.fee_reversal"); len(reversals) != 2 {
+		t.Fatalf("got %d commission reversals, want 2", len(reversals))
+	}
+
+	commission, reversed, err := env.fees.totals("pay_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commission != 10 || reversed != 5 {
+		t.Errorf("commission %.2f reversed %.2f, want 10 and 5", commission, reversed)
+	}
+
+	payment, _ := env.store.GetPayment("pay_1")
+	if payment.Status != models.StatusSuccess {
+		t.Errorf("status after partial refunds = %s, want %s", payment.Status, models.StatusSuccess)
+	}
+}
+
+func TestRefundingTheRestMarksPaymentRefunded(t *testing.T) {
+	env := newTestEnv(t)
+	env.capturedPayment(t, "pay_1", 100)
+
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(40)); err != nil {
+		t.Fatal(err)
+	}
+	payment, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(60))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != models.StatusRefunded {
+		t.Errorf("status = %s, want %s", payment.Status, models.StatusRefunded)
+	}
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(1)); err != ErrPaymentNotRefundable {
+		t.Errorf("refunding a refunded payment: err = %v, want %v", err, ErrPaymentNotRefundable)
+	}
+}
+
+func TestRefundOverRemainingIsRejected(t *testing.T) {
+	env := newTestEnv(t)
+	env.capturedPayment(t, "pay_1", 100)
+
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(80)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_1", refundOf(30)); err == nil {
+		t.Fatal("refunding more than remains succeeded")
+	}
+	if posted := env.store.entries("payment.refunded"); len(posted) != 1 {
+		t.Errorf("got %d refund entries, want 1", len(posted))
+	}
+}
+
+func TestCompletePaymentActsOnce(t *testing.T) {
+	env := newTestEnv(t)
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	env.pendingPayment(t, "pay_1", "txn_1", created)
+
+	first, _ := env.store.GetPayment("pay_1")
+	second, _ := env.store.GetPayment("pay_1")
+	if !env.payments.completePayment(context.Background(), first, models.StatusCancelled, "payment.expired") {
+		t.Fatal("first worker did not move the payment")
+	}
+	if env.payments.completePayment(context.Background(), second, models.StatusSuccess, "payment.success") {
+		t.Fatal("second worker moved a payment that was no longer pending")
+	}
+
+	stored, _ := env.store.GetPayment("pay_1")
+	if stored.Status != models.StatusCancelled {
+		t.Errorf("status = %s, want %s", stored.Status, models.StatusCancelled)
+	}
+	if !stored.Date.Equal(created) {
+		t.Errorf("date moved from %v to %v", created, stored.Date)
+	}
+	if captured := env.store.entries("payment.captured"); len(captured) != 0 {
+		t.Errorf("losing worker posted %d captures", len(captured))
+	}
+}
+
+func TestStatusUpdatesOnlyAuditWhatChanged(t *testing.T) {
+	env := newTestEnv(t)
+	env.pendingPayment(t, "pay_1", "txn_1", time.Now())
+	held, _ := env.store.GetPayment("pay_1")
+	held.Status = models.StatusInReview
+
+	// Read as held, but pending in the store: the write must not land
+	moved, err := env.payments.updatePaymentStatus(context.Background(), held, models.StatusPending)
+	if err != nil || moved {
+		t.Fatalf("moved %v, err %v", moved, err)
+	}
+	if held.Status != models.StatusInReview || len(env.store.audit) != 0 {
+		t.Errorf("lost update changed the payment to %s or was audited: %+v", held.Status, env.store.audit)
+	}
+
+	current, _ := env.store.GetPayment("pay_1")
+	if moved, err := env.payments.updatePaymentStatus(context.Background(), current, models.StatusFailed); err != nil || !moved {
+		t.Fatalf("moved %v, err %v", moved, err)
+	}
+	if stored, _ := env.store.GetPayment("pay_1"); stored.Status != models.StatusFailed || len(env.store.audit) != 1 {
+		t.Errorf("status %s, audit %+v", stored.Status, env.store.audit)
+	}
+}
+
+func TestOTPAfterExpiryDoesNotSucceed(t *testing.T) {
+	env := newTestEnv(t)
+	payment := env.pendingPayment(t, "pay_1", "txn_1", time.Now().Add(-time.Hour))
+	if _, err := env.payments.redis.AddOTP("123456", payment.OrderID); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, _ := env.store.GetPayment("pay_1")
+	if !env.payments.completePayment(context.Background(), stale, models.StatusCancelled, "payment.expired") {
+		t.Fatal("expiry did not move the payment")
+	}
+	// The sweeper released the lock; the attendee's code arrives late
+	if _, err := env.payments.redis.AddOTP("123456", payment.OrderID); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.payments.CheckOTP(context.Background(), payment.OrderID, "123456"); err != ErrOTPExpired {
+		t.Errorf("err = %v, want %v", err, ErrOTPExpired)
+	}
+
+	stored, _ := env.store.GetPayment("pay_1")
+	if stored.Status != models.StatusCancelled {
+		t.Errorf("status = %s, want %s", stored.Status, models.StatusCancelled)
+	}
+}
+
+func TestWrongOTPOnlyFailsAPendingPayment(t *testing.T) {
+	env := newTestEnv(t)
+	env.pendingPayment(t, "pay_pending", "txn_pending", time.Now())
+	env.capturedPayment(t, "pay_done", 100)
+	lock := env.payments.redis.(*memLock)
+
+	for _, id := range []string{"pay_pending", "pay_done"} {
+		if _, err := lock.AddOTP("123456", "order_"+id); err != nil {
+			t.Fatal(err)
+		}
+		if err := env.payments.CheckOTP(context.Background(), "order_"+id, "000000"); !errors.Is(err, ErrOTPInvalid) {
+			t.Errorf("%s: err = %v, want ErrOTPInvalid", id, err)
+		}
+	}
+	// Nothing outstanding for the order
+	if err := env.payments.CheckOTP(context.Background(), "order_pay_done", "123456"); err == nil {
+		t.Error("OTP accepted with none outstanding")
+	}
+
+	if pending, _ := env.store.GetPayment("pay_pending"); pending.Status != models.StatusFailed {
+		t.Errorf("pending payment is %s after a wrong OTP, want failed", pending.Status)
+	}
+	if done, _ := env.store.GetPayment("pay_done"); done.Status != models.StatusSuccess {
+		t.Errorf("captured payment is %s after a wrong OTP", done.Status)
+	}
+	if failed := env.audited(models.AuditOTPFailed); len(failed) != 1 || failed[0].PaymentID != "pay_pending" {
+		t.Errorf("audit = %+v", failed)
+	}
+}
+
+func TestRefundsKeepThePaymentCurrency(t *testing.T) {
+	env := newTestEnv(t)
+	env.payments.fx = fx.NewConverter(fx.NewStaticProvider("usd", map[string]float64{"eur": 0.8}), "usd")
+	for id, currency := range map[string]string{"pay_eur": "eur", "pay_gbp": "gbp"} {
+		payment := env.capturedPayment(t, id, 100)
+		payment.Currency = currency
+		if err := env.store.UpdatePayment(payment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_eur", refundOf(40)); err != nil {
+		t.Fatal(err)
+	}
+	refunds, _ := env.store.ListRefunds("pay_eur")
+	if len(refunds) != 1 || refunds[0].Currency != "eur" || refunds[0].FX == nil ||
+		refunds[0].FX.BaseCurrency != "usd" || refunds[0].FX.Rate != 1.25 {
+		t.Errorf("refunds = %+v", refunds)
+	}
+
+	// A missing rate is logged, never a reason to keep the customer's money
+	if _, err := env.payments.RefundPayment(context.Background(), "pay_gbp", refundOf(40)); err != nil {
+		t.Fatal(err)
+	}
+	refunds, _ = env.store.ListRefunds("pay_gbp")
+	if len(refunds) != 1 || refunds[0].Currency != "gbp" || refunds[0].FX != nil {
+		t.Errorf("refunds = %+v", refunds)
+	}
+	posted := map[string]int{}
+	for _, entry := range env.store.entries("payment.refunded") {
+		posted[entry.Currency]++
+	}
+	if len(posted) != 2 || posted["eur"] != 1 || posted["gbp"] != 1 {
+		t.Errorf("refunds posted in %v, want one each in eur and gbp", posted)
+	}
+}
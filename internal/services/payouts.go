@@ -0,0 +1,504 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/fx"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+var (
+	ErrPayoutNotFound   = apperror.New(apperror.PayoutNotFound, "payout not found")
+	ErrPayoutNotPending = apperror.New(apperror.PayoutNotPending, "payout is not awaiting confirmation")
+)
+
+// PayoutProvider sends money to an organizer.
+type PayoutProvider interface {
+	Method() models.PayoutMethod
+	// Send starts the payout and returns the provider's reference. paid
+	// reports whether the money has already left; otherwise the payout stays
+	// pending until it is confirmed.
+	Send(ctx context.Context, org *models.Organizer, payout *models.Payout) (reference string, paid bool, err error)
+}
+
+// stripeTransferProvider pays organizers with a Connect account.
+type stripeTransferProvider struct {
+	stripe *StripeService
+}
+
+func (p *stripeTransferProvider) Method() models.PayoutMethod {
+	return models.PayoutStripeTransfer
+}
+
+func (p *stripeTransferProvider) Send(ctx context.Context, org *models.Organizer, payout *models.Payout) (string, bool, error) {
+	ref, err := p.stripe.CreateTransfer(ctx, payout.ID, payoutKey(payout), org.StripeAccountID, payout.Currency, payout.Amount)
+	if err != nil {
+		return "", false, err
+	}
+	return ref, true, nil
+}
+
+// payoutKey identifies the money a payout moves: an organizer's balance in
+// one currency from the start of its period. Unlike the payout ID, a run
+// that pays the same period again derives the same key.
+func payoutKey(payout *models.Payout) string {
+	return fmt.Sprintf("payout_%s_%s_%d", payout.OrganizerID, payout.Currency, payout.PeriodStart.Unix())
+}
+
+// bankExportProvider leaves the payout pending; finance downloads the bank
+// export, sends the transfer and confirms it.
+type bankExportProvider struct{}
+
+func (bankExportProvider) Method() models.PayoutMethod {
+	return models.PayoutBankExport
+}
+
+func (bankExportProvider) Send(ctx context.Context, org *models.Organizer, payout *models.Payout) (string, bool, error) {
+	return "", false, nil
+}
+
+// PayoutService pays organizers their settled balance on their schedule.
+// The balance comes from the ledger, so it is already net of platform fees,
+// refunds and disputes.
+type PayoutService struct {
+	store      storage.PayoutStore
+	organizers storage.OrganizerStore
+	ledger     *ledger.Ledger
+	stripe     PayoutProvider
+	bank       PayoutProvider
+	fx         *fx.Converter
+	audit      *AuditService
+	cfg        config.PayoutConfig
+	log        *logger.Logger
+	mu         sync.Mutex // one payout run at a time in this process; the store guards across instances
+}
+
+func NewPayoutService(store storage.PayoutStore, organizers storage.OrganizerStore, ledger *ledger.Ledger, stripe *StripeService, converter *fx.Converter, audit *AuditService, cfg config.PayoutConfig, log *logger.Logger) *PayoutService {
+	s := &PayoutService{
+		store:      store,
+		organizers: organizers,
+		ledger:     ledger,
+		bank:       bankExportProvider{},
+		fx:         converter,
+		audit:      audit,
+		cfg:        cfg,
+		log:        log,
+	}
+	if stripe != nil {
+		s.stripe = &stripeTransferProvider{stripe: stripe}
+	}
+	return s
+}
+
+// Start checks for due payouts on the configured interval until ctx is
+// cancelled.
+func (s *PayoutService) Start(ctx context.Context) {
+	s.log.LogProcess("PAYOUTS", fmt.Sprintf("Payout scheduler started (interval: %v, reserve: %.1f%%)", s.cfg.Interval, s.cfg.ReservePercent))
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.LogProcess("PAYOUTS", "Payout scheduler stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.Run(ctx, &models.PayoutRunRequest{}); err != nil {
+				s.log.Error("PAYOUTS", fmt.Sprintf("Scheduled payout run failed: %v", err))
+			}
+		}
+	}
+}
+
+// Run pays every due organizer, or only req.OrganizerID when set. Force
+// ignores the organizer's schedule but still honours the reserve.
+func (s *PayoutService) Run(ctx context.Context, req *models.PayoutRunRequest) ([]*models.Payout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var organizers []*models.Organizer
+	if req.OrganizerID != "" {
+		org, err := s.organizers.GetOrganizer(req.OrganizerID)
+		if err != nil {
+			return nil, ErrOrganizerNotFound
+		}
+		if org.Status != models.OrganizerActive {
+			return nil, ErrOrganizerInactive
+		}
+		organizers = append(organizers, org)
+	} else {
+		var err error
+		organizers, err = s.organizers.ListOrganizers(models.OrganizerActive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizers: %w", err)
+		}
+	}
+
+	var payouts []*models.Payout
+	for _, org := range organizers {
+		if ctx.Err() != nil {
+			return payouts, ctx.Err()
+		}
+		created, err := s.payOrganizer(ctx, org, req.Force)
+		if err != nil {
+			s.log.Error("PAYOUTS", fmt.Sprintf("Payout for organizer %s failed: %v", org.ID, err))
+			continue
+		}
+		for _, payout := range created {
+			s.audit.Record(ctx, payoutAudit(models.AuditPayoutCreated, payout), nil, payout)
+		}
+		payouts = append(payouts, created...)
+	}
+
+	if len(payouts) > 0 {
+		s.log.LogProcess("PAYOUTS", fmt.Sprintf("Created %d payouts", len(payouts)))
+	}
+	return payouts, nil
+}
+
+// payOrganizer creates one payout per currency the organizer is owed.
+func (s *PayoutService) payOrganizer(ctx context.Context, org *models.Organizer, force bool) ([]*models.Payout, error) {
+	owed, err := s.owed(org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var payouts []*models.Payout
+	for currency, available := range owed {
+		if available <= 0 {
+			continue
+		}
+
+		latest, err := s.store.LatestPayout(org.ID, currency)
+		if err != nil {
+			return payouts, err
+		}
+		if latest != nil && latest.Status == models.PayoutPending {
+			// The previous bank transfer has not been confirmed yet
+			continue
+		}
+		if !force && !payoutDue(org, latest, now) {
+			continue
+		}
+
+		var reserve int64
+		if org.EventEndsAt != nil && now.Before(*org.EventEndsAt) {
+			reserve = int64(math.Round(float64(available) * s.cfg.ReservePercent / 100))
+		}
+		amount := available - reserve
+		if amount < models.ToMinor(s.cfg.MinimumAmount, currency) {
+			continue
+		}
+
+		periodStart := org.CreatedAt
+		if latest != nil {
+			periodStart = latest.PeriodEnd
+		}
+
+		payout := &models.Payout{
+			ID:          utils.GeneratePayoutID(),
+			OrganizerID: org.ID,
+			Currency:    currency,
+			Available:   models.FromMinor(available, currency),
+			Reserve:     models.FromMinor(reserve, currency),
+			Amount:      models.FromMinor(amount, currency),
+			Status:      models.PayoutPending,
+			PeriodStart: periodStart,
+			PeriodEnd:   now,
+			CreatedAt:   now,
+		}
+		s.convert(ctx, org, payout)
+		if err := s.send(ctx, org, payout); err != nil {
+			if errors.Is(err, storage.ErrDuplicateReference) {
+				// Another instance is already paying this period
+				s.log.Info("PAYOUTS", fmt.Sprintf("Payout for organizer %s in %s since %s already exists, skipping",
+					org.ID, currency, periodStart.Format(time.RFC3339)))
+				continue
+			}
+			return payouts, err
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts, nil
+}
+
+// convert fills in the settlement amount in the organizer's settlement
+// currency. Without a rate the payout is settled in the currency it was
+// earned in rather than held back.
+func (s *PayoutService) convert(ctx context.Context, org *models.Organizer, payout *models.Payout) {
+	payout.SettlementCurrency = payout.Currency
+	payout.SettlementAmount = payout.Amount
+
+	target := org.SettlementCurrency
+	if target == "" || target == payout.Currency {
+		return
+	}
+	snapshot, err := s.fx.SnapshotTo(ctx, payout.Currency, target)
+	if err != nil {
+		s.log.Warn("PAYOUTS", fmt.Sprintf("No %s/%s rate for payout %s, settling in %s: %v", payout.Currency, target, payout.ID, payout.Currency, err))
+		return
+	}
+	payout.SettlementCurrency = target
+	payout.SettlementAmount = snapshot.ToBase(payout.Amount)
+	payout.FX = snapshot
+}
+
+func (s *PayoutService) send(ctx context.Context, org *models.Organizer, payout *models.Payout) error {
+	provider := s.bank
+	if org.StripeAccountID != "" && s.stripe != nil {
+		provider = s.stripe
+	}
+	payout.Method = provider.Method()
+
+	if err := s.store.SavePayout(payout); err != nil {
+		return err
+	}
+	s.log.Info("PAYOUTS", fmt.Sprintf("Payout %s of %.2f %s to organizer %s via %s (reserve %.2f)",
+		payout.ID, payout.Amount, payout.Currency, org.ID, payout.Method, payout.Reserve))
+
+	ref, paid, err := provider.Send(ctx, org, payout)
+	if err != nil {
+		payout.Status = models.PayoutFailed
+		payout.FailureReason = err.Error()
+		if updateErr := s.store.UpdatePayout(payout); updateErr != nil {
+			s.log.Error("PAYOUTS", fmt.Sprintf("Failed to mark payout %s failed: %v", payout.ID, updateErr))
+		}
+		return nil
+	}
+
+	payout.ProviderReference = ref
+	if paid {
+		return s.markPaid(payout)
+	}
+	return s.store.UpdatePayout(payout)
+}
+
+func (s *PayoutService) markPaid(payout *models.Payout) error {
+	paidAt := time.Now()
+	payout.Status = models.PayoutPaid
+	payout.PaidAt = &paidAt
+	if err := s.store.UpdatePayout(payout); err != nil {
+		return err
+	}
+
+	err := s.ledger.PayoutSent(ledger.Movement{
+		OrganizerID: payout.OrganizerID,
+		Currency:    payout.Currency,
+		Amount:      payout.Amount,
+		Reference:   "payout.sent:" + payout.ID,
+	})
+	if err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post payout %s: %v", payout.ID, err))
+	}
+	return nil
+}
+
+// ConfirmPayout marks a bank-export payout as sent.
+func (s *PayoutService) ConfirmPayout(ctx context.Context, id, reference string) (*models.Payout, error) {
+	payout, err := s.pendingBankPayout(id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *payout
+	payout.ProviderReference = reference
+	if err := s.markPaid(payout); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, payoutAudit(models.AuditPayoutConfirmed, payout), before, payout)
+	s.log.Info("PAYOUTS", fmt.Sprintf("Payout %s confirmed (bank reference %s)", payout.ID, reference))
+	return payout, nil
+}
+
+// FailPayout records that a bank-export payout could not be sent. The
+// balance stays owed and is picked up by the next payout.
+func (s *PayoutService) FailPayout(ctx context.Context, id, reason string) (*models.Payout, error) {
+	payout, err := s.pendingBankPayout(id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *payout
+	payout.Status = models.PayoutFailed
+	payout.FailureReason = reason
+	if err := s.store.UpdatePayout(payout); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, payoutAudit(models.AuditPayoutFailed, payout), before, payout)
+	s.log.Warn("PAYOUTS", fmt.Sprintf("Payout %s failed: %s", payout.ID, reason))
+	return payout, nil
+}
+
+func payoutAudit(action string, payout *models.Payout) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "payout",
+		TargetID:    payout.ID,
+		OrganizerID: payout.OrganizerID,
+	}
+}
+
+func (s *PayoutService) pendingBankPayout(id string) (*models.Payout, error) {
+	payout, err := s.store.GetPayout(id)
+	if err != nil {
+		return nil, ErrPayoutNotFound
+	}
+	if payout.Method != models.PayoutBankExport || payout.Status != models.PayoutPending {
+		return nil, ErrPayoutNotPending
+	}
+	return payout, nil
+}
+
+// BankExport lists the pending bank payouts for finance to send.
+func (s *PayoutService) BankExport() ([]*models.BankExportLine, error) {
+	payouts, err := s.store.ListPayoutsByStatus(models.PayoutBankExport, models.PayoutPending)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*models.BankExportLine, 0, len(payouts))
+	for _, payout := range payouts {
+		line := &models.BankExportLine{Payout: payout}
+		if org, err := s.organizers.GetOrganizer(payout.OrganizerID); err == nil {
+			line.OrganizerName = org.Name
+			line.OrganizerEmail = org.Email
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func (s *PayoutService) GetPayout(ctx context.Context, id string) (*models.Payout, error) {
+	payout, err := s.store.GetPayout(id)
+	if err != nil || !tenant.CanAccess(ctx, payout.OrganizerID) {
+		return nil, ErrPayoutNotFound
+	}
+	return payout, nil
+}
+
+func (s *PayoutService) ListPayouts(ctx context.Context, organizerID string, limit, offset int) ([]*models.Payout, error) {
+	if !tenant.CanAccess(ctx, organizerID) {
+		return nil, ErrForbidden
+	}
+	return s.store.ListPayouts(organizerID, limit, offset)
+}
+
+// Statement explains a payout: every ledger movement on the organizer's
+// balance during the payout period, grouped into totals.
+func (s *PayoutService) Statement(ctx context.Context, id string) (*models.SettlementStatement, error) {
+	payout, err := s.GetPayout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.ledger.OrganizerEntries(payout.OrganizerID, payout.Currency, payout.PeriodStart, payout.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &models.SettlementStatement{
+		Payout:         payout,
+		ClosingBalance: payout.Available,
+		Reserve:        payout.Reserve,
+		PayoutAmount:   payout.Amount,
+		Lines:          make([]*models.StatementLine, 0, len(entries)),
+	}
+
+	var movement int64
+	for _, entry := range entries {
+		var amount int64
+		for _, line := range entry.Lines {
+			if chargedToOrganizer(line.Account) {
+				amount += line.Credit - line.Debit
+			}
+		}
+		if amount == 0 {
+			continue
+		}
+		movement += amount
+
+		switch entry.Kind {
+		case "payment.captured":
+			statement.Sales += models.FromMinor(amount, payout.Currency)
+		case "payment.refunded":
+			statement.Refunds += models.FromMinor(amount, payout.Currency)
+		case "platform.fee", "platform.fee_reversal":
+			statement.PlatformFees += models.FromMinor(amount, payout.Currency)
+		case "payout.sent", "payout.reversed":
+			statement.PreviousPayout += models.FromMinor(amount, payout.Currency)
+		default:
+			statement.Adjustments += models.FromMinor(amount, payout.Currency)
+		}
+
+		statement.Lines = append(statement.Lines, &models.StatementLine{
+			Date:      entry.PostedAt,
+			Kind:      entry.Kind,
+			Reference: entry.Reference,
+			PaymentID: entry.PaymentID,
+			OrderID:   entry.OrderID,
+			Amount:    models.FromMinor(amount, payout.Currency),
+		})
+	}
+	statement.OpeningBalance = roundMinor(payout.Available - models.FromMinor(movement, payout.Currency))
+
+	return statement, nil
+}
+
+// owed returns what the organizer is owed per currency, in minor units:
+// credits to their payable account less refunds and disputes charged back
+// to them.
+func (s *PayoutService) owed(organizerID string) (map[string]int64, error) {
+	balances, err := s.ledger.Balances(organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	owed := make(map[string]int64)
+	for _, balance := range balances {
+		if chargedToOrganizer(balance.Account) {
+			owed[balance.Currency] -= balance.Balance
+		}
+	}
+	return owed, nil
+}
+
+// chargedToOrganizer reports whether an account's organizer-tagged lines
+// change what the organizer is owed.
+func chargedToOrganizer(account models.LedgerAccount) bool {
+	switch account {
+	case models.AccountOrganizerPayable, models.AccountRefunds, models.AccountDisputes:
+		return true
+	}
+	return false
+}
+
+// payoutDue reports whether the organizer's schedule calls for a payout now.
+func payoutDue(org *models.Organizer, latest *models.Payout, now time.Time) bool {
+	switch org.PayoutSchedule {
+	case models.PayoutManual:
+		return false
+	case models.PayoutDaily:
+		return latest == nil || now.Sub(latest.CreatedAt) >= 24*time.Hour
+	case models.PayoutAfterEvent:
+		if org.EventEndsAt == nil || now.Before(*org.EventEndsAt) {
+			return false
+		}
+		return latest == nil || latest.CreatedAt.Before(*org.EventEndsAt)
+	default:
+		return latest == nil || now.Sub(latest.CreatedAt) >= 7*24*time.Hour
+	}
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/ledger"
+	"payment-gateway/internal/models"
+)
+
+// unseenPayouts hides earlier payouts, as a gateway instance sees the table
+// when another instance inserts between its read and its write
+type unseenPayouts struct {
+	*memStore
+}
+
+func (unseenPayouts) LatestPayout(organizerID, currency string) (*models.Payout, error) {
+	return nil, nil
+}
+
+func payoutEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := newTestEnv(t)
+	org := &models.Organizer{
+		ID:             "org_1",
+		Name:           "Org",
+		Status:         models.OrganizerActive,
+		PayoutSchedule: models.PayoutDaily,
+		CreatedAt:      time.Now().Add(-48 * time.Hour).Truncate(time.Second),
+	}
+	if err := env.store.SaveOrganizer(org); err != nil {
+		t.Fatal(err)
+	}
+	err := env.ledger.PaymentCaptured(ledger.Movement{PaymentID: "pay_1", OrganizerID: "org_1", Currency: "usd", Amount: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return env
+}
+
+func TestPayoutRunPaysBalance(t *testing.T) {
+	env := payoutEnv(t)
+	payouts := NewPayoutService(env.store, env.store, env.ledger, nil, nil, env.audit, config.PayoutConfig{}, env.log)
+
+	created, err := payouts.Run(context.Background(), &models.PayoutRunRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("got %d payouts, want 1", len(created))
+	}
+	payout := created[0]
+	if payout.Amount != 100 || payout.Method != models.PayoutBankExport || payout.Status != models.PayoutPending {
+		t.Errorf("payout = %.2f %s %s, want 100.00 pending bank export", payout.Amount, payout.Method, payout.Status)
+	}
+
+	// The bank transfer is unconfirmed, so the next run waits for it
+	again, err := payouts.Run(context.Background(), &models.PayoutRunRequest{Force: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again) != 0 {
+		t.Errorf("second run created %d payouts while one is pending", len(again))
+	}
+}
+
+func TestConcurrentPayoutRunsPayPeriodOnce(t *testing.T) {
+	env := payoutEnv(t)
+	store := unseenPayouts{env.store}
+	first := NewPayoutService(store, env.store, env.ledger, nil, nil, env.audit, config.PayoutConfig{}, env.log)
+	second := NewPayoutService(store, env.store, env.ledger, nil, nil, env.audit, config.PayoutConfig{}, env.log)
+
+	a, err := first.Run(context.Background(), &models.PayoutRunRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := second.Run(context.Background(), &models.PayoutRunRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a)+len(b) != 1 {
+		t.Errorf("two runs created %d payouts for one period, want 1", len(a)+len(b))
+	}
+	if len(env.store.payouts) != 1 {
+		t.Errorf("stored %d payouts, want 1", len(env.store.payouts))
+	}
+}
+
+func TestPayoutKeyNamesThePeriod(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	a := &models.Payout{ID: "po_1", OrganizerID: "org_1", Currency: "usd", PeriodStart: start}
+	b := &models.Payout{ID: "po_2", OrganizerID: "org_1", Currency: "usd", PeriodStart: start, PeriodEnd: start.Add(time.Hour)}
+	if payoutKey(a) != payoutKey(b) {
+		t.Errorf("payouts of one period have keys %q and %q", payoutKey(a), payoutKey(b))
+	}
+
+	next := &models.Payout{ID: "po_1", OrganizerID: "org_1", Currency: "usd", PeriodStart: start.Add(24 * time.Hour)}
+	eur := &models.Payout{ID: "po_1", OrganizerID: "org_1", Currency: "eur", PeriodStart: start}
+	if payoutKey(a) == payoutKey(next) || payoutKey(a) == payoutKey(eur) {
+		t.Error("payouts of different periods or currencies share a key")
+	}
+}
@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/utils"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+var (
+	ErrReconciliationNotFound = apperror.New(apperror.ReconciliationNotFound, "reconciliation run not found")
+	ErrInvalidReconcileRange  = apperror.New(apperror.InvalidReconciliationRange, "invalid reconciliation date range")
+
+	errReconcileRangeFormat = fmt.Errorf("%w: from and to must be YYYY-MM-DD with from <= to", ErrInvalidReconcileRange)
+)
+
+// StripeLedgerSource is the read-only view of Stripe the reconciler needs.
+// StripeService implements it; point STRIPE_API_BASE at stripe-mock to run
+// against a local fake.
+type StripeLedgerSource interface {
+	ListCharges(ctx context.Context, from, to time.Time) ([]*stripe.Charge, error)
+	ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*stripe.BalanceTransaction, error)
+}
+
+// ReconciliationService compares Stripe charges with the payments table and
+// records every discrepancy it finds.
+type ReconciliationService struct {
+	payments storage.Store
+	store    storage.ReconciliationStore
+	stripe   StripeLedgerSource
+	cfg      config.ReconciliationConfig
+	log      *logger.Logger
+}
+
+func NewReconciliationService(payments storage.Store, store storage.ReconciliationStore, stripe StripeLedgerSource, cfg config.ReconciliationConfig, log *logger.Logger) *ReconciliationService {
+	return &ReconciliationService{
+		payments: payments,
+		store:    store,
+		stripe:   stripe,
+		cfg:      cfg,
+		log:      log,
+	}
+}
+
+// StartDaily reconciles the previous UTC day once a day at the configured hour.
+func (s *ReconciliationService) StartDaily(ctx context.Context) {
+	s.log.LogProcess("RECONCILE", fmt.Sprintf("Daily reconciliation scheduled at %02d:00 UTC", s.cfg.RunHour))
+
+	for {
+		now := time.Now().UTC()
+		next := time.Date(now.Year(), now.Month(), now.Day(), s.cfg.RunHour, 0, 0, 0, time.UTC)
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.log.LogProcess("RECONCILE", "Daily reconciliation stopped")
+			return
+		case <-timer.C:
+		}
+
+		end := time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.UTC)
+		if _, err := s.Run(ctx, end.AddDate(0, 0, -1), end); err != nil {
+			s.log.Error("RECONCILE", fmt.Sprintf("Daily reconciliation failed: %v", err))
+		}
+	}
+}
+
+// StartRun parses a date range and runs reconciliation in the background.
+// The returned run is in the running state; poll GetRun for the result.
+func (s *ReconciliationService) StartRun(req *models.ReconciliationRequest) (*models.ReconciliationRun, error) {
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, errReconcileRangeFormat
+	}
+	to, err := time.Parse("2006-01-02", req.To)
+	if err != nil {
+		return nil, errReconcileRangeFormat
+	}
+	// The end date is inclusive for callers
+	to = to.AddDate(0, 0, 1)
+	if !to.After(from) {
+		return nil, errReconcileRangeFormat
+	}
+
+	run, err := s.newRun(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.execute(context.Background(), run)
+	return run, nil
+}
+
+// Run reconciles [from, to) synchronously.
+func (s *ReconciliationService) Run(ctx context.Context, from, to time.Time) (*models.ReconciliationRun, error) {
+	run, err := s.newRun(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.execute(ctx, run); err != nil {
+		return run, err
+	}
+	return run, nil
+}
+
+func (s *ReconciliationService) GetRun(id string) (*models.ReconciliationRun, error) {
+	run, err := s.store.GetReconciliationRun(id)
+	if err != nil {
+		return nil, ErrReconciliationNotFound
+	}
+	return run, nil
+}
+
+func (s *ReconciliationService) ListRuns(limit, offset int) ([]*models.ReconciliationRun, error) {
+	return s.store.ListReconciliationRuns(limit, offset)
+}
+
+func (s *ReconciliationService) ListItems(runID string) ([]*models.ReconciliationItem, error) {
+	if _, err := s.GetRun(runID); err != nil {
+		return nil, err
+	}
+	return s.store.ListReconciliationItems(runID)
+}
+
+func (s *ReconciliationService) newRun(from, to time.Time) (*models.ReconciliationRun, error) {
+	run := &models.ReconciliationRun{
+		ID:          utils.GenerateReconciliationID(),
+		PeriodStart: from,
+		PeriodEnd:   to,
+		Status:      models.ReconciliationRunning,
+		StartedAt:   time.Now(),
+	}
+	if err := s.store.SaveReconciliationRun(run); err != nil {
+		return nil, fmt.Errorf("failed to create reconciliation run: %w", err)
+	}
+	return run, nil
+}
+
+func (s *ReconciliationService) execute(ctx context.Context, run *models.ReconciliationRun) error {
+	s.log.LogProcess("RECONCILE", fmt.Sprintf("Run %s started for %s to %s", run.ID,
+		run.PeriodStart.Format("2006-01-02"), run.PeriodEnd.Format("2006-01-02")))
+
+	items, err := s.compare(ctx, run)
+	if err == nil {
+		err = s.store.SaveReconciliationItems(items)
+	}
+
+	completed := time.Now()
+	run.CompletedAt = &completed
+	if err != nil {
+		run.Status = models.ReconciliationFailed
+		run.Error = err.Error()
+		s.log.Error("RECONCILE", fmt.Sprintf("Run %s failed: %v", run.ID, err))
+	} else {
+		run.Status = models.ReconciliationCompleted
+		run.DiscrepancyCount = len(items)
+		s.log.LogProcess("RECONCILE", fmt.Sprintf("Run %s completed: %d stripe, %d local, %d matched, %d discrepancies",
+			run.ID, run.StripeCount, run.LocalCount, run.MatchedCount, run.DiscrepancyCount))
+	}
+
+	if saveErr := s.store.SaveReconciliationRun(run); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+// compare matches Stripe charges to local payments and returns the discrepancies.
+func (s *ReconciliationService) compare(ctx context.Context, run *models.ReconciliationRun) ([]*models.ReconciliationItem, error) {
+	charges, err := s.stripe.ListCharges(ctx, run.PeriodStart, run.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	txns, err := s.stripe.ListBalanceTransactions(ctx, run.PeriodStart, run.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	local, err := s.payments.ListPaymentsBetween(run.PeriodStart, run.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := make(map[string]int64)
+	for _, txn := range txns {
+		if txn.Source != nil {
+			fees[txn.Source.ID] += txn.Fee
+		}
+	}
+
+	// A PaymentIntent can have several charges (failed attempts before the
+	// successful one); keep the one that best represents the final state.
+	byIntent := make(map[string]*stripe.Charge)
+	var order []string
+	for _, ch := range charges {
+		key := ch.ID
+		if ch.PaymentIntent != nil && ch.PaymentIntent.ID != "" {
+			key = ch.PaymentIntent.ID
+		}
+		prev, seen := byIntent[key]
+		if !seen {
+			order = append(order, key)
+		}
+		if !seen || prev.Status != stripe.ChargeStatusSucceeded {
+			byIntent[key] = ch
+		}
+	}
+	run.StripeCount = len(byIntent)
+
+	var items []*models.ReconciliationItem
+	matched := make(map[string]bool)
+
+	for _, key := range order {
+		ch := byIntent[key]
+		paymentID, orderID := chargeReferences(ch)
+		item := &models.ReconciliationItem{
+			RunID:        run.ID,
+			PaymentID:    paymentID,
+			OrderID:      orderID,
+			ChargeID:     ch.ID,
+			StripeAmount: models.FromMinor(ch.Amount, string(ch.Currency)),
+			StripeFee:    models.FromMinor(fees[ch.ID], string(ch.Currency)),
+			Currency:     string(ch.Currency),
+			StripeStatus: string(chargeStatus(ch)),
+		}
+		if ch.PaymentIntent != nil {
+			item.PaymentIntentID = ch.PaymentIntent.ID
+		}
+
+		payment := s.findLocal(paymentID, orderID)
+		if payment == nil {
+			item.Type = models.DiscrepancyMissingLocal
+			item.Details = "charge has no matching local payment"
+			items = append(items, item)
+			continue
+		}
+
+		matched[payment.PaymentID] = true
+		item.PaymentID = payment.PaymentID
+		item.OrderID = payment.OrderID
+		item.LocalAmount = payment.Price
+		item.LocalStatus = string(payment.Status)
+
+		clean := true
+		if models.ToMinor(payment.Price, string(ch.Currency)) != ch.Amount {
+			mismatch := *item
+			mismatch.Type = models.DiscrepancyAmountMismatch
+			mismatch.Details = fmt.Sprintf("local %.2f vs stripe %.2f", payment.Price, item.StripeAmount)
+			items = append(items, &mismatch)
+			clean = false
+		}
+		if payment.Status != chargeStatus(ch) {
+			mismatch := *item
+			mismatch.Type = models.DiscrepancyStatusMismatch
+			mismatch.Details = fmt.Sprintf("local %s vs stripe %s", payment.Status, item.StripeStatus)
+			items = append(items, &mismatch)
+			clean = false
+		}
+		if clean {
+			run.MatchedCount++
+		}
+	}
+
+	for _, payment := range local {
+		if !isStripePaymentIntent(payment.TransactionID) {
+			continue
+		}
+		run.LocalCount++
+		if matched[payment.PaymentID] {
+			continue
+		}
+		if _, ok := byIntent[payment.TransactionID]; ok {
+			continue
+		}
+		items = append(items, &models.ReconciliationItem{
+			RunID:           run.ID,
+			Type:            models.DiscrepancyMissingStripe,
+			PaymentID:       payment.PaymentID,
+			OrderID:         payment.OrderID,
+			PaymentIntentID: payment.TransactionID,
+			LocalAmount:     payment.Price,
+			LocalStatus:     string(payment.Status),
+			Details:         "local payment has no Stripe charge in period",
+		})
+	}
+
+	return items, nil
+}
+
+func (s *ReconciliationService) findLocal(paymentID, orderID string) *models.Payment {
+	if paymentID != "" {
+		if payment, err := s.payments.GetPayment(paymentID); err == nil {
+			return payment
+		}
+	}
+	if orderID != "" {
+		if payment, err := s.payments.GetTicketByOrderID(orderID); err == nil {
+			return payment
+		}
+	}
+	return nil
+}
+
+// chargeReferences reads our payment_id/order_id from the PaymentIntent
+// metadata, falling back to the charge's own metadata.
+func chargeReferences(ch *stripe.Charge) (paymentID, orderID string) {
+	if ch.PaymentIntent != nil && ch.PaymentIntent.Metadata != nil {
+		paymentID = ch.PaymentIntent.Metadata["payment_id"]
+		orderID = ch.PaymentIntent.Metadata["order_id"]
+	}
+	if paymentID == "" {
+		paymentID = ch.Metadata["payment_id"]
+	}
+	if orderID == "" {
+		orderID = ch.Metadata["order_id"]
+	}
+	return paymentID, orderID
+}
+
+// chargeStatus maps a Stripe charge onto our payment status vocabulary.
+func chargeStatus(ch *stripe.Charge) models.PaymentStatus {
+	switch ch.Status {
+	case stripe.ChargeStatusSucceeded:
+		if ch.Refunded {
+			return models.StatusRefunded
+		}
+		return models.StatusSuccess
+	case stripe.ChargeStatusPending:
+		return models.StatusPending
+	default:
+		return models.StatusFailed
+	}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+// fakeStripeLedger serves fixed charges and balance transactions
+type fakeStripeLedger struct {
+	charges []*stripe.Charge
+	txns    []*stripe.BalanceTransaction
+	err     error
+}
+
+func (f *fakeStripeLedger) ListCharges(ctx context.Context, from, to time.Time) ([]*stripe.Charge, error) {
+	return f.charges, f.err
+}
+
+func (f *fakeStripeLedger) ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*stripe.BalanceTransaction, error) {
+	return f.txns, f.err
+}
+
+func charge(id, intentID, paymentID string, amount int64, status stripe.ChargeStatus) *stripe.Charge {
+	return &stripe.Charge{
+		ID:       id,
+		Amount:   amount,
+		Currency: "usd",
+		Status:   status,
+		PaymentIntent: &stripe.PaymentIntent{
+			ID:       intentID,
+			Metadata: map[string]string{"payment_id": paymentID},
+		},
+	}
+}
+
+func reconcile(t *testing.T, env *testEnv, source *fakeStripeLedger) (*models.ReconciliationRun, map[models.DiscrepancyType][]*models.ReconciliationItem) {
+	t.Helper()
+	service := NewReconciliationService(env.store, env.store, source, config.ReconciliationConfig{}, env.log)
+	from := time.Now().Add(-24 * time.Hour)
+	run, err := service.Run(context.Background(), from, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := service.ListItems(run.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byType := make(map[models.DiscrepancyType][]*models.ReconciliationItem)
+	for _, item := range items {
+		byType[item.Type] = append(byType[item.Type], item)
+	}
+	return run, byType
+}
+
+func localPayment(t *testing.T, env *testEnv, id, intentID string, price float64, status models.PaymentStatus) {
+	t.Helper()
+	err := env.store.SavePayment(&models.Payment{
+		PaymentID:     id,
+		OrderID:       "order_" + id,
+		Status:        status,
+		Price:         price,
+		Currency:      "usd",
+		Date:          time.Now().Add(-time.Hour),
+		TransactionID: intentID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcileMatchesCleanPayments(t *testing.T) {
+	env := newTestEnv(t)
+	localPayment(t, env, "pay_ok", "pi_ok", 50, models.StatusSuccess)
+	source := &fakeStripeLedger{
+		charges: []*stripe.Charge{charge("ch_ok", "pi_ok", "pay_ok", 5000, stripe.ChargeStatusSucceeded)},
+		txns:    []*stripe.BalanceTransaction{{Fee: 175, Source: &stripe.BalanceTransactionSource{ID: "ch_ok"}}},
+	}
+
+	run, byType := reconcile(t, env, source)
+	if run.Status != models.ReconciliationCompleted {
+		t.Fatalf("run status = %s (%s)", run.Status, run.Error)
+	}
+	if len(byType) != 0 {
+		t.Errorf("clean payment reported discrepancies: %v", byType)
+	}
+	if run.StripeCount != 1 || run.LocalCount != 1 || run.MatchedCount != 1 {
+		t.Errorf("counts stripe %d local %d matched %d, want 1 each", run.StripeCount, run.LocalCount, run.MatchedCount)
+	}
+}
+
+func TestReconcileReportsDiscrepancies(t *testing.T) {
+	env := newTestEnv(t)
+	localPayment(t, env, "pay_lost", "pi_lost", 20, models.StatusSuccess)
+	localPayment(t, env, "pay_amount", "pi_amount", 40, models.StatusSuccess)
+	localPayment(t, env, "pay_status", "pi_status", 30, models.StatusPending)
+	// Not a Stripe payment, so never expected in Stripe
+	localPayment(t, env, "pay_otp", "txn_otp", 10, models.StatusSuccess)
+
+	source := &fakeStripeLedger{charges: []*stripe.Charge{
+		charge("ch_ghost", "pi_ghost", "pay_ghost", 1500, stripe.ChargeStatusSucceeded),
+		charge("ch_amount", "pi_amount", "pay_amount", 4500, stripe.ChargeStatusSucceeded),
+		charge("ch_status", "pi_status", "pay_status", 3000, stripe.ChargeStatusSucceeded),
+	}}
+
+	run, byType := reconcile(t, env, source)
+	if run.DiscrepancyCount != 4 {
+		t.Errorf("discrepancy count = %d, want 4", run.DiscrepancyCount)
+	}
+
+	if items := byType[models.DiscrepancyMissingLocal]; len(items) != 1 || items[0].ChargeID != "ch_ghost" {
+		t.Errorf("missing in ledger = %+v, want ch_ghost", items)
+	}
+	if items := byType[models.DiscrepancyMissingStripe]; len(items) != 1 || items[0].PaymentID != "pay_lost" {
+		t.Errorf("missing in Stripe = %+v, want pay_lost", items)
+	}
+	if items := byType[models.DiscrepancyAmountMismatch]; len(items) != 1 ||
+		items[0].PaymentID != "pay_amount" || items[0].LocalAmount != 40 || items[0].StripeAmount != 45 {
+		t.Errorf("amount mismatch = %+v, want pay_amount 40 vs 45", items)
+	}
+	if items := byType[models.DiscrepancyStatusMismatch]; len(items) != 1 ||
+		items[0].PaymentID != "pay_status" || items[0].LocalStatus != "pending" || items[0].StripeStatus != "success" {
+		t.Errorf("status mismatch = %+v, want pay_status pending vs success", items)
+	}
+}
+
+func TestReconcileKeepsSucceededAttempt(t *testing.T) {
+	env := newTestEnv(t)
+	localPayment(t, env, "pay_1", "pi_1", 25, models.StatusSuccess)
+	source := &fakeStripeLedger{charges: []*stripe.Charge{
+		charge("ch_declined", "pi_1", "pay_1", 2500, stripe.ChargeStatusFailed),
+		charge("ch_paid", "pi_1", "pay_1", 2500, stripe.ChargeStatusSucceeded),
+	}}
+
+	run, byType := reconcile(t, env, source)
+	if len(byType) != 0 || run.MatchedCount != 1 {
+		t.Errorf("retried intent: %d matched, discrepancies %v", run.MatchedCount, byType)
+	}
+}
+
+func TestReconcileRecordsStripeFailure(t *testing.T) {
+	env := newTestEnv(t)
+	service := NewReconciliationService(env.store, env.store, &fakeStripeLedger{err: errors.New("stripe down")}, config.ReconciliationConfig{}, env.log)
+
+	run, err := service.Run(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	if err == nil {
+		t.Fatal("run succeeded without Stripe")
+	}
+	stored, _ := service.GetRun(run.ID)
+	if stored.Status != models.ReconciliationFailed || stored.Error == "" {
+		t.Errorf("stored run = %s %q, want failed with the error", stored.Status, stored.Error)
+	}
+}
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/risk"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+var (
+	ErrRiskDenied       = apperror.New(apperror.PaymentDeclined, "payment declined")
+	ErrReviewNotFound   = apperror.New(apperror.ReviewNotFound, "risk review not found")
+	ErrReviewNotPending = apperror.New(apperror.ReviewNotPending, "risk review has already been decided")
+	ErrReviewExpired    = apperror.New(apperror.ReviewExpired, "payment is no longer held for review")
+)
+
+const (
+	defaultReviewLimit = 50
+	maxReviewLimit     = 500
+)
+
+// RiskService scores payments before they are sent to the provider and
+// queues the ones the rules hold for review.
+type RiskService struct {
+	engine *risk.Engine
+	store  storage.RiskStore
+	audit  *AuditService
+	cfg    config.RiskConfig
+	log    *logger.Logger
+}
+
+func NewRiskService(engine *risk.Engine, store storage.RiskStore, audit *AuditService, cfg config.RiskConfig, log *logger.Logger) *RiskService {
+	return &RiskService{
+		engine: engine,
+		store:  store,
+		audit:  audit,
+		cfg:    cfg,
+		log:    log,
+	}
+}
+
+// Assess scores a payment. The client IP is taken from the request when the
+// input has none. It always returns an assessment: when the rules cannot be
+// applied the payment is allowed or held according to FailOpen.
+func (s *RiskService) Assess(ctx context.Context, in risk.Input) *models.RiskAssessment {
+	if !s.cfg.Enabled {
+		return &models.RiskAssessment{Decision: models.DecisionAllow, RulesFrom: "disabled"}
+	}
+	if in.IP == "" {
+		if src, ok := audit.SourceFrom(ctx); ok {
+			in.IP = src.IP
+		}
+	}
+
+	assessment, err := s.engine.Evaluate(ctx, in)
+	if assessment == nil {
+		decision := models.DecisionAllow
+		if !s.cfg.FailOpen {
+			decision = models.DecisionReview
+		}
+		s.log.Error("RISK", fmt.Sprintf("Cannot score payment %s, falling back to %s: %v", in.PaymentID, decision, err))
+		return &models.RiskAssessment{Decision: decision, Warnings: []string{err.Error()}, RulesFrom: "fallback"}
+	}
+	for _, warning := range assessment.Warnings {
+		s.log.Warn("RISK", fmt.Sprintf("Payment %s scored without a signal: %s", in.PaymentID, warning))
+	}
+
+	switch assessment.Decision {
+	case models.DecisionDeny:
+		s.log.LogSecurity("RISK_DENIED", fmt.Sprintf("Payment %s for order %s denied with score %d (%s)", in.PaymentID, in.OrderID, assessment.Score, hitNames(assessment)))
+		s.audit.Record(ctx, &models.AuditEntry{
+			Action:      models.AuditRiskDenied,
+			TargetType:  "payment",
+			TargetID:    in.PaymentID,
+			PaymentID:   in.PaymentID,
+			OrderID:     in.OrderID,
+			OrganizerID: in.OrganizerID,
+		}, nil, assessment)
+	case models.DecisionReview:
+		s.log.LogSecurity("RISK_REVIEW", fmt.Sprintf("Payment %s for order %s held with score %d (%s)", in.PaymentID, in.OrderID, assessment.Score, hitNames(assessment)))
+	default:
+		s.log.LogPayment("RISK", in.PaymentID, fmt.Sprintf("Allowed with score %d", assessment.Score))
+	}
+	return assessment
+}
+
+// Hold queues a payment that has been saved in review status.
+func (s *RiskService) Hold(ctx context.Context, payment *models.Payment, assessment *models.RiskAssessment) {
+	review := &models.RiskReview{
+		PaymentID:     payment.PaymentID,
+		OrganizerID:   payment.OrganizerID,
+		OrderID:       payment.OrderID,
+		TransactionID: payment.TransactionID,
+		Amount:        payment.Price,
+		Currency:      payment.Currency,
+		Score:         assessment.Score,
+		Assessment:    assessment,
+		Status:        models.ReviewPending,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.store.SaveRiskReview(review); err != nil {
+		s.log.Error("RISK", fmt.Sprintf("Failed to queue payment %s for review: %v", payment.PaymentID, err))
+		return
+	}
+	s.audit.Record(ctx, riskAudit(models.AuditRiskHeld, review), nil, review)
+}
+
+// Rules returns the rules currently in force.
+func (s *RiskService) Rules(ctx context.Context) (*risk.Rules, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.engine.Rules()
+}
+
+func hitNames(assessment *models.RiskAssessment) string {
+	names := make([]string, 0, len(assessment.Hits))
+	for _, hit := range assessment.Hits {
+		names = append(names, hit.Rule)
+	}
+	return strings.Join(names, ", ")
+}
+
+// ReviewService works the queue of held payments. Approving a payment lets
+// it continue where the risk check stopped it; rejecting it fails the
+// payment and, for Stripe, releases the authorization.
+type ReviewService struct {
+	store    storage.RiskStore
+	payments *PaymentService
+	stripe   *StripeService
+	audit    *AuditService
+	log      *logger.Logger
+}
+
+func NewReviewService(store storage.RiskStore, payments *PaymentService, stripe *StripeService, audit *AuditService, log *logger.Logger) *ReviewService {
+	return &ReviewService{
+		store:    store,
+		payments: payments,
+		stripe:   stripe,
+		audit:    audit,
+		log:      log,
+	}
+}
+
+// List returns reviews oldest first, pending ones by default.
+func (s *ReviewService) List(ctx context.Context, organizerID string, status models.RiskReviewStatus, limit, offset int) ([]*models.RiskReview, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	if status == "" {
+		status = models.ReviewPending
+	}
+	if limit <= 0 {
+		limit = defaultReviewLimit
+	}
+	if limit > maxReviewLimit {
+		limit = maxReviewLimit
+	}
+	return s.store.ListRiskReviews(organizerID, status, limit, offset)
+}
+
+func (s *ReviewService) Get(ctx context.Context, paymentID string) (*models.RiskReview, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	review, err := s.store.GetRiskReview(paymentID)
+	if err != nil {
+		return nil, ErrReviewNotFound
+	}
+	return review, nil
+}
+
+// Approve releases a held payment. Stripe authorizations are captured;
+// simulated payments continue to the OTP step.
+func (s *ReviewService) Approve(ctx context.Context, paymentID, note string) (*models.RiskReview, error) {
+	review, payment, err := s.open(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStripePaymentIntent(payment.TransactionID) {
+		result, err := s.stripe.CaptureHeldPayment(ctx, payment.TransactionID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.payments.SettleStripePayment(ctx, payment.TransactionID, result.Status); err != nil {
+			return nil, err
+		}
+	} else if err := s.payments.ReleaseHeldPayment(ctx, payment); err != nil {
+		return nil, err
+	}
+
+	return s.decide(ctx, review, models.ReviewApproved, note)
+}
+
+// Reject fails a held payment. Stripe authorizations are cancelled as
+// fraudulent so the funds go back to the cardholder straight away.
+func (s *ReviewService) Reject(ctx context.Context, paymentID, note string) (*models.RiskReview, error) {
+	review, payment, err := s.open(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStripePaymentIntent(payment.TransactionID) {
+		if err := s.stripe.CancelPaymentIntent(ctx, payment.TransactionID, stripe.PaymentIntentCancellationReasonFraudulent); err != nil {
+			return nil, err
+		}
+		if _, err := s.payments.SettleStripePayment(ctx, payment.TransactionID, models.StatusFailed); err != nil {
+			return nil, err
+		}
+	} else if err := s.payments.RejectHeldPayment(ctx, payment); err != nil {
+		return nil, err
+	}
+
+	return s.decide(ctx, review, models.ReviewRejected, note)
+}
+
+// open loads a pending review and its payment. A payment that has left
+// review status, such as an authorization Stripe expired, closes the review.
+func (s *ReviewService) open(ctx context.Context, paymentID string) (*models.RiskReview, *models.Payment, error) {
+	review, err := s.Get(ctx, paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if review.Status != models.ReviewPending {
+		return nil, nil, ErrReviewNotPending
+	}
+
+	payment, err := s.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if payment.Status != models.StatusInReview {
+		s.log.Warn("RISK", fmt.Sprintf("Payment %s under review is already %s, closing the review", paymentID, payment.Status))
+		if _, err := s.decide(ctx, review, models.ReviewExpired, "payment was "+string(payment.Status)); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, ErrReviewExpired
+	}
+	return review, payment, nil
+}
+
+func (s *ReviewService) decide(ctx context.Context, review *models.RiskReview, status models.RiskReviewStatus, note string) (*models.RiskReview, error) {
+	before := *review
+	now := time.Now()
+	review.Status = status
+	review.Note = note
+	review.Reviewer, _ = auditActor(ctx)
+	review.DecidedAt = &now
+
+	ok, err := s.store.DecideRiskReview(review)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrReviewNotPending
+	}
+	s.log.LogPayment("RISK_REVIEW", review.PaymentID, fmt.Sprintf("Review %s by %s", status, review.Reviewer))
+
+	action := models.AuditRiskApproved
+	switch status {
+	case models.ReviewRejected:
+		action = models.AuditRiskRejected
+	case models.ReviewExpired:
+		action = models.AuditRiskExpired
+	}
+	s.audit.Record(ctx, riskAudit(action, review), before, review)
+	return review, nil
+}
+
+func riskAudit(action string, review *models.RiskReview) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "risk_review",
+		TargetID:    review.PaymentID,
+		PaymentID:   review.PaymentID,
+		OrderID:     review.OrderID,
+		OrganizerID: review.OrganizerID,
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"payment-gateway/internal/audit"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/risk"
+)
+
+// brokenRules has no rules to give, as a rules file that never parsed
+type brokenRules struct{}
+
+func (brokenRules) Rules() (*risk.Rules, error) {
+	return nil, errors.New("risk rules unavailable")
+}
+
+func TestAssessFallsBackWithoutRules(t *testing.T) {
+	env := newTestEnv(t)
+	engine := risk.NewEngine(brokenRules{}, nil, nil)
+
+	held := NewRiskService(engine, nil, env.audit, config.RiskConfig{Enabled: true}, env.log).Assess(context.Background(), risk.Input{PaymentID: "pay_1"})
+	if held.Decision != models.DecisionReview || held.RulesFrom != "fallback" || len(held.Warnings) != 1 {
+		t.Errorf("fail closed: %+v", held)
+	}
+	allowed := NewRiskService(engine, nil, env.audit, config.RiskConfig{Enabled: true, FailOpen: true}, env.log).Assess(context.Background(), risk.Input{PaymentID: "pay_1"})
+	if allowed.Decision != models.DecisionAllow {
+		t.Errorf("fail open: %+v", allowed)
+	}
+	off := NewRiskService(engine, nil, env.audit, config.RiskConfig{}, env.log).Assess(context.Background(), risk.Input{PaymentID: "pay_1"})
+	if off.Decision != models.DecisionAllow || off.RulesFrom != "disabled" {
+		t.Errorf("disabled: %+v", off)
+	}
+}
+
+func TestAssessDeniesFromTheRequestIP(t *testing.T) {
+	env := newTestEnv(t)
+	rules, err := risk.ParseRules([]byte(`
+rules:
+  - {name: blocklisted, signal: blocklisted, op: eq, value: 1, outcome: deny}
+lists:
+  ips: [203.0.113.0/24]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := NewRiskService(risk.NewEngine(risk.NewStaticRules(rules), nil, nil), nil, env.audit, config.RiskConfig{Enabled: true}, env.log)
+	ctx := audit.WithSource(context.Background(), audit.Source{IP: "203.0.113.9"})
+
+	assessment := service.Assess(ctx, risk.Input{PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1", Amount: 40})
+	if assessment.Decision != models.DecisionDeny {
+		t.Fatalf("assessment = %+v", assessment)
+	}
+	if len(env.store.audit) != 1 || env.store.audit[0].Action != models.AuditRiskDenied || env.store.audit[0].PaymentID != "pay_1" {
+		t.Errorf("audit = %+v", env.store.audit)
+	}
+
+	// An IP given with the payment wins over the caller's
+	if assessment := service.Assess(ctx, risk.Input{PaymentID: "pay_2", IP: "198.51.100.1"}); assessment.Decision != models.DecisionAllow {
+		t.Errorf("assessment = %+v", assessment)
+	}
+}
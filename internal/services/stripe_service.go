@@ -2,70 +2,125 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
-	"strconv"
+	"strings"
 	"time"
 
+	"payment-gateway/internal/apperror"
+	cardcheck "payment-gateway/internal/card"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/ledger"
 	"payment-gateway/internal/logger"
 	"payment-gateway/internal/models"
+	"payment-gateway/internal/risk"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
 
 	"github.com/stripe/stripe-go/v82"
 	"github.com/stripe/stripe-go/v82/client"
+	"github.com/stripe/stripe-go/v82/webhook"
 )
 
 var (
-	ErrStripeAPIError         = errors.New("stripe API error")
-	ErrStripeClientInitFailed = errors.New("failed to initialize Stripe client")
-	ErrCardValidationFailed   = errors.New("card validation failed")
+	ErrStripeAPIError         = apperror.New(apperror.ProviderUnavailable, "stripe API error")
+	ErrStripeClientInitFailed = apperror.New(apperror.ServiceUnavailable, "failed to initialize Stripe client")
+	ErrCardValidationFailed   = apperror.New(apperror.CardValidationFailed, "card validation failed")
+	ErrWebhookNotConfigured   = apperror.New(apperror.WebhookNotConfigured, "STRIPE_WEBHOOK_SECRET is not set")
+	ErrInvalidWebhook         = apperror.New(apperror.InvalidWebhook, "invalid webhook signature")
+	ErrAuthenticationRequired = apperror.New(apperror.AuthenticationRequired, "card requires authentication, customer must complete the payment on-session")
+	ErrRawCardData            = apperror.New(apperror.RawCardData, "raw card data is only accepted in test mode; send a Stripe token or PaymentMethod ID")
+	ErrInvalidCardToken       = apperror.New(apperror.InvalidCardToken, "token must be a Stripe token (tok_) or PaymentMethod ID (pm_)")
 )
 
 // StripeService handles integration with Stripe payment gateway
 type StripeService struct {
-	client *client.API
-	log    *logger.Logger
-}
-
-// parseStringToInt64 safely converts a string to int64, returns 0 if conversion fails
-func parseStringToInt64(s string) int64 {
-	val, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		return 0
-	}
-	return val
+	client        *client.API
+	webhookSecret string
+	log           *logger.Logger
+	ledger        *ledger.Ledger
+	organizers    *OrganizerService
+	fees          *FeeService
+	audit         *AuditService
+	risk          *RiskService
+	rawCards      bool // raw card numbers may be sent to Stripe's test environment
 }
 
 // NewStripeService creates a new instance of StripeService
-func NewStripeService(log *logger.Logger) (*StripeService, error) {
+func NewStripeService(log *logger.Logger, ledger *ledger.Ledger, organizers *OrganizerService, fees *FeeService, audit *AuditService, risk *RiskService, cards config.CardDataConfig) (*StripeService, error) {
 	stripeKey := os.Getenv("STRIPE_SECRET_KEY")
 	if stripeKey == "" {
 		log.Error("STRIPE", "STRIPE_SECRET_KEY environment variable not set")
 		return nil, ErrStripeClientInitFailed
 	}
 
-	sc := client.New(stripeKey, nil)
+	// STRIPE_API_BASE points the client at a local fake such as stripe-mock
+	var backends *stripe.Backends
+	if apiBase := os.Getenv("STRIPE_API_BASE"); apiBase != "" {
+		backends = stripe.NewBackendsWithConfig(&stripe.BackendConfig{URL: stripe.String(apiBase)})
+		log.Warn("STRIPE", "Using Stripe API base override: "+apiBase)
+	}
+
+	sc := client.New(stripeKey, backends)
 	if sc == nil {
 		log.Error("STRIPE", "Failed to initialize Stripe client")
 		return nil, ErrStripeClientInitFailed
 	}
 
+	webhookSecret := os.Getenv("STRIPE_WEBHOOK_SECRET")
+	if webhookSecret == "" {
+		log.Warn("STRIPE", "STRIPE_WEBHOOK_SECRET not set, Stripe webhooks will be rejected")
+	}
+
 	log.Info("STRIPE", "Stripe client initialized successfully")
 	return &StripeService{
-		client: sc,
-		log:    log,
+		client:        sc,
+		webhookSecret: webhookSecret,
+		log:           log,
+		ledger:        ledger,
+		organizers:    organizers,
+		fees:          fees,
+		audit:         audit,
+		risk:          risk,
+		rawCards:      cards.RawCardTestMode && strings.HasPrefix(stripeKey, "sk_test_"),
 	}, nil
 }
 
 // ValidateCard validates the provided card details using Stripe
 func (s *StripeService) ValidateCard(card *models.StripeCard) (*models.StripeCardValidationResponse, error) {
+	if !s.rawCards {
+		return nil, ErrRawCardData
+	}
+
+	// Check the card locally first; Stripe is only asked about cards that
+	// could plausibly be valid
+	result := cardcheck.Validate(cardcheck.Details{
+		Number:   card.Number,
+		ExpMonth: card.ExpMonth,
+		ExpYear:  card.ExpYear,
+		CVC:      card.CVC,
+	}, time.Now())
+	if !result.Valid() {
+		s.log.LogPayment("VALIDATE", "card", fmt.Sprintf("Card failed local validation: %s", result.Error()))
+		return &models.StripeCardValidationResponse{
+			Valid:    false,
+			Message:  "Card failed validation",
+			CardType: string(result.Brand),
+			Last4:    result.Last4,
+			Errors:   result.Errors,
+		}, nil
+	}
+
 	// Create a payment method to validate the card
 	params := &stripe.PaymentMethodParams{
 		Type: stripe.String("card"),
 		Card: &stripe.PaymentMethodCardParams{
 			Number:   stripe.String(card.Number),
-			ExpMonth: stripe.Int64(parseStringToInt64(card.ExpMonth)),
-			ExpYear:  stripe.Int64(parseStringToInt64(card.ExpYear)),
+			ExpMonth: stripe.Int64(result.ExpMonth),
+			ExpYear:  stripe.Int64(result.ExpYear),
 			CVC:      stripe.String(card.CVC),
 		},
 	}
@@ -74,9 +129,11 @@ func (s *StripeService) ValidateCard(card *models.StripeCard) (*models.StripeCar
 	if err != nil {
 		s.log.Error("STRIPE", fmt.Sprintf("Card validation failed: %v", err))
 
+		// Stripe's decline reason would tell a card tester which detail is
+		// wrong, so it stays in the log
 		return &models.StripeCardValidationResponse{
 			Valid:   false,
-			Message: err.Error(),
+			Message: "Card could not be validated",
 		}, nil
 	}
 
@@ -104,18 +161,57 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 	s.log.LogPayment("PROCESS", req.PaymentID, fmt.Sprintf("Processing Stripe payment for order %s, amount: %.2f %s",
 		req.OrderID, req.Amount, req.Currency))
 
+	organizerID, err := resolveOrganizer(ctx, req.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.organizers.RequireActive(organizerID)
+	if err != nil {
+		return nil, err
+	}
+	req.OrganizerID = organizerID
+	breakdown := s.fees.Quote(org, req.Amount)
+
+	if req.Card != nil && !s.rawCards {
+		s.log.LogSecurity("RAW_CARD_REJECTED", fmt.Sprintf("Payment %s sent raw card data outside test mode", req.PaymentID))
+		return nil, ErrRawCardData
+	}
+	if req.Token != "" && !utils.IsCardToken(req.Token) {
+		return nil, ErrInvalidCardToken
+	}
+	// Check the itemization before charging; the local record stores it
+	lineItems, err := itemize(req.LineItems, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if req.TicketCount == 0 {
+		req.TicketCount = ticketCount(lineItems)
+	}
+
 	var paymentMethod string
+	var card *stripe.PaymentMethodCard
 	if req.Token != "" {
 		paymentMethod = req.Token
 		s.log.LogPayment("STRIPE", req.PaymentID, "Using provided token/payment method ID")
 	} else if req.Card != nil {
-		// Legacy/test: create payment method from card
+		// Test mode only: create payment method from card
+		result := cardcheck.Validate(cardcheck.Details{
+			Number:   req.Card.Number,
+			ExpMonth: req.Card.ExpMonth,
+			ExpYear:  req.Card.ExpYear,
+			CVC:      req.Card.CVC,
+		}, time.Now())
+		if !result.Valid() {
+			s.log.LogPayment("STRIPE", req.PaymentID, fmt.Sprintf("Card failed local validation: %s", result.Error()))
+			return nil, fmt.Errorf("%w: %s", ErrCardValidationFailed, result.Error())
+		}
+
 		pmParams := &stripe.PaymentMethodParams{
 			Type: stripe.String("card"),
 			Card: &stripe.PaymentMethodCardParams{
 				Number:   stripe.String(req.Card.Number),
-				ExpMonth: stripe.Int64(parseStringToInt64(req.Card.ExpMonth)),
-				ExpYear:  stripe.Int64(parseStringToInt64(req.Card.ExpYear)),
+				ExpMonth: stripe.Int64(result.ExpMonth),
+				ExpYear:  stripe.Int64(result.ExpYear),
 				CVC:      stripe.String(req.Card.CVC),
 			},
 		}
@@ -138,16 +234,39 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 		pm, err := s.client.PaymentMethods.New(pmParams)
 		if err != nil {
 			s.log.Error("STRIPE", fmt.Sprintf("Failed to create payment method: %v", err))
-			return nil, fmt.Errorf("%w: %v", ErrStripeAPIError, err)
+			return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
 		}
 		paymentMethod = pm.ID
+		card = pm.Card
 		s.log.LogPayment("STRIPE", req.PaymentID, fmt.Sprintf("Payment method created: %s", pm.ID))
 	} else {
-		return nil, fmt.Errorf("%w: no payment method provided", ErrStripeAPIError)
+		return nil, apperror.New(apperror.InvalidRequest, "a token or card is required")
+	}
+
+	billingCountry := req.BillingCountry
+	if billingCountry == "" && req.Card != nil && req.Card.Address != nil {
+		billingCountry = req.Card.Address.Country
+	}
+	fingerprint, cardCountry := s.cardSignals(ctx, paymentMethod, card)
+	assessment := s.risk.Assess(ctx, risk.Input{
+		PaymentID:       req.PaymentID,
+		OrganizerID:     organizerID,
+		OrderID:         req.OrderID,
+		Email:           req.Email,
+		CardFingerprint: fingerprint,
+		BillingCountry:  billingCountry,
+		CardCountry:     cardCountry,
+		Amount:          req.Amount,
+		Currency:        strings.ToLower(req.Currency),
+		TicketCount:     req.TicketCount,
+	})
+	if assessment.Decision == models.DecisionDeny {
+		return nil, ErrRiskDenied
 	}
+	held := assessment.Decision == models.DecisionReview
 
-	// Convert amount to cents (Stripe uses smallest currency unit)
-	amountInCents := int64(req.Amount * 100)
+	// Stripe charges in the currency's smallest unit
+	amountInCents := models.ToMinor(req.Amount, req.Currency)
 	metadata := make(map[string]string)
 	metadata["payment_id"] = req.PaymentID
 	metadata["order_id"] = req.OrderID
@@ -156,6 +275,8 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 	for k, v := range req.Metadata {
 		metadata[k] = v
 	}
+	// Set last so request metadata cannot move the payment to another tenant
+	metadata["organizer_id"] = req.OrganizerID
 
 	// Create a payment intent
 	piParams := &stripe.PaymentIntentParams{
@@ -168,15 +289,61 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 		Confirm:            stripe.Bool(true),
 		PaymentMethodTypes: []*string{stripe.String("card")},
 	}
+	if req.ReturnURL != "" {
+		piParams.ReturnURL = stripe.String(req.ReturnURL)
+	}
+	if req.CustomerID != "" {
+		piParams.Customer = stripe.String(req.CustomerID)
+		if req.OffSession {
+			// Merchant-initiated: Stripe applies the mandate from the
+			// SetupIntent instead of challenging the absent customer
+			piParams.OffSession = stripe.Bool(true)
+		} else if req.SavePaymentMethod {
+			piParams.SetupFutureUsage = stripe.String(string(stripe.PaymentIntentSetupFutureUsageOffSession))
+		}
+	}
+
+	// Held payments are only authorized; the review captures or cancels
+	// them. Card networks release uncaptured authorizations after about
+	// seven days.
+	if held {
+		piParams.CaptureMethod = stripe.String(string(stripe.PaymentIntentCaptureMethodManual))
+	}
+
+	// Organizers on the destination schedule are paid by Stripe as each
+	// charge settles, and Evently keeps its commission as the application
+	// fee. Everyone else is paid by scheduled payouts from the platform
+	// balance, so a reserve can be held until their event has taken place.
+	if org.StripeAccountID != "" {
+		if org.PayoutSchedule == models.PayoutDestination {
+			piParams.TransferData = &stripe.PaymentIntentTransferDataParams{
+				Destination: stripe.String(org.StripeAccountID),
+			}
+			if breakdown.Fee > 0 {
+				piParams.ApplicationFeeAmount = stripe.Int64(models.ToMinor(breakdown.Fee, req.Currency))
+			}
+		} else {
+			piParams.TransferGroup = stripe.String(organizerID)
+		}
+	}
 
 	s.log.LogPayment("STRIPE", req.PaymentID, "Creating payment intent")
 	pi, err := s.client.PaymentIntents.New(piParams)
 	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.Code == stripe.ErrorCodeAuthenticationRequired && stripeErr.PaymentIntent != nil {
+			s.log.LogPayment("STRIPE", req.PaymentID, fmt.Sprintf("Off-session charge needs authentication (intent %s)", stripeErr.PaymentIntent.ID))
+			return nil, fmt.Errorf("%w: intent %s", ErrAuthenticationRequired, stripeErr.PaymentIntent.ID)
+		}
 		s.log.Error("STRIPE", fmt.Sprintf("Failed to create payment intent: %v", err))
-		return nil, fmt.Errorf("%w: %v", ErrStripeAPIError, err)
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
 	}
 	s.log.LogPayment("STRIPE", req.PaymentID, fmt.Sprintf("Payment intent created: %s", pi.ID))
 
+	if err := s.fees.RecordCommission(req.PaymentID, breakdown); err != nil {
+		s.log.Error("FEES", fmt.Sprintf("Failed to record commission for payment %s: %v", req.PaymentID, err))
+	}
+
 	// Handle payment intent status
 	var status models.PaymentStatus
 	switch pi.Status {
@@ -189,6 +356,9 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 	case stripe.PaymentIntentStatusRequiresAction:
 		status = models.StatusPending
 		s.log.LogPayment("STRIPE", req.PaymentID, "Payment requires further action")
+	case stripe.PaymentIntentStatusRequiresCapture:
+		status = models.StatusInReview
+		s.log.LogPayment("STRIPE", req.PaymentID, "Payment authorized and held for review")
 	default:
 		status = models.StatusFailed
 		s.log.LogPayment("STRIPE", req.PaymentID, fmt.Sprintf("Payment failed with status: %s", pi.Status))
@@ -197,33 +367,175 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req *models.StripePa
 	// Create response
 	response := &models.StripePaymentResponse{
 		PaymentID:     req.PaymentID,
+		OrganizerID:   req.OrganizerID,
 		OrderID:       req.OrderID,
 		Status:        status,
-		Amount:        float64(pi.Amount) / 100.0, // Convert back from cents
+		Amount:        models.FromMinor(pi.Amount, string(pi.Currency)),
 		Currency:      string(pi.Currency),
 		TransactionID: pi.ID,
 		PaymentMethod: paymentMethod,
 		Created:       pi.Created,
+		PlatformFee:   breakdown.Fee,
+		ClientSecret:  pi.ClientSecret,
+		NextAction:    nextAction(pi),
 	}
 
-	if pi.LatestCharge != nil && pi.LatestCharge.ID != "" {
-		charge, err := s.client.Charges.Get(pi.LatestCharge.ID, nil)
-		if err == nil && charge.ReceiptURL != "" {
-			response.ReceiptURL = charge.ReceiptURL
-		}
+	var fee int64
+	response.ReceiptURL, fee = s.chargeDetails(pi)
+
+	if status == models.StatusSuccess {
+		s.recordCapture(req, response, fee, destinationCharge(pi))
+	}
+	if held && (status == models.StatusPending || status == models.StatusInReview) {
+		// Still held after 3-D Secure, so the local record starts in review
+		response.Status = models.StatusInReview
+		s.risk.Hold(ctx, &models.Payment{
+			PaymentID:     req.PaymentID,
+			OrganizerID:   req.OrganizerID,
+			OrderID:       req.OrderID,
+			Price:         response.Amount,
+			Currency:      response.Currency,
+			TransactionID: pi.ID,
+		}, assessment)
 	}
 
 	return response, nil
 }
 
-// RefundPayment refunds a payment through Stripe
-func (s *StripeService) RefundPayment(ctx context.Context, req *models.StripeRefundRequest) (*models.Payment, error) {
+// cardSignals returns the card fingerprint and issuing country for risk
+// scoring. Lookups are best effort: a card that cannot be loaded is scored
+// without them.
+func (s *StripeService) cardSignals(ctx context.Context, paymentMethod string, card *stripe.PaymentMethodCard) (string, string) {
+	if card != nil {
+		return card.Fingerprint, card.Country
+	}
+
+	switch {
+	case strings.HasPrefix(paymentMethod, "pm_"):
+		pm, err := s.GetPaymentMethod(ctx, paymentMethod)
+		if err != nil || pm.Card == nil {
+			return "", ""
+		}
+		return pm.Card.Fingerprint, pm.Card.Country
+	case strings.HasPrefix(paymentMethod, "tok_"):
+		params := &stripe.TokenParams{}
+		params.Context = ctx
+		token, err := s.client.Tokens.Get(paymentMethod, params)
+		if err != nil || token.Card == nil {
+			s.log.Warn("STRIPE", fmt.Sprintf("Failed to load token %s for risk scoring: %v", paymentMethod, err))
+			return "", ""
+		}
+		return token.Card.Fingerprint, token.Card.Country
+	}
+	return "", ""
+}
+
+// CaptureHeldPayment captures an intent authorized while its payment was
+// held for review and posts it to the ledger.
+func (s *StripeService) CaptureHeldPayment(ctx context.Context, paymentIntentID string) (*models.StripePaymentResponse, error) {
+	s.log.LogPayment("CAPTURE", paymentIntentID, "Capturing payment approved in review")
+
+	params := &stripe.PaymentIntentCaptureParams{}
+	params.Context = ctx
+	pi, err := s.client.PaymentIntents.Capture(paymentIntentID, params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to capture payment intent %s: %v", paymentIntentID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return s.settleIntent(pi), nil
+}
+
+// chargeDetails returns the receipt URL and Stripe's processing fee, in
+// minor units, for the intent's latest charge.
+func (s *StripeService) chargeDetails(pi *stripe.PaymentIntent) (string, int64) {
+	if pi.LatestCharge == nil || pi.LatestCharge.ID == "" {
+		return "", 0
+	}
+
+	params := &stripe.ChargeParams{}
+	params.AddExpand("balance_transaction")
+	charge, err := s.client.Charges.Get(pi.LatestCharge.ID, params)
+	if err != nil {
+		s.log.Warn("STRIPE", fmt.Sprintf("Failed to load charge %s: %v", pi.LatestCharge.ID, err))
+		return "", 0
+	}
+
+	var fee int64
+	if charge.BalanceTransaction != nil {
+		fee = charge.BalanceTransaction.Fee
+	}
+	return charge.ReceiptURL, fee
+}
+
+// destinationCharge reports whether Stripe sends the intent's funds
+// straight to a Connect account
+func destinationCharge(pi *stripe.PaymentIntent) bool {
+	return pi.TransferData != nil && pi.TransferData.Destination != nil
+}
+
+// recordCapture posts the captured amount, Stripe's fee and Evently's
+// commission to the ledger. A destination charge has already paid the
+// organizer their share, so that is posted as paid out.
+func (s *StripeService) recordCapture(req *models.StripePaymentRequest, resp *models.StripePaymentResponse, fee int64, destination bool) {
+	movement := ledger.Movement{
+		PaymentID:   req.PaymentID,
+		OrderID:     req.OrderID,
+		OrganizerID: req.OrganizerID,
+		Currency:    resp.Currency,
+		Amount:      resp.Amount,
+	}
+	if err := s.ledger.PaymentCaptured(movement); err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post capture for payment %s: %v", req.PaymentID, err))
+	}
+
+	if fee > 0 {
+		movement.Amount = models.FromMinor(fee, resp.Currency)
+		if err := s.ledger.ProcessorFee(movement); err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post processor fee for payment %s: %v", req.PaymentID, err))
+		}
+	}
+
+	payment := &models.Payment{
+		PaymentID:   req.PaymentID,
+		OrderID:     req.OrderID,
+		OrganizerID: req.OrganizerID,
+		Price:       resp.Amount,
+	}
+	if err := s.fees.PostCommission(payment, resp.Currency); err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post commission for payment %s: %v", req.PaymentID, err))
+	}
+
+	if destination {
+		commission, _, err := s.fees.totals(req.PaymentID)
+		if err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to load commission for payment %s: %v", req.PaymentID, err))
+			return
+		}
+		movement.Amount = roundMinor(resp.Amount - commission)
+		movement.Reference = "payout.sent:" + req.PaymentID
+		if err := s.ledger.PayoutSent(movement); err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post destination transfer for payment %s: %v", req.PaymentID, err))
+		}
+	}
+}
+
+// RefundPayment refunds a payment through Stripe. The returned refund carries
+// the Stripe refund ID and the gateway payment ID from the intent metadata.
+func (s *StripeService) RefundPayment(ctx context.Context, req *models.StripeRefundRequest) (*models.Refund, error) {
 	s.log.LogPayment("REFUND", req.PaymentID, "Processing Stripe refund")
 
-	// Fetch the original payment to get the Stripe transaction ID
-	// This would typically come from your database
-	// For now, assuming req.PaymentID is the Stripe payment intent ID
+	// req.PaymentID is the Stripe payment intent ID. Load it first so the
+	// refund can be checked against the caller's organizer.
 	paymentIntentID := req.PaymentID
+	pi, err := s.GetPaymentIntent(ctx, paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	organizerID := pi.Metadata["organizer_id"]
+	if !tenant.CanAccess(ctx, organizerID) {
+		s.log.LogSecurity("TENANT_DENIED", fmt.Sprintf("Refund of intent %s requested outside its organizer scope", paymentIntentID))
+		return nil, ErrPaymentNotFound
+	}
 
 	// Create refund parameters
 	params := &stripe.RefundParams{
@@ -231,9 +543,16 @@ func (s *StripeService) RefundPayment(ctx context.Context, req *models.StripeRef
 		Reason:        stripe.String(string(stripe.RefundReasonRequestedByCustomer)),
 	}
 
+	// Intents created as destination charges: pull the money back from the
+	// organizer's account and return the matching share of the application fee.
+	if destinationCharge(pi) {
+		params.ReverseTransfer = stripe.Bool(true)
+		params.RefundApplicationFee = stripe.Bool(true)
+	}
+
 	// If a specific amount is provided, add it to the refund request
 	if req.Amount != nil {
-		amountInCents := int64(*req.Amount * 100)
+		amountInCents := models.ToMinor(*req.Amount, string(pi.Currency))
 		params.Amount = stripe.Int64(amountInCents)
 		s.log.LogPayment("REFUND", req.PaymentID, fmt.Sprintf("Refunding partial amount: %.2f", *req.Amount))
 	} else {
@@ -244,22 +563,79 @@ func (s *StripeService) RefundPayment(ctx context.Context, req *models.StripeRef
 	refundObj, err := s.client.Refunds.New(params)
 	if err != nil {
 		s.log.Error("STRIPE", fmt.Sprintf("Refund failed: %v", err))
-		return nil, fmt.Errorf("%w: %v", ErrStripeAPIError, err)
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
 	}
 
 	s.log.LogPayment("REFUND", req.PaymentID, fmt.Sprintf("Refund successful, refund ID: %s", refundObj.ID))
 
 	// Create a payment object with the refund details
 	// In a real implementation, you would update your database record
-	refundedAmount := float64(refundObj.Amount) / 100.0
-	payment := &models.Payment{
-		PaymentID: req.PaymentID,
-		Status:    models.StatusRefunded,
-		Price:     refundedAmount,
-		Date:      time.Now(),
+	refundedAmount := models.FromMinor(refundObj.Amount, string(refundObj.Currency))
+	err = s.ledger.PaymentRefunded(ledger.Movement{
+		PaymentID:   pi.Metadata["payment_id"],
+		OrderID:     pi.Metadata["order_id"],
+		OrganizerID: organizerID,
+		Currency:    string(refundObj.Currency),
+		Amount:      refundedAmount,
+		Reference:   "payment.refunded:" + refundObj.ID,
+	})
+	if err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to post refund %s: %v", refundObj.ID, err))
 	}
 
-	return payment, nil
+	original := &models.Payment{
+		PaymentID:   pi.Metadata["payment_id"],
+		OrderID:     pi.Metadata["order_id"],
+		OrganizerID: organizerID,
+		Price:       models.FromMinor(pi.Amount, string(pi.Currency)),
+	}
+	reversedFee, err := s.fees.ReverseForRefund(original, string(refundObj.Currency), refundedAmount, refundObj.ID)
+	if err != nil {
+		s.log.Error("LEDGER", fmt.Sprintf("Failed to reverse commission for refund %s: %v", refundObj.ID, err))
+	}
+	// The transfer reversal takes the refund back from the organizer, who
+	// gets the returned share of the application fee
+	if destinationCharge(pi) {
+		err = s.ledger.PayoutReversed(ledger.Movement{
+			PaymentID:   original.PaymentID,
+			OrderID:     original.OrderID,
+			OrganizerID: organizerID,
+			Currency:    string(refundObj.Currency),
+			Amount:      roundMinor(refundedAmount - reversedFee),
+			Reference:   "payout.reversed:" + refundObj.ID,
+		})
+		if err != nil {
+			s.log.Error("LEDGER", fmt.Sprintf("Failed to post transfer reversal for refund %s: %v", refundObj.ID, err))
+		}
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = string(refundObj.Reason)
+	}
+	refund := &models.Refund{
+		ID:          refundObj.ID,
+		PaymentID:   pi.Metadata["payment_id"],
+		OrganizerID: organizerID,
+		Amount:      refundedAmount,
+		Currency:    string(refundObj.Currency),
+		Reason:      reason,
+		CreatedAt:   time.Unix(refundObj.Created, 0),
+	}
+	s.audit.Record(ctx, &models.AuditEntry{
+		Action:      models.AuditStripeRefunded,
+		TargetType:  "refund",
+		TargetID:    refund.ID,
+		PaymentID:   refund.PaymentID,
+		OrderID:     pi.Metadata["order_id"],
+		OrganizerID: organizerID,
+	}, map[string]interface{}{
+		"payment_intent": paymentIntentID,
+		"status":         pi.Status,
+		"amount":         models.FromMinor(pi.Amount, string(pi.Currency)),
+	}, refund)
+
+	return refund, nil
 }
 
 // GetPaymentDetails retrieves payment details from Stripe
@@ -269,53 +645,469 @@ func (s *StripeService) GetPaymentDetails(ctx context.Context, paymentIntentID s
 	pi, err := s.client.PaymentIntents.Get(paymentIntentID, nil)
 	if err != nil {
 		s.log.Error("STRIPE", fmt.Sprintf("Failed to retrieve payment intent: %v", err))
-		return nil, fmt.Errorf("%w: %v", ErrStripeAPIError, err)
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	if !tenant.CanAccess(ctx, pi.Metadata["organizer_id"]) {
+		s.log.LogSecurity("TENANT_DENIED", fmt.Sprintf("Intent %s requested outside its organizer scope", paymentIntentID))
+		return nil, ErrPaymentNotFound
 	}
 
-	// Map Stripe status to our status
-	var status models.PaymentStatus
-	switch pi.Status {
+	response := intentResponse(pi)
+	response.ReceiptURL, _ = s.chargeDetails(pi)
+
+	return response, nil
+}
+
+// ConfirmPayment re-confirms an intent after the customer has completed
+// 3-D Secure. Intents that no longer need confirming are returned as they
+// are, so the frontend can also use this to poll for the final status.
+func (s *StripeService) ConfirmPayment(ctx context.Context, paymentIntentID, returnURL string) (*models.StripePaymentResponse, error) {
+	pi, err := s.GetPaymentIntent(ctx, paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.CanAccess(ctx, pi.Metadata["organizer_id"]) {
+		s.log.LogSecurity("TENANT_DENIED", fmt.Sprintf("Confirmation of intent %s requested outside its organizer scope", paymentIntentID))
+		return nil, ErrPaymentNotFound
+	}
+
+	if pi.Status == stripe.PaymentIntentStatusRequiresConfirmation {
+		before := pi.Status
+		params := &stripe.PaymentIntentConfirmParams{}
+		if returnURL != "" {
+			params.ReturnURL = stripe.String(returnURL)
+		}
+		params.Context = ctx
+
+		s.log.LogPayment("CONFIRM", paymentIntentID, "Re-confirming payment intent after authentication")
+		pi, err = s.client.PaymentIntents.Confirm(paymentIntentID, params)
+		if err != nil {
+			s.log.Error("STRIPE", fmt.Sprintf("Failed to confirm payment intent %s: %v", paymentIntentID, err))
+			return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+		}
+		s.audit.Record(ctx, &models.AuditEntry{
+			Action:      models.AuditStripeConfirmed,
+			TargetType:  "payment_intent",
+			TargetID:    pi.ID,
+			PaymentID:   pi.Metadata["payment_id"],
+			OrderID:     pi.Metadata["order_id"],
+			OrganizerID: pi.Metadata["organizer_id"],
+		}, map[string]interface{}{"status": before}, map[string]interface{}{"status": pi.Status})
+	}
+	s.log.LogPayment("CONFIRM", paymentIntentID, fmt.Sprintf("Payment intent status: %s", pi.Status))
+
+	return s.settleIntent(pi), nil
+}
+
+// IsPaymentIntentEvent reports whether a webhook event settles a PaymentIntent.
+func IsPaymentIntentEvent(eventType stripe.EventType) bool {
+	switch eventType {
+	case stripe.EventTypePaymentIntentSucceeded,
+		stripe.EventTypePaymentIntentPaymentFailed,
+		stripe.EventTypePaymentIntentCanceled:
+		return true
+	}
+	return false
+}
+
+// HandleIntentEvent applies a payment_intent webhook, posting the capture to
+// the ledger when the intent has succeeded.
+func (s *StripeService) HandleIntentEvent(event stripe.Event) (*models.StripePaymentResponse, error) {
+	var pi stripe.PaymentIntent
+	if err := json.Unmarshal(event.Data.Raw, &pi); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payment intent: %v", ErrInvalidWebhook, err)
+	}
+	s.log.LogPayment("WEBHOOK", pi.ID, fmt.Sprintf("%s (status: %s)", event.Type, pi.Status))
+
+	return s.settleIntent(&pi), nil
+}
+
+// RecordIntentCapture posts the ledger entries for an intent that succeeded
+// after the initial request, such as once 3-D Secure completed. Entries are
+// keyed by payment so repeated calls are harmless.
+func (s *StripeService) RecordIntentCapture(pi *stripe.PaymentIntent) {
+	if pi.Status != stripe.PaymentIntentStatusSucceeded {
+		return
+	}
+
+	req := &models.StripePaymentRequest{
+		PaymentID:   pi.Metadata["payment_id"],
+		OrganizerID: pi.Metadata["organizer_id"],
+		OrderID:     pi.Metadata["order_id"],
+	}
+	if req.PaymentID == "" {
+		s.log.Warn("STRIPE", fmt.Sprintf("Intent %s has no payment_id metadata, skipping ledger capture", pi.ID))
+		return
+	}
+
+	resp := intentResponse(pi)
+	var fee int64
+	resp.ReceiptURL, fee = s.chargeDetails(pi)
+	s.recordCapture(req, resp, fee, destinationCharge(pi))
+}
+
+func (s *StripeService) settleIntent(pi *stripe.PaymentIntent) *models.StripePaymentResponse {
+	s.RecordIntentCapture(pi)
+	return intentResponse(pi)
+}
+
+// intentStatus maps a PaymentIntent status to our payment status. Intents
+// still waiting on the customer or the bank stay pending; only payments held
+// for risk review are authorized without capture.
+func intentStatus(status stripe.PaymentIntentStatus) models.PaymentStatus {
+	switch status {
 	case stripe.PaymentIntentStatusSucceeded:
-		status = models.StatusSuccess
-	case stripe.PaymentIntentStatusProcessing:
-		status = models.StatusPending
+		return models.StatusSuccess
+	case stripe.PaymentIntentStatusProcessing,
+		stripe.PaymentIntentStatusRequiresAction,
+		stripe.PaymentIntentStatusRequiresConfirmation:
+		return models.StatusPending
+	case stripe.PaymentIntentStatusRequiresCapture:
+		return models.StatusInReview
 	case stripe.PaymentIntentStatusCanceled:
-		status = models.StatusCancelled
+		return models.StatusCancelled
 	default:
-		status = models.StatusFailed
-	}
-
-	// Extract order ID from metadata
-	orderID := ""
-	if val, ok := pi.Metadata["order_id"]; ok {
-		orderID = val
+		return models.StatusFailed
 	}
+}
 
-	// Extract payment ID from metadata
-	paymentID := ""
-	if val, ok := pi.Metadata["payment_id"]; ok {
-		paymentID = val
-	} else {
-		paymentID = paymentIntentID // Use Stripe ID as fallback
+func intentResponse(pi *stripe.PaymentIntent) *models.StripePaymentResponse {
+	paymentID := pi.Metadata["payment_id"]
+	if paymentID == "" {
+		paymentID = pi.ID // Use Stripe ID as fallback
 	}
 
 	response := &models.StripePaymentResponse{
 		PaymentID:     paymentID,
-		OrderID:       orderID,
-		Status:        status,
-		Amount:        float64(pi.Amount) / 100.0,
+		OrganizerID:   pi.Metadata["organizer_id"],
+		OrderID:       pi.Metadata["order_id"],
+		Status:        intentStatus(pi.Status),
+		Amount:        models.FromMinor(pi.Amount, string(pi.Currency)),
 		Currency:      string(pi.Currency),
 		TransactionID: pi.ID,
-		PaymentMethod: pi.PaymentMethod.ID,
 		Created:       pi.Created,
+		NextAction:    nextAction(pi),
+	}
+	if pi.PaymentMethod != nil {
+		response.PaymentMethod = pi.PaymentMethod.ID
 	}
+	if response.NextAction != nil {
+		response.ClientSecret = pi.ClientSecret
+	}
+	return response
+}
 
-	if pi.LatestCharge != nil && pi.LatestCharge.ID != "" {
-		charge, err := s.client.Charges.Get(pi.LatestCharge.ID, nil)
-		if err == nil && charge.ReceiptURL != "" {
-			response.ReceiptURL = charge.ReceiptURL
-		}
+// nextAction describes what the customer must do to authenticate, or nil
+// when the intent is not waiting on them.
+func nextAction(pi *stripe.PaymentIntent) *models.StripeNextAction {
+	if pi.Status != stripe.PaymentIntentStatusRequiresAction || pi.NextAction == nil {
+		return nil
 	}
 
-	return response, nil
+	action := &models.StripeNextAction{
+		Type:         string(pi.NextAction.Type),
+		UseStripeSDK: pi.NextAction.UseStripeSDK != nil,
+	}
+	if redirect := pi.NextAction.RedirectToURL; redirect != nil {
+		action.RedirectURL = redirect.URL
+		action.ReturnURL = redirect.ReturnURL
+	}
+	return action
+}
+
+// GetPaymentIntent retrieves the raw PaymentIntent so callers can act on the
+// exact Stripe status rather than the mapped PaymentStatus.
+func (s *StripeService) GetPaymentIntent(ctx context.Context, paymentIntentID string) (*stripe.PaymentIntent, error) {
+	pi, err := s.client.PaymentIntents.Get(paymentIntentID, nil)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to retrieve payment intent %s: %v", paymentIntentID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return pi, nil
+}
+
+// CancelPaymentIntent cancels a PaymentIntent that has not been completed.
+func (s *StripeService) CancelPaymentIntent(ctx context.Context, paymentIntentID string, reason stripe.PaymentIntentCancellationReason) error {
+	s.log.LogPayment("CANCEL", paymentIntentID, fmt.Sprintf("Cancelling payment intent, reason: %s", reason))
+
+	params := &stripe.PaymentIntentCancelParams{
+		CancellationReason: stripe.String(string(reason)),
+	}
+	if _, err := s.client.PaymentIntents.Cancel(paymentIntentID, params); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to cancel payment intent %s: %v", paymentIntentID, err))
+		return fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return nil
+}
+
+// CreateTransfer moves funds from the platform balance to a Connect
+// account. The idempotency key should name what is being paid rather than
+// the attempt, so a retried payout is never sent twice.
+func (s *StripeService) CreateTransfer(ctx context.Context, payoutID, idempotencyKey, destination, currency string, amount float64) (string, error) {
+	params := &stripe.TransferParams{
+		Amount:      stripe.Int64(models.ToMinor(amount, currency)),
+		Currency:    stripe.String(currency),
+		Destination: stripe.String(destination),
+		Metadata:    map[string]string{"payout_id": payoutID},
+	}
+	params.Context = ctx
+	params.SetIdempotencyKey(idempotencyKey)
+
+	transfer, err := s.client.Transfers.New(params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Transfer for payout %s failed: %v", payoutID, err))
+		return "", fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+
+	s.log.LogPayment("TRANSFER", payoutID, fmt.Sprintf("Transferred %.2f %s to %s (%s)", amount, currency, destination, transfer.ID))
+	return transfer.ID, nil
+}
+
+// CreateCustomer creates a Stripe Customer for one of our users. The user ID
+// is the idempotency key so a retried request cannot create a second one.
+func (s *StripeService) CreateCustomer(ctx context.Context, req *models.CustomerRequest) (*stripe.Customer, error) {
+	params := &stripe.CustomerParams{
+		Metadata: map[string]string{"user_id": req.UserID},
+	}
+	if req.Email != "" {
+		params.Email = stripe.String(req.Email)
+	}
+	if req.Name != "" {
+		params.Name = stripe.String(req.Name)
+	}
+	params.Context = ctx
+	params.SetIdempotencyKey("customer:" + req.UserID)
+
+	customer, err := s.client.Customers.New(params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to create customer for user %s: %v", req.UserID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return customer, nil
+}
+
+// UpdateCustomer updates a customer's contact details. Empty values are
+// left unchanged.
+func (s *StripeService) UpdateCustomer(ctx context.Context, customerID, email, name string) error {
+	params := &stripe.CustomerParams{}
+	if email != "" {
+		params.Email = stripe.String(email)
+	}
+	if name != "" {
+		params.Name = stripe.String(name)
+	}
+	params.Context = ctx
+
+	if _, err := s.client.Customers.Update(customerID, params); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to update customer %s: %v", customerID, err))
+		return fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return nil
+}
+
+// DefaultPaymentMethod returns the ID of the customer's default payment
+// method, or "" when none is set.
+func (s *StripeService) DefaultPaymentMethod(ctx context.Context, customerID string) (string, error) {
+	params := &stripe.CustomerParams{}
+	params.Context = ctx
+
+	customer, err := s.client.Customers.Get(customerID, params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to retrieve customer %s: %v", customerID, err))
+		return "", fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	if customer.InvoiceSettings == nil || customer.InvoiceSettings.DefaultPaymentMethod == nil {
+		return "", nil
+	}
+	return customer.InvoiceSettings.DefaultPaymentMethod.ID, nil
+}
+
+// SetDefaultPaymentMethod makes paymentMethodID the card charged when a
+// payment names the customer but no card.
+func (s *StripeService) SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) error {
+	params := &stripe.CustomerParams{
+		InvoiceSettings: &stripe.CustomerInvoiceSettingsParams{
+			DefaultPaymentMethod: stripe.String(paymentMethodID),
+		},
+	}
+	params.Context = ctx
+
+	if _, err := s.client.Customers.Update(customerID, params); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to set default payment method for customer %s: %v", customerID, err))
+		return fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return nil
+}
+
+// CreateSetupIntent starts saving a card on the customer. Usage is
+// off_session so the card is authenticated once, up front, for later
+// merchant-initiated charges.
+func (s *StripeService) CreateSetupIntent(ctx context.Context, customerID, userID string) (*stripe.SetupIntent, error) {
+	params := &stripe.SetupIntentParams{
+		Customer:           stripe.String(customerID),
+		Usage:              stripe.String(string(stripe.SetupIntentUsageOffSession)),
+		PaymentMethodTypes: []*string{stripe.String("card")},
+		Metadata:           map[string]string{"user_id": userID},
+	}
+	params.Context = ctx
+
+	intent, err := s.client.SetupIntents.New(params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to create setup intent for customer %s: %v", customerID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return intent, nil
+}
+
+// ListCustomerPaymentMethods pages through the cards saved on a customer.
+func (s *StripeService) ListCustomerPaymentMethods(ctx context.Context, customerID string) ([]*stripe.PaymentMethod, error) {
+	params := &stripe.PaymentMethodListParams{
+		Customer: stripe.String(customerID),
+		Type:     stripe.String(string(stripe.PaymentMethodTypeCard)),
+	}
+	params.Context = ctx
+	params.Limit = stripe.Int64(100)
+
+	var methods []*stripe.PaymentMethod
+	iter := s.client.PaymentMethods.List(params)
+	for iter.Next() {
+		methods = append(methods, iter.PaymentMethod())
+	}
+	if err := iter.Err(); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to list payment methods for customer %s: %v", customerID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return methods, nil
+}
+
+func (s *StripeService) GetPaymentMethod(ctx context.Context, paymentMethodID string) (*stripe.PaymentMethod, error) {
+	params := &stripe.PaymentMethodParams{}
+	params.Context = ctx
+
+	pm, err := s.client.PaymentMethods.Get(paymentMethodID, params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to retrieve payment method %s: %v", paymentMethodID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return pm, nil
+}
+
+// DetachPaymentMethod removes a saved card from its customer.
+func (s *StripeService) DetachPaymentMethod(ctx context.Context, paymentMethodID string) error {
+	params := &stripe.PaymentMethodDetachParams{}
+	params.Context = ctx
+
+	if _, err := s.client.PaymentMethods.Detach(paymentMethodID, params); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to detach payment method %s: %v", paymentMethodID, err))
+		return fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return nil
+}
+
+// ConstructWebhookEvent verifies a webhook payload against the
+// Stripe-Signature header and decodes the event.
+func (s *StripeService) ConstructWebhookEvent(payload []byte, signature string) (stripe.Event, error) {
+	if s.webhookSecret == "" {
+		return stripe.Event{}, ErrWebhookNotConfigured
+	}
+
+	event, err := webhook.ConstructEventWithOptions(payload, signature, s.webhookSecret, webhook.ConstructEventOptions{
+		// Events are pinned to the account's API version, which may lag the library
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		s.log.LogSecurity("WEBHOOK_REJECTED", fmt.Sprintf("Stripe webhook failed verification: %v", err))
+		return stripe.Event{}, fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+	return event, nil
+}
+
+// UploadDisputeFile uploads a document to Stripe for use as dispute
+// evidence and returns the file ID.
+func (s *StripeService) UploadDisputeFile(ctx context.Context, filename string, r io.Reader) (string, error) {
+	params := &stripe.FileParams{
+		FileReader: r,
+		Filename:   stripe.String(filename),
+		Purpose:    stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+	}
+	params.Context = ctx
+
+	file, err := s.client.Files.New(params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to upload dispute file %s: %v", filename, err))
+		return "", fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+	return file.ID, nil
+}
+
+// UpdateDisputeEvidence stages evidence on a dispute, or submits it to the
+// bank when submit is true.
+func (s *StripeService) UpdateDisputeEvidence(ctx context.Context, disputeID string, evidence *stripe.DisputeEvidenceParams, submit bool) (*stripe.Dispute, error) {
+	params := &stripe.DisputeParams{
+		Evidence: evidence,
+		Submit:   stripe.Bool(submit),
+	}
+	params.Context = ctx
+
+	dispute, err := s.client.Disputes.Update(disputeID, params)
+	if err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to update evidence for dispute %s: %v", disputeID, err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+
+	s.log.LogPayment("DISPUTE", disputeID, fmt.Sprintf("Evidence updated (submitted: %t)", submit))
+	return dispute, nil
+}
+
+// ListCharges pages through all charges created within [from, to), with the
+// PaymentIntent expanded so its metadata is available.
+func (s *StripeService) ListCharges(ctx context.Context, from, to time.Time) ([]*stripe.Charge, error) {
+	params := &stripe.ChargeListParams{
+		CreatedRange: &stripe.RangeQueryParams{
+			GreaterThanOrEqual: from.Unix(),
+			LesserThan:         to.Unix(),
+		},
+	}
+	params.Context = ctx
+	params.Limit = stripe.Int64(100)
+	params.AddExpand("data.payment_intent")
+
+	var charges []*stripe.Charge
+	iter := s.client.Charges.List(params)
+	for iter.Next() {
+		charges = append(charges, iter.Charge())
+	}
+	if err := iter.Err(); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to list charges: %v", err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+
+	s.log.LogPayment("LIST", "charges", fmt.Sprintf("Fetched %d charges from Stripe", len(charges)))
+	return charges, nil
+}
+
+// ListBalanceTransactions pages through all balance transactions created
+// within [from, to).
+func (s *StripeService) ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*stripe.BalanceTransaction, error) {
+	params := &stripe.BalanceTransactionListParams{
+		CreatedRange: &stripe.RangeQueryParams{
+			GreaterThanOrEqual: from.Unix(),
+			LesserThan:         to.Unix(),
+		},
+	}
+	params.Context = ctx
+	params.Limit = stripe.Int64(100)
+
+	var txns []*stripe.BalanceTransaction
+	iter := s.client.BalanceTransactions.List(params)
+	for iter.Next() {
+		txns = append(txns, iter.BalanceTransaction())
+	}
+	if err := iter.Err(); err != nil {
+		s.log.Error("STRIPE", fmt.Sprintf("Failed to list balance transactions: %v", err))
+		return nil, fmt.Errorf("%w: %w", ErrStripeAPIError, err)
+	}
+
+	s.log.LogPayment("LIST", "balance_transactions", fmt.Sprintf("Fetched %d balance transactions from Stripe", len(txns)))
+	return txns, nil
 }
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+func TestDestinationCaptureLeavesNothingToPayOut(t *testing.T) {
+	env := newTestEnv(t)
+	stripeService := &StripeService{log: env.log, ledger: env.ledger, fees: env.fees}
+	payouts := NewPayoutService(env.store, env.store, env.ledger, nil, nil, env.audit, config.PayoutConfig{}, env.log)
+
+	if err := env.fees.RecordCommission("pay_1", &models.FeeBreakdown{Fee: 10}); err != nil {
+		t.Fatal(err)
+	}
+	req := &models.StripePaymentRequest{PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1"}
+	resp := &models.StripePaymentResponse{Amount: 100, Currency: "usd"}
+
+	stripeService.recordCapture(req, resp, 0, true)
+	owed, err := payouts.owed("org_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owed["usd"] != 0 {
+		t.Errorf("owed after a destination charge = %d, want 0", owed["usd"])
+	}
+	if sent := env.store.entries("payout.sent"); len(sent) != 1 || sent[0].Lines[0].Debit != 9000 {
+		t.Errorf("destination transfer not posted as a 90.00 payout: %+v", sent)
+	}
+}
+
+func TestPlatformChargeIsOwedToOrganizer(t *testing.T) {
+	env := newTestEnv(t)
+	stripeService := &StripeService{log: env.log, ledger: env.ledger, fees: env.fees}
+	payouts := NewPayoutService(env.store, env.store, env.ledger, nil, nil, env.audit, config.PayoutConfig{}, env.log)
+
+	if err := env.fees.RecordCommission("pay_1", &models.FeeBreakdown{Fee: 10}); err != nil {
+		t.Fatal(err)
+	}
+	req := &models.StripePaymentRequest{PaymentID: "pay_1", OrderID: "order_1", OrganizerID: "org_1"}
+	resp := &models.StripePaymentResponse{Amount: 100, Currency: "usd"}
+
+	stripeService.recordCapture(req, resp, 0, false)
+	owed, err := payouts.owed("org_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owed["usd"] != 9000 {
+		t.Errorf("owed = %d, want 9000", owed["usd"])
+	}
+}
+
+func TestNextActionOnlyWhileAwaitingCustomer(t *testing.T) {
+	pi := &stripe.PaymentIntent{
+		ID:           "pi_1",
+		Status:       stripe.PaymentIntentStatusRequiresAction,
+		ClientSecret: "pi_1_secret",
+		Amount:       4000,
+		Currency:     "usd",
+		Metadata:     map[string]string{"payment_id": "pay_1"},
+		NextAction: &stripe.PaymentIntentNextAction{
+			Type:          stripe.PaymentIntentNextActionTypeRedirectToURL,
+			RedirectToURL: &stripe.PaymentIntentNextActionRedirectToURL{URL: "https://hooks.stripe.com/3ds", ReturnURL: "https://evently.test/done"},
+		},
+	}
+	resp := intentResponse(pi)
+	if resp.Status != models.StatusPending || resp.NextAction == nil || resp.ClientSecret != "pi_1_secret" {
+		t.Fatalf("awaiting 3-D Secure: %+v", resp)
+	}
+	if resp.NextAction.RedirectURL != "https://hooks.stripe.com/3ds" || resp.NextAction.ReturnURL != "https://evently.test/done" {
+		t.Errorf("next action = %+v", resp.NextAction)
+	}
+
+	// Once authenticated the client secret is no longer handed out
+	pi.Status = stripe.PaymentIntentStatusSucceeded
+	resp = intentResponse(pi)
+	if resp.Status != models.StatusSuccess || resp.NextAction != nil || resp.ClientSecret != "" {
+		t.Errorf("succeeded: %+v", resp)
+	}
+
+	for status, want := range map[stripe.PaymentIntentStatus]models.PaymentStatus{
+		stripe.PaymentIntentStatusRequiresConfirmation:  models.StatusPending,
+		stripe.PaymentIntentStatusProcessing:            models.StatusPending,
+		stripe.PaymentIntentStatusRequiresCapture:       models.StatusInReview,
+		stripe.PaymentIntentStatusCanceled:              models.StatusCancelled,
+		stripe.PaymentIntentStatusRequiresPaymentMethod: models.StatusFailed,
+	} {
+		if got := intentStatus(status); got != want {
+			t.Errorf("intentStatus(%s) = %s, want %s", status, got, want)
+		}
+	}
+}
+
+// fakeStripe serves one PaymentIntent that needs confirming after 3-D
+// Secure, and the charge it creates
+type fakeStripe struct {
+	mu        sync.Mutex
+	status    stripe.PaymentIntentStatus
+	confirmed int
+}
+
+func (f *fakeStripe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	intent := func() map[string]interface{} {
+		return map[string]interface{}{
+			"id": "pi_1", "object": "payment_intent", "status": f.status, "amount": 4000, "currency": "usd",
+			"client_secret": "pi_1_secret", "latest_charge": "ch_1",
+			"metadata": map[string]string{"payment_id": "pay_1", "order_id": "order_pay_1", "organizer_id": "org_1"},
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/payment_intents/pi_1":
+		_ = json.NewEncoder(w).Encode(intent())
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/payment_intents/pi_1/confirm":
+		f.confirmed++
+		f.status = stripe.PaymentIntentStatusSucceeded
+		_ = json.NewEncoder(w).Encode(intent())
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/charges/ch_1":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id": "ch_1", "object": "charge", "receipt_url": "https://pay.stripe.com/receipts/ch_1",
+			"balance_transaction": map[string]interface{}{"id": "txn_1", "object": "balance_transaction", "fee": 150},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "message": "no such route"}}`))
+	}
+}
+
+// stripeAgainst returns a StripeService whose API calls are served by fake
+func stripeAgainst(t *testing.T, env *testEnv, fake http.Handler) *StripeService {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	t.Setenv("STRIPE_SECRET_KEY", "sk_test_fake")
+	t.Setenv("STRIPE_API_BASE", server.URL)
+	stripeService, err := NewStripeService(env.log, env.ledger, env.organizers, env.fees, env.audit, nil, config.CardDataConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stripeService
+}
+
+func TestConfirmPaymentAfterAuthentication(t *testing.T) {
+	env := newTestEnv(t)
+	fake := &fakeStripe{status: stripe.PaymentIntentStatusRequiresConfirmation}
+	stripeService := stripeAgainst(t, env, fake)
+	if err := env.fees.RecordCommission("pay_1", &models.FeeBreakdown{Fee: 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The frontend may call again while polling for the final status
+	for i := 0; i < 2; i++ {
+		resp, err := stripeService.ConfirmPayment(context.Background(), "pi_1", "https://evently.test/done")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != models.StatusSuccess || resp.NextAction != nil || resp.PaymentID != "pay_1" {
+			t.Errorf("call %d: %+v", i+1, resp)
+		}
+	}
+	if fake.confirmed != 1 {
+		t.Errorf("intent confirmed %d times, want 1", fake.confirmed)
+	}
+
+	for kind, amount := range map[string]int64{"payment.captured": 4000, "processor.fee": 150, "platform.fee": 400} {
+		entries := env.store.entries(kind)
+		if len(entries) != 1 || entries[0].Lines[0].Debit != amount {
+			t.Errorf("%s entries = %+v, want one of %d", kind, entries, amount)
+		}
+	}
+	confirmations := 0
+	for _, entry := range env.store.audit {
+		if entry.Action == models.AuditStripeConfirmed {
+			confirmations++
+		}
+	}
+	if confirmations != 1 {
+		t.Errorf("%d confirmations audited, want 1", confirmations)
+	}
+}
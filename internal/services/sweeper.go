@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+
+	"github.com/stripe/stripe-go/v82"
+)
+
+// PaymentSweeper periodically resolves payments that have been stuck in the
+// pending state. OTP payments past their TTL are expired; Stripe-backed
+// payments are settled against the PaymentIntent and cancelled if abandoned.
+type PaymentSweeper struct {
+	payments *PaymentService
+	stripe   *StripeService
+	cfg      config.SweeperConfig
+	log      *logger.Logger
+}
+
+func NewPaymentSweeper(payments *PaymentService, stripe *StripeService, cfg config.SweeperConfig, log *logger.Logger) *PaymentSweeper {
+	return &PaymentSweeper{
+		payments: payments,
+		stripe:   stripe,
+		cfg:      cfg,
+		log:      log,
+	}
+}
+
+// Start runs the sweeper on the configured interval until ctx is cancelled.
+func (w *PaymentSweeper) Start(ctx context.Context) {
+	w.log.LogProcess("SWEEPER", fmt.Sprintf("Pending payment sweeper started (interval: %v, pending TTL: %v, Stripe TTL: %v)",
+		w.cfg.Interval, w.cfg.PendingTTL, w.cfg.StripePendingTTL))
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.log.LogProcess("SWEEPER", "Pending payment sweeper stopped")
+			return
+		case <-ticker.C:
+			if _, err := w.Sweep(ctx); err != nil {
+				w.log.Error("SWEEPER", fmt.Sprintf("Sweep failed: %v", err))
+			}
+		}
+	}
+}
+
+// Sweep performs a single pass and returns the number of payments it moved
+// out of the pending state. OTP and Stripe payments have their own TTLs, so
+// each is listed on its own; payments left pending, such as intents still
+// processing, are paged past so they cannot hold up the rest.
+func (w *PaymentSweeper) Sweep(ctx context.Context) (int, error) {
+	otp, err := w.sweep(ctx, false, w.cfg.PendingTTL, func(payment *models.Payment) bool {
+		w.log.LogPayment("EXPIRED", payment.PaymentID, fmt.Sprintf("OTP not confirmed within %v", w.cfg.PendingTTL))
+		return w.payments.completePayment(ctx, payment, models.StatusCancelled, "payment.expired")
+	})
+	if err != nil {
+		return otp, err
+	}
+
+	intents, err := w.sweep(ctx, true, w.cfg.StripePendingTTL, func(payment *models.Payment) bool {
+		return w.settleStripePayment(ctx, payment)
+	})
+	resolved := otp + intents
+	if resolved > 0 {
+		w.log.LogProcess("SWEEPER", fmt.Sprintf("Resolved %d stale pending payments", resolved))
+	}
+	return resolved, err
+}
+
+// sweep resolves the pending payments of one kind older than ttl, a batch
+// at a time, and returns how many it resolved.
+func (w *PaymentSweeper) sweep(ctx context.Context, stripe bool, ttl time.Duration, resolve func(*models.Payment) bool) (int, error) {
+	filter := models.StaleFilter{
+		Status: models.StatusPending,
+		Before: time.Now().Add(-ttl),
+		Stripe: stripe,
+		Limit:  w.cfg.BatchSize,
+	}
+
+	resolved := 0
+	for {
+		stale, err := w.payments.store.ListStalePayments(filter)
+		if err != nil {
+			return resolved, fmt.Errorf("failed to list pending payments: %w", err)
+		}
+
+		for _, payment := range stale {
+			if ctx.Err() != nil {
+				return resolved, ctx.Err()
+			}
+			if resolve(payment) {
+				resolved++
+			}
+		}
+
+		if len(stale) == 0 || len(stale) < filter.Limit {
+			return resolved, nil
+		}
+		last := stale[len(stale)-1]
+		filter.AfterDate, filter.AfterID = last.Date, last.PaymentID
+	}
+}
+
+// settleStripePayment reconciles a pending payment with its PaymentIntent.
+func (w *PaymentSweeper) settleStripePayment(ctx context.Context, payment *models.Payment) bool {
+	if w.stripe == nil {
+		return false
+	}
+
+	pi, err := w.stripe.GetPaymentIntent(ctx, payment.TransactionID)
+	if err != nil {
+		w.log.Warn("SWEEPER", fmt.Sprintf("Skipping payment %s, could not load intent %s: %v", payment.PaymentID, payment.TransactionID, err))
+		return false
+	}
+
+	switch pi.Status {
+	case stripe.PaymentIntentStatusSucceeded:
+		w.log.LogPayment("SETTLED", payment.PaymentID, "Stripe reports intent succeeded")
+		w.stripe.RecordIntentCapture(pi)
+		return w.payments.completePayment(ctx, payment, models.StatusSuccess, "payment.success")
+	case stripe.PaymentIntentStatusCanceled:
+		w.log.LogPayment("SETTLED", payment.PaymentID, "Stripe reports intent cancelled")
+		return w.payments.completePayment(ctx, payment, models.StatusCancelled, "payment.cancelled")
+	case stripe.PaymentIntentStatusProcessing:
+		// Funds are in flight; Stripe will resolve it and we will see it next pass.
+		w.log.LogPayment("SWEEP_SKIP", payment.PaymentID, "Stripe intent still processing")
+		return false
+	default:
+		if err := w.stripe.CancelPaymentIntent(ctx, pi.ID, stripe.PaymentIntentCancellationReasonAbandoned); err != nil {
+			w.log.Warn("SWEEPER", fmt.Sprintf("Failed to cancel abandoned intent %s for payment %s: %v", pi.ID, payment.PaymentID, err))
+			return false
+		}
+		w.log.LogPayment("CANCELLED", payment.PaymentID, fmt.Sprintf("Abandoned Stripe intent cancelled (was %s)", pi.Status))
+		return w.payments.completePayment(ctx, payment, models.StatusCancelled, "payment.cancelled")
+	}
+}
+
+func isStripePaymentIntent(transactionID string) bool {
+	return strings.HasPrefix(transactionID, "pi_")
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/models"
+)
+
+func TestSweepIsNotHeldUpByUnresolvedPayments(t *testing.T) {
+	env := newTestEnv(t)
+	now := time.Now()
+	// Oldest first: intents the sweeper cannot settle, then OTP payments
+	// that have expired
+	for i := 0; i < 3; i++ {
+		env.pendingPayment(t, fmt.Sprintf("pay_pi_%d", i), fmt.Sprintf("pi_%d", i), now.Add(-2*time.Hour))
+	}
+	for i := 0; i < 3; i++ {
+		env.pendingPayment(t, fmt.Sprintf("pay_otp_%d", i), fmt.Sprintf("txn_%d", i), now.Add(-time.Hour))
+	}
+	// Past the Stripe TTL but not the OTP TTL
+	env.pendingPayment(t, "pay_recent", "txn_recent", now.Add(-10*time.Minute))
+
+	sweeper := NewPaymentSweeper(env.payments, nil, config.SweeperConfig{
+		PendingTTL:       30 * time.Minute,
+		StripePendingTTL: 5 * time.Minute,
+		BatchSize:        2,
+	}, env.log)
+
+	resolved, err := sweeper.Sweep(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved != 3 {
+		t.Errorf("resolved %d payments, want 3", resolved)
+	}
+	for i := 0; i < 3; i++ {
+		payment, _ := env.store.GetPayment(fmt.Sprintf("pay_otp_%d", i))
+		if payment.Status != models.StatusCancelled {
+			t.Errorf("%s is %s, want %s", payment.PaymentID, payment.Status, models.StatusCancelled)
+		}
+		intent, _ := env.store.GetPayment(fmt.Sprintf("pay_pi_%d", i))
+		if intent.Status != models.StatusPending {
+			t.Errorf("%s is %s, want it left pending", intent.PaymentID, intent.Status)
+		}
+	}
+	if recent, _ := env.store.GetPayment("pay_recent"); recent.Status != models.StatusPending {
+		t.Errorf("payment inside its TTL is %s, want pending", recent.Status)
+	}
+}
+
+func TestSweepPagesPastSkippedPayments(t *testing.T) {
+	env := newTestEnv(t)
+	now := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		env.pendingPayment(t, fmt.Sprintf("pay_%d", i), fmt.Sprintf("pi_%d", i), now)
+	}
+
+	sweeper := NewPaymentSweeper(env.payments, nil, config.SweeperConfig{
+		PendingTTL:       time.Minute,
+		StripePendingTTL: time.Minute,
+		BatchSize:        2,
+	}, env.log)
+
+	if _, err := sweeper.Sweep(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// An empty page of OTP payments, then intents in pages of 2, 2 and 1,
+	// each reaching past the unsettled intents of the page before
+	if env.store.staleQueries != 4 {
+		t.Errorf("listed %d pages, want 4", env.store.staleQueries)
+	}
+}
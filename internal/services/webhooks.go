@@ -0,0 +1,663 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"payment-gateway/internal/apperror"
+	"payment-gateway/internal/config"
+	"payment-gateway/internal/logger"
+	"payment-gateway/internal/models"
+	"payment-gateway/internal/storage"
+	"payment-gateway/internal/tenant"
+	"payment-gateway/internal/utils"
+)
+
+var (
+	ErrWebhookEndpointNotFound = apperror.New(apperror.WebhookEndpointNotFound, "webhook endpoint not found")
+	ErrWebhookDeliveryNotFound = apperror.New(apperror.WebhookDeliveryNotFound, "webhook delivery not found")
+	ErrInvalidWebhookEndpoint  = apperror.New(apperror.InvalidWebhookEndpoint, "invalid webhook endpoint")
+	ErrWebhookEndpointDisabled = apperror.New(apperror.WebhookEndpointDisabled, "webhook endpoint is disabled")
+)
+
+// Headers sent with every delivery. The signature covers "<t>.<body>" so a
+// captured request cannot be replayed with a new timestamp.
+const (
+	WebhookSignatureHeader = "Evently-Signature"
+	webhookEventIDHeader   = "Evently-Event-Id"
+	webhookDeliveryHeader  = "Evently-Delivery-Id"
+	webhookUserAgent       = "Evently-Webhooks/1.0"
+
+	// maxStoredResponse caps the response body kept in the delivery log
+	maxStoredResponse = 1024
+	// dueBatchSize is how many deliveries one pass sends at most
+	dueBatchSize = 100
+	// claimMargin is how long past the request timeout a claimed delivery
+	// stays with the instance sending it before another may retry it
+	claimMargin = time.Minute
+)
+
+// WebhookService sends payment events to HTTP endpoints registered by
+// organizers and partners. Every matching event is queued as a delivery,
+// signed with the endpoint's secret and retried with exponential backoff.
+type WebhookService struct {
+	store  storage.WebhookStore
+	client *http.Client
+	audit  *AuditService
+	cfg    config.WebhookConfig
+	log    *logger.Logger
+	mu     sync.Mutex // one delivery pass at a time
+}
+
+func NewWebhookService(store storage.WebhookStore, audit *AuditService, cfg config.WebhookConfig, log *logger.Logger) *WebhookService {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = publicAddressOnly
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &WebhookService{
+		store: store,
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: transport,
+			// A redirect would send the signed payload somewhere the
+			// endpoint owner never registered
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		audit: audit,
+		cfg:   cfg,
+		log:   log,
+	}
+}
+
+// CreateEndpoint registers an endpoint. Organizers register endpoints for
+// themselves; platform callers name the organizer or leave it empty for a
+// platform endpoint that receives every organizer's events.
+func (s *WebhookService) CreateEndpoint(ctx context.Context, req *models.CreateWebhookEndpointRequest) (*models.WebhookEndpointSecret, error) {
+	organizerID := req.OrganizerID
+	if scope := tenant.Scope(ctx); scope != "" {
+		if organizerID != "" && organizerID != scope {
+			return nil, ErrForbidden
+		}
+		organizerID = scope
+	}
+
+	if err := validateWebhookURL(req.URL, tenant.IsPlatform(ctx)); err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	now := time.Now()
+	endpoint := &models.WebhookEndpoint{
+		ID:          utils.GenerateWebhookEndpointID(),
+		OrganizerID: organizerID,
+		URL:         req.URL,
+		Description: req.Description,
+		EventTypes:  eventTypes,
+		Secret:      secret,
+		Enabled:     true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.store.SaveWebhookEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, endpointAudit(models.AuditWebhookEndpointCreated, endpoint), nil, endpoint)
+	s.log.Info("WEBHOOKS", fmt.Sprintf("Webhook endpoint %s registered for organizer %q: %s", endpoint.ID, organizerID, endpoint.URL))
+	return &models.WebhookEndpointSecret{Endpoint: endpoint, Secret: secret}, nil
+}
+
+func (s *WebhookService) GetEndpoint(ctx context.Context, id string) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.store.GetWebhookEndpoint(id)
+	if err != nil || !canAccessEndpoint(ctx, endpoint) {
+		return nil, ErrWebhookEndpointNotFound
+	}
+	return endpoint, nil
+}
+
+// ListEndpoints lists the caller's endpoints; platform callers see all.
+func (s *WebhookService) ListEndpoints(ctx context.Context) ([]*models.WebhookEndpoint, error) {
+	return s.store.ListWebhookEndpoints(tenant.Scope(ctx))
+}
+
+// UpdateEndpoint applies a partial update. Re-enabling an endpoint that was
+// disabled after repeated failures starts its failure count again.
+func (s *WebhookService) UpdateEndpoint(ctx context.Context, id string, req *models.UpdateWebhookEndpointRequest) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *endpoint
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL, tenant.IsPlatform(ctx)); err != nil {
+			return nil, err
+		}
+		endpoint.URL = *req.URL
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	if req.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(*req.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		endpoint.EventTypes = eventTypes
+	}
+	now := time.Now()
+	if req.Enabled != nil && *req.Enabled != endpoint.Enabled {
+		endpoint.Enabled = *req.Enabled
+		if endpoint.Enabled {
+			endpoint.ConsecutiveFailures = 0
+			endpoint.DisabledReason = ""
+			endpoint.DisabledAt = nil
+		} else {
+			endpoint.DisabledReason = "disabled manually"
+			endpoint.DisabledAt = &now
+		}
+	}
+	endpoint.UpdatedAt = now
+
+	if err := s.store.UpdateWebhookEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, endpointAudit(models.AuditWebhookEndpointUpdated, endpoint), before, endpoint)
+	return endpoint, nil
+}
+
+// RotateSecret issues a new signing secret. Deliveries sent from now on are
+// signed with it, including retries of earlier events.
+func (s *WebhookService) RotateSecret(ctx context.Context, id string) (*models.WebhookEndpointSecret, error) {
+	endpoint, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	endpoint.Secret = secret
+	endpoint.UpdatedAt = time.Now()
+	if err := s.store.UpdateWebhookEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, endpointAudit(models.AuditWebhookSecretRotated, endpoint), nil, nil)
+	s.log.LogSecurity("WEBHOOK_SECRET_ROTATED", fmt.Sprintf("Signing secret rotated for webhook endpoint %s", endpoint.ID))
+	return &models.WebhookEndpointSecret{Endpoint: endpoint, Secret: secret}, nil
+}
+
+// DeleteEndpoint removes an endpoint. Its delivery log is kept; pending
+// deliveries fail on their next attempt.
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id string) error {
+	endpoint, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.store.DeleteWebhookEndpoint(endpoint.ID); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, endpointAudit(models.AuditWebhookEndpointDeleted, endpoint), endpoint, nil)
+	return nil
+}
+
+// ListDeliveries lists an endpoint's delivery log, newest first.
+func (s *WebhookService) ListDeliveries(ctx context.Context, endpointID string, limit, offset int) ([]*models.WebhookDelivery, error) {
+	endpoint, err := s.GetEndpoint(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.ListWebhookDeliveries(endpoint.ID, limit, offset)
+}
+
+// ListFailedDeliveries lists deliveries across all endpoints that were
+// given up after the last retry: the gateway's dead letters.
+func (s *WebhookService) ListFailedDeliveries(ctx context.Context, limit, offset int) ([]*models.WebhookDelivery, error) {
+	if !tenant.IsPlatform(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.store.ListFailedWebhookDeliveries(limit, offset)
+}
+
+func (s *WebhookService) GetDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	delivery, err := s.store.GetWebhookDelivery(id)
+	if err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	// Deliveries are visible to whoever owns the endpoint, which for
+	// platform endpoints is not the organizer the event belongs to
+	if _, err := s.GetEndpoint(ctx, delivery.EndpointID); err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+// Redeliver sends a past delivery's payload again as a new delivery and
+// returns it with the outcome of the first attempt. Failures are retried on
+// the usual schedule.
+func (s *WebhookService) Redeliver(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	original, err := s.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.GetEndpoint(ctx, original.EndpointID)
+	if err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if !endpoint.Enabled {
+		return nil, ErrWebhookEndpointDisabled
+	}
+
+	now := time.Now()
+	delivery := &models.WebhookDelivery{
+		ID:           utils.GenerateWebhookDeliveryID(),
+		EndpointID:   original.EndpointID,
+		OrganizerID:  original.OrganizerID,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		Status:       models.DeliveryPending,
+		RedeliveryOf: original.ID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	// Saved without a next attempt so the worker leaves it alone while it
+	// is sent here
+	if err := s.store.SaveWebhookDelivery(delivery); err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, &models.AuditEntry{
+		Action:      models.AuditWebhookRedelivered,
+		TargetType:  "webhook_delivery",
+		TargetID:    original.ID,
+		OrganizerID: original.OrganizerID,
+	}, nil, map[string]string{"delivery_id": delivery.ID, "endpoint_id": endpoint.ID, "event_id": delivery.EventID})
+	s.log.Info("WEBHOOKS", fmt.Sprintf("Redelivering %s as %s to endpoint %s", original.ID, delivery.ID, endpoint.ID))
+	s.attempt(ctx, endpoint, delivery)
+	return delivery, nil
+}
+
+// Enqueue queues an event for every enabled endpoint subscribed to it. It
+// is called after the event has been published to Kafka; failures are
+// logged and never affect the payment.
+func (s *WebhookService) Enqueue(event *models.PaymentEvent) {
+	if !s.cfg.Enabled {
+		return
+	}
+
+	organizerID := eventOrganizer(event)
+	endpoints, err := s.store.ListEnabledWebhookEndpoints(organizerID)
+	if err != nil {
+		s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to load webhook endpoints for %s: %v", event.Type, err))
+		return
+	}
+
+	var payload []byte
+	eventID := event.EventID
+	if eventID == "" {
+		eventID = utils.GenerateEventID()
+	}
+	now := time.Now()
+	for _, endpoint := range endpoints {
+		if !subscribed(endpoint, event.Type) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(&models.WebhookPayload{
+				ID:      eventID,
+				Type:    event.Type,
+				Created: event.Timestamp.Unix(),
+				Data:    event,
+			})
+			if err != nil {
+				s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to encode %s for webhooks: %v", event.Type, err))
+				return
+			}
+		}
+
+		delivery := &models.WebhookDelivery{
+			ID:            utils.GenerateWebhookDeliveryID(),
+			EndpointID:    endpoint.ID,
+			OrganizerID:   organizerID,
+			EventID:       eventID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        models.DeliveryPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := s.store.SaveWebhookDelivery(delivery); err != nil {
+			s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to queue %s for endpoint %s: %v", event.Type, endpoint.ID, err))
+		}
+	}
+}
+
+// Start sends due deliveries on the configured interval until ctx is
+// cancelled.
+func (s *WebhookService) Start(ctx context.Context) {
+	s.log.LogProcess("WEBHOOKS", fmt.Sprintf("Webhook delivery worker started (interval: %v, max attempts: %d)", s.cfg.PollInterval, s.cfg.MaxAttempts))
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.LogProcess("WEBHOOKS", "Webhook delivery worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.DeliverDue(ctx); err != nil {
+				s.log.Error("WEBHOOKS", fmt.Sprintf("Delivery pass failed: %v", err))
+			}
+		}
+	}
+}
+
+// DeliverDue makes one attempt at every delivery that is due and returns
+// how many were attempted. Each delivery is claimed before it is sent, so
+// with several instances running every attempt is made by only one of them.
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deliveries, err := s.store.ListDueWebhookDeliveries(time.Now(), dueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	endpoints := make(map[string]*models.WebhookEndpoint)
+	for _, delivery := range deliveries {
+		if !s.claim(delivery) {
+			continue
+		}
+		attempted++
+
+		endpoint, ok := endpoints[delivery.EndpointID]
+		if !ok {
+			endpoint, err = s.store.GetWebhookEndpoint(delivery.EndpointID)
+			if err != nil {
+				endpoint = nil
+			}
+			endpoints[delivery.EndpointID] = endpoint
+		}
+
+		switch {
+		case endpoint == nil:
+			s.abandon(delivery, "endpoint was deleted")
+		case !endpoint.Enabled:
+			s.abandon(delivery, "endpoint is disabled")
+		default:
+			s.attempt(ctx, endpoint, delivery)
+		}
+	}
+	return attempted, nil
+}
+
+// claim takes a due delivery for this instance until the attempt has had
+// time to finish. A delivery another instance claimed first is skipped.
+func (s *WebhookService) claim(delivery *models.WebhookDelivery) bool {
+	until := time.Now().Add(s.cfg.Timeout + claimMargin)
+	claimed, err := s.store.ClaimWebhookDelivery(delivery.ID, *delivery.NextAttemptAt, until)
+	if err != nil {
+		s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to claim delivery %s: %v", delivery.ID, err))
+		return false
+	}
+	if !claimed {
+		s.log.Debug("WEBHOOKS", fmt.Sprintf("Delivery %s was claimed by another instance, skipping", delivery.ID))
+		return false
+	}
+	delivery.NextAttemptAt = &until
+	return true
+}
+
+// attempt sends a delivery once and records the outcome on the delivery and
+// on the endpoint's failure count.
+func (s *WebhookService) attempt(ctx context.Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) {
+	statusCode, response, err := s.send(ctx, endpoint, delivery)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastResponse = response
+	delivery.UpdatedAt = now
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		delivery.Status = models.DeliverySucceeded
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+		s.log.Info("WEBHOOKS", fmt.Sprintf("Delivered %s %s to endpoint %s (HTTP %d)", delivery.EventType, delivery.ID, endpoint.ID, statusCode))
+
+		if endpoint.ConsecutiveFailures > 0 {
+			endpoint.ConsecutiveFailures = 0
+			endpoint.UpdatedAt = now
+			s.updateEndpoint(endpoint)
+		}
+	} else {
+		if err != nil {
+			delivery.LastError = err.Error()
+		} else {
+			delivery.LastError = fmt.Sprintf("endpoint returned HTTP %d", statusCode)
+		}
+		if delivery.Attempts >= s.cfg.MaxAttempts {
+			delivery.Status = models.DeliveryFailed
+			delivery.NextAttemptAt = nil
+			s.log.Warn("WEBHOOKS", fmt.Sprintf("Giving up on %s after %d attempts: %s", delivery.ID, delivery.Attempts, delivery.LastError))
+		} else {
+			next := now.Add(s.backoff(delivery.Attempts))
+			delivery.NextAttemptAt = &next
+			s.log.Warn("WEBHOOKS", fmt.Sprintf("Delivery %s to endpoint %s failed (attempt %d), retrying at %s: %s",
+				delivery.ID, endpoint.ID, delivery.Attempts, next.Format(time.RFC3339), delivery.LastError))
+		}
+
+		endpoint.ConsecutiveFailures++
+		endpoint.UpdatedAt = now
+		if s.cfg.DisableAfter > 0 && endpoint.ConsecutiveFailures >= s.cfg.DisableAfter {
+			endpoint.Enabled = false
+			endpoint.DisabledReason = fmt.Sprintf("disabled after %d failed deliveries in a row", endpoint.ConsecutiveFailures)
+			endpoint.DisabledAt = &now
+			s.log.Warn("WEBHOOKS", fmt.Sprintf("Webhook endpoint %s %s", endpoint.ID, endpoint.DisabledReason))
+		}
+		s.updateEndpoint(endpoint)
+	}
+
+	if err := s.store.UpdateWebhookDelivery(delivery); err != nil {
+		s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to record delivery %s: %v", delivery.ID, err))
+	}
+}
+
+// send posts the signed payload and returns the status code and the start
+// of the response body.
+func (s *WebhookService) send(ctx context.Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhookEventIDHeader, delivery.EventID)
+	req.Header.Set(webhookDeliveryHeader, delivery.ID)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(endpoint.Secret, timestamp, []byte(delivery.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxStoredResponse))
+	return resp.StatusCode, string(bytes.ToValidUTF8(body, nil)), nil
+}
+
+// SignWebhookPayload builds the signature header value:
+// t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed by the secret>.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// backoff doubles the wait after every failed attempt, up to RetryMax.
+func (s *WebhookService) backoff(attempts int) time.Duration {
+	wait := s.cfg.RetryBase
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= s.cfg.RetryMax {
+			return s.cfg.RetryMax
+		}
+	}
+	return wait
+}
+
+func (s *WebhookService) abandon(delivery *models.WebhookDelivery, reason string) {
+	delivery.Status = models.DeliveryFailed
+	delivery.LastError = reason
+	delivery.NextAttemptAt = nil
+	delivery.UpdatedAt = time.Now()
+	if err := s.store.UpdateWebhookDelivery(delivery); err != nil {
+		s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to record delivery %s: %v", delivery.ID, err))
+	}
+}
+
+func (s *WebhookService) updateEndpoint(endpoint *models.WebhookEndpoint) {
+	if err := s.store.UpdateWebhookEndpoint(endpoint); err != nil {
+		s.log.Error("WEBHOOKS", fmt.Sprintf("Failed to update webhook endpoint %s: %v", endpoint.ID, err))
+	}
+}
+
+// canAccessEndpoint only lets platform callers see platform endpoints.
+func canAccessEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) bool {
+	if endpoint.OrganizerID == "" {
+		return tenant.IsPlatform(ctx)
+	}
+	return tenant.CanAccess(ctx, endpoint.OrganizerID)
+}
+
+func eventOrganizer(event *models.PaymentEvent) string {
+	if event.Payment != nil && event.Payment.OrganizerID != "" {
+		return event.Payment.OrganizerID
+	}
+	if event.Dispute != nil {
+		return event.Dispute.OrganizerID
+	}
+	return ""
+}
+
+// subscribed reports whether an endpoint wants an event type. Filters are
+// exact types, "*", or a prefix such as "payment.dispute_*".
+func subscribed(endpoint *models.WebhookEndpoint, eventType string) bool {
+	if len(endpoint.EventTypes) == 0 {
+		return true
+	}
+	for _, filter := range endpoint.EventTypes {
+		if filter == "*" || filter == eventType {
+			return true
+		}
+		if strings.HasSuffix(filter, "*") && strings.HasPrefix(eventType, strings.TrimSuffix(filter, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	seen := make(map[string]bool, len(eventTypes))
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.ToLower(strings.TrimSpace(eventType))
+		// A wildcard may only end a filter
+		wildcard := strings.Index(eventType, "*")
+		if eventType == "" || strings.ContainsAny(eventType, " \t") || (wildcard >= 0 && wildcard < len(eventType)-1) {
+			return nil, fmt.Errorf("%w: invalid event type %q", ErrInvalidWebhookEndpoint, eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			normalized = append(normalized, eventType)
+		}
+	}
+	return normalized, nil
+}
+
+// validateWebhookURL requires an absolute http(s) URL. Organizer endpoints
+// must use https; plain http is left to platform endpoints on internal
+// networks.
+func validateWebhookURL(raw string, platform bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: url must be absolute", ErrInvalidWebhookEndpoint)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && platform:
+	default:
+		return fmt.Errorf("%w: url must use https", ErrInvalidWebhookEndpoint)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: url must not contain credentials", ErrInvalidWebhookEndpoint)
+	}
+	return nil
+}
+
+// publicAddressOnly stops deliveries from reaching loopback, private or
+// link-local addresses, wherever the endpoint's DNS points.
+func publicAddressOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("webhook delivery to %s is not allowed", host)
+	}
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+func endpointAudit(action string, endpoint *models.WebhookEndpoint) *models.AuditEntry {
+	return &models.AuditEntry{
+		Action:      action,
+		TargetType:  "webhook_endpoint",
+		TargetID:    endpoint.ID,
+		OrganizerID: endpoint.OrganizerID,
+	}
+}
@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initCheckoutTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating checkout_sessions table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS checkout_sessions (
+        id VARCHAR(64) PRIMARY KEY,
+        kind VARCHAR(20) NOT NULL,
+        organizer_id VARCHAR(64) NOT NULL,
+        order_id VARCHAR(64) NOT NULL,
+        status VARCHAR(20) NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        amount DECIMAL(10,2) NOT NULL,
+        line_items TEXT NOT NULL,
+        description VARCHAR(255) NOT NULL DEFAULT '',
+        reference VARCHAR(100) NOT NULL DEFAULT '',
+        email VARCHAR(255) NOT NULL DEFAULT '',
+        success_url VARCHAR(500) NOT NULL DEFAULT '',
+        cancel_url VARCHAR(500) NOT NULL DEFAULT '',
+        url VARCHAR(500) NOT NULL,
+        payment_id VARCHAR(64) NOT NULL DEFAULT '',
+        expires_at TIMESTAMP NOT NULL,
+        completed_at TIMESTAMP NULL DEFAULT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        INDEX idx_organizer_status (organizer_id, status, created_at),
+        INDEX idx_order_id (order_id)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create checkout_sessions table: %w", err)
+	}
+	return nil
+}
+
+const checkoutSessionColumns = `id, kind, organizer_id, order_id, status, currency, amount, line_items, description,
+    reference, email, success_url, cancel_url, url, payment_id, expires_at, completed_at, created_at, updated_at`
+
+func scanCheckoutSession(row rowScanner) (*models.CheckoutSession, error) {
+	cs := &models.CheckoutSession{}
+	var lineItems string
+	var completedAt sql.NullTime
+	err := row.Scan(
+		&cs.ID, &cs.Kind, &cs.OrganizerID, &cs.OrderID, &cs.Status, &cs.Currency, &cs.Amount, &lineItems, &cs.Description,
+		&cs.Reference, &cs.Email, &cs.SuccessURL, &cs.CancelURL, &cs.URL, &cs.PaymentID, &cs.ExpiresAt, &completedAt, &cs.CreatedAt, &cs.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(lineItems), &cs.LineItems); err != nil {
+		return nil, fmt.Errorf("failed to decode line items: %w", err)
+	}
+	cs.CompletedAt = nullTime(completedAt)
+	return cs, nil
+}
+
+func (s *MySQLStore) SaveCheckoutSession(cs *models.CheckoutSession) error {
+	s.log.LogDatabase("INSERT", "checkout_sessions", fmt.Sprintf("Saving checkout session %s for order %s", cs.ID, cs.OrderID))
+
+	lineItems, err := json.Marshal(cs.LineItems)
+	if err != nil {
+		return fmt.Errorf("failed to encode line items: %w", err)
+	}
+
+	query := `
+    INSERT INTO checkout_sessions (` + checkoutSessionColumns + `)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err = s.db.Exec(query,
+		cs.ID, cs.Kind, cs.OrganizerID, cs.OrderID, cs.Status, cs.Currency, cs.Amount, string(lineItems), cs.Description,
+		cs.Reference, cs.Email, cs.SuccessURL, cs.CancelURL, cs.URL, cs.PaymentID, cs.ExpiresAt, cs.CompletedAt, cs.CreatedAt, cs.UpdatedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save checkout session %s: %s", cs.ID, err.Error()))
+		return fmt.Errorf("failed to save checkout session: %w", err)
+	}
+	return nil
+}
+
+// UpdateCheckoutSession records a session's state. Line items and amount
+// never change once a session is created.
+func (s *MySQLStore) UpdateCheckoutSession(cs *models.CheckoutSession) error {
+	s.log.LogDatabase("UPDATE", "checkout_sessions", fmt.Sprintf("Updating checkout session %s to %s", cs.ID, cs.Status))
+
+	query := `
+    UPDATE checkout_sessions SET status = ?, payment_id = ?, completed_at = ?, updated_at = ?
+    WHERE id = ?
+    `
+	if _, err := s.db.Exec(query, cs.Status, cs.PaymentID, cs.CompletedAt, cs.UpdatedAt, cs.ID); err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update checkout session %s: %s", cs.ID, err.Error()))
+		return fmt.Errorf("failed to update checkout session: %w", err)
+	}
+	return nil
+}
+
+// ClaimCheckoutSession moves an open, unexpired session to processing. It
+// reports false when the session was not open, so two payments can never
+// be started for one session.
+func (s *MySQLStore) ClaimCheckoutSession(id string, now time.Time) (bool, error) {
+	result, err := s.db.Exec(`
+    UPDATE checkout_sessions SET status = ?, updated_at = ?
+    WHERE id = ? AND status = ? AND expires_at > ?
+    `, models.CheckoutProcessing, now, id, models.CheckoutOpen, now)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to claim checkout session %s: %s", id, err.Error()))
+		return false, fmt.Errorf("failed to claim checkout session: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim checkout session: %w", err)
+	}
+	return affected == 1, nil
+}
+
+func (s *MySQLStore) GetCheckoutSession(id string) (*models.CheckoutSession, error) {
+	query := `SELECT ` + checkoutSessionColumns + ` FROM checkout_sessions WHERE id = ?`
+
+	cs, err := scanCheckoutSession(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("checkout session not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get checkout session %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get checkout session: %w", err)
+	}
+	return cs, nil
+}
+
+// ListCheckoutSessions lists sessions newest first. An empty organizerID or
+// status does not filter.
+func (s *MySQLStore) ListCheckoutSessions(organizerID string, status models.CheckoutStatus, limit, offset int) ([]*models.CheckoutSession, error) {
+	query := `SELECT ` + checkoutSessionColumns + ` FROM checkout_sessions WHERE 1 = 1`
+	var args []interface{}
+	if organizerID != "" {
+		query += ` AND organizer_id = ?`
+		args = append(args, organizerID)
+	}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY created_at DESC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list checkout sessions: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list checkout sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*models.CheckoutSession
+	for rows.Next() {
+		cs, err := scanCheckoutSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checkout session: %w", err)
+		}
+		sessions = append(sessions, cs)
+	}
+	return sessions, rows.Err()
+}
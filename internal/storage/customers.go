@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func (s *MySQLStore) initCustomerTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating customers table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS customers (
+        user_id VARCHAR(64) PRIMARY KEY,
+        stripe_customer_id VARCHAR(64) NOT NULL,
+        email VARCHAR(255) NOT NULL DEFAULT '',
+        name VARCHAR(255) NOT NULL DEFAULT '',
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE KEY idx_stripe_customer_id (stripe_customer_id)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create customers table: %w", err)
+	}
+	return nil
+}
+
+// SaveCustomer inserts the user's Stripe Customer mapping. A user already
+// mapped returns ErrDuplicateReference so concurrent creates can be resolved
+// by reading the existing row.
+func (s *MySQLStore) SaveCustomer(customer *models.Customer) error {
+	s.log.LogDatabase("INSERT", "customers", fmt.Sprintf("Saving customer %s for user %s", customer.StripeCustomerID, customer.UserID))
+
+	query := `
+    INSERT INTO customers (user_id, stripe_customer_id, email, name, created_at, updated_at)
+    VALUES (?, ?, ?, ?, ?, ?)
+    `
+	_, err := s.db.Exec(query,
+		customer.UserID, customer.StripeCustomerID, customer.Email, customer.Name, customer.CreatedAt, customer.UpdatedAt,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return ErrDuplicateReference
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save customer for user %s: %s", customer.UserID, err.Error()))
+		return fmt.Errorf("failed to save customer: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) UpdateCustomer(customer *models.Customer) error {
+	query := `UPDATE customers SET email = ?, name = ?, updated_at = ? WHERE user_id = ?`
+
+	if _, err := s.db.Exec(query, customer.Email, customer.Name, customer.UpdatedAt, customer.UserID); err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update customer for user %s: %s", customer.UserID, err.Error()))
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetCustomer(userID string) (*models.Customer, error) {
+	query := `
+    SELECT user_id, stripe_customer_id, email, name, created_at, updated_at
+    FROM customers WHERE user_id = ?
+    `
+	customer := &models.Customer{}
+	err := s.db.QueryRow(query, userID).Scan(
+		&customer.UserID, &customer.StripeCustomerID, &customer.Email, &customer.Name, &customer.CreatedAt, &customer.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("customer not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get customer for user %s: %s", userID, err.Error()))
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+	return customer, nil
+}
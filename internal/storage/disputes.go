@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initDisputeTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating disputes table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS disputes (
+        id VARCHAR(64) PRIMARY KEY,
+        payment_id VARCHAR(64) NOT NULL DEFAULT '',
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        charge_id VARCHAR(64) NOT NULL DEFAULT '',
+        payment_intent_id VARCHAR(64) NOT NULL DEFAULT '',
+        amount DECIMAL(12,2) NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        reason VARCHAR(50) NOT NULL DEFAULT '',
+        status VARCHAR(30) NOT NULL,
+        evidence_due_by TIMESTAMP NULL DEFAULT NULL,
+        evidence_submitted_at TIMESTAMP NULL DEFAULT NULL,
+        reminder_sent_at TIMESTAMP NULL DEFAULT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        closed_at TIMESTAMP NULL DEFAULT NULL,
+        INDEX idx_organizer_status (organizer_id, status),
+        INDEX idx_payment_id (payment_id),
+        INDEX idx_evidence_due_by (evidence_due_by)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create disputes table: %w", err)
+	}
+	return nil
+}
+
+const disputeColumns = `id, payment_id, organizer_id, charge_id, payment_intent_id, amount, currency, reason, status,
+    evidence_due_by, evidence_submitted_at, reminder_sent_at, created_at, updated_at, closed_at`
+
+func scanDispute(row rowScanner) (*models.Dispute, error) {
+	d := &models.Dispute{}
+	var dueBy, submittedAt, reminderAt, closedAt sql.NullTime
+	err := row.Scan(
+		&d.ID, &d.PaymentID, &d.OrganizerID, &d.ChargeID, &d.PaymentIntentID, &d.Amount, &d.Currency, &d.Reason, &d.Status,
+		&dueBy, &submittedAt, &reminderAt, &d.CreatedAt, &d.UpdatedAt, &closedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d.EvidenceDueBy = nullTime(dueBy)
+	d.EvidenceSubmittedAt = nullTime(submittedAt)
+	d.ReminderSentAt = nullTime(reminderAt)
+	d.ClosedAt = nullTime(closedAt)
+	return d, nil
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// SaveDispute inserts a dispute or updates it with the latest state from
+// Stripe. Webhooks can arrive out of order, so the owning payment is only
+// filled in when it was not known before.
+func (s *MySQLStore) SaveDispute(d *models.Dispute) error {
+	s.log.LogDatabase("UPSERT", "disputes", fmt.Sprintf("Saving dispute %s (%s)", d.ID, d.Status))
+
+	query := `
+    INSERT INTO disputes (
+        id, payment_id, organizer_id, charge_id, payment_intent_id, amount, currency, reason, status,
+        evidence_due_by, evidence_submitted_at, reminder_sent_at, created_at, updated_at, closed_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    ON DUPLICATE KEY UPDATE
+        payment_id = IF(payment_id = '', VALUES(payment_id), payment_id),
+        organizer_id = IF(organizer_id = '', VALUES(organizer_id), organizer_id),
+        amount = VALUES(amount), reason = VALUES(reason), status = VALUES(status),
+        evidence_due_by = VALUES(evidence_due_by),
+        evidence_submitted_at = COALESCE(VALUES(evidence_submitted_at), evidence_submitted_at),
+        reminder_sent_at = COALESCE(VALUES(reminder_sent_at), reminder_sent_at),
+        updated_at = VALUES(updated_at),
+        closed_at = COALESCE(VALUES(closed_at), closed_at)
+    `
+	_, err := s.db.Exec(query,
+		d.ID, d.PaymentID, d.OrganizerID, d.ChargeID, d.PaymentIntentID, d.Amount, d.Currency, d.Reason, d.Status,
+		d.EvidenceDueBy, d.EvidenceSubmittedAt, d.ReminderSentAt, d.CreatedAt, d.UpdatedAt, d.ClosedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save dispute %s: %s", d.ID, err.Error()))
+		return fmt.Errorf("failed to save dispute: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetDispute(id string) (*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM disputes WHERE id = ?`
+
+	d, err := scanDispute(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("dispute not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get dispute %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+	return d, nil
+}
+
+// ListDisputes lists disputes newest first. Empty organizerID or status
+// means no filter on that field.
+func (s *MySQLStore) ListDisputes(organizerID string, status string, limit, offset int) ([]*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM disputes WHERE 1 = 1`
+	var args []interface{}
+	if organizerID != "" {
+		query += ` AND organizer_id = ?`
+		args = append(args, organizerID)
+	}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY created_at DESC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	return s.queryDisputes(query, args...)
+}
+
+// ListDisputesDueBefore returns open disputes whose evidence deadline falls
+// before the given time, that have no evidence and no reminder yet.
+func (s *MySQLStore) ListDisputesDueBefore(before time.Time) ([]*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + `
+    FROM disputes
+    WHERE status IN ('needs_response', 'warning_needs_response')
+        AND evidence_due_by IS NOT NULL AND evidence_due_by < ?
+        AND evidence_submitted_at IS NULL AND reminder_sent_at IS NULL
+    ORDER BY evidence_due_by ASC
+    `
+	return s.queryDisputes(query, before)
+}
+
+// ListPaymentDisputes returns every dispute on a payment, oldest first.
+func (s *MySQLStore) ListPaymentDisputes(paymentID string) ([]*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM disputes WHERE payment_id = ? ORDER BY created_at ASC`
+	return s.queryDisputes(query, paymentID)
+}
+
+func (s *MySQLStore) queryDisputes(query string, args ...interface{}) ([]*models.Dispute, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list disputes: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list disputes: %w", err)
+	}
+	defer rows.Close()
+
+	var disputes []*models.Dispute
+	for rows.Next() {
+		d, err := scanDispute(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dispute: %w", err)
+		}
+		disputes = append(disputes, d)
+	}
+	return disputes, rows.Err()
+}
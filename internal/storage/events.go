@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initEventTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating payment_event_log table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS payment_event_log (
+        id VARCHAR(64) PRIMARY KEY,
+        type VARCHAR(100) NOT NULL,
+        payment_id VARCHAR(64) NOT NULL DEFAULT '',
+        order_id VARCHAR(64) NOT NULL DEFAULT '',
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        payload MEDIUMTEXT NOT NULL,
+        published BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+        INDEX idx_payment_created (payment_id, created_at),
+        INDEX idx_order_created (order_id, created_at),
+        INDEX idx_created_at (created_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create payment_event_log table: %w", err)
+	}
+	return nil
+}
+
+const eventLogColumns = `id, type, payment_id, order_id, organizer_id, payload, published, created_at`
+
+func scanEventLogEntry(row rowScanner) (*models.EventLogEntry, error) {
+	e := &models.EventLogEntry{}
+	var payload string
+	if err := row.Scan(&e.ID, &e.Type, &e.PaymentID, &e.OrderID, &e.OrganizerID, &payload, &e.Published, &e.CreatedAt); err != nil {
+		return nil, err
+	}
+	e.Event = &models.PaymentEvent{}
+	if err := json.Unmarshal([]byte(payload), e.Event); err != nil {
+		return nil, fmt.Errorf("failed to decode event %s: %w", e.ID, err)
+	}
+	return e, nil
+}
+
+// SaveEvent appends an event to the log. The stored payload is the event
+// exactly as it was published.
+func (s *MySQLStore) SaveEvent(entry *models.EventLogEntry) error {
+	payload, err := json.Marshal(entry.Event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	query := `
+    INSERT INTO payment_event_log (` + eventLogColumns + `)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err = s.db.Exec(query,
+		entry.ID, entry.Type, entry.PaymentID, entry.OrderID, entry.OrganizerID, string(payload), entry.Published, entry.CreatedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to log event %s: %s", entry.ID, err.Error()))
+		return fmt.Errorf("failed to save event: %w", err)
+	}
+	return nil
+}
+
+// ListEvents returns logged events oldest first.
+func (s *MySQLStore) ListEvents(filter models.EventFilter) ([]*models.EventLogEntry, error) {
+	query := `SELECT ` + eventLogColumns + ` FROM payment_event_log WHERE 1 = 1`
+	var args []interface{}
+	if filter.PaymentID != "" {
+		query += ` AND payment_id = ?`
+		args = append(args, filter.PaymentID)
+	}
+	if filter.OrderID != "" {
+		query += ` AND order_id = ?`
+		args = append(args, filter.OrderID)
+	}
+	if filter.From != nil {
+		query += ` AND created_at >= ?`
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		query += ` AND created_at < ?`
+		args = append(args, *filter.To)
+	}
+	if len(filter.EventTypes) > 0 {
+		query += ` AND type IN (?` + strings.Repeat(`, ?`, len(filter.EventTypes)-1) + `)`
+		for _, eventType := range filter.EventTypes {
+			args = append(args, eventType)
+		}
+	}
+	query += ` ORDER BY created_at ASC, id ASC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list events: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.EventLogEntry
+	for rows.Next() {
+		entry, err := scanEventLogEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// ListUnpublishedEvents returns logged events whose Kafka publish failed,
+// oldest first.
+func (s *MySQLStore) ListUnpublishedEvents(limit int) ([]*models.EventLogEntry, error) {
+	query := `SELECT ` + eventLogColumns + ` FROM payment_event_log
+    WHERE published = FALSE
+    ORDER BY created_at ASC, id ASC
+    LIMIT ?
+    `
+	rows, err := s.db.Query(query, limit)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list unpublished events: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list unpublished events: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.EventLogEntry
+	for rows.Next() {
+		entry, err := scanEventLogEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// MarkEventPublished records that a logged event has reached Kafka.
+func (s *MySQLStore) MarkEventPublished(id string) error {
+	if _, err := s.db.Exec(`UPDATE payment_event_log SET published = TRUE WHERE id = ?`, id); err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to mark event %s published: %s", id, err.Error()))
+		return fmt.Errorf("failed to mark event published: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func (s *MySQLStore) initFeeTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating fee tables if not exist")
+
+	plans := `
+    CREATE TABLE IF NOT EXISTS fee_plans (
+        id VARCHAR(64) PRIMARY KEY,
+        name VARCHAR(255) NOT NULL,
+        percent DECIMAL(6,3) NOT NULL DEFAULT 0,
+        fixed DECIMAL(10,2) NOT NULL DEFAULT 0,
+        tiers JSON NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(plans); err != nil {
+		return fmt.Errorf("failed to create fee_plans table: %w", err)
+	}
+
+	lines := `
+    CREATE TABLE IF NOT EXISTS payment_fee_lines (
+        id BIGINT AUTO_INCREMENT PRIMARY KEY,
+        payment_id VARCHAR(64) NOT NULL,
+        kind VARCHAR(50) NOT NULL,
+        plan_id VARCHAR(64) NOT NULL,
+        reference VARCHAR(191) NOT NULL,
+        amount DECIMAL(10,2) NOT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE KEY uniq_reference (reference),
+        INDEX idx_payment_id (payment_id)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(lines); err != nil {
+		return fmt.Errorf("failed to create payment_fee_lines table: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) SaveFeePlan(plan *models.FeePlan) error {
+	s.log.LogDatabase("UPSERT", "fee_plans", fmt.Sprintf("Saving fee plan %s", plan.ID))
+
+	tiers, err := json.Marshal(plan.Tiers)
+	if err != nil {
+		return fmt.Errorf("failed to encode fee tiers: %w", err)
+	}
+
+	query := `
+    INSERT INTO fee_plans (id, name, percent, fixed, tiers, created_at, updated_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?)
+    ON DUPLICATE KEY UPDATE
+        name = VALUES(name), percent = VALUES(percent), fixed = VALUES(fixed),
+        tiers = VALUES(tiers), updated_at = VALUES(updated_at)
+    `
+	_, err = s.db.Exec(query, plan.ID, plan.Name, plan.Percent, plan.Fixed, string(tiers), plan.CreatedAt, plan.UpdatedAt)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save fee plan %s: %s", plan.ID, err.Error()))
+		return fmt.Errorf("failed to save fee plan: %w", err)
+	}
+	return nil
+}
+
+func scanFeePlan(row rowScanner) (*models.FeePlan, error) {
+	plan := &models.FeePlan{}
+	var tiers sql.NullString
+	if err := row.Scan(&plan.ID, &plan.Name, &plan.Percent, &plan.Fixed, &tiers, &plan.CreatedAt, &plan.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if tiers.Valid && tiers.String != "" && tiers.String != "null" {
+		if err := json.Unmarshal([]byte(tiers.String), &plan.Tiers); err != nil {
+			return nil, fmt.Errorf("failed to decode fee tiers: %w", err)
+		}
+	}
+	return plan, nil
+}
+
+func (s *MySQLStore) GetFeePlan(id string) (*models.FeePlan, error) {
+	row := s.db.QueryRow(`SELECT id, name, percent, fixed, tiers, created_at, updated_at FROM fee_plans WHERE id = ?`, id)
+
+	plan, err := scanFeePlan(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("fee plan not found")
+		}
+		return nil, fmt.Errorf("failed to get fee plan: %w", err)
+	}
+	return plan, nil
+}
+
+func (s *MySQLStore) ListFeePlans() ([]*models.FeePlan, error) {
+	rows, err := s.db.Query(`SELECT id, name, percent, fixed, tiers, created_at, updated_at FROM fee_plans ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*models.FeePlan
+	for rows.Next() {
+		plan, err := scanFeePlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee plan: %w", err)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, rows.Err()
+}
+
+// SaveFeeLine stores a fee line. A line whose reference already exists is
+// ignored so retries do not double-charge or double-reverse.
+func (s *MySQLStore) SaveFeeLine(line *models.FeeLine) error {
+	s.log.LogDatabase("INSERT", "payment_fee_lines", fmt.Sprintf("Saving %s line for payment %s", line.Kind, line.PaymentID))
+
+	res, err := s.db.Exec(`
+    INSERT INTO payment_fee_lines (payment_id, kind, plan_id, reference, amount, created_at)
+    VALUES (?, ?, ?, ?, ?, ?)
+    `, line.PaymentID, line.Kind, line.PlanID, line.Reference, line.Amount, line.CreatedAt)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return ErrDuplicateReference
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save fee line for payment %s: %s", line.PaymentID, err.Error()))
+		return fmt.Errorf("failed to save fee line: %w", err)
+	}
+	line.ID, _ = res.LastInsertId()
+	return nil
+}
+
+func (s *MySQLStore) ListFeeLines(paymentID string) ([]*models.FeeLine, error) {
+	rows, err := s.db.Query(`
+    SELECT id, payment_id, kind, plan_id, reference, amount, created_at
+    FROM payment_fee_lines
+    WHERE payment_id = ?
+    ORDER BY id ASC
+    `, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []*models.FeeLine
+	for rows.Next() {
+		line := &models.FeeLine{}
+		if err := rows.Scan(&line.ID, &line.PaymentID, &line.Kind, &line.PlanID, &line.Reference, &line.Amount, &line.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan fee line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	return lines, rows.Err()
+}
@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// ErrDuplicateReference is returned when a journal entry with the same
+// reference has already been posted.
+var ErrDuplicateReference = errors.New("journal entry reference already posted")
+
+func (s *MySQLStore) initLedgerTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating ledger tables if not exist")
+
+	entries := `
+    CREATE TABLE IF NOT EXISTS ledger_entries (
+        id VARCHAR(64) PRIMARY KEY,
+        kind VARCHAR(50) NOT NULL,
+        reference VARCHAR(191) NOT NULL,
+        payment_id VARCHAR(64) NOT NULL DEFAULT '',
+        order_id VARCHAR(64) NOT NULL DEFAULT '',
+        currency VARCHAR(3) NOT NULL,
+        description VARCHAR(255) NOT NULL DEFAULT '',
+        posted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE KEY uniq_reference (reference),
+        INDEX idx_payment_id (payment_id),
+        INDEX idx_posted_at (posted_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(entries); err != nil {
+		return fmt.Errorf("failed to create ledger_entries table: %w", err)
+	}
+
+	lines := `
+    CREATE TABLE IF NOT EXISTS ledger_lines (
+        id BIGINT AUTO_INCREMENT PRIMARY KEY,
+        entry_id VARCHAR(64) NOT NULL,
+        account VARCHAR(50) NOT NULL,
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        currency VARCHAR(3) NOT NULL,
+        debit BIGINT NOT NULL DEFAULT 0,
+        credit BIGINT NOT NULL DEFAULT 0,
+        INDEX idx_entry_id (entry_id),
+        INDEX idx_account (account, currency),
+        INDEX idx_organizer (organizer_id, account)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(lines); err != nil {
+		return fmt.Errorf("failed to create ledger_lines table: %w", err)
+	}
+
+	// Journal rows are append-only. Creating triggers can require extra
+	// privileges when binary logging is on, so failure is not fatal.
+	for _, table := range []string{"ledger_entries", "ledger_lines"} {
+		for _, op := range []string{"UPDATE", "DELETE"} {
+			trigger := fmt.Sprintf(`
+    CREATE TRIGGER IF NOT EXISTS %s_no_%s BEFORE %s ON %s
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = '%s is append-only'
+    `, table, op, op, table, table)
+			if _, err := s.db.Exec(trigger); err != nil {
+				s.log.Warn("DATABASE", fmt.Sprintf("Could not create append-only trigger on %s: %v", table, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// PostJournalEntry writes an entry and all its lines in a single transaction.
+func (s *MySQLStore) PostJournalEntry(entry *models.JournalEntry) error {
+	s.log.LogDatabase("INSERT", "ledger_entries", fmt.Sprintf("Posting %s entry %s (%s)", entry.Kind, entry.ID, entry.Reference))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+    INSERT INTO ledger_entries (id, kind, reference, payment_id, order_id, currency, description, posted_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `, entry.ID, entry.Kind, entry.Reference, entry.PaymentID, entry.OrderID, entry.Currency, entry.Description, entry.PostedAt)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return ErrDuplicateReference
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to post journal entry %s: %s", entry.ID, err.Error()))
+		return fmt.Errorf("failed to post journal entry: %w", err)
+	}
+
+	for _, line := range entry.Lines {
+		line.EntryID = entry.ID
+		res, err := tx.Exec(`
+    INSERT INTO ledger_lines (entry_id, account, organizer_id, currency, debit, credit)
+    VALUES (?, ?, ?, ?, ?, ?)
+    `, line.EntryID, line.Account, line.OrganizerID, entry.Currency, line.Debit, line.Credit)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to post journal line for entry %s: %s", entry.ID, err.Error()))
+			return fmt.Errorf("failed to post journal line: %w", err)
+		}
+		line.ID, _ = res.LastInsertId()
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit journal entry: %w", err)
+	}
+	return nil
+}
+
+// ListJournalEntries returns the entries posted for a payment, with lines.
+func (s *MySQLStore) ListJournalEntries(paymentID string) ([]*models.JournalEntry, error) {
+	rows, err := s.db.Query(`
+    SELECT id, kind, reference, payment_id, order_id, currency, description, posted_at
+    FROM ledger_entries
+    WHERE payment_id = ?
+    ORDER BY posted_at ASC
+    `, paymentID)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list journal entries: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.JournalEntry
+	byID := make(map[string]*models.JournalEntry)
+	for rows.Next() {
+		entry := &models.JournalEntry{}
+		if err := rows.Scan(&entry.ID, &entry.Kind, &entry.Reference, &entry.PaymentID, &entry.OrderID,
+			&entry.Currency, &entry.Description, &entry.PostedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+		byID[entry.ID] = entry
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	lineRows, err := s.db.Query(`
+    SELECT l.id, l.entry_id, l.account, l.organizer_id, l.debit, l.credit
+    FROM ledger_lines l JOIN ledger_entries e ON e.id = l.entry_id
+    WHERE e.payment_id = ?
+    ORDER BY l.id ASC
+    `, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal lines: %w", err)
+	}
+	defer lineRows.Close()
+
+	for lineRows.Next() {
+		line := &models.JournalLine{}
+		if err := lineRows.Scan(&line.ID, &line.EntryID, &line.Account, &line.OrganizerID, &line.Debit, &line.Credit); err != nil {
+			return nil, fmt.Errorf("failed to scan journal line: %w", err)
+		}
+		if entry, ok := byID[line.EntryID]; ok {
+			entry.Lines = append(entry.Lines, line)
+		}
+	}
+	return entries, lineRows.Err()
+}
+
+// ListOrganizerJournalEntries returns entries posted in [from, to) that touch
+// an organizer, with only that organizer's lines attached.
+func (s *MySQLStore) ListOrganizerJournalEntries(organizerID, currency string, from, to time.Time) ([]*models.JournalEntry, error) {
+	rows, err := s.db.Query(`
+    SELECT e.id, e.kind, e.reference, e.payment_id, e.order_id, e.currency, e.description, e.posted_at,
+        l.id, l.account, l.organizer_id, l.debit, l.credit
+    FROM ledger_entries e JOIN ledger_lines l ON l.entry_id = e.id
+    WHERE l.organizer_id = ? AND e.currency = ? AND e.posted_at >= ? AND e.posted_at < ?
+    ORDER BY e.posted_at ASC, l.id ASC
+    `, organizerID, currency, from, to)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list organizer journal entries: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.JournalEntry
+	byID := make(map[string]*models.JournalEntry)
+	for rows.Next() {
+		entry := &models.JournalEntry{}
+		line := &models.JournalLine{}
+		if err := rows.Scan(&entry.ID, &entry.Kind, &entry.Reference, &entry.PaymentID, &entry.OrderID,
+			&entry.Currency, &entry.Description, &entry.PostedAt,
+			&line.ID, &line.Account, &line.OrganizerID, &line.Debit, &line.Credit); err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		if existing, ok := byID[entry.ID]; ok {
+			entry = existing
+		} else {
+			entries = append(entries, entry)
+			byID[entry.ID] = entry
+		}
+		line.EntryID = entry.ID
+		entry.Lines = append(entry.Lines, line)
+	}
+	return entries, rows.Err()
+}
+
+// AccountBalances sums lines per account and currency. When organizerID is
+// set only that organizer's lines are included.
+func (s *MySQLStore) AccountBalances(organizerID string) ([]*models.AccountBalance, error) {
+	query := `
+    SELECT account, currency, COALESCE(SUM(debit), 0), COALESCE(SUM(credit), 0)
+    FROM ledger_lines
+    `
+	var args []interface{}
+	if organizerID != "" {
+		query += ` WHERE organizer_id = ?`
+		args = append(args, organizerID)
+	}
+	query += ` GROUP BY account, currency ORDER BY account, currency`
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to compute account balances: %s", err.Error()))
+		return nil, fmt.Errorf("failed to compute account balances: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []*models.AccountBalance
+	for rows.Next() {
+		balance := &models.AccountBalance{OrganizerID: organizerID}
+		if err := rows.Scan(&balance.Account, &balance.Currency, &balance.Debits, &balance.Credits); err != nil {
+			return nil, fmt.Errorf("failed to scan account balance: %w", err)
+		}
+		balance.Balance = balance.Debits - balance.Credits
+		balances = append(balances, balance)
+	}
+	return balances, rows.Err()
+}
+
+// CheckLedgerInvariants verifies that every entry, and the ledger as a
+// whole, has equal debits and credits.
+func (s *MySQLStore) CheckLedgerInvariants() (*models.LedgerInvariantReport, error) {
+	report := &models.LedgerInvariantReport{}
+
+	err := s.db.QueryRow(`
+    SELECT COUNT(DISTINCT entry_id), COALESCE(SUM(debit), 0), COALESCE(SUM(credit), 0) FROM ledger_lines
+    `).Scan(&report.EntriesChecked, &report.TotalDebits, &report.TotalCredits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total ledger: %w", err)
+	}
+
+	rows, err := s.db.Query(`
+    SELECT e.id
+    FROM ledger_entries e LEFT JOIN ledger_lines l ON l.entry_id = e.id
+    GROUP BY e.id
+    HAVING COALESCE(SUM(l.debit), 0) <> COALESCE(SUM(l.credit), 0) OR COUNT(l.id) < 2
+    `)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check journal balance: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan unbalanced entry: %w", err)
+		}
+		report.UnbalancedEntries = append(report.UnbalancedEntries, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	report.Balanced = report.TotalDebits == report.TotalCredits && len(report.UnbalancedEntries) == 0
+	return report, nil
+}
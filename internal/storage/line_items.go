@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"fmt"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initLineItemTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating payment_line_items table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS payment_line_items (
+        id VARCHAR(64) PRIMARY KEY,
+        payment_id VARCHAR(64) NOT NULL,
+        position INT NOT NULL,
+        kind VARCHAR(20) NOT NULL,
+        sku VARCHAR(100) NOT NULL DEFAULT '',
+        name VARCHAR(255) NOT NULL,
+        ticket_tier VARCHAR(100) NOT NULL DEFAULT '',
+        unit_amount DECIMAL(12,2) NOT NULL,
+        quantity INT NOT NULL,
+        discount DECIMAL(12,2) NOT NULL DEFAULT 0,
+        tax DECIMAL(12,2) NOT NULL DEFAULT 0,
+        fee DECIMAL(12,2) NOT NULL DEFAULT 0,
+        amount DECIMAL(12,2) NOT NULL,
+        refunded_quantity INT NOT NULL DEFAULT 0,
+        refunded_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        INDEX idx_payment_id (payment_id, position)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create payment_line_items table: %w", err)
+	}
+	return nil
+}
+
+const lineItemColumns = `id, kind, sku, name, ticket_tier, unit_amount, quantity, discount, tax, fee, amount,
+    refunded_quantity, refunded_amount`
+
+func scanLineItem(row rowScanner) (*models.LineItem, error) {
+	item := &models.LineItem{}
+	err := row.Scan(
+		&item.ID, &item.Kind, &item.SKU, &item.Name, &item.TicketTier, &item.UnitAmount, &item.Quantity,
+		&item.Discount, &item.Tax, &item.Fee, &item.Amount, &item.RefundedQuantity, &item.RefundedAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// SavePaymentLineItems stores the lines of a payment in one transaction,
+// in the order given.
+func (s *MySQLStore) SavePaymentLineItems(paymentID string, items []*models.LineItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	s.log.LogDatabase("INSERT", "payment_line_items", fmt.Sprintf("Saving %d line items for payment %s", len(items), paymentID))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+    INSERT INTO payment_line_items (
+        id, payment_id, position, kind, sku, name, ticket_tier, unit_amount, quantity, discount, tax, fee, amount
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare line item insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for i, item := range items {
+		_, err := stmt.Exec(
+			item.ID, paymentID, i, item.Kind, item.SKU, item.Name, item.TicketTier, item.UnitAmount, item.Quantity,
+			item.Discount, item.Tax, item.Fee, item.Amount,
+		)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to save line item for payment %s: %s", paymentID, err.Error()))
+			return fmt.Errorf("failed to save line item: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *MySQLStore) ListPaymentLineItems(paymentID string) ([]*models.LineItem, error) {
+	query := `SELECT ` + lineItemColumns + ` FROM payment_line_items WHERE payment_id = ? ORDER BY position`
+
+	rows, err := s.db.Query(query, paymentID)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list line items for payment %s: %s", paymentID, err.Error()))
+		return nil, fmt.Errorf("failed to list line items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.LineItem
+	for rows.Next() {
+		item, err := scanLineItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan line item: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// RefundPaymentLineItems adds the refunded parts to their lines in one
+// transaction. It reports false, changing nothing, when any line of the
+// payment does not have that many unrefunded units left, so concurrent
+// refunds can never return a unit twice.
+func (s *MySQLStore) RefundPaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) (bool, error) {
+	s.log.LogDatabase("UPDATE", "payment_line_items", fmt.Sprintf("Refunding %d line items of payment %s", len(refunded), paymentID))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, part := range refunded {
+		result, err := tx.Exec(`
+        UPDATE payment_line_items
+        SET refunded_quantity = refunded_quantity + ?, refunded_amount = refunded_amount + ?
+        WHERE id = ? AND payment_id = ? AND refunded_quantity + ? <= quantity
+        `, part.Quantity, part.Amount, part.LineItemID, paymentID, part.Quantity)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to refund line item %s: %s", part.LineItemID, err.Error()))
+			return false, fmt.Errorf("failed to refund line item: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to refund line item: %w", err)
+		}
+		if affected != 1 {
+			return false, nil
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to refund line items: %w", err)
+	}
+	return true, nil
+}
+
+// ReleasePaymentLineItems takes back parts added by RefundPaymentLineItems
+// when the refund itself did not go through.
+func (s *MySQLStore) ReleasePaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) error {
+	s.log.LogDatabase("UPDATE", "payment_line_items", fmt.Sprintf("Releasing %d refunded line items of payment %s", len(refunded), paymentID))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, part := range refunded {
+		_, err := tx.Exec(`
+        UPDATE payment_line_items
+        SET refunded_quantity = refunded_quantity - ?, refunded_amount = refunded_amount - ?
+        WHERE id = ? AND payment_id = ? AND refunded_quantity >= ?
+        `, part.Quantity, part.Amount, part.LineItemID, paymentID, part.Quantity)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to release line item %s: %s", part.LineItemID, err.Error()))
+			return fmt.Errorf("failed to release line item: %w", err)
+		}
+	}
+	return tx.Commit()
+}
@@ -3,6 +3,8 @@ package storage
 import (
 	"database/sql"
 	"fmt"
+	"time"
+
 	"payment-gateway/internal/config"
 	"payment-gateway/internal/logger"
 	"payment-gateway/internal/models"
@@ -10,6 +12,28 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
+// paymentColumns is the column list shared by every payments SELECT so that
+// scanPayment stays in sync with the queries.
+const paymentColumns = "payment_id, order_id, status, price, date, transaction_id, organizer_id, currency, email, " + fxColumns
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPayment(row rowScanner) (*models.Payment, error) {
+	payment := &models.Payment{}
+	var fx fxRow
+	err := row.Scan(
+		&payment.PaymentID, &payment.OrderID, &payment.Status, &payment.Price, &payment.Date, &payment.TransactionID,
+		&payment.OrganizerID, &payment.Currency, &payment.Email, &fx.base, &fx.rate, &fx.source, &fx.asOf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payment.FX = fx.snapshot()
+	return payment, nil
+}
+
 type MySQLStore struct {
 	db  *sql.DB
 	log *logger.Logger
@@ -73,7 +97,109 @@ func (s *MySQLStore) initTables() error {
 		return fmt.Errorf("failed to create payments table: %w", err)
 	}
 
+	// Columns added after the initial schema; CREATE TABLE IF NOT EXISTS does
+	// not touch existing tables so they are added individually.
+	if err := s.ensureColumn("payments", "transaction_id", "VARCHAR(255) NOT NULL DEFAULT '' AFTER date"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("payments", "organizer_id", "VARCHAR(64) NOT NULL DEFAULT '' AFTER payment_id, ADD INDEX idx_organizer_id (organizer_id)"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("payments", "currency", "VARCHAR(3) NOT NULL DEFAULT '' AFTER price"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("payments", "email", "VARCHAR(254) NOT NULL DEFAULT '' AFTER currency, ADD INDEX idx_email (email)"); err != nil {
+		return err
+	}
+	if err := s.ensureFXColumns("payments"); err != nil {
+		return err
+	}
+
 	s.log.LogDatabase("SUCCESS", "mysql", "Payments table ready")
+
+	if err := s.initReconciliationTables(); err != nil {
+		return err
+	}
+	if err := s.initLedgerTables(); err != nil {
+		return err
+	}
+	if err := s.initOrganizerTables(); err != nil {
+		return err
+	}
+	if err := s.initFeeTables(); err != nil {
+		return err
+	}
+	if err := s.initPayoutTables(); err != nil {
+		return err
+	}
+	if err := s.initDisputeTables(); err != nil {
+		return err
+	}
+	if err := s.initCustomerTables(); err != nil {
+		return err
+	}
+	if err := s.initRefundTables(); err != nil {
+		return err
+	}
+	if err := s.initWebhookTables(); err != nil {
+		return err
+	}
+	if err := s.initEventTables(); err != nil {
+		return err
+	}
+	if err := s.initAuditTables(); err != nil {
+		return err
+	}
+	if err := s.initRiskTables(); err != nil {
+		return err
+	}
+	if err := s.initCheckoutTables(); err != nil {
+		return err
+	}
+	if err := s.initLineItemTables(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ensureColumn adds a column to an existing table if it is not there yet.
+func (s *MySQLStore) ensureColumn(table, column, definition string) error {
+	var count int
+	err := s.db.QueryRow(`
+    SELECT COUNT(*) FROM information_schema.COLUMNS
+    WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?
+    `, table, column).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	s.log.LogDatabase("MIGRATE", "mysql", fmt.Sprintf("Adding column %s.%s", table, column))
+	if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) ensureIndex(table, index, definition string) error {
+	var count int
+	err := s.db.QueryRow(`
+    SELECT COUNT(*) FROM information_schema.STATISTICS
+    WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = ?
+    `, table, index).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to inspect index %s.%s: %w", table, index, err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	s.log.LogDatabase("MIGRATE", "mysql", fmt.Sprintf("Adding index %s.%s", table, index))
+	if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD %s", table, definition)); err != nil {
+		return fmt.Errorf("failed to add index %s.%s: %w", table, index, err)
+	}
 	return nil
 }
 
@@ -83,12 +209,14 @@ func (s *MySQLStore) SavePayment(payment *models.Payment) error {
 
 	query := `
     INSERT INTO payments (
-        payment_id, order_id, status, price, date
-    ) VALUES (?, ?, ?, ?, ?)
+        payment_id, order_id, status, price, date, transaction_id, organizer_id, currency, email, ` + fxColumns + `
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
     `
 
+	fx := fxArgs(payment.FX)
 	_, err := s.db.Exec(query,
-		payment.PaymentID, payment.OrderID, payment.Status, payment.Price, payment.Date,
+		payment.PaymentID, payment.OrderID, payment.Status, payment.Price, payment.Date, payment.TransactionID,
+		payment.OrganizerID, payment.Currency, payment.Email, fx[0], fx[1], fx[2], fx[3],
 	)
 
 	if err != nil {
@@ -104,15 +232,9 @@ func (s *MySQLStore) SavePayment(payment *models.Payment) error {
 func (s *MySQLStore) GetPayment(id string) (*models.Payment, error) {
 	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Fetching payment %s", id))
 
-	query := `
-    SELECT payment_id, order_id, status, price, date
-    FROM payments WHERE payment_id = ?
-    `
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE payment_id = ?`
 
-	payment := &models.Payment{}
-	err := s.db.QueryRow(query, id).Scan(
-		&payment.PaymentID, &payment.OrderID, &payment.Status, &payment.Price, &payment.Date,
-	)
+	payment, err := scanPayment(s.db.QueryRow(query, id))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -127,18 +249,68 @@ func (s *MySQLStore) GetPayment(id string) (*models.Payment, error) {
 	return payment, nil
 }
 
+// GetPaymentByTransactionID finds the payment backed by a Stripe
+// PaymentIntent.
+func (s *MySQLStore) GetPaymentByTransactionID(transactionID string) (*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE transaction_id = ? ORDER BY date DESC LIMIT 1`
+
+	payment, err := scanPayment(s.db.QueryRow(query, transactionID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("payment not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get payment for transaction %s: %s", transactionID, err.Error()))
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	return payment, nil
+}
+
+// SearchPayments finds payments whose payment ID, order ID, transaction ID or
+// attendee email equals term, newest first.
+func (s *MySQLStore) SearchPayments(term string, limit int) ([]*models.Payment, error) {
+	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Searching payments (limit: %d)", limit))
+
+	query := `SELECT ` + paymentColumns + `
+    FROM payments
+    WHERE payment_id = ? OR order_id = ? OR transaction_id = ? OR email = ?
+    ORDER BY date DESC
+    LIMIT ?
+    `
+
+	rows, err := s.db.Query(query, term, term, term, term, limit)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to search payments: %s", err.Error()))
+		return nil, fmt.Errorf("failed to search payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
+
 // Update UpdatePayment to match new fields
 func (s *MySQLStore) UpdatePayment(payment *models.Payment) error {
 	s.log.LogDatabase("UPDATE", "mysql", fmt.Sprintf("Updating payment %s", payment.PaymentID))
 
 	query := `
     UPDATE payments SET
-        order_id = ?, status = ?, price = ?, date = ?
+        order_id = ?, status = ?, price = ?, date = ?, transaction_id = ?, organizer_id = ?, currency = ?, email = ?,
+        fx_base_currency = ?, fx_rate = ?, fx_source = ?, fx_as_of = ?
     WHERE payment_id = ?
     `
 
+	fx := fxArgs(payment.FX)
 	_, err := s.db.Exec(query,
-		payment.OrderID, payment.Status, payment.Price, payment.Date, payment.PaymentID,
+		payment.OrderID, payment.Status, payment.Price, payment.Date, payment.TransactionID, payment.OrganizerID,
+		payment.Currency, payment.Email, fx[0], fx[1], fx[2], fx[3],
+		payment.PaymentID,
 	)
 
 	if err != nil {
@@ -150,12 +322,29 @@ func (s *MySQLStore) UpdatePayment(payment *models.Payment) error {
 	return nil
 }
 
+// TransitionPayment moves a payment from one status to another. It reports
+// false, changing nothing, when the payment is no longer in the from status,
+// so of two workers settling the same payment only one goes on to act.
+func (s *MySQLStore) TransitionPayment(paymentID string, from, to models.PaymentStatus) (bool, error) {
+	s.log.LogDatabase("UPDATE", "mysql", fmt.Sprintf("Moving payment %s from %s to %s", paymentID, from, to))
+
+	result, err := s.db.Exec(`UPDATE payments SET status = ? WHERE payment_id = ? AND status = ?`, to, paymentID, from)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to move payment %s to %s: %s", paymentID, to, err.Error()))
+		return false, fmt.Errorf("failed to update payment status: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update payment status: %w", err)
+	}
+	return affected == 1, nil
+}
+
 // Update ListPayments to match new fields
 func (s *MySQLStore) ListPayments(orderID string, limit, offset int) ([]*models.Payment, error) {
 	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Listing payments for order %s (limit: %d, offset: %d)", orderID, limit, offset))
 
-	query := `
-    SELECT payment_id, order_id, status, price, date
+	query := `SELECT ` + paymentColumns + `
     FROM payments 
     WHERE order_id = ? 
     ORDER BY date DESC 
@@ -171,11 +360,7 @@ func (s *MySQLStore) ListPayments(orderID string, limit, offset int) ([]*models.
 
 	var payments []*models.Payment
 	for rows.Next() {
-		payment := &models.Payment{}
-		err := rows.Scan(
-			&payment.PaymentID, &payment.OrderID, &payment.Status, &payment.Price, &payment.Date,
-		)
-
+		payment, err := scanPayment(rows)
 		if err != nil {
 			s.log.Error("DATABASE", fmt.Sprintf("Failed to scan payment row: %s", err.Error()))
 			return nil, fmt.Errorf("failed to scan payment: %w", err)
@@ -205,15 +390,9 @@ func (s *MySQLStore) HealthCheck() error {
 func (s *MySQLStore) GetTicketByOrderID(OrderID string) (*models.Payment, error) {
 	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Fetching payment %s", OrderID))
 
-	query := `
-    SELECT payment_id, order_id, status, price, date
-    FROM payments WHERE order_id = ?
-    `
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE order_id = ?`
 
-	payment := &models.Payment{}
-	err := s.db.QueryRow(query, OrderID).Scan(
-		&payment.PaymentID, &payment.OrderID, &payment.Status, &payment.Price, &payment.Date,
-	)
+	payment, err := scanPayment(s.db.QueryRow(query, OrderID))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -227,3 +406,46 @@ func (s *MySQLStore) GetTicketByOrderID(OrderID string) (*models.Payment, error)
 	s.log.LogDatabase("SUCCESS", "mysql", fmt.Sprintf("Payment %s fetched successfully for OrderID %s", OrderID, OrderID))
 	return payment, nil
 }
+
+// ListStalePayments returns payments that have been in the filter's status
+// since before its cutoff, oldest first, one page at a time.
+func (s *MySQLStore) ListStalePayments(filter models.StaleFilter) ([]*models.Payment, error) {
+	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Listing %s payments older than %s (stripe: %v, limit: %d)",
+		filter.Status, filter.Before.Format(time.RFC3339), filter.Stripe, filter.Limit))
+
+	intents := "NOT LIKE"
+	if filter.Stripe {
+		intents = "LIKE"
+	}
+	query := `SELECT ` + paymentColumns + `
+    FROM payments
+    WHERE status = ? AND date < ? AND transaction_id ` + intents + ` 'pi\_%'
+        AND (date > ? OR (date = ? AND payment_id > ?))
+    ORDER BY date ASC, payment_id ASC
+    LIMIT ?
+    `
+
+	rows, err := s.db.Query(query, filter.Status, filter.Before, filter.AfterDate, filter.AfterDate, filter.AfterID, filter.Limit)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list stale payments: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list stale payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to scan payment row: %s", err.Error()))
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+
+	if err = rows.Err(); err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Row iteration error: %s", err.Error()))
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return payments, nil
+}
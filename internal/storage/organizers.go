@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initOrganizerTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating organizers table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS organizers (
+        id VARCHAR(64) PRIMARY KEY,
+        name VARCHAR(255) NOT NULL,
+        email VARCHAR(255) NOT NULL,
+        stripe_account_id VARCHAR(255) NOT NULL DEFAULT '',
+        default_currency VARCHAR(3) NOT NULL,
+        fee_plan VARCHAR(64) NOT NULL DEFAULT '',
+        payout_schedule VARCHAR(20) NOT NULL DEFAULT 'weekly',
+        event_ends_at TIMESTAMP NULL DEFAULT NULL,
+        status VARCHAR(20) NOT NULL,
+        api_key_hash CHAR(64) NOT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        UNIQUE KEY uniq_api_key_hash (api_key_hash)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create organizers table: %w", err)
+	}
+
+	if err := s.ensureColumn("organizers", "payout_schedule", "VARCHAR(20) NOT NULL DEFAULT 'weekly' AFTER fee_plan"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("organizers", "event_ends_at", "TIMESTAMP NULL DEFAULT NULL AFTER payout_schedule"); err != nil {
+		return err
+	}
+	return s.ensureColumn("organizers", "settlement_currency", "VARCHAR(3) NOT NULL DEFAULT '' AFTER default_currency")
+}
+
+const organizerColumns = `id, name, email, stripe_account_id, default_currency, settlement_currency, fee_plan, payout_schedule, event_ends_at, status, api_key_hash, created_at, updated_at`
+
+func scanOrganizer(row rowScanner) (*models.Organizer, error) {
+	org := &models.Organizer{}
+	var eventEndsAt sql.NullTime
+	err := row.Scan(
+		&org.ID, &org.Name, &org.Email, &org.StripeAccountID, &org.DefaultCurrency, &org.SettlementCurrency, &org.FeePlan,
+		&org.PayoutSchedule, &eventEndsAt, &org.Status, &org.APIKeyHash, &org.CreatedAt, &org.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if eventEndsAt.Valid {
+		org.EventEndsAt = &eventEndsAt.Time
+	}
+	if org.SettlementCurrency == "" {
+		org.SettlementCurrency = org.DefaultCurrency
+	}
+	return org, nil
+}
+
+func (s *MySQLStore) SaveOrganizer(org *models.Organizer) error {
+	s.log.LogDatabase("INSERT", "organizers", fmt.Sprintf("Saving organizer %s", org.ID))
+
+	query := `
+    INSERT INTO organizers (
+        id, name, email, stripe_account_id, default_currency, settlement_currency, fee_plan, payout_schedule,
+        event_ends_at, status, api_key_hash, created_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err := s.db.Exec(query,
+		org.ID, org.Name, org.Email, org.StripeAccountID, org.DefaultCurrency, org.SettlementCurrency, org.FeePlan,
+		org.PayoutSchedule, org.EventEndsAt, org.Status, org.APIKeyHash, org.CreatedAt, org.UpdatedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save organizer %s: %s", org.ID, err.Error()))
+		return fmt.Errorf("failed to save organizer: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) UpdateOrganizer(org *models.Organizer) error {
+	s.log.LogDatabase("UPDATE", "organizers", fmt.Sprintf("Updating organizer %s", org.ID))
+
+	query := `
+    UPDATE organizers SET
+        name = ?, email = ?, stripe_account_id = ?, default_currency = ?, settlement_currency = ?, fee_plan = ?,
+        payout_schedule = ?, event_ends_at = ?, status = ?, api_key_hash = ?, updated_at = ?
+    WHERE id = ?
+    `
+	_, err := s.db.Exec(query,
+		org.Name, org.Email, org.StripeAccountID, org.DefaultCurrency, org.SettlementCurrency, org.FeePlan,
+		org.PayoutSchedule, org.EventEndsAt, org.Status, org.APIKeyHash, org.UpdatedAt, org.ID,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update organizer %s: %s", org.ID, err.Error()))
+		return fmt.Errorf("failed to update organizer: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetOrganizer(id string) (*models.Organizer, error) {
+	query := `SELECT ` + organizerColumns + ` FROM organizers WHERE id = ?`
+
+	org, err := scanOrganizer(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("organizer not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get organizer %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get organizer: %w", err)
+	}
+	return org, nil
+}
+
+func (s *MySQLStore) GetOrganizerByAPIKeyHash(hash string) (*models.Organizer, error) {
+	query := `SELECT ` + organizerColumns + ` FROM organizers WHERE api_key_hash = ?`
+
+	org, err := scanOrganizer(s.db.QueryRow(query, hash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("organizer not found")
+		}
+		return nil, fmt.Errorf("failed to get organizer: %w", err)
+	}
+	return org, nil
+}
+
+// ListOrganizers returns every organizer with the given status.
+func (s *MySQLStore) ListOrganizers(status models.OrganizerStatus) ([]*models.Organizer, error) {
+	query := `SELECT ` + organizerColumns + ` FROM organizers WHERE status = ? ORDER BY id`
+
+	rows, err := s.db.Query(query, status)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list organizers: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list organizers: %w", err)
+	}
+	defer rows.Close()
+
+	var organizers []*models.Organizer
+	for rows.Next() {
+		org, err := scanOrganizer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organizer: %w", err)
+		}
+		organizers = append(organizers, org)
+	}
+	return organizers, rows.Err()
+}
+
+// ListOrganizerPayments lists payments owned by an organizer, newest first.
+func (s *MySQLStore) ListOrganizerPayments(organizerID string, limit, offset int) ([]*models.Payment, error) {
+	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Listing payments for organizer %s (limit: %d, offset: %d)", organizerID, limit, offset))
+
+	query := `SELECT ` + paymentColumns + `
+    FROM payments
+    WHERE organizer_id = ?
+    ORDER BY date DESC
+    LIMIT ? OFFSET ?
+    `
+
+	rows, err := s.db.Query(query, organizerID, limit, offset)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list organizer payments: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func (s *MySQLStore) initPayoutTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating payouts table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS payouts (
+        id VARCHAR(64) PRIMARY KEY,
+        organizer_id VARCHAR(64) NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        available DECIMAL(12,2) NOT NULL DEFAULT 0,
+        reserve DECIMAL(12,2) NOT NULL DEFAULT 0,
+        amount DECIMAL(12,2) NOT NULL,
+        method VARCHAR(30) NOT NULL,
+        status VARCHAR(20) NOT NULL,
+        provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+        failure_reason TEXT,
+        period_start TIMESTAMP NULL DEFAULT NULL,
+        period_end TIMESTAMP NULL DEFAULT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        paid_at TIMESTAMP NULL DEFAULT NULL,
+        INDEX idx_organizer_created (organizer_id, created_at),
+        INDEX idx_status_method (status, method),
+        UNIQUE KEY uniq_organizer_period (organizer_id, currency, period_start)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create payouts table: %w", err)
+	}
+
+	if err := s.ensureIndex("payouts", "uniq_organizer_period", "UNIQUE KEY uniq_organizer_period (organizer_id, currency, period_start)"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("payouts", "settlement_currency", "VARCHAR(3) NOT NULL DEFAULT '' AFTER amount"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("payouts", "settlement_amount", "DECIMAL(12,2) NOT NULL DEFAULT 0 AFTER settlement_currency"); err != nil {
+		return err
+	}
+	return s.ensureFXColumns("payouts")
+}
+
+const payoutColumns = `id, organizer_id, currency, available, reserve, amount, settlement_currency, settlement_amount,
+    method, status, provider_reference, COALESCE(failure_reason, ''), period_start, period_end, created_at, paid_at, ` + fxColumns
+
+func scanPayout(row rowScanner) (*models.Payout, error) {
+	payout := &models.Payout{}
+	var paidAt sql.NullTime
+	var fx fxRow
+	err := row.Scan(
+		&payout.ID, &payout.OrganizerID, &payout.Currency, &payout.Available, &payout.Reserve, &payout.Amount,
+		&payout.SettlementCurrency, &payout.SettlementAmount,
+		&payout.Method, &payout.Status, &payout.ProviderReference, &payout.FailureReason,
+		&payout.PeriodStart, &payout.PeriodEnd, &payout.CreatedAt, &paidAt,
+		&fx.base, &fx.rate, &fx.source, &fx.asOf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payout.FX = fx.snapshot()
+	// Payouts made before settlement currencies were tracked
+	if payout.SettlementCurrency == "" {
+		payout.SettlementCurrency = payout.Currency
+		payout.SettlementAmount = payout.Amount
+	}
+	if paidAt.Valid {
+		payout.PaidAt = &paidAt.Time
+	}
+	return payout, nil
+}
+
+// SavePayout stores a new payout. Only one payout may cover an organizer's
+// period in a currency; a second one returns ErrDuplicateReference, so
+// gateway instances running payouts at the same time cannot both pay.
+func (s *MySQLStore) SavePayout(payout *models.Payout) error {
+	s.log.LogDatabase("INSERT", "payouts", fmt.Sprintf("Saving payout %s for organizer %s", payout.ID, payout.OrganizerID))
+
+	query := `
+    INSERT INTO payouts (
+        id, organizer_id, currency, available, reserve, amount, settlement_currency, settlement_amount,
+        method, status, provider_reference, failure_reason, period_start, period_end, created_at, paid_at,
+        ` + fxColumns + `
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	fx := fxArgs(payout.FX)
+	_, err := s.db.Exec(query,
+		payout.ID, payout.OrganizerID, payout.Currency, payout.Available, payout.Reserve, payout.Amount,
+		payout.SettlementCurrency, payout.SettlementAmount,
+		payout.Method, payout.Status, payout.ProviderReference, payout.FailureReason,
+		payout.PeriodStart, payout.PeriodEnd, payout.CreatedAt, payout.PaidAt,
+		fx[0], fx[1], fx[2], fx[3],
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return ErrDuplicateReference
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save payout %s: %s", payout.ID, err.Error()))
+		return fmt.Errorf("failed to save payout: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) UpdatePayout(payout *models.Payout) error {
+	s.log.LogDatabase("UPDATE", "payouts", fmt.Sprintf("Updating payout %s to %s", payout.ID, payout.Status))
+
+	query := `
+    UPDATE payouts SET status = ?, provider_reference = ?, failure_reason = ?, paid_at = ?
+    WHERE id = ?
+    `
+	_, err := s.db.Exec(query, payout.Status, payout.ProviderReference, payout.FailureReason, payout.PaidAt, payout.ID)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update payout %s: %s", payout.ID, err.Error()))
+		return fmt.Errorf("failed to update payout: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetPayout(id string) (*models.Payout, error) {
+	query := `SELECT ` + payoutColumns + ` FROM payouts WHERE id = ?`
+
+	payout, err := scanPayout(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("payout not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get payout %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get payout: %w", err)
+	}
+	return payout, nil
+}
+
+// ListPayouts lists an organizer's payouts, newest first.
+func (s *MySQLStore) ListPayouts(organizerID string, limit, offset int) ([]*models.Payout, error) {
+	query := `SELECT ` + payoutColumns + `
+    FROM payouts
+    WHERE organizer_id = ?
+    ORDER BY created_at DESC
+    LIMIT ? OFFSET ?
+    `
+	return s.queryPayouts(query, organizerID, limit, offset)
+}
+
+// ListPayoutsByStatus lists payouts of one method in a given status, oldest
+// first.
+func (s *MySQLStore) ListPayoutsByStatus(method models.PayoutMethod, status models.PayoutStatus) ([]*models.Payout, error) {
+	query := `SELECT ` + payoutColumns + `
+    FROM payouts
+    WHERE method = ? AND status = ?
+    ORDER BY created_at ASC
+    `
+	return s.queryPayouts(query, method, status)
+}
+
+// LatestPayout returns the most recent payout that was not failed, or nil
+// if the organizer has never been paid in this currency.
+func (s *MySQLStore) LatestPayout(organizerID, currency string) (*models.Payout, error) {
+	query := `SELECT ` + payoutColumns + `
+    FROM payouts
+    WHERE organizer_id = ? AND currency = ? AND status <> ?
+    ORDER BY created_at DESC
+    LIMIT 1
+    `
+	payout, err := scanPayout(s.db.QueryRow(query, organizerID, currency, models.PayoutFailed))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest payout: %w", err)
+	}
+	return payout, nil
+}
+
+func (s *MySQLStore) queryPayouts(query string, args ...interface{}) ([]*models.Payout, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list payouts: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list payouts: %w", err)
+	}
+	defer rows.Close()
+
+	var payouts []*models.Payout
+	for rows.Next() {
+		payout, err := scanPayout(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payout: %w", err)
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts, rows.Err()
+}
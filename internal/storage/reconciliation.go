@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initReconciliationTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating reconciliation tables if not exist")
+
+	runs := `
+    CREATE TABLE IF NOT EXISTS reconciliation_runs (
+        id VARCHAR(64) PRIMARY KEY,
+        period_start DATETIME NOT NULL,
+        period_end DATETIME NOT NULL,
+        status VARCHAR(20) NOT NULL,
+        stripe_count INT NOT NULL DEFAULT 0,
+        local_count INT NOT NULL DEFAULT 0,
+        matched_count INT NOT NULL DEFAULT 0,
+        discrepancy_count INT NOT NULL DEFAULT 0,
+        error_message TEXT,
+        started_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        completed_at TIMESTAMP NULL,
+        INDEX idx_started_at (started_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(runs); err != nil {
+		return fmt.Errorf("failed to create reconciliation_runs table: %w", err)
+	}
+
+	items := `
+    CREATE TABLE IF NOT EXISTS reconciliation_items (
+        id BIGINT AUTO_INCREMENT PRIMARY KEY,
+        run_id VARCHAR(64) NOT NULL,
+        type VARCHAR(32) NOT NULL,
+        payment_id VARCHAR(64) NOT NULL DEFAULT '',
+        order_id VARCHAR(64) NOT NULL DEFAULT '',
+        charge_id VARCHAR(255) NOT NULL DEFAULT '',
+        payment_intent_id VARCHAR(255) NOT NULL DEFAULT '',
+        local_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+        stripe_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+        stripe_fee DECIMAL(10,2) NOT NULL DEFAULT 0,
+        currency VARCHAR(3) NOT NULL DEFAULT '',
+        local_status VARCHAR(50) NOT NULL DEFAULT '',
+        stripe_status VARCHAR(50) NOT NULL DEFAULT '',
+        details TEXT,
+        INDEX idx_run_id (run_id),
+        INDEX idx_payment_id (payment_id)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(items); err != nil {
+		return fmt.Errorf("failed to create reconciliation_items table: %w", err)
+	}
+
+	return nil
+}
+
+const reconciliationRunColumns = `id, period_start, period_end, status, stripe_count, local_count,
+        matched_count, discrepancy_count, COALESCE(error_message, ''), started_at, completed_at`
+
+func scanReconciliationRun(row rowScanner) (*models.ReconciliationRun, error) {
+	run := &models.ReconciliationRun{}
+	var completedAt sql.NullTime
+	err := row.Scan(
+		&run.ID, &run.PeriodStart, &run.PeriodEnd, &run.Status, &run.StripeCount, &run.LocalCount,
+		&run.MatchedCount, &run.DiscrepancyCount, &run.Error, &run.StartedAt, &completedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		run.CompletedAt = &completedAt.Time
+	}
+	return run, nil
+}
+
+func (s *MySQLStore) SaveReconciliationRun(run *models.ReconciliationRun) error {
+	s.log.LogDatabase("UPSERT", "reconciliation_runs", fmt.Sprintf("Saving reconciliation run %s", run.ID))
+
+	query := `
+    INSERT INTO reconciliation_runs (
+        id, period_start, period_end, status, stripe_count, local_count,
+        matched_count, discrepancy_count, error_message, started_at, completed_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    ON DUPLICATE KEY UPDATE
+        status = VALUES(status), stripe_count = VALUES(stripe_count), local_count = VALUES(local_count),
+        matched_count = VALUES(matched_count), discrepancy_count = VALUES(discrepancy_count),
+        error_message = VALUES(error_message), completed_at = VALUES(completed_at)
+    `
+
+	_, err := s.db.Exec(query,
+		run.ID, run.PeriodStart, run.PeriodEnd, run.Status, run.StripeCount, run.LocalCount,
+		run.MatchedCount, run.DiscrepancyCount, run.Error, run.StartedAt, run.CompletedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save reconciliation run %s: %s", run.ID, err.Error()))
+		return fmt.Errorf("failed to save reconciliation run: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetReconciliationRun(id string) (*models.ReconciliationRun, error) {
+	query := `SELECT ` + reconciliationRunColumns + ` FROM reconciliation_runs WHERE id = ?`
+
+	run, err := scanReconciliationRun(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("reconciliation run not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get reconciliation run %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get reconciliation run: %w", err)
+	}
+	return run, nil
+}
+
+func (s *MySQLStore) ListReconciliationRuns(limit, offset int) ([]*models.ReconciliationRun, error) {
+	query := `SELECT ` + reconciliationRunColumns + `
+    FROM reconciliation_runs
+    ORDER BY started_at DESC
+    LIMIT ? OFFSET ?
+    `
+
+	rows, err := s.db.Query(query, limit, offset)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list reconciliation runs: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list reconciliation runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*models.ReconciliationRun
+	for rows.Next() {
+		run, err := scanReconciliationRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reconciliation run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// SaveReconciliationItems stores the discrepancies of a run in one transaction.
+func (s *MySQLStore) SaveReconciliationItems(items []*models.ReconciliationItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	s.log.LogDatabase("INSERT", "reconciliation_items", fmt.Sprintf("Saving %d reconciliation items", len(items)))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+    INSERT INTO reconciliation_items (
+        run_id, type, payment_id, order_id, charge_id, payment_intent_id,
+        local_amount, stripe_amount, stripe_fee, currency, local_status, stripe_status, details
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare reconciliation item insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, item := range items {
+		res, err := stmt.Exec(
+			item.RunID, item.Type, item.PaymentID, item.OrderID, item.ChargeID, item.PaymentIntentID,
+			item.LocalAmount, item.StripeAmount, item.StripeFee, item.Currency, item.LocalStatus, item.StripeStatus, item.Details,
+		)
+		if err != nil {
+			s.log.Error("DATABASE", fmt.Sprintf("Failed to save reconciliation item for run %s: %s", item.RunID, err.Error()))
+			return fmt.Errorf("failed to save reconciliation item: %w", err)
+		}
+		item.ID, _ = res.LastInsertId()
+	}
+
+	return tx.Commit()
+}
+
+func (s *MySQLStore) ListReconciliationItems(runID string) ([]*models.ReconciliationItem, error) {
+	query := `
+    SELECT id, run_id, type, payment_id, order_id, charge_id, payment_intent_id,
+        local_amount, stripe_amount, stripe_fee, currency, local_status, stripe_status, COALESCE(details, '')
+    FROM reconciliation_items
+    WHERE run_id = ?
+    ORDER BY id ASC
+    `
+
+	rows, err := s.db.Query(query, runID)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list reconciliation items: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list reconciliation items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.ReconciliationItem
+	for rows.Next() {
+		item := &models.ReconciliationItem{}
+		err := rows.Scan(
+			&item.ID, &item.RunID, &item.Type, &item.PaymentID, &item.OrderID, &item.ChargeID, &item.PaymentIntentID,
+			&item.LocalAmount, &item.StripeAmount, &item.StripeFee, &item.Currency, &item.LocalStatus, &item.StripeStatus, &item.Details,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reconciliation item: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// ListPaymentsBetween returns all payments dated within [from, to).
+func (s *MySQLStore) ListPaymentsBetween(from, to time.Time) ([]*models.Payment, error) {
+	s.log.LogDatabase("SELECT", "mysql", fmt.Sprintf("Listing payments between %s and %s", from.Format(time.RFC3339), to.Format(time.RFC3339)))
+
+	query := `SELECT ` + paymentColumns + `
+    FROM payments
+    WHERE date >= ? AND date < ?
+    ORDER BY date ASC
+    `
+
+	rows, err := s.db.Query(query, from, to)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list payments: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
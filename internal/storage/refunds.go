@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"payment-gateway/internal/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// fxColumns hold an FX snapshot on payments, refunds and payouts.
+const fxColumns = "fx_base_currency, fx_rate, fx_source, fx_as_of"
+
+// ensureFXColumns adds the FX snapshot columns to a table.
+func (s *MySQLStore) ensureFXColumns(table string) error {
+	columns := [][2]string{
+		{"fx_base_currency", "VARCHAR(3) NOT NULL DEFAULT ''"},
+		{"fx_rate", "DECIMAL(18,8) NOT NULL DEFAULT 0"},
+		{"fx_source", "VARCHAR(255) NOT NULL DEFAULT ''"},
+		{"fx_as_of", "TIMESTAMP NULL DEFAULT NULL"},
+	}
+	for _, c := range columns {
+		if err := s.ensureColumn(table, c[0], c[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fxRow scans the FX snapshot columns; a zero rate means none was taken.
+type fxRow struct {
+	base   string
+	rate   float64
+	source string
+	asOf   sql.NullTime
+}
+
+func (r fxRow) snapshot() *models.FXSnapshot {
+	if r.rate <= 0 {
+		return nil
+	}
+	snapshot := &models.FXSnapshot{BaseCurrency: r.base, Rate: r.rate, Source: r.source}
+	if r.asOf.Valid {
+		snapshot.AsOf = r.asOf.Time
+	}
+	return snapshot
+}
+
+func fxArgs(snapshot *models.FXSnapshot) [4]interface{} {
+	if snapshot == nil {
+		return [4]interface{}{"", 0, "", nil}
+	}
+	return [4]interface{}{snapshot.BaseCurrency, snapshot.Rate, snapshot.Source, snapshot.AsOf}
+}
+
+func (s *MySQLStore) initRefundTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating payment_refunds table if not exists")
+
+	query := `
+    CREATE TABLE IF NOT EXISTS payment_refunds (
+        id VARCHAR(64) PRIMARY KEY,
+        payment_id VARCHAR(64) NOT NULL,
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        amount DECIMAL(12,2) NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        reason VARCHAR(255) NOT NULL DEFAULT '',
+        line_items TEXT NULL,
+        fx_base_currency VARCHAR(3) NOT NULL DEFAULT '',
+        fx_rate DECIMAL(18,8) NOT NULL DEFAULT 0,
+        fx_source VARCHAR(255) NOT NULL DEFAULT '',
+        fx_as_of TIMESTAMP NULL DEFAULT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        INDEX idx_payment_id (payment_id),
+        INDEX idx_organizer_created (organizer_id, created_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create payment_refunds table: %w", err)
+	}
+	return s.ensureColumn("payment_refunds", "line_items", "TEXT NULL AFTER reason")
+}
+
+const refundColumns = `id, payment_id, organizer_id, amount, currency, reason, line_items, ` + fxColumns + `, created_at`
+
+func scanRefund(row rowScanner) (*models.Refund, error) {
+	refund := &models.Refund{}
+	var lineItems sql.NullString
+	var fx fxRow
+	err := row.Scan(
+		&refund.ID, &refund.PaymentID, &refund.OrganizerID, &refund.Amount, &refund.Currency, &refund.Reason, &lineItems,
+		&fx.base, &fx.rate, &fx.source, &fx.asOf, &refund.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if lineItems.Valid && lineItems.String != "" {
+		if err := json.Unmarshal([]byte(lineItems.String), &refund.LineItems); err != nil {
+			return nil, fmt.Errorf("failed to decode refunded line items: %w", err)
+		}
+	}
+	refund.FX = fx.snapshot()
+	return refund, nil
+}
+
+// SaveRefund records a refund. Refunds are keyed by the provider's refund ID,
+// so a webhook or retry that reports the same refund returns
+// ErrDuplicateReference.
+func (s *MySQLStore) SaveRefund(refund *models.Refund) error {
+	s.log.LogDatabase("INSERT", "payment_refunds", fmt.Sprintf("Saving refund %s for payment %s", refund.ID, refund.PaymentID))
+
+	var lineItems interface{}
+	if len(refund.LineItems) > 0 {
+		encoded, err := json.Marshal(refund.LineItems)
+		if err != nil {
+			return fmt.Errorf("failed to encode refunded line items: %w", err)
+		}
+		lineItems = string(encoded)
+	}
+
+	query := `
+    INSERT INTO payment_refunds (` + refundColumns + `)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	fx := fxArgs(refund.FX)
+	_, err := s.db.Exec(query,
+		refund.ID, refund.PaymentID, refund.OrganizerID, refund.Amount, refund.Currency, refund.Reason, lineItems,
+		fx[0], fx[1], fx[2], fx[3], refund.CreatedAt,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return ErrDuplicateReference
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save refund %s: %s", refund.ID, err.Error()))
+		return fmt.Errorf("failed to save refund: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) ListRefunds(paymentID string) ([]*models.Refund, error) {
+	query := `SELECT ` + refundColumns + ` FROM payment_refunds WHERE payment_id = ? ORDER BY created_at`
+
+	rows, err := s.db.Query(query, paymentID)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list refunds for payment %s: %s", paymentID, err.Error()))
+		return nil, fmt.Errorf("failed to list refunds: %w", err)
+	}
+	defer rows.Close()
+
+	var refunds []*models.Refund
+	for rows.Next() {
+		refund, err := scanRefund(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan refund: %w", err)
+		}
+		refunds = append(refunds, refund)
+	}
+	return refunds, rows.Err()
+}
@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initRiskTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating risk tables if not exist")
+
+	reviews := `
+    CREATE TABLE IF NOT EXISTS risk_reviews (
+        payment_id VARCHAR(64) PRIMARY KEY,
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        order_id VARCHAR(64) NOT NULL,
+        transaction_id VARCHAR(255) NOT NULL DEFAULT '',
+        amount DECIMAL(10,2) NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        score INT NOT NULL,
+        assessment TEXT NOT NULL,
+        status VARCHAR(20) NOT NULL,
+        reviewer VARCHAR(191) NOT NULL DEFAULT '',
+        note TEXT,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        decided_at TIMESTAMP NULL DEFAULT NULL,
+        INDEX idx_status_created (status, created_at),
+        INDEX idx_organizer_status (organizer_id, status)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(reviews); err != nil {
+		return fmt.Errorf("failed to create risk_reviews table: %w", err)
+	}
+	return nil
+}
+
+const riskReviewColumns = `payment_id, organizer_id, order_id, transaction_id, amount, currency, score, assessment,
+    status, reviewer, COALESCE(note, ''), created_at, decided_at`
+
+func scanRiskReview(row rowScanner) (*models.RiskReview, error) {
+	r := &models.RiskReview{}
+	var assessment string
+	var decidedAt sql.NullTime
+	err := row.Scan(
+		&r.PaymentID, &r.OrganizerID, &r.OrderID, &r.TransactionID, &r.Amount, &r.Currency, &r.Score, &assessment,
+		&r.Status, &r.Reviewer, &r.Note, &r.CreatedAt, &decidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(assessment), &r.Assessment); err != nil {
+		return nil, fmt.Errorf("failed to decode risk assessment: %w", err)
+	}
+	r.DecidedAt = nullTime(decidedAt)
+	return r, nil
+}
+
+func (s *MySQLStore) SaveRiskReview(r *models.RiskReview) error {
+	s.log.LogDatabase("INSERT", "risk_reviews", fmt.Sprintf("Saving risk review for payment %s", r.PaymentID))
+
+	assessment, err := json.Marshal(r.Assessment)
+	if err != nil {
+		return fmt.Errorf("failed to encode risk assessment: %w", err)
+	}
+
+	query := `
+    INSERT INTO risk_reviews (
+        payment_id, organizer_id, order_id, transaction_id, amount, currency, score, assessment,
+        status, reviewer, note, created_at, decided_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err = s.db.Exec(query,
+		r.PaymentID, r.OrganizerID, r.OrderID, r.TransactionID, r.Amount, r.Currency, r.Score, string(assessment),
+		r.Status, r.Reviewer, r.Note, r.CreatedAt, r.DecidedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save risk review for payment %s: %s", r.PaymentID, err.Error()))
+		return fmt.Errorf("failed to save risk review: %w", err)
+	}
+	return nil
+}
+
+// DecideRiskReview records a decision on a pending review. It reports false
+// when the review had already been decided, so two reviewers cannot both
+// act on one payment.
+func (s *MySQLStore) DecideRiskReview(r *models.RiskReview) (bool, error) {
+	s.log.LogDatabase("UPDATE", "risk_reviews", fmt.Sprintf("Recording %s for payment %s", r.Status, r.PaymentID))
+
+	result, err := s.db.Exec(`
+    UPDATE risk_reviews SET status = ?, reviewer = ?, note = ?, decided_at = ?
+    WHERE payment_id = ? AND status = ?
+    `, r.Status, r.Reviewer, r.Note, r.DecidedAt, r.PaymentID, models.ReviewPending)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update risk review for payment %s: %s", r.PaymentID, err.Error()))
+		return false, fmt.Errorf("failed to update risk review: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update risk review: %w", err)
+	}
+	return affected == 1, nil
+}
+
+func (s *MySQLStore) GetRiskReview(paymentID string) (*models.RiskReview, error) {
+	query := `SELECT ` + riskReviewColumns + ` FROM risk_reviews WHERE payment_id = ?`
+
+	r, err := scanRiskReview(s.db.QueryRow(query, paymentID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("risk review not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get risk review for payment %s: %s", paymentID, err.Error()))
+		return nil, fmt.Errorf("failed to get risk review: %w", err)
+	}
+	return r, nil
+}
+
+// ListRiskReviews lists reviews oldest first so the queue is worked in
+// order. Empty filters match everything.
+func (s *MySQLStore) ListRiskReviews(organizerID string, status models.RiskReviewStatus, limit, offset int) ([]*models.RiskReview, error) {
+	query := `SELECT ` + riskReviewColumns + ` FROM risk_reviews WHERE 1 = 1`
+	var args []interface{}
+	if organizerID != "" {
+		query += ` AND organizer_id = ?`
+		args = append(args, organizerID)
+	}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY created_at ASC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list risk reviews: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list risk reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*models.RiskReview
+	for rows.Next() {
+		r, err := scanRiskReview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan risk review: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+	return reviews, rows.Err()
+}
+
+// AveragePaymentAmount returns the mean amount and number of an organizer's
+// successful payments in a currency since the given time.
+func (s *MySQLStore) AveragePaymentAmount(organizerID, currency string, since time.Time) (float64, int, error) {
+	var average sql.NullFloat64
+	var count int
+	err := s.db.QueryRow(`
+    SELECT AVG(price), COUNT(*) FROM payments
+    WHERE organizer_id = ? AND currency = ? AND status = ? AND date >= ?
+    `, organizerID, currency, models.StatusSuccess, since).Scan(&average, &count)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to average payments for organizer %s: %s", organizerID, err.Error()))
+		return 0, 0, fmt.Errorf("failed to average payments: %w", err)
+	}
+	return average.Float64, count, nil
+}
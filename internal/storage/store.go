@@ -1,13 +1,135 @@
 package storage
 
 import (
+	"time"
+
 	"payment-gateway/internal/models"
 )
 
 type Store interface {
 	SavePayment(payment *models.Payment) error
 	GetPayment(id string) (*models.Payment, error)
+	GetPaymentByTransactionID(transactionID string) (*models.Payment, error)
 	UpdatePayment(payment *models.Payment) error
+	TransitionPayment(paymentID string, from, to models.PaymentStatus) (bool, error)
 	ListPayments(merchantID string, limit, offset int) ([]*models.Payment, error)
 	GetTicketByOrderID(OrderID string) (*models.Payment, error)
+	ListStalePayments(filter models.StaleFilter) ([]*models.Payment, error)
+	ListPaymentsBetween(from, to time.Time) ([]*models.Payment, error)
+	ListOrganizerPayments(organizerID string, limit, offset int) ([]*models.Payment, error)
+	SearchPayments(term string, limit int) ([]*models.Payment, error)
+}
+
+type ReconciliationStore interface {
+	SaveReconciliationRun(run *models.ReconciliationRun) error
+	GetReconciliationRun(id string) (*models.ReconciliationRun, error)
+	ListReconciliationRuns(limit, offset int) ([]*models.ReconciliationRun, error)
+	SaveReconciliationItems(items []*models.ReconciliationItem) error
+	ListReconciliationItems(runID string) ([]*models.ReconciliationItem, error)
+}
+
+type LedgerStore interface {
+	PostJournalEntry(entry *models.JournalEntry) error
+	ListJournalEntries(paymentID string) ([]*models.JournalEntry, error)
+	ListOrganizerJournalEntries(organizerID, currency string, from, to time.Time) ([]*models.JournalEntry, error)
+	AccountBalances(organizerID string) ([]*models.AccountBalance, error)
+	CheckLedgerInvariants() (*models.LedgerInvariantReport, error)
+}
+
+type OrganizerStore interface {
+	SaveOrganizer(org *models.Organizer) error
+	UpdateOrganizer(org *models.Organizer) error
+	GetOrganizer(id string) (*models.Organizer, error)
+	GetOrganizerByAPIKeyHash(hash string) (*models.Organizer, error)
+	ListOrganizers(status models.OrganizerStatus) ([]*models.Organizer, error)
+}
+
+type FeeStore interface {
+	SaveFeePlan(plan *models.FeePlan) error
+	GetFeePlan(id string) (*models.FeePlan, error)
+	ListFeePlans() ([]*models.FeePlan, error)
+	SaveFeeLine(line *models.FeeLine) error
+	ListFeeLines(paymentID string) ([]*models.FeeLine, error)
+}
+
+type PayoutStore interface {
+	SavePayout(payout *models.Payout) error
+	UpdatePayout(payout *models.Payout) error
+	GetPayout(id string) (*models.Payout, error)
+	ListPayouts(organizerID string, limit, offset int) ([]*models.Payout, error)
+	ListPayoutsByStatus(method models.PayoutMethod, status models.PayoutStatus) ([]*models.Payout, error)
+	LatestPayout(organizerID, currency string) (*models.Payout, error)
+}
+
+type DisputeStore interface {
+	SaveDispute(dispute *models.Dispute) error
+	GetDispute(id string) (*models.Dispute, error)
+	ListDisputes(organizerID string, status string, limit, offset int) ([]*models.Dispute, error)
+	ListDisputesDueBefore(before time.Time) ([]*models.Dispute, error)
+	ListPaymentDisputes(paymentID string) ([]*models.Dispute, error)
+}
+
+type CustomerStore interface {
+	SaveCustomer(customer *models.Customer) error
+	UpdateCustomer(customer *models.Customer) error
+	GetCustomer(userID string) (*models.Customer, error)
+}
+
+type RefundStore interface {
+	SaveRefund(refund *models.Refund) error
+	ListRefunds(paymentID string) ([]*models.Refund, error)
+}
+
+type WebhookStore interface {
+	SaveWebhookEndpoint(endpoint *models.WebhookEndpoint) error
+	UpdateWebhookEndpoint(endpoint *models.WebhookEndpoint) error
+	GetWebhookEndpoint(id string) (*models.WebhookEndpoint, error)
+	DeleteWebhookEndpoint(id string) error
+	ListWebhookEndpoints(organizerID string) ([]*models.WebhookEndpoint, error)
+	ListEnabledWebhookEndpoints(organizerID string) ([]*models.WebhookEndpoint, error)
+	SaveWebhookDelivery(delivery *models.WebhookDelivery) error
+	UpdateWebhookDelivery(delivery *models.WebhookDelivery) error
+	GetWebhookDelivery(id string) (*models.WebhookDelivery, error)
+	ListWebhookDeliveries(endpointID string, limit, offset int) ([]*models.WebhookDelivery, error)
+	ListDueWebhookDeliveries(before time.Time, limit int) ([]*models.WebhookDelivery, error)
+	ClaimWebhookDelivery(id string, due, until time.Time) (bool, error)
+	ListFailedWebhookDeliveries(limit, offset int) ([]*models.WebhookDelivery, error)
+}
+
+type EventStore interface {
+	SaveEvent(entry *models.EventLogEntry) error
+	ListEvents(filter models.EventFilter) ([]*models.EventLogEntry, error)
+	ListUnpublishedEvents(limit int) ([]*models.EventLogEntry, error)
+	MarkEventPublished(id string) error
+}
+
+// AuditStore is append-only: there is no way to change or remove an entry.
+type AuditStore interface {
+	AppendAuditEntry(entry *models.AuditEntry) error
+	ListAuditEntries(filter models.AuditFilter) ([]*models.AuditEntry, error)
+	ListAuditChain(afterSeq int64, limit int) ([]*models.AuditEntry, error)
+	AuditChainHead() (int64, string, error)
+}
+
+type RiskStore interface {
+	SaveRiskReview(review *models.RiskReview) error
+	DecideRiskReview(review *models.RiskReview) (bool, error)
+	GetRiskReview(paymentID string) (*models.RiskReview, error)
+	ListRiskReviews(organizerID string, status models.RiskReviewStatus, limit, offset int) ([]*models.RiskReview, error)
+	AveragePaymentAmount(organizerID, currency string, since time.Time) (float64, int, error)
+}
+
+type CheckoutStore interface {
+	SaveCheckoutSession(session *models.CheckoutSession) error
+	UpdateCheckoutSession(session *models.CheckoutSession) error
+	ClaimCheckoutSession(id string, now time.Time) (bool, error)
+	GetCheckoutSession(id string) (*models.CheckoutSession, error)
+	ListCheckoutSessions(organizerID string, status models.CheckoutStatus, limit, offset int) ([]*models.CheckoutSession, error)
+}
+
+type LineItemStore interface {
+	SavePaymentLineItems(paymentID string, items []*models.LineItem) error
+	ListPaymentLineItems(paymentID string) ([]*models.LineItem, error)
+	RefundPaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) (bool, error)
+	ReleasePaymentLineItems(paymentID string, refunded []*models.RefundedLineItem) error
 }
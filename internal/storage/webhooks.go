@@ -0,0 +1,353 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"payment-gateway/internal/models"
+)
+
+func (s *MySQLStore) initWebhookTables() error {
+	s.log.LogDatabase("MIGRATE", "mysql", "Creating webhook tables if not exist")
+
+	endpoints := `
+    CREATE TABLE IF NOT EXISTS webhook_endpoints (
+        id VARCHAR(64) PRIMARY KEY,
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        url VARCHAR(500) NOT NULL,
+        description VARCHAR(255) NOT NULL DEFAULT '',
+        event_types TEXT,
+        secret VARCHAR(128) NOT NULL,
+        enabled BOOLEAN NOT NULL DEFAULT TRUE,
+        consecutive_failures INT NOT NULL DEFAULT 0,
+        disabled_reason VARCHAR(255) NOT NULL DEFAULT '',
+        disabled_at TIMESTAMP NULL DEFAULT NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        INDEX idx_organizer_enabled (organizer_id, enabled)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(endpoints); err != nil {
+		return fmt.Errorf("failed to create webhook_endpoints table: %w", err)
+	}
+
+	deliveries := `
+    CREATE TABLE IF NOT EXISTS webhook_deliveries (
+        id VARCHAR(64) PRIMARY KEY,
+        endpoint_id VARCHAR(64) NOT NULL,
+        organizer_id VARCHAR(64) NOT NULL DEFAULT '',
+        event_id VARCHAR(64) NOT NULL,
+        event_type VARCHAR(100) NOT NULL,
+        payload MEDIUMTEXT NOT NULL,
+        status VARCHAR(20) NOT NULL,
+        attempts INT NOT NULL DEFAULT 0,
+        next_attempt_at TIMESTAMP NULL DEFAULT NULL,
+        last_status_code INT NOT NULL DEFAULT 0,
+        last_error TEXT,
+        last_response TEXT,
+        redelivery_of VARCHAR(64) NOT NULL DEFAULT '',
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        delivered_at TIMESTAMP NULL DEFAULT NULL,
+        INDEX idx_endpoint_created (endpoint_id, created_at),
+        INDEX idx_status_next_attempt (status, next_attempt_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+    `
+	if _, err := s.db.Exec(deliveries); err != nil {
+		return fmt.Errorf("failed to create webhook_deliveries table: %w", err)
+	}
+	return nil
+}
+
+const webhookEndpointColumns = `id, organizer_id, url, description, event_types, secret, enabled,
+    consecutive_failures, disabled_reason, disabled_at, created_at, updated_at`
+
+func scanWebhookEndpoint(row rowScanner) (*models.WebhookEndpoint, error) {
+	e := &models.WebhookEndpoint{}
+	var eventTypes sql.NullString
+	var disabledAt sql.NullTime
+	err := row.Scan(
+		&e.ID, &e.OrganizerID, &e.URL, &e.Description, &eventTypes, &e.Secret, &e.Enabled,
+		&e.ConsecutiveFailures, &e.DisabledReason, &disabledAt, &e.CreatedAt, &e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if eventTypes.Valid && eventTypes.String != "" && eventTypes.String != "null" {
+		if err := json.Unmarshal([]byte(eventTypes.String), &e.EventTypes); err != nil {
+			return nil, fmt.Errorf("failed to decode event types: %w", err)
+		}
+	}
+	if e.EventTypes == nil {
+		e.EventTypes = []string{}
+	}
+	e.DisabledAt = nullTime(disabledAt)
+	return e, nil
+}
+
+func (s *MySQLStore) SaveWebhookEndpoint(e *models.WebhookEndpoint) error {
+	s.log.LogDatabase("INSERT", "webhook_endpoints", fmt.Sprintf("Saving webhook endpoint %s", e.ID))
+
+	eventTypes, err := json.Marshal(e.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to encode event types: %w", err)
+	}
+
+	query := `
+    INSERT INTO webhook_endpoints (
+        id, organizer_id, url, description, event_types, secret, enabled,
+        consecutive_failures, disabled_reason, disabled_at, created_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err = s.db.Exec(query,
+		e.ID, e.OrganizerID, e.URL, e.Description, string(eventTypes), e.Secret, e.Enabled,
+		e.ConsecutiveFailures, e.DisabledReason, e.DisabledAt, e.CreatedAt, e.UpdatedAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save webhook endpoint %s: %s", e.ID, err.Error()))
+		return fmt.Errorf("failed to save webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) UpdateWebhookEndpoint(e *models.WebhookEndpoint) error {
+	s.log.LogDatabase("UPDATE", "webhook_endpoints", fmt.Sprintf("Updating webhook endpoint %s", e.ID))
+
+	eventTypes, err := json.Marshal(e.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to encode event types: %w", err)
+	}
+
+	query := `
+    UPDATE webhook_endpoints SET
+        url = ?, description = ?, event_types = ?, secret = ?, enabled = ?,
+        consecutive_failures = ?, disabled_reason = ?, disabled_at = ?, updated_at = ?
+    WHERE id = ?
+    `
+	_, err = s.db.Exec(query,
+		e.URL, e.Description, string(eventTypes), e.Secret, e.Enabled,
+		e.ConsecutiveFailures, e.DisabledReason, e.DisabledAt, e.UpdatedAt, e.ID,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update webhook endpoint %s: %s", e.ID, err.Error()))
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetWebhookEndpoint(id string) (*models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = ?`
+
+	e, err := scanWebhookEndpoint(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("webhook endpoint not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get webhook endpoint %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	return e, nil
+}
+
+func (s *MySQLStore) DeleteWebhookEndpoint(id string) error {
+	s.log.LogDatabase("DELETE", "webhook_endpoints", fmt.Sprintf("Deleting webhook endpoint %s", id))
+
+	if _, err := s.db.Exec(`DELETE FROM webhook_endpoints WHERE id = ?`, id); err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to delete webhook endpoint %s: %s", id, err.Error()))
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookEndpoints lists endpoints oldest first. An empty organizerID
+// lists every endpoint, including platform ones.
+func (s *MySQLStore) ListWebhookEndpoints(organizerID string) ([]*models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints`
+	var args []interface{}
+	if organizerID != "" {
+		query += ` WHERE organizer_id = ?`
+		args = append(args, organizerID)
+	}
+	query += ` ORDER BY created_at ASC`
+
+	return s.queryWebhookEndpoints(query, args...)
+}
+
+// ListEnabledWebhookEndpoints returns the enabled endpoints that receive an
+// organizer's events: its own and the platform endpoints.
+func (s *MySQLStore) ListEnabledWebhookEndpoints(organizerID string) ([]*models.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + `
+    FROM webhook_endpoints
+    WHERE enabled = TRUE AND (organizer_id = ? OR organizer_id = '')
+    `
+	return s.queryWebhookEndpoints(query, organizerID)
+}
+
+func (s *MySQLStore) queryWebhookEndpoints(query string, args ...interface{}) ([]*models.WebhookEndpoint, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list webhook endpoints: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []*models.WebhookEndpoint
+	for rows.Next() {
+		e, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, rows.Err()
+}
+
+const webhookDeliveryColumns = `id, endpoint_id, organizer_id, event_id, event_type, payload, status, attempts,
+    next_attempt_at, last_status_code, COALESCE(last_error, ''), COALESCE(last_response, ''), redelivery_of,
+    created_at, updated_at, delivered_at`
+
+func scanWebhookDelivery(row rowScanner) (*models.WebhookDelivery, error) {
+	d := &models.WebhookDelivery{}
+	var nextAttemptAt, deliveredAt sql.NullTime
+	err := row.Scan(
+		&d.ID, &d.EndpointID, &d.OrganizerID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts,
+		&nextAttemptAt, &d.LastStatusCode, &d.LastError, &d.LastResponse, &d.RedeliveryOf,
+		&d.CreatedAt, &d.UpdatedAt, &deliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d.NextAttemptAt = nullTime(nextAttemptAt)
+	d.DeliveredAt = nullTime(deliveredAt)
+	return d, nil
+}
+
+func (s *MySQLStore) SaveWebhookDelivery(d *models.WebhookDelivery) error {
+	s.log.LogDatabase("INSERT", "webhook_deliveries", fmt.Sprintf("Queueing %s delivery %s to endpoint %s", d.EventType, d.ID, d.EndpointID))
+
+	query := `
+    INSERT INTO webhook_deliveries (
+        id, endpoint_id, organizer_id, event_id, event_type, payload, status, attempts,
+        next_attempt_at, last_status_code, last_error, last_response, redelivery_of,
+        created_at, updated_at, delivered_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	_, err := s.db.Exec(query,
+		d.ID, d.EndpointID, d.OrganizerID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts,
+		d.NextAttemptAt, d.LastStatusCode, d.LastError, d.LastResponse, d.RedeliveryOf,
+		d.CreatedAt, d.UpdatedAt, d.DeliveredAt,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to save webhook delivery %s: %s", d.ID, err.Error()))
+		return fmt.Errorf("failed to save webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) UpdateWebhookDelivery(d *models.WebhookDelivery) error {
+	query := `
+    UPDATE webhook_deliveries SET
+        status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?,
+        last_response = ?, updated_at = ?, delivered_at = ?
+    WHERE id = ?
+    `
+	_, err := s.db.Exec(query,
+		d.Status, d.Attempts, d.NextAttemptAt, d.LastStatusCode, d.LastError,
+		d.LastResponse, d.UpdatedAt, d.DeliveredAt, d.ID,
+	)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to update webhook delivery %s: %s", d.ID, err.Error()))
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQLStore) GetWebhookDelivery(id string) (*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE id = ?`
+
+	d, err := scanWebhookDelivery(s.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("webhook delivery not found")
+		}
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to get webhook delivery %s: %s", id, err.Error()))
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return d, nil
+}
+
+// ListWebhookDeliveries lists an endpoint's deliveries, newest first.
+func (s *MySQLStore) ListWebhookDeliveries(endpointID string, limit, offset int) ([]*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + `
+    FROM webhook_deliveries
+    WHERE endpoint_id = ?
+    ORDER BY created_at DESC
+    LIMIT ? OFFSET ?
+    `
+	return s.queryWebhookDeliveries(query, endpointID, limit, offset)
+}
+
+// ListDueWebhookDeliveries returns pending deliveries whose next attempt is
+// due, oldest first.
+func (s *MySQLStore) ListDueWebhookDeliveries(before time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + `
+    FROM webhook_deliveries
+    WHERE status = ? AND next_attempt_at <= ?
+    ORDER BY next_attempt_at ASC
+    LIMIT ?
+    `
+	return s.queryWebhookDeliveries(query, models.DeliveryPending, before, limit)
+}
+
+// ClaimWebhookDelivery moves a due delivery's next attempt out to until, so
+// no other instance sends it meanwhile. It reports false when the delivery
+// is no longer pending at the attempt time that was read: another instance
+// claimed or finished it first.
+func (s *MySQLStore) ClaimWebhookDelivery(id string, due, until time.Time) (bool, error) {
+	result, err := s.db.Exec(`
+    UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = ?
+    WHERE id = ? AND status = ? AND next_attempt_at = ?
+    `, until, time.Now(), id, models.DeliveryPending, due)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to claim webhook delivery %s: %s", id, err.Error()))
+		return false, fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+	return affected == 1, nil
+}
+
+// ListFailedWebhookDeliveries lists deliveries that were given up after the
+// last retry, newest first.
+func (s *MySQLStore) ListFailedWebhookDeliveries(limit, offset int) ([]*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + `
+    FROM webhook_deliveries
+    WHERE status = ?
+    ORDER BY updated_at DESC
+    LIMIT ? OFFSET ?
+    `
+	return s.queryWebhookDeliveries(query, models.DeliveryFailed, limit, offset)
+}
+
+func (s *MySQLStore) queryWebhookDeliveries(query string, args ...interface{}) ([]*models.WebhookDelivery, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		s.log.Error("DATABASE", fmt.Sprintf("Failed to list webhook deliveries: %s", err.Error()))
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,53 @@
+package tenant
+
+import "context"
+
+// Principal identifies who is calling the API. Platform principals are
+// other Evently services and staff tooling; they may act on any organizer.
+// Support staff using the admin console are platform principals that also
+// carry their own name.
+type Principal struct {
+	OrganizerID string
+	Platform    bool
+	Staff       string
+}
+
+type contextKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the principal stored by the auth middleware.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(Principal)
+	return p, ok
+}
+
+// CanAccess reports whether the caller may see data owned by organizerID.
+// Contexts without a principal come from internal jobs (sweeper, Kafka
+// consumer) and are trusted.
+func CanAccess(ctx context.Context, organizerID string) bool {
+	p, ok := FromContext(ctx)
+	if !ok || p.Platform {
+		return true
+	}
+	return p.OrganizerID != "" && p.OrganizerID == organizerID
+}
+
+// Scope returns the organizer the caller is restricted to, or "" when the
+// caller may see every tenant.
+func Scope(ctx context.Context) string {
+	p, ok := FromContext(ctx)
+	if !ok || p.Platform {
+		return ""
+	}
+	return p.OrganizerID
+}
+
+// IsPlatform reports whether the caller is a platform principal.
+func IsPlatform(ctx context.Context) bool {
+	p, ok := FromContext(ctx)
+	return !ok || p.Platform
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"payment-gateway/internal/card"
+)
+
+// panCandidate matches runs of 13-19 digits, optionally grouped with spaces
+// or dashes the way card numbers are usually written.
+var panCandidate = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+
+// ContainsPAN reports whether s contains something that looks like a card
+// number: 13-19 digits that pass the Luhn check.
+func ContainsPAN(s string) bool {
+	for _, match := range panCandidate.FindAllString(s, -1) {
+		if card.Luhn(digitsOnly(match)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactPAN masks every card-number-like sequence in s, keeping the last
+// four digits so support can still match a card to a customer.
+func RedactPAN(s string) string {
+	return panCandidate.ReplaceAllStringFunc(s, func(match string) string {
+		digits := digitsOnly(match)
+		if !card.Luhn(digits) {
+			return match
+		}
+		return "****" + digits[len(digits)-4:]
+	})
+}
+
+// IsCardToken reports whether id is a Stripe token or PaymentMethod ID, the
+// only card references accepted outside test mode.
+func IsCardToken(id string) bool {
+	return strings.HasPrefix(id, "pm_") || strings.HasPrefix(id, "tok_")
+}
+
+func digitsOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
@@ -18,3 +18,71 @@ func GenerateTransactionID() string {
 	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999999))
 	return fmt.Sprintf("txn_%d_%09d", timestamp, randomNum.Int64())
 }
+
+func GenerateReconciliationID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("rec_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateJournalEntryID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999999))
+	return fmt.Sprintf("je_%d_%09d", timestamp, randomNum.Int64())
+}
+
+func GenerateOrganizerID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("org_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GeneratePayoutID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("po_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateRefundID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("rf_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateLineItemID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999999))
+	return fmt.Sprintf("li_%d_%09d", timestamp, randomNum.Int64())
+}
+
+func GenerateWebhookEndpointID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("we_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateWebhookDeliveryID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("whd_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateEventID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999))
+	return fmt.Sprintf("ev_%d_%06d", timestamp, randomNum.Int64())
+}
+
+func GenerateRequestID() string {
+	timestamp := time.Now().Unix()
+	randomNum, _ := rand.Int(rand.Reader, big.NewInt(999999999))
+	return fmt.Sprintf("req_%d_%09d", timestamp, randomNum.Int64())
+}
+
+// GenerateCheckoutSessionID returns an unguessable ID, since the ID is all
+// an attendee needs to open and pay a checkout link
+func GenerateCheckoutSessionID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return fmt.Sprintf("cs_%x", buf)
+}
@@ -6,7 +6,6 @@ type APIResponse struct {
 	Success   bool        `json:"success"`
 	Message   string      `json:"message"`
 	Data      interface{} `json:"data,omitempty"`
-	Error     string      `json:"error,omitempty"`
 	Timestamp time.Time   `json:"timestamp"`
 }
 
@@ -18,12 +17,3 @@ func SuccessResponse(message string, data interface{}) APIResponse {
 		Timestamp: time.Now(),
 	}
 }
-
-func ErrorResponse(message, error string) APIResponse {
-	return APIResponse{
-		Success:   false,
-		Message:   message,
-		Error:     error,
-		Timestamp: time.Now(),
-	}
-}
@@ -2,26 +2,32 @@ package main
 
 import (
 	"context"
+	"expvar"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
 	"github.com/stripe/stripe-go/v82"
 
+	"payment-gateway/api"
+	"payment-gateway/internal/captcha"
 	"payment-gateway/internal/config"
+	"payment-gateway/internal/gateway"
 	"payment-gateway/internal/handlers"
 	"payment-gateway/internal/kafka"
 	"payment-gateway/internal/logger"
 	"payment-gateway/internal/middleware"
-	rediswrap "payment-gateway/internal/redis"
+	"payment-gateway/internal/rpc"
 	"payment-gateway/internal/services"
 	"payment-gateway/internal/storage"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 // Global logger instance
@@ -68,12 +74,6 @@ func main() {
 	}
 	defer kafkaConsumer.Close()
 	log.LogKafka("INIT", "consumer", "Kafka consumer initialized successfully")
-	redisAddr := os.Getenv("REDIS_ADDR")
-	redisClient := redis.NewClient(&redis.Options{
-		Addr: redisAddr,
-	})
-
-	log.LogProcess("SERVICE", " Redis connection successful")
 
 	// Initialize Stripe with API key
 	stripeKey := os.Getenv("STRIPE_SECRET_KEY")
@@ -86,34 +86,128 @@ func main() {
 		log.LogProcess("STRIPE", "Stripe API initialized with key")
 	}
 
+	// Raw card numbers may only ever be sent to Stripe's test environment
+	if cfg.Cards.RawCardTestMode {
+		if strings.HasPrefix(stripeKey, "sk_test_") {
+			log.Warn("STRIPE", "Raw card test mode is on; card numbers are accepted in request bodies")
+		} else {
+			log.Error("STRIPE", "STRIPE_RAW_CARD_TEST_MODE needs a Stripe test key; raw card data stays disabled")
+			cfg.Cards.RawCardTestMode = false
+		}
+	}
+
 	// Initialize services
-	paymentService := services.NewPaymentService(store, kafkaProducer, log, rediswrap.NewRedis(redisClient))
-	log.LogProcess("SERVICE", "Payment service initialized")
+	core, err := gateway.New(cfg, store, kafkaProducer, log)
+	if err != nil {
+		log.Fatal("SERVICE", "Failed to initialize services: "+err.Error())
+	}
+	log.LogProcess("SERVICE", "Ledger, payment, risk and event services initialized")
 
-	// Initialize Stripe service
-	stripeService, err := services.NewStripeService(log)
+	backed, err := core.WithStripe()
 	if err != nil {
-		log.Fatal("STRIPE", "Failed to initialize Stripe service: "+err.Error())
+		log.Fatal("STRIPE", err.Error())
 	}
-	log.LogProcess("SERVICE", "Stripe service initialized")
+	log.LogProcess("SERVICE", "Stripe, payout, dispute, checkout and reconciliation services initialized")
+
+	// Initialize card-testing protection for the checkout card checks
+	if cfg.CardTests.Enabled && (cfg.CardTests.CaptchaVerifyURL == "" || cfg.CardTests.CaptchaSecret == "") {
+		log.Warn("CARD_TESTING", "CAPTCHA_VERIFY_URL or CAPTCHA_SECRET not set; callers over the card check limits cannot continue")
+	}
+	captchaVerifier := captcha.NewSiteVerifier(cfg.CardTests.CaptchaVerifyURL, cfg.CardTests.CaptchaSecret)
+	cardTestingService := services.NewCardTestingService(core.Redis, captchaVerifier, core.Audit, cfg.CardTests, log)
+	log.LogProcess("SERVICE", "Card testing guard initialized")
 
 	// Initialize handlers
-	paymentHandler := handlers.NewPaymentHandler(paymentService)
-	stripeHandler := handlers.NewStripeHandler(stripeService, paymentService)
+	routes := routeHandlers{
+		payment:        handlers.NewPaymentHandler(core.Payments),
+		stripe:         handlers.NewStripeHandler(backed.Stripe, core.Payments, backed.Disputes, backed.Customers, cardTestingService),
+		reconciliation: handlers.NewReconciliationHandler(backed.Reconciliation),
+		ledger:         handlers.NewLedgerHandler(core.Ledger),
+		organizer:      handlers.NewOrganizerHandler(core.Organizers, core.Payments),
+		fees:           handlers.NewFeeHandler(core.Fees, core.Payments),
+		payouts:        handlers.NewPayoutHandler(backed.Payouts),
+		disputes:       handlers.NewDisputeHandler(backed.Disputes),
+		webhooks:       handlers.NewWebhookHandler(core.Webhooks),
+		events:         handlers.NewEventHandler(core.Events),
+		streams:        handlers.NewStreamHandler(core.Events, cfg.Streams),
+		checkout:       handlers.NewCheckoutHandler(backed.Checkout, cardTestingService),
+		audit:          handlers.NewAuditHandler(core.Audit),
+		risk:           handlers.NewRiskHandler(core.Risk, backed.Reviews, cardTestingService),
+		customers:      handlers.NewCustomerHandler(backed.Customers),
+		admin:          handlers.NewAdminHandler(backed.Admin),
+	}
 	log.LogProcess("HANDLER", "All handlers initialized")
 
 	// Start Kafka consumer in background
 	go func() {
 		log.LogKafka("START", "consumer", "Starting Kafka consumer goroutine")
-		if err := kafkaConsumer.ConsumePayments(context.Background(), paymentService.ProcessPaymentEvent); err != nil {
+		if err := kafkaConsumer.ConsumePayments(context.Background(), core.Payments.ProcessPaymentEvent); err != nil {
 			log.Error("KAFKA", "Consumer error: "+err.Error())
 		}
 	}()
 
+	// Start background jobs (events from other instances, pending payment
+	// sweeper, daily reconciliation, payouts, dispute deadlines, webhook
+	// deliveries)
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go core.Bus.Run(backgroundCtx)
+	if cfg.Sweeper.Enabled {
+		sweeper := services.NewPaymentSweeper(core.Payments, backed.Stripe, cfg.Sweeper, log)
+		go sweeper.Start(backgroundCtx)
+	}
+	if cfg.Reconcile.Enabled {
+		go backed.Reconciliation.StartDaily(backgroundCtx)
+	}
+	if cfg.Payouts.Enabled {
+		go backed.Payouts.Start(backgroundCtx)
+	}
+	go backed.Disputes.Start(backgroundCtx)
+	if cfg.Webhooks.Enabled {
+		go core.Webhooks.Start(backgroundCtx)
+	}
+
 	// Setup router
-	router := setupRouter(paymentHandler, stripeHandler)
+	if !cfg.Auth.Enabled {
+		log.Warn("AUTH", "API authentication is disabled; all callers are treated as platform")
+	}
+	if cfg.Auth.Enabled && len(cfg.Auth.SupportAPIKeys) == 0 {
+		log.Warn("AUTH", "SUPPORT_API_KEYS not set; the admin console API is closed")
+	}
+	contractDoc, err := api.Load()
+	if err != nil {
+		log.Fatal("OPENAPI", "Failed to load the API contract: "+err.Error())
+	}
+	contract, err := middleware.ValidateOpenAPI(contractDoc, cfg.OpenAPI, log)
+	if err != nil {
+		log.Fatal("OPENAPI", "Failed to set up contract validation: "+err.Error())
+	}
+	if cfg.OpenAPI.Validation != "enforce" {
+		log.Warn("OPENAPI", "Requests that break the API contract are not rejected")
+	}
+	router := setupRouter(routes, contract, middleware.Authenticate(core.Organizers, cfg.Auth, log), middleware.AuthenticateSupport(cfg.Auth, log),
+		middleware.CardDataGuard(cfg.Cards, log), middleware.BlockBannedIPs(cardTestingService, log))
 	log.LogProcess("ROUTER", "HTTP router configured")
 
+	// gRPC API for other Evently services, sharing the services above
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcMetrics := rpc.NewMetrics()
+		expvar.Publish("grpc", expvar.Func(func() interface{} { return grpcMetrics.Snapshot() }))
+		grpcServer = rpc.NewServer(core.Payments, core.Events, core.Organizers, grpcMetrics, cfg.GRPC, cfg.Auth, log)
+
+		listener, err := net.Listen("tcp", cfg.GRPC.Port)
+		if err != nil {
+			log.Fatal("GRPC", "Failed to listen on "+cfg.GRPC.Port+": "+err.Error())
+		}
+		go func() {
+			log.LogProcess("GRPC", "Starting gRPC server on port "+cfg.GRPC.Port)
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Error("GRPC", "gRPC server stopped: "+err.Error())
+			}
+		}()
+	}
+
 	// Create server
 	srv := &http.Server{
 		Addr:         ":8085",
@@ -141,11 +235,26 @@ func main() {
 	<-quit
 
 	log.Warn("SHUTDOWN", "Received shutdown signal, initiating graceful shutdown...")
+	stopBackground()
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if grpcServer != nil {
+		// Open WatchPayment streams would hold GracefulStop until they end
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(10 * time.Second):
+			grpcServer.Stop()
+		}
+	}
+
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatal("SHUTDOWN", "Server forced to shutdown: "+err.Error())
 	}
@@ -153,14 +262,36 @@ func main() {
 	log.Info("SHUTDOWN", "✅ Payment Gateway shutdown completed successfully")
 }
 
-func setupRouter(paymentHandler *handlers.PaymentHandler, stripeHandler *handlers.StripeHandler) *gin.Engine {
+// routeHandlers groups the HTTP handlers wired into the router
+type routeHandlers struct {
+	payment        *handlers.PaymentHandler
+	stripe         *handlers.StripeHandler
+	reconciliation *handlers.ReconciliationHandler
+	ledger         *handlers.LedgerHandler
+	organizer      *handlers.OrganizerHandler
+	fees           *handlers.FeeHandler
+	payouts        *handlers.PayoutHandler
+	disputes       *handlers.DisputeHandler
+	customers      *handlers.CustomerHandler
+	webhooks       *handlers.WebhookHandler
+	events         *handlers.EventHandler
+	streams        *handlers.StreamHandler
+	checkout       *handlers.CheckoutHandler
+	audit          *handlers.AuditHandler
+	risk           *handlers.RiskHandler
+	admin          *handlers.AdminHandler
+}
+
+func setupRouter(h routeHandlers, contract, auth, supportAuth, cardGuard, banGuard gin.HandlerFunc) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 
 	router.Use(middleware.EnhancedLogger(log))
 	router.Use(middleware.Recovery(log))
+	router.Use(middleware.RequestSource())
 	router.Use(middleware.CORS())
 	router.Use(middleware.RateLimit(log))
+	router.Use(contract)
 
 	// Health check
 	router.GET("/health", func(c *gin.Context) {
@@ -176,25 +307,197 @@ func setupRouter(paymentHandler *handlers.PaymentHandler, stripeHandler *handler
 	// API routes
 	v1 := router.Group("/api/v1")
 	{
-		payments := v1.Group("/payments")
+		// The OpenAPI contract the typed client is generated from
+		v1.GET("/openapi.yaml", func(c *gin.Context) {
+			c.Data(http.StatusOK, "application/yaml", api.Spec())
+		})
+
+		// Stripe signs the raw webhook body, so it must reach the handler untouched
+		v1.POST("/stripe/webhook", h.stripe.HandleStripeWebhook)
+
+		// Checkout endpoints called directly by attendees' browsers. IPs
+		// banned for card testing are refused before anything else.
+		checkout := v1.Group("", banGuard, cardGuard)
+		checkout.POST("/payments/OTP", h.payment.OTP)
+		checkout.POST("/payments/validate", h.payment.ValidateOTP)
+		checkout.POST("/stripe/validate-card", h.stripe.ValidateCard)
+		checkout.GET("/checkout/pay/:id", h.checkout.GetPage)
+		checkout.POST("/checkout/pay/:id", h.checkout.Pay)
+		checkout.POST("/checkout/pay/:id/confirm", h.checkout.Confirm)
+
+		// Status streams, opened by browsers with a stream token or by
+		// backends with an API key
+		v1.GET("/payments/:id/stream", h.streams.Authorize(handlers.StreamKindPayment, auth), h.streams.StreamPayment) // SSE, or WebSocket on upgrade
+		v1.GET("/orders/:id/stream", h.streams.Authorize(handlers.StreamKindOrder, auth), h.streams.StreamOrder)
+
+		// Everything else requires an organizer or platform API key
+		api := v1.Group("", auth, cardGuard)
+
+		payments := api.Group("/payments")
+		{
+			payments.POST("/process", h.payment.ProcessPayment)
+			payments.GET("/:id", h.payment.GetPayment)
+			payments.GET("/:id/status", h.payment.GetPaymentStatus)
+			payments.POST("/:id/refund", h.payment.RefundPayment)
+			payments.GET("/:id/refunds", h.payment.ListRefunds)
+			payments.GET("/:id/timeline", h.events.Timeline)
+			payments.POST("/:id/stream-token", h.streams.IssuePaymentToken)
+			payments.GET("/:id/fees", h.fees.GetPaymentFees)
+		}
+
+		// Hosted checkout sessions and payment links, paid on the routes above
+		sessions := api.Group("/checkout/sessions")
 		{
-			payments.POST("/process", paymentHandler.ProcessPayment)
-			payments.GET("/:id", paymentHandler.GetPayment)
-			payments.GET("/:id/status", paymentHandler.GetPaymentStatus)
-			payments.POST("/:id/refund", paymentHandler.RefundPayment)
-			payments.POST("/OTP", paymentHandler.OTP)
-			payments.POST("/validate", paymentHandler.ValidateOTP)
+			sessions.POST("", h.checkout.CreateSession)
+			sessions.GET("", h.checkout.ListSessions)
+			sessions.GET("/:id", h.checkout.GetSession)
+			sessions.POST("/:id/cancel", h.checkout.CancelSession)
+		}
+
+		// Orders, followed by checkout pages that know only the order ID
+		orders := api.Group("/orders")
+		{
+			orders.POST("/:id/stream-token", h.streams.IssueOrderToken)
 		}
 
 		// Stripe-specific routes
-		stripe := v1.Group("/stripe")
+		stripe := api.Group("/stripe")
+		{
+			stripe.POST("/payment", h.stripe.ProcessPayment)
+			stripe.POST("/refund", h.stripe.RefundPayment)
+			stripe.GET("/payment/:id", h.stripe.GetPaymentDetails)
+			stripe.POST("/payment/:id/confirm", h.stripe.ConfirmPayment)
+		}
+
+		// Organizer (merchant) accounts
+		organizers := api.Group("/organizers")
+		{
+			organizers.POST("", h.organizer.CreateOrganizer)
+			organizers.GET("/:id", h.organizer.GetOrganizer)
+			organizers.PATCH("/:id", h.organizer.UpdateOrganizer)
+			organizers.POST("/:id/api-key", h.organizer.RotateAPIKey)
+			organizers.GET("/:id/payments", h.organizer.ListPayments)
+			organizers.GET("/:id/balances", h.ledger.GetOrganizerBalances)
+			organizers.GET("/:id/payouts", h.payouts.ListPayouts)
+		}
+
+		// Organizer payouts and settlement statements
+		payouts := api.Group("/payouts")
+		{
+			payouts.GET("/:id", h.payouts.GetPayout)
+			payouts.GET("/:id/statement", h.payouts.GetStatement)
+			payouts.GET("/:id/statement/export", h.payouts.ExportStatement)
+
+			platformPayouts := payouts.Group("", middleware.RequirePlatform())
+			platformPayouts.POST("/run", h.payouts.RunPayouts)
+			platformPayouts.GET("/bank-export", h.payouts.BankExport)
+			platformPayouts.POST("/:id/confirm", h.payouts.ConfirmPayout)
+			platformPayouts.POST("/:id/fail", h.payouts.FailPayout)
+		}
+
+		// Platform commission plans
+		feePlans := api.Group("/fee-plans", middleware.RequirePlatform())
+		{
+			feePlans.POST("", h.fees.SavePlan)
+			feePlans.GET("", h.fees.ListPlans)
+			feePlans.GET("/:id", h.fees.GetPlan)
+			feePlans.PUT("/:id", h.fees.SavePlan)
+		}
+
+		// Chargebacks raised against organizers' payments
+		disputes := api.Group("/disputes")
+		{
+			disputes.GET("", h.disputes.ListDisputes)
+			disputes.GET("/:id", h.disputes.GetDispute)
+			disputes.POST("/:id/evidence", h.disputes.SubmitEvidence)
+		}
+
+		// Outbound webhooks to organizer and partner endpoints
+		webhooks := api.Group("/webhooks")
+		{
+			webhooks.POST("/endpoints", h.webhooks.CreateEndpoint)
+			webhooks.GET("/endpoints", h.webhooks.ListEndpoints)
+			webhooks.GET("/endpoints/:id", h.webhooks.GetEndpoint)
+			webhooks.PATCH("/endpoints/:id", h.webhooks.UpdateEndpoint)
+			webhooks.DELETE("/endpoints/:id", h.webhooks.DeleteEndpoint)
+			webhooks.POST("/endpoints/:id/rotate-secret", h.webhooks.RotateSecret)
+			webhooks.GET("/endpoints/:id/deliveries", h.webhooks.ListDeliveries)
+			webhooks.GET("/deliveries/:id", h.webhooks.GetDelivery)
+			webhooks.POST("/deliveries/:id/redeliver", h.webhooks.Redeliver)
+		}
+
+		// Payment event log and replay to Kafka
+		events := api.Group("/events", middleware.RequirePlatform())
+		{
+			events.GET("", h.events.ListEvents)
+			events.POST("/replay", h.events.Replay)
+		}
+
+		// Append-only audit log of money-moving and admin actions
+		auditLog := api.Group("/audit", middleware.RequirePlatform())
+		{
+			auditLog.GET("", h.audit.ListEntries)
+			auditLog.GET("/export", h.audit.Export)
+			auditLog.GET("/verify", h.audit.Verify)
+		}
+
+		// Fraud scoring rules and the queue of payments held for review
+		riskGroup := api.Group("/risk", middleware.RequirePlatform())
+		{
+			riskGroup.GET("/rules", h.risk.GetRules)
+			riskGroup.GET("/reviews", h.risk.ListReviews)
+			riskGroup.GET("/reviews/:id", h.risk.GetReview)
+			riskGroup.POST("/reviews/:id/approve", h.risk.ApproveReview)
+			riskGroup.POST("/reviews/:id/reject", h.risk.RejectReview)
+			riskGroup.GET("/ip-bans", h.risk.ListBans)
+			riskGroup.POST("/ip-bans", h.risk.BanIP)
+			riskGroup.DELETE("/ip-bans/:ip", h.risk.UnbanIP)
+		}
+
+		// Attendees' Stripe Customers and saved cards, managed by Evently's
+		// checkout on the attendee's behalf
+		customers := api.Group("/customers", middleware.RequirePlatform())
 		{
-			stripe.POST("/validate-card", stripeHandler.ValidateCard)
-			stripe.POST("/payment", stripeHandler.ProcessPayment)
-			stripe.POST("/refund", stripeHandler.RefundPayment)
-			stripe.GET("/payment/:id", stripeHandler.GetPaymentDetails)
-			stripe.POST("/webhook", stripeHandler.HandleStripeWebhook)
+			customers.POST("", h.customers.EnsureCustomer)
+			customers.GET("/:id", h.customers.GetCustomer)
+			customers.POST("/:id/setup-intents", h.customers.CreateSetupIntent)
+			customers.GET("/:id/payment-methods", h.customers.ListPaymentMethods)
+			customers.POST("/:id/payment-methods/:pm/default", h.customers.SetDefaultPaymentMethod)
+			customers.DELETE("/:id/payment-methods/:pm", h.customers.DeletePaymentMethod)
 		}
+
+		// Stripe-to-ledger reconciliation
+		reconciliation := api.Group("/reconciliation", middleware.RequirePlatform())
+		{
+			reconciliation.POST("/runs", h.reconciliation.StartRun)
+			reconciliation.GET("/runs", h.reconciliation.ListRuns)
+			reconciliation.GET("/runs/:id", h.reconciliation.GetRun)
+			reconciliation.GET("/runs/:id/export", h.reconciliation.ExportRun)
+		}
+
+		// Process metrics, including per-method gRPC call counts
+		api.GET("/debug/vars", middleware.RequirePlatform(), gin.WrapH(expvar.Handler()))
+
+		// Double-entry ledger
+		ledgerRoutes := api.Group("/ledger", middleware.RequirePlatform())
+		{
+			ledgerRoutes.GET("/balances", h.ledger.GetBalances)
+			ledgerRoutes.GET("/organizers/:id/balances", h.ledger.GetOrganizerBalances)
+			ledgerRoutes.GET("/payments/:id/entries", h.ledger.GetPaymentEntries)
+			ledgerRoutes.GET("/invariants", h.ledger.CheckInvariants)
+		}
+	}
+
+	// Admin console for support staff, open to support keys only
+	admin := router.Group("/admin/v1", supportAuth, cardGuard)
+	{
+		admin.GET("/payments", h.admin.LookupPayments)
+		admin.GET("/payments/:id/timeline", h.admin.GetTimeline)
+		admin.POST("/payments/:id/status", h.admin.OverrideStatus)
+		admin.POST("/payments/:id/refund", h.admin.ForceRefund)
+		admin.POST("/payments/:id/otp/reset", h.admin.ResetOTP)
+		admin.POST("/payments/:id/otp/resend", h.admin.ResendOTP)
+		admin.POST("/payments/:id/resync", h.admin.Resync)
 	}
 
 	log.LogProcess("ROUTER", "All routes registered successfully")
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"payment-gateway/api"
+	"payment-gateway/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ginParam = regexp.MustCompile(`:([A-Za-z_]+)`)
+
+// TestRoutesMatchContract fails when a route is served without being in
+// api/openapi.yaml, or the document describes a route that is not served
+func TestRoutesMatchContract(t *testing.T) {
+	log = logger.NewFileLogger()
+	doc, err := api.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	noop := func(c *gin.Context) { c.Next() }
+	router := setupRouter(routeHandlers{}, noop, noop, noop, noop, noop)
+
+	served := make(map[string]bool)
+	for _, route := range router.Routes() {
+		path := ginParam.ReplaceAllString(route.Path, "{$1}")
+		served[route.Method+" "+path] = true
+		item := doc.Paths.Value(path)
+		if item == nil || item.GetOperation(route.Method) == nil {
+			t.Errorf("%s %s is not in api/openapi.yaml", route.Method, path)
+		}
+	}
+
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			if !served[method+" "+path] {
+				t.Errorf("api/openapi.yaml describes %s %s, which is not served", method, path)
+			}
+		}
+	}
+}